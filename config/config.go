@@ -2,10 +2,12 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/bmizerany/lpx"
 )
@@ -15,6 +17,42 @@ type Config struct {
 	HerokuLogStream chan HerokuLogStreamItem
 
 	Servers []ServerConfig
+
+	ControlServer ControlServerConfig
+
+	// Limits the upload rate of snapshots and log files, shared across all servers
+	// (upload_max_bytes_per_sec in the [pganalyze] section). Disabled when 0.
+	UploadMaxBytesPerSec int
+
+	// Keeps recent activity snapshots in memory for this many minutes, up to a
+	// total size of ActivityHistoryMaxBytes, to be dumped through the control
+	// server (activity_history_minutes and activity_history_max_bytes in the
+	// [pganalyze] section). Disabled when 0.
+	ActivityHistoryMinutes  int
+	ActivityHistoryMaxBytes int
+
+	// How long (in seconds) in-flight submissions may continue when the collector
+	// is asked to exit, before they get cancelled (shutdown_grace_period in the
+	// [pganalyze] section). 0 cancels them right away, and a negative value (the
+	// default when not set) uses 10 seconds.
+	ShutdownGracePeriod int
+
+	// Config sections that generate servers through service discovery
+	discoveryTemplates []discoveryTemplate
+}
+
+// ControlServerConfig - Settings for the local HTTP server the collector exposes
+// for debugging (e.g. Go's pprof endpoints), configured in the [pganalyze] section
+type ControlServerConfig struct {
+	// Address to listen on (e.g. 127.0.0.1:9919), disabled when empty. Without
+	// TLS and a client CA this has to be a loopback address.
+	ListenAddress string `ini:"control_listen_address"`
+
+	// Serves the endpoints over TLS using the given certificate and key. When a
+	// client CA is set as well, clients have to present a certificate signed by it.
+	TLSCertFile     string `ini:"control_tls_cert_file"`
+	TLSKeyFile      string `ini:"control_tls_key_file"`
+	TLSClientCAFile string `ini:"control_tls_client_ca_file"`
 }
 
 type HerokuLogStreamItem struct {
@@ -39,6 +77,15 @@ type ServerConfig struct {
 	APIKey     string `ini:"api_key"`
 	APIBaseURL string `ini:"api_base_url"`
 
+	// Restricts the TLS connection to the pganalyze API to the given minimum
+	// version (1.0/1.1/1.2/1.3) and cipher suites (comma separated, using the
+	// names from Go's crypto/tls package, e.g. TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256)
+	//
+	// Only ECDHE suites with GCM or ChaCha20-Poly1305 are accepted. Note that cipher
+	// suites are not configurable for TLS 1.3 connections
+	APIMinTLSVersion string `ini:"api_min_tls_version"`
+	APICipherSuites  string `ini:"api_cipher_suites"`
+
 	ErrorCallback   string `ini:"error_callback"`
 	SuccessCallback string `ini:"success_callback"`
 
@@ -47,6 +94,15 @@ type ServerConfig struct {
 	DisableActivity  bool `ini:"disable_activity"`
 	EnableLogExplain bool `ini:"enable_log_explain"`
 
+	// Calculates how many bytes each table and index grew since the previous
+	// collection, based on the sizes remembered in the state file
+	EnableSizeGrowth bool `ini:"enable_size_growth"`
+
+	// Collects which tables and indices use the most shared buffers, using the
+	// pg_buffercache extension. Since scanning shared buffers is expensive on
+	// large instances this only runs with the full snapshot (every 10 minutes)
+	EnableBufferCacheStats bool `ini:"enable_buffer_cache_stats"`
+
 	DbURL                 string `ini:"db_url"`
 	DbName                string `ini:"db_name"`
 	DbUsername            string `ini:"db_username"`
@@ -57,6 +113,79 @@ type ServerConfig struct {
 	DbSslRootCert         string `ini:"db_sslrootcert"`
 	DbSslRootCertContents string `ini:"db_sslrootcert_contents"`
 
+	// Hostname to verify the server certificate against (and send as SNI), when
+	// it differs from the address the collector connects to, e.g. when going
+	// through a load balancer. Only meaningful with db_sslmode verify-ca or
+	// verify-full
+	DbSslServerName string `ini:"db_sslservername"`
+
+	// Timeout (in seconds) for each attempt to connect to the database, and how
+	// often to retry with a backoff when connecting fails with a transient error
+	// (e.g. the database is still starting up, or DNS didn't resolve yet)
+	//
+	// Defaults to a 10 second timeout and 3 retries
+	DbConnectTimeout int `ini:"db_connect_timeout"`
+	DbConnectRetries int `ini:"db_connect_retries"`
+
+	// Interval (in seconds) of TCP keepalive probes on database connections, so
+	// that connections to a host that went away (e.g. due to a network partition)
+	// fail promptly instead of hanging until the OS gives up on them
+	//
+	// Defaults to 15 seconds, set to -1 to disable keepalives
+	DbKeepaliveInterval int `ini:"db_keepalive_interval"`
+
+	// Maximum age (in seconds) of a database connection before it gets replaced
+	// by a new one. Connections are also checked (and replaced if broken) before
+	// continuing a full snapshot after the schema collection.
+	//
+	// Defaults to 30 seconds
+	DbConnMaxLifetime int `ini:"db_conn_max_lifetime"`
+
+	// How often to retry the whole collection of a full snapshot when it fails
+	// with a transient database error (e.g. a serialization failure, or too many
+	// connections) - other errors, such as missing permissions, are not retried.
+	//
+	// Defaults to 1 retry, set to 0 to disable retries
+	CollectionRetries int `ini:"collection_retries"`
+
+	// Waits a random delay of up to this many seconds before first connecting to
+	// each database after the collector starts, to spread out the connection load
+	// when many collectors restart at once (e.g. all sharing a PgBouncer)
+	//
+	// Disabled by default (0)
+	DbConnectJitter int `ini:"db_connect_jitter"`
+
+	// Connects to Postgres through an SSH tunnel via the given bastion host
+	// (host or host:port), using public key authentication. db_host/db_port
+	// are then resolved from the bastion host, not the collector.
+	//
+	// The bastion's host key is verified against ssh_tunnel_known_hosts_file,
+	// which defaults to ~/.ssh/known_hosts
+	SSHTunnelHost           string `ini:"ssh_tunnel_host"`
+	SSHTunnelUser           string `ini:"ssh_tunnel_user"`
+	SSHTunnelKeyFile        string `ini:"ssh_tunnel_key_file"`
+	SSHTunnelKnownHostsFile string `ini:"ssh_tunnel_known_hosts_file"`
+
+	// Local port the SSH tunnel is listening on, set once the tunnel is running
+	SSHTunnelLocalPort int
+
+	// Generates one server per database endpoint found through service
+	// discovery, instead of connecting to db_host/db_port directly - all other
+	// settings of the section (e.g. credentials) are used as a template.
+	//
+	// discover_dns_srv takes a DNS SRV record name (e.g. _postgres._tcp.db.example.com),
+	// discover_consul_service a Consul service name, looked up in the catalog at
+	// discover_consul_url (defaults to http://127.0.0.1:8500) and optionally
+	// filtered by discover_consul_tag.
+	//
+	// Discovery is re-run every discover_interval seconds (defaults to 300),
+	// and the configuration reloaded when databases appear or disappear
+	DiscoverDNSSRV        string `ini:"discover_dns_srv"`
+	DiscoverConsulService string `ini:"discover_consul_service"`
+	DiscoverConsulTag     string `ini:"discover_consul_tag"`
+	DiscoverConsulURL     string `ini:"discover_consul_url"`
+	DiscoverInterval      int    `ini:"discover_interval"`
+
 	// We have to do some tricks to support sslmode=prefer, namely we have to
 	// first try an SSL connection (= require), and if that fails change the
 	// sslmode to none
@@ -65,6 +194,14 @@ type ServerConfig struct {
 	DbExtraNames []string // Additional databases that should be fetched (determined by additional databases in db_name)
 	DbAllNames   bool     // All databases except template databases should be fetched (determined by * in the db_name list)
 
+	// Restricts which databases are collected when db_name includes "*", using
+	// comma-separated patterns matched against the database name (with Golang's
+	// filepath.Match function, so e.g. "archive_*" works). Databases larger than
+	// db_max_size_mb are skipped as well, unless it is 0 (the default).
+	DbIncludePattern string `ini:"db_include_pattern"`
+	DbExcludePattern string `ini:"db_exclude_pattern"`
+	DbMaxSizeMB      int    `ini:"db_max_size_mb"`
+
 	AwsRegion          string `ini:"aws_region"`
 	AwsDbInstanceID    string `ini:"aws_db_instance_id"`
 	AwsAccessKeyID     string `ini:"aws_access_key_id"`
@@ -100,11 +237,50 @@ type ServerConfig struct {
 	// development and debugging. The value needs to be the name of the container.
 	LogDockerTail string `ini:"db_log_docker_tail"`
 
+	// Maximum length of a single log line (in bytes) - anything beyond that is
+	// truncated, to avoid excessive memory use for very long log lines (e.g. a
+	// query logged with a very large bind parameter). Set to 0 to disable.
+	//
+	// Defaults to 1 MB
+	MaxLogLineLength int `ini:"max_log_line_length"`
+
+	// Directory used to keep full snapshots that failed to submit (e.g. during a
+	// network outage), which get re-submitted with their original collection
+	// time once the pganalyze API is reachable again. Disabled when empty.
+	//
+	// The oldest snapshots get dropped once there are more than
+	// snapshot_buffer_max_count snapshots (default 144, i.e. one day), or once
+	// they use more than snapshot_buffer_max_size_mb (default 100 MB). Snapshots
+	// older than snapshot_buffer_max_age_hours (default 24) are not re-submitted.
+	SnapshotBufferDir         string `ini:"snapshot_buffer_dir"`
+	SnapshotBufferMaxCount    int    `ini:"snapshot_buffer_max_count"`
+	SnapshotBufferMaxSizeMB   int    `ini:"snapshot_buffer_max_size_mb"`
+	SnapshotBufferMaxAgeHours int    `ini:"snapshot_buffer_max_age_hours"`
+
 	// Specifies a table pattern to ignore - no statistics will be collected for
 	// tables that match the name. This uses Golang's filepath.Match function for
 	// comparison, so you can e.g. use "*" for wildcard matching.
 	IgnoreTablePattern string `ini:"ignore_table_pattern"`
 
+	// Table that records materialized view refreshes (schema-qualified name, looked
+	// up in each monitored database), used to report when each materialized view was
+	// last refreshed. It needs a "matview" column (the view name, optionally
+	// schema-qualified) and a "refreshed_at" timestamp column.
+	MatviewRefreshTrackingTable string `ini:"matview_refresh_tracking_table"`
+
+	// Replaces the names of matching tables ("schema.table" patterns) and columns
+	// ("schema.table.column" patterns) with a token before sending the schema, in
+	// case the names themselves are sensitive. Statistics are still collected for
+	// these objects, and reported under the token. Patterns are comma separated and
+	// use the same matching as ignore_table_pattern. All columns of a redacted
+	// table are redacted as well.
+	//
+	// Tokens are derived from the name and redact_salt, and stay the same across
+	// collections as long as the salt doesn't change.
+	RedactRelationPattern string `ini:"redact_relation_pattern"`
+	RedactColumnPattern   string `ini:"redact_column_pattern"`
+	RedactSalt            string `ini:"redact_salt"`
+
 	// Specifies the frequency of query statistics collection in seconds
 	//
 	// Currently supported values: 600 (10 minutes), 60 (1 minute)
@@ -112,6 +288,48 @@ type ServerConfig struct {
 	// Defaults to once per minute (60)
 	QueryStatsInterval int `ini:"query_stats_interval"`
 
+	// Where to collect query statistics from, and the query_source submitted
+	// with each snapshot
+	//
+	// Currently supported values: pg_stat_statements, pg_stat_monitor (2.0+)
+	//
+	// Defaults to pg_stat_statements
+	StatementSource string `ini:"statement_source"`
+
+	// Only sends statistics for queries that were called at least this many
+	// times since the last snapshot - all other queries are combined into one
+	// "<other queries below min calls>" entry per database and role, so total
+	// time and calls still add up. Disabled when 0 (the default).
+	QueryStatsMinCalls int `ini:"query_stats_min_calls"`
+
+	// Only sends statistics for this many queries, ranked by the given metric
+	// (see QueryStatsRankByMetrics) within the snapshot interval - all other
+	// queries are combined into one "<other queries outside top statements>"
+	// entry per database and role. Disabled when 0 (the default).
+	//
+	// The metric defaults to total_exec_time
+	QueryStatsMaxStatements int    `ini:"query_stats_max_statements"`
+	QueryStatsRankBy        string `ini:"query_stats_rank_by"`
+
+	// Only sends the query text for this many queries, ranked by the
+	// query_stats_rank_by metric - all other queries still have their statistics
+	// sent, but are only identified by their query ID. Disabled when 0 (the
+	// default).
+	QueryTextMaxStatements int `ini:"query_text_max_statements"`
+
+	// Leaves out table, index and function definitions from full snapshots when
+	// they are unchanged since the last snapshot (based on a hash of the schema),
+	// letting the server reuse the last schema it received. The full schema is
+	// still sent every schema_refresh_interval minutes (defaults to 60).
+	SkipUnchangedSchema   bool `ini:"skip_unchanged_schema"`
+	SchemaRefreshInterval int  `ini:"schema_refresh_interval"`
+
+	// Sends every Nth full snapshot without any of the above optimizations (i.e.
+	// with the complete schema and statistics for all queries), so the server
+	// can re-baseline in case it missed an earlier snapshot. Disabled when 0 (the
+	// default).
+	ForceFullSnapshotEvery int `ini:"force_full_snapshot_every"`
+
 	// Maximum connections allowed to the database with the collector
 	// application_name, in order to protect against accidental connection leaks
 	// in the collector
@@ -120,14 +338,164 @@ type ServerConfig struct {
 	// the collector multiple times against the same database server
 	MaxCollectorConnections int `ini:"max_collector_connections"`
 
+	// The application_name the collector connects with, so its connections can be
+	// told apart from application traffic (defaults to "pganalyze_collector"). When
+	// a suffix is set, it gets appended with an underscore, e.g. to identify the
+	// config section.
+	ApplicationName       string `ini:"application_name"`
+	ApplicationNameSuffix string `ini:"application_name_suffix"`
+
+	// Statement timeout (in milliseconds) for the diagnostic queries of a full
+	// snapshot, so a single hanging query (e.g. on pg_locks) doesn't stall the
+	// whole collection. Sections that time out are reported as such, and the
+	// remaining ones are still collected. Query texts and schema information
+	// are exempt, and use the regular statement timeout.
+	//
+	// Defaults to 5000 (5 seconds), set to 0 to disable
+	SectionStatementTimeoutMs int `ini:"section_statement_timeout_ms"`
+
+	// Warns (and flags it in the snapshot) when the oldest xmin held by a
+	// backend, prepared transaction or replication slot is older than this many
+	// transactions. Set to 0 to disable the warning.
+	//
+	// Defaults to 50 million transactions (the default vacuum_freeze_min_age)
+	XminHorizonWarnAge int `ini:"xmin_horizon_warn_age"`
+
+	// Warns (and flags it in the snapshot) when a logical replication subscription
+	// hasn't reported progress to its publisher for more than this many seconds.
+	// Set to 0 to disable the warning.
+	//
+	// Defaults to 300 seconds
+	SubscriptionLagWarnSecs int `ini:"subscription_lag_warn_secs"`
+
+	// Warns (and flags it in the snapshot) when the peak number of client
+	// connections since the last full snapshot reaches this percentage of
+	// max_connections (minus superuser_reserved_connections). Set to 0 to disable
+	// the warning.
+	//
+	// Defaults to 80 percent
+	ConnectionSaturationWarnPercent int `ini:"connection_saturation_warn_percent"`
+
+	// Backend types (as in pg_stat_activity.backend_type, comma separated) that are
+	// included in activity snapshots, e.g. "client backend,autovacuum worker,walsender".
+	// Set to "all" to include every backend. This only affects the activity list,
+	// the connection counts in the full snapshot always cover all backends.
+	//
+	// Defaults to "client backend,autovacuum worker"
+	ActivityBackendTypes string `ini:"activity_backend_types"`
+
+	// Collects full snapshots of this server on its own schedule (a cron expression
+	// with a leading seconds field, e.g. "0 */5 * * * * *" for every 5 minutes),
+	// instead of every 10 minutes. Servers with the same schedule are collected
+	// together, independently of servers with a different schedule, so a slow
+	// server doesn't delay the others.
+	FullSnapshotSchedule string `ini:"full_snapshot_schedule"`
+
 	// Configuration for PII filtering
 	FilterLogSecret   string `ini:"filter_log_secret"`   // none/all/credential/parsing_error/statement_text/statement_parameter/table_data/ops/unidentified (comma separated)
 	FilterQuerySample string `ini:"filter_query_sample"` // none/all
 
+	// Restricts which queries EXPLAIN is run for (when enable_log_explain is set)
+	//
+	// The regexp settings are matched against the query text, the fingerprint
+	// settings take a comma separated list of query fingerprints (hex encoded).
+	// Deny lists take precedence - once an allow list is configured, only
+	// queries matching the allow list are explained.
+	ExplainAllowRegexp       string `ini:"explain_allow_regexp"`
+	ExplainDenyRegexp        string `ini:"explain_deny_regexp"`
+	ExplainAllowFingerprints string `ini:"explain_allow_fingerprints"`
+	ExplainDenyFingerprints  string `ini:"explain_deny_fingerprints"`
+
+	// Minimum time (in minutes) between EXPLAINs of the same query (by fingerprint),
+	// to bound the EXPLAIN load while still capturing plan changes over time.
+	// Queries seen for the first time are always explained. Disabled when 0 (the default).
+	ExplainSampleInterval int `ini:"explain_sample_interval"`
+
+	// ExplainFilter - Validated version of the explain_allow_* and explain_deny_* settings
+	ExplainFilter *ExplainFilter
+
+	// Free-form labels (e.g. "environment=production,cluster=main") that get sent
+	// with every full snapshot, so the fleet can be organized by them. They have
+	// no effect on what gets collected.
+	Labels string `ini:"labels"`
+
+	// LabelMap - Validated version of the labels setting
+	LabelMap map[string]string
+
+	// Query that returns additional labels on every full snapshot (e.g. to identify
+	// the tenant or shard of the database), as rows of (key, value) - or with a
+	// third column containing a table OID, labels for that table only. It runs in
+	// a read-only transaction with section_statement_timeout_ms as the timeout.
+	// Labels from the labels setting take precedence.
+	LabelsQuery string `ini:"labels_query"`
+
+	// Additional pganalyze installations (e.g. a self-hosted one during a
+	// migration) that full, activity and system snapshots get submitted to as
+	// well, as "api_key@api_base_url" entries separated by commas. Failing to
+	// submit to one of them doesn't affect the others.
+	AdditionalAPIDestinations string `ini:"additional_api_destinations"`
+
+	// APIDestinations - Validated version of the additional_api_destinations setting
+	APIDestinations []APIDestination
+
 	// HttpClient - Client to be used for API connections
 	HTTPClient *http.Client
 }
 
+// QueryStatsRankByMetrics - Valid values for query_stats_rank_by, named like the
+// pg_stat_statements columns (Postgres 13+ naming for the timing columns)
+var QueryStatsRankByMetrics = []string{
+	"total_exec_time", "mean_exec_time", "calls", "rows",
+	"shared_blks_hit", "shared_blks_read", "shared_blks_dirtied", "shared_blks_written",
+	"local_blks_read", "local_blks_written", "temp_blks_read", "temp_blks_written",
+	"blk_read_time", "blk_write_time",
+}
+
+// GetQueryStatsRankBy - Gets the metric used to rank queries for query_stats_max_statements
+func (config ServerConfig) GetQueryStatsRankBy() string {
+	if config.QueryStatsRankBy == "" {
+		return "total_exec_time"
+	}
+	return config.QueryStatsRankBy
+}
+
+// GetActivityBackendTypes - Gets the backend types to include in activity snapshots,
+// or nil if all backends should be included
+func (config ServerConfig) GetActivityBackendTypes() map[string]bool {
+	if config.ActivityBackendTypes == "" || config.ActivityBackendTypes == "all" {
+		return nil
+	}
+
+	backendTypes := make(map[string]bool)
+	for _, backendType := range strings.Split(config.ActivityBackendTypes, ",") {
+		backendType = strings.TrimSpace(backendType)
+		if backendType != "" {
+			backendTypes[backendType] = true
+		}
+	}
+	return backendTypes
+}
+
+// GetDbKeepaliveInterval - Gets the interval of TCP keepalive probes on database
+// connections (negative if keepalives are disabled)
+func (config ServerConfig) GetDbKeepaliveInterval() time.Duration {
+	if config.DbKeepaliveInterval < 0 {
+		return -1
+	}
+	if config.DbKeepaliveInterval == 0 {
+		return 15 * time.Second
+	}
+	return time.Duration(config.DbKeepaliveInterval) * time.Second
+}
+
+// GetDbConnectTimeout - Gets the timeout (in seconds) for connecting to the database
+func (config ServerConfig) GetDbConnectTimeout() int {
+	if config.DbConnectTimeout <= 0 {
+		return 10
+	}
+	return config.DbConnectTimeout
+}
+
 // GetPqOpenString - Gets the database configuration as a string that can be passed to lib/pq for connecting
 func (config ServerConfig) GetPqOpenString(dbNameOverride string) string {
 	var dbUsername, dbPassword, dbName, dbHost, dbSslMode, dbSslRootCert string
@@ -200,6 +568,17 @@ func (config ServerConfig) GetPqOpenString(dbNameOverride string) string {
 		dbSslMode = "prefer"
 	}
 
+	// Connect through the local end of the SSH tunnel instead
+	if config.SSHTunnelLocalPort != 0 {
+		dbPort = config.SSHTunnelLocalPort
+	}
+
+	// The TCP connection itself goes to GetDbDialAddress in that case (see the
+	// dialer set up in EstablishConnection), pq only uses host for TLS
+	if sslServerName := config.GetDbSslServerName(); sslServerName != "" {
+		dbHost = sslServerName
+	}
+
 	// Handle SSL mode prefer
 	if dbSslMode == "prefer" {
 		if config.DbSslModePreferFailed {
@@ -239,11 +618,85 @@ func (config ServerConfig) GetPqOpenString(dbNameOverride string) string {
 	if dbSslRootCert != "" {
 		dbinfo = append(dbinfo, fmt.Sprintf("sslrootcert='%s'", strings.Replace(dbSslRootCert, "'", "\\'", -1)))
 	}
-	dbinfo = append(dbinfo, "connect_timeout=10")
+	dbinfo = append(dbinfo, fmt.Sprintf("connect_timeout=%d", config.GetDbConnectTimeout()))
 
 	return strings.Join(dbinfo, " ")
 }
 
+// GetApplicationName - Gets the application_name to connect with, based on the
+// collector's default name (which differs for test runs)
+func (config ServerConfig) GetApplicationName(defaultName string) string {
+	applicationName := defaultName
+	if config.ApplicationName != "" {
+		applicationName = config.ApplicationName
+	}
+	if config.ApplicationNameSuffix != "" {
+		applicationName += "_" + config.ApplicationNameSuffix
+	}
+	return applicationName
+}
+
+// GetSSHTunnelRemoteAddr - Gets the database address the SSH tunnel connects to (as seen from the bastion host)
+func (config ServerConfig) GetSSHTunnelRemoteAddr() string {
+	dbHost := config.GetDbHost()
+	if config.DbHost != "" {
+		dbHost = config.DbHost
+	}
+	if dbHost == "" {
+		dbHost = "localhost"
+	}
+
+	dbPort := config.GetDbPort()
+	if config.DbPort != 0 {
+		dbPort = config.DbPort
+	}
+	if dbPort == 0 {
+		dbPort = 5432
+	}
+
+	return net.JoinHostPort(dbHost, strconv.Itoa(dbPort))
+}
+
+// GetDbDialAddress - Gets the address the database connection is made to, which
+// differs from the host passed to pq when db_sslservername is set or an SSH
+// tunnel is used
+func (config ServerConfig) GetDbDialAddress() string {
+	if config.SSHTunnelLocalPort != 0 {
+		return net.JoinHostPort("127.0.0.1", strconv.Itoa(config.SSHTunnelLocalPort))
+	}
+	return config.GetSSHTunnelRemoteAddr()
+}
+
+// GetDbSslServerName - Gets the hostname pq verifies the server certificate
+// against, if it differs from where the connection is made to
+//
+// Connections through an SSH tunnel go to 127.0.0.1, so they verify against the
+// database host (as seen from the bastion host) unless db_sslservername is set.
+func (config ServerConfig) GetDbSslServerName() string {
+	if config.DbSslServerName != "" {
+		return config.DbSslServerName
+	}
+	if config.SSHTunnelLocalPort != 0 {
+		host, _, _ := net.SplitHostPort(config.GetSSHTunnelRemoteAddr())
+		return host
+	}
+	return ""
+}
+
+// GetDbSslMode - Gets the configured sslmode (without applying the default)
+func (config ServerConfig) GetDbSslMode() string {
+	if config.DbSslMode != "" {
+		return config.DbSslMode
+	}
+	if config.DbURL != "" {
+		u, err := url.Parse(config.DbURL)
+		if err == nil {
+			return u.Query().Get("sslmode")
+		}
+	}
+	return ""
+}
+
 // GetDbHost - Gets the database hostname from the given configuration
 func (config ServerConfig) GetDbHost() string {
 	if config.DbURL != "" {
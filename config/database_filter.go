@@ -0,0 +1,47 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// DatabaseExcludedReason - Why the given database is left out when collecting
+// all databases (db_name includes "*"), based on db_include_pattern,
+// db_exclude_pattern and db_max_size_mb - returns "" if it is collected
+//
+// Pass 0 as the size if it is not known, which never exceeds db_max_size_mb.
+func (config ServerConfig) DatabaseExcludedReason(name string, sizeBytes int64) string {
+	if includePatterns := splitDatabasePatterns(config.DbIncludePattern); len(includePatterns) > 0 && !anyDatabasePatternMatches(name, includePatterns) {
+		return "does not match db_include_pattern"
+	}
+	for _, pattern := range splitDatabasePatterns(config.DbExcludePattern) {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return fmt.Sprintf("matches db_exclude_pattern \"%s\"", pattern)
+		}
+	}
+	if config.DbMaxSizeMB > 0 && sizeBytes > int64(config.DbMaxSizeMB)*1024*1024 {
+		return fmt.Sprintf("size of %d MB exceeds db_max_size_mb", sizeBytes/1024/1024)
+	}
+	return ""
+}
+
+func splitDatabasePatterns(list string) []string {
+	var patterns []string
+	for _, pattern := range strings.Split(list, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+func anyDatabasePatternMatches(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/pganalyze/collector/config"
+)
+
+var databaseExcludedReasonTests = []struct {
+	name           string
+	includePattern string
+	excludePattern string
+	maxSizeMB      int
+	database       string
+	sizeBytes      int64
+	expected       string
+}{
+	{"no filters", "", "", 0, "app", 0, ""},
+	{"included", "app*, reporting", "", 0, "app_production", 0, ""},
+	{"included (second pattern)", "app*, reporting", "", 0, "reporting", 0, ""},
+	{"not included", "app*, reporting", "", 0, "postgres", 0, "does not match db_include_pattern"},
+	{"excluded", "", "template*,test_*", 0, "test_1", 0, "matches db_exclude_pattern \"test_*\""},
+	{"not excluded", "", "template*,test_*", 0, "app", 0, ""},
+	{"included but excluded", "app*", "app_staging", 0, "app_staging", 0, "matches db_exclude_pattern \"app_staging\""},
+	{"within max size", "", "", 100, "app", 100 * 1024 * 1024, ""},
+	{"exceeds max size", "", "", 100, "app", 250 * 1024 * 1024, "size of 250 MB exceeds db_max_size_mb"},
+	{"unknown size", "", "", 100, "app", 0, ""},
+	{"max size disabled", "", "", 0, "app", 250 * 1024 * 1024, ""},
+}
+
+func TestDatabaseExcludedReason(t *testing.T) {
+	for _, test := range databaseExcludedReasonTests {
+		conf := config.ServerConfig{
+			DbIncludePattern: test.includePattern,
+			DbExcludePattern: test.excludePattern,
+			DbMaxSizeMB:      test.maxSizeMB,
+		}
+		actual := conf.DatabaseExcludedReason(test.database, test.sizeBytes)
+		if actual != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// APIDestination - An additional pganalyze installation that snapshots get
+// submitted to, besides the one configured with api_key and api_base_url
+type APIDestination struct {
+	APIKey     string
+	APIBaseURL string
+}
+
+// parseAPIDestinations - Parses the additional_api_destinations setting
+// ("api_key@api_base_url" entries, comma separated)
+func parseAPIDestinations(value string) ([]APIDestination, error) {
+	var destinations []APIDestination
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "@", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("Invalid destination \"%s\", needs to be in api_key@api_base_url format", redactAPIKey(entry))
+		}
+		apiBaseURL := strings.TrimSuffix(parts[1], "/")
+		u, err := url.Parse(apiBaseURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("Invalid API base URL \"%s\", needs to be a http(s) URL", parts[1])
+		}
+		for _, existing := range destinations {
+			if existing.APIBaseURL == apiBaseURL && existing.APIKey == parts[0] {
+				return nil, fmt.Errorf("Duplicate destination %s", apiBaseURL)
+			}
+		}
+		destinations = append(destinations, APIDestination{APIKey: parts[0], APIBaseURL: apiBaseURL})
+	}
+
+	return destinations, nil
+}
+
+// redactAPIKey - Avoids including API keys in error messages
+func redactAPIKey(entry string) string {
+	if idx := strings.Index(entry, "@"); idx != -1 {
+		return "<api_key>" + entry[idx:]
+	}
+	return "<redacted>"
+}
@@ -0,0 +1,175 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pganalyze/collector/util"
+)
+
+const defaultDiscoverConsulURL = "http://127.0.0.1:8500"
+const defaultDiscoverInterval = 300
+
+// discoveryTemplate - A config section that generates servers based on
+// service discovery, together with the endpoints found when reading the config
+type discoveryTemplate struct {
+	config    ServerConfig
+	endpoints []string
+}
+
+type consulCatalogService struct {
+	Address        string
+	ServiceAddress string
+	ServicePort    int
+}
+
+var discoveryHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+func (config ServerConfig) hasDiscovery() bool {
+	return config.DiscoverDNSSRV != "" || config.DiscoverConsulService != ""
+}
+
+// discoverEndpoints - Resolves the database endpoints (host:port, sorted) for the
+// service discovery settings of the given config section
+func discoverEndpoints(config ServerConfig) ([]string, error) {
+	var endpoints []string
+
+	if config.DiscoverDNSSRV != "" {
+		_, records, err := net.LookupSRV("", "", config.DiscoverDNSSRV)
+		if err != nil {
+			return nil, fmt.Errorf("DNS SRV lookup for %s failed: %s", config.DiscoverDNSSRV, err)
+		}
+		for _, record := range records {
+			host := strings.TrimSuffix(record.Target, ".")
+			endpoints = append(endpoints, net.JoinHostPort(host, strconv.Itoa(int(record.Port))))
+		}
+	}
+
+	if config.DiscoverConsulService != "" {
+		consulURL := config.DiscoverConsulURL
+		if consulURL == "" {
+			consulURL = defaultDiscoverConsulURL
+		}
+		requestURL := strings.TrimSuffix(consulURL, "/") + "/v1/catalog/service/" + url.PathEscape(config.DiscoverConsulService)
+		if config.DiscoverConsulTag != "" {
+			requestURL += "?tag=" + url.QueryEscape(config.DiscoverConsulTag)
+		}
+
+		resp, err := discoveryHTTPClient.Get(requestURL)
+		if err != nil {
+			return nil, fmt.Errorf("Consul catalog request failed: %s", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("Consul catalog request failed: %s", resp.Status)
+		}
+
+		var services []consulCatalogService
+		err = json.NewDecoder(resp.Body).Decode(&services)
+		if err != nil {
+			return nil, fmt.Errorf("Could not decode Consul catalog response: %s", err)
+		}
+		for _, service := range services {
+			host := service.ServiceAddress
+			if host == "" {
+				host = service.Address
+			}
+			port := service.ServicePort
+			if port == 0 {
+				port = 5432
+			}
+			endpoints = append(endpoints, net.JoinHostPort(host, strconv.Itoa(port)))
+		}
+	}
+
+	sort.Strings(endpoints)
+
+	return endpoints, nil
+}
+
+// configForEndpoint - Generates the server config for one discovered endpoint,
+// using the discovery section's settings (e.g. credentials) as a template
+func configForEndpoint(template ServerConfig, endpoint string) (ServerConfig, error) {
+	host, portStr, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return template, err
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return template, err
+	}
+
+	config := template
+	config.SectionName = endpoint
+	if template.SectionName != "" {
+		config.SectionName = template.SectionName + "/" + endpoint
+	}
+	config.DbHost = host
+	config.DbPort = port
+	if config.DbURL != "" {
+		u, err := url.Parse(config.DbURL)
+		if err != nil {
+			return template, err
+		}
+		u.Host = endpoint
+		config.DbURL = u.String()
+	}
+
+	return config, nil
+}
+
+// WatchDiscovery - Periodically re-runs service discovery for all config
+// sections that use it, and calls changed() when the set of endpoints differs
+// from the one the config was read with (the caller is expected to reload)
+func WatchDiscovery(conf Config, logger *util.Logger, changed func()) chan<- bool {
+	if len(conf.discoveryTemplates) == 0 {
+		return nil
+	}
+
+	stop := make(chan bool)
+	for _, template := range conf.discoveryTemplates {
+		go func(template discoveryTemplate) {
+			prefixedLogger := logger.WithPrefix(template.config.SectionName)
+			interval := template.config.DiscoverInterval
+			if interval <= 0 {
+				interval = defaultDiscoverInterval
+			}
+			ticker := time.NewTicker(time.Duration(interval) * time.Second)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ticker.C:
+					endpoints, err := discoverEndpoints(template.config)
+					if err != nil {
+						prefixedLogger.PrintWarning("Could not re-run service discovery: %s", err)
+						continue
+					}
+					if !reflect.DeepEqual(endpoints, template.endpoints) && !(len(endpoints) == 0 && len(template.endpoints) == 0) {
+						prefixedLogger.PrintInfo("Discovered databases changed (%d -> %d endpoints)", len(template.endpoints), len(endpoints))
+						template.endpoints = endpoints
+						changed()
+					}
+				case <-stop:
+					return
+				}
+			}
+		}(template)
+	}
+
+	// All watchers share one stop channel, so close (rather than send) to stop them all
+	stopAll := make(chan bool)
+	go func() {
+		<-stopAll
+		close(stop)
+	}()
+	return stopAll
+}
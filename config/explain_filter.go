@@ -0,0 +1,105 @@
+package config
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pganalyze/collector/util"
+)
+
+// ExplainFilter - Decides which query samples the collector is allowed to run EXPLAIN for,
+// based on the explain_allow_* and explain_deny_* settings
+type ExplainFilter struct {
+	allowRegexp       *regexp.Regexp
+	denyRegexp        *regexp.Regexp
+	allowFingerprints map[[21]byte]bool
+	denyFingerprints  map[[21]byte]bool
+}
+
+func parseFingerprintList(setting string, value string) (map[[21]byte]bool, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	fingerprints := make(map[[21]byte]bool)
+	for _, s := range strings.Split(value, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		decoded, err := hex.DecodeString(s)
+		if err != nil || len(decoded) != 21 {
+			return nil, fmt.Errorf("Invalid query fingerprint \"%s\" in %s, needs to be a 42 character hex string", s, setting)
+		}
+		var fp [21]byte
+		copy(fp[:], decoded)
+		fingerprints[fp] = true
+	}
+	return fingerprints, nil
+}
+
+func parseRegexp(setting string, value string) (*regexp.Regexp, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	re, err := regexp.Compile(value)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid regular expression in %s: %s", setting, err)
+	}
+	return re, nil
+}
+
+// NewExplainFilter - Validates the EXPLAIN allow/deny settings and returns the resulting filter
+func NewExplainFilter(config ServerConfig) (filter *ExplainFilter, err error) {
+	filter = &ExplainFilter{}
+
+	filter.allowRegexp, err = parseRegexp("explain_allow_regexp", config.ExplainAllowRegexp)
+	if err != nil {
+		return nil, err
+	}
+	filter.denyRegexp, err = parseRegexp("explain_deny_regexp", config.ExplainDenyRegexp)
+	if err != nil {
+		return nil, err
+	}
+	filter.allowFingerprints, err = parseFingerprintList("explain_allow_fingerprints", config.ExplainAllowFingerprints)
+	if err != nil {
+		return nil, err
+	}
+	filter.denyFingerprints, err = parseFingerprintList("explain_deny_fingerprints", config.ExplainDenyFingerprints)
+	if err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// SkipReason - Returns why EXPLAIN should not be run for the given query, or an empty string if its allowed
+func (filter *ExplainFilter) SkipReason(query string) string {
+	if filter == nil {
+		return ""
+	}
+
+	var fp [21]byte
+	if filter.allowFingerprints != nil || filter.denyFingerprints != nil {
+		fp = util.FingerprintQuery(query)
+	}
+
+	if filter.denyFingerprints[fp] {
+		return "EXPLAIN skipped: query fingerprint matches explain_deny_fingerprints"
+	}
+	if filter.denyRegexp != nil && filter.denyRegexp.MatchString(query) {
+		return "EXPLAIN skipped: query matches explain_deny_regexp"
+	}
+
+	// When any allow list is configured, only queries on the allow list(s) are explained
+	if filter.allowRegexp == nil && filter.allowFingerprints == nil {
+		return ""
+	}
+	if filter.allowFingerprints[fp] || (filter.allowRegexp != nil && filter.allowRegexp.MatchString(query)) {
+		return ""
+	}
+	return "EXPLAIN skipped: query does not match explain_allow_regexp or explain_allow_fingerprints"
+}
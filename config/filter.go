@@ -0,0 +1,82 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FilterServers - Restricts the servers to the config sections listed in only
+// (all if empty), leaving out those listed in exclude
+//
+// Servers generated by service discovery can be selected either by their own
+// name ("section/host:port") or the name of the discovery section. Names that
+// don't match any server are an error, to avoid silently collecting from
+// nothing (or everything) because of a typo.
+func FilterServers(servers []ServerConfig, only []string, exclude []string) ([]ServerConfig, error) {
+	if len(only) == 0 && len(exclude) == 0 {
+		return servers, nil
+	}
+
+	for _, name := range append(append([]string(nil), only...), exclude...) {
+		found := false
+		for _, server := range servers {
+			if sectionMatches(server.SectionName, name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("Unknown config section \"%s\" (known sections: %s)", name, strings.Join(sectionNames(servers), ", "))
+		}
+	}
+
+	var filtered []ServerConfig
+	for _, server := range servers {
+		if len(only) > 0 && !anySectionMatches(server.SectionName, only) {
+			continue
+		}
+		if anySectionMatches(server.SectionName, exclude) {
+			continue
+		}
+		filtered = append(filtered, server)
+	}
+
+	if len(filtered) == 0 {
+		return nil, fmt.Errorf("No config sections left to collect from after applying --only/--exclude")
+	}
+
+	return filtered, nil
+}
+
+// SplitSectionNames - Splits a comma-separated list of config section names
+func SplitSectionNames(list string) []string {
+	var names []string
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func sectionMatches(sectionName string, name string) bool {
+	return sectionName == name || strings.HasPrefix(sectionName, name+"/")
+}
+
+func anySectionMatches(sectionName string, names []string) bool {
+	for _, name := range names {
+		if sectionMatches(sectionName, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func sectionNames(servers []ServerConfig) []string {
+	var names []string
+	for _, server := range servers {
+		names = append(names, server.SectionName)
+	}
+	return names
+}
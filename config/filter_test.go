@@ -0,0 +1,73 @@
+package config_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pganalyze/collector/config"
+)
+
+var filterServersTests = []struct {
+	name     string
+	only     []string
+	exclude  []string
+	expected []string
+	err      string
+}{
+	{"no filters", nil, nil, []string{"default", "prod", "rds", "rds/db1:5432", "rds/db2:5432"}, ""},
+	{"only", []string{"prod"}, nil, []string{"prod"}, ""},
+	{"only multiple", []string{"default", "prod"}, nil, []string{"default", "prod"}, ""},
+	{"only discovery section", []string{"rds"}, nil, []string{"rds", "rds/db1:5432", "rds/db2:5432"}, ""},
+	{"only discovered server", []string{"rds/db1:5432"}, nil, []string{"rds/db1:5432"}, ""},
+	{"exclude", nil, []string{"default"}, []string{"prod", "rds", "rds/db1:5432", "rds/db2:5432"}, ""},
+	{"exclude discovered server", []string{"rds"}, []string{"rds/db2:5432"}, []string{"rds", "rds/db1:5432"}, ""},
+	{"name is not a prefix", []string{"pro"}, nil, nil, "Unknown config section \"pro\""},
+	{"unknown section in exclude", nil, []string{"staging"}, nil, "Unknown config section \"staging\""},
+	{"nothing left", []string{"prod"}, []string{"prod"}, nil, "No config sections left"},
+}
+
+func TestFilterServers(t *testing.T) {
+	var servers []config.ServerConfig
+	for _, name := range []string{"default", "prod", "rds", "rds/db1:5432", "rds/db2:5432"} {
+		servers = append(servers, config.ServerConfig{SectionName: name})
+	}
+
+	for _, test := range filterServersTests {
+		filtered, err := config.FilterServers(servers, test.only, test.exclude)
+		if test.err != "" {
+			if err == nil || !strings.HasPrefix(err.Error(), test.err) {
+				t.Errorf("%s: expected error %q, got %v", test.name, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		var actual []string
+		for _, server := range filtered {
+			actual = append(actual, server.SectionName)
+		}
+		if strings.Join(actual, ",") != strings.Join(test.expected, ",") {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+		}
+	}
+}
+
+var splitSectionNamesTests = []struct {
+	list     string
+	expected []string
+}{
+	{"", nil},
+	{"prod", []string{"prod"}},
+	{"prod, staging ,,rds/db1:5432", []string{"prod", "staging", "rds/db1:5432"}},
+}
+
+func TestSplitSectionNames(t *testing.T) {
+	for _, test := range splitSectionNamesTests {
+		actual := config.SplitSectionNames(test.list)
+		if strings.Join(actual, "|") != strings.Join(test.expected, "|") || len(actual) != len(test.expected) {
+			t.Errorf("%q: expected %v, got %v", test.list, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// maxLabelsSize - Upper bound for the combined length of all label keys and values
+// of a server, since they get sent with every full snapshot
+const maxLabelsSize = 2048
+
+var labelKeyRegexp = regexp.MustCompile(`^[A-Za-z0-9_.\-]+$`)
+
+// parseLabels - Parses the labels setting ("key=value" pairs, comma separated) into a map
+func parseLabels(value string) (map[string]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	labels := make(map[string]string)
+	size := 0
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		keyValue := strings.SplitN(pair, "=", 2)
+		if len(keyValue) != 2 {
+			return nil, fmt.Errorf("Invalid label \"%s\", needs to be in key=value format", pair)
+		}
+		key := strings.TrimSpace(keyValue[0])
+		labelValue := strings.TrimSpace(keyValue[1])
+		if !labelKeyRegexp.MatchString(key) {
+			return nil, fmt.Errorf("Invalid label key \"%s\", may only contain letters, digits, underscores, dots and dashes", key)
+		}
+		if _, exists := labels[key]; exists {
+			return nil, fmt.Errorf("Duplicate label key \"%s\"", key)
+		}
+		size += len(key) + len(labelValue)
+		if size > maxLabelsSize {
+			return nil, fmt.Errorf("Labels exceed the maximum size of %d bytes", maxLabelsSize)
+		}
+		labels[key] = labelValue
+	}
+	return labels, nil
+}
+
+// MergeDynamicLabels - Adds labels determined at runtime (see labels_query) to the
+// ones from the labels setting, returning why any dynamic labels were left out
+//
+// Labels from the setting take precedence, dynamic labels with invalid keys are
+// skipped, and so are all labels that would exceed the maximum size.
+func MergeDynamicLabels(static map[string]string, dynamic map[string]string) (map[string]string, []string) {
+	if len(dynamic) == 0 {
+		return static, nil
+	}
+
+	var skipped []string
+	labels := make(map[string]string)
+	size := 0
+	for key, value := range static {
+		labels[key] = value
+		size += len(key) + len(value)
+	}
+
+	keys := make([]string, 0, len(dynamic))
+	for key := range dynamic {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := dynamic[key]
+		if !labelKeyRegexp.MatchString(key) {
+			skipped = append(skipped, fmt.Sprintf("invalid label key \"%s\"", key))
+			continue
+		}
+		if _, exists := labels[key]; exists {
+			continue
+		}
+		if size+len(key)+len(value) > maxLabelsSize {
+			skipped = append(skipped, fmt.Sprintf("label \"%s\" exceeds the maximum size of %d bytes", key, maxLabelsSize))
+			continue
+		}
+		size += len(key) + len(value)
+		labels[key] = value
+	}
+
+	return labels, skipped
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var parseLabelsTests = []struct {
+	name     string
+	value    string
+	expected map[string]string
+	err      string
+}{
+	{"empty", "  ", nil, ""},
+	{"single", "team=payments", map[string]string{"team": "payments"}, ""},
+	{"multiple with whitespace", " team = payments , env=prod,,", map[string]string{"team": "payments", "env": "prod"}, ""},
+	{"empty value", "team=", map[string]string{"team": ""}, ""},
+	{"value with equals sign", "note=a=b", map[string]string{"note": "a=b"}, ""},
+	{"key with dots and dashes", "k8s.app-name_1=x", map[string]string{"k8s.app-name_1": "x"}, ""},
+	{"key with slash", "app.kubernetes.io/name=x", nil, "Invalid label key"},
+	{"missing value", "team", nil, "Invalid label \"team\""},
+	{"empty key", "=payments", nil, "Invalid label key"},
+	{"duplicate key", "team=a,team=b", nil, "Duplicate label key \"team\""},
+	{"too large", "a=" + strings.Repeat("x", maxLabelsSize), nil, "Labels exceed the maximum size"},
+}
+
+func TestParseLabels(t *testing.T) {
+	for _, test := range parseLabelsTests {
+		actual, err := parseLabels(test.value)
+		if test.err != "" {
+			if err == nil || !strings.HasPrefix(err.Error(), test.err) {
+				t.Errorf("%s: expected error %q, got %v", test.name, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+		} else if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+		}
+	}
+}
+
+var mergeDynamicLabelsTests = []struct {
+	name            string
+	static          map[string]string
+	dynamic         map[string]string
+	expected        map[string]string
+	expectedSkipped []string
+}{
+	{
+		"no dynamic labels",
+		map[string]string{"team": "payments"},
+		nil,
+		map[string]string{"team": "payments"},
+		nil,
+	},
+	{
+		"no static labels",
+		nil,
+		map[string]string{"tier": "gold"},
+		map[string]string{"tier": "gold"},
+		nil,
+	},
+	{
+		"merged",
+		map[string]string{"team": "payments"},
+		map[string]string{"tier": "gold", "region": "eu"},
+		map[string]string{"team": "payments", "tier": "gold", "region": "eu"},
+		nil,
+	},
+	{
+		"static labels take precedence",
+		map[string]string{"team": "payments"},
+		map[string]string{"team": "billing"},
+		map[string]string{"team": "payments"},
+		nil,
+	},
+	{
+		"invalid key",
+		nil,
+		map[string]string{"tier": "gold", "has space": "x"},
+		map[string]string{"tier": "gold"},
+		[]string{"invalid label key \"has space\""},
+	},
+	{
+		"exceeds maximum size",
+		map[string]string{"team": strings.Repeat("x", maxLabelsSize-10)},
+		map[string]string{"a": "b", "description": "more than ten bytes"},
+		map[string]string{"team": strings.Repeat("x", maxLabelsSize-10), "a": "b"},
+		[]string{"label \"description\" exceeds the maximum size of 2048 bytes"},
+	},
+}
+
+func TestMergeDynamicLabels(t *testing.T) {
+	for _, test := range mergeDynamicLabelsTests {
+		static := make(map[string]string)
+		for key, value := range test.static {
+			static[key] = value
+		}
+		actual, skipped := MergeDynamicLabels(static, test.dynamic)
+		if !reflect.DeepEqual(actual, test.expected) && !(len(actual) == 0 && len(test.expected) == 0) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+		}
+		if !reflect.DeepEqual(skipped, test.expectedSkipped) {
+			t.Errorf("%s: expected skipped %v, got %v", test.name, test.expectedSkipped, skipped)
+		}
+		if !reflect.DeepEqual(static, test.static) && len(test.static) > 0 {
+			t.Errorf("%s: expected static labels to be unchanged, got %v", test.name, static)
+		}
+	}
+}
@@ -9,12 +9,15 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-ini/ini"
 
+	"github.com/pganalyze/collector/scheduler"
 	"github.com/pganalyze/collector/util"
 )
 
@@ -22,11 +25,26 @@ const defaultAPIBaseURL = "https://api.pganalyze.com"
 
 func getDefaultConfig() *ServerConfig {
 	config := &ServerConfig{
-		APIBaseURL:              defaultAPIBaseURL,
-		AwsRegion:               "us-east-1",
-		SectionName:             "default",
-		QueryStatsInterval:      60,
-		MaxCollectorConnections: 10,
+		APIBaseURL:                      defaultAPIBaseURL,
+		AwsRegion:                       "us-east-1",
+		SectionName:                     "default",
+		QueryStatsInterval:              60,
+		StatementSource:                 "pg_stat_statements",
+		XminHorizonWarnAge:              50000000,
+		SubscriptionLagWarnSecs:         300,
+		ConnectionSaturationWarnPercent: 80,
+		ActivityBackendTypes:            "client backend,autovacuum worker",
+		MaxCollectorConnections:         10,
+		DbConnectTimeout:                10,
+		DbConnectRetries:                3,
+		DbConnMaxLifetime:               30,
+		CollectionRetries:               1,
+		SectionStatementTimeoutMs:       5000,
+		MaxLogLineLength:                1024 * 1024,
+		SnapshotBufferMaxCount:          144,
+		SnapshotBufferMaxSizeMB:         100,
+		SnapshotBufferMaxAgeHours:       24,
+		SchemaRefreshInterval:           60,
 	}
 
 	// The environment variables are the default way to configure when running inside a Docker container.
@@ -36,6 +54,12 @@ func getDefaultConfig() *ServerConfig {
 	if apiBaseURL := os.Getenv("PGA_API_BASEURL"); apiBaseURL != "" {
 		config.APIBaseURL = apiBaseURL
 	}
+	if apiMinTLSVersion := os.Getenv("PGA_API_MIN_TLS_VERSION"); apiMinTLSVersion != "" {
+		config.APIMinTLSVersion = apiMinTLSVersion
+	}
+	if apiCipherSuites := os.Getenv("PGA_API_CIPHER_SUITES"); apiCipherSuites != "" {
+		config.APICipherSuites = apiCipherSuites
+	}
 	if systemID := os.Getenv("PGA_API_SYSTEM_ID"); systemID != "" {
 		config.SystemID = systemID
 	}
@@ -57,6 +81,12 @@ func getDefaultConfig() *ServerConfig {
 	if enableLogExplain := os.Getenv("PGA_ENABLE_LOG_EXPLAIN"); enableLogExplain != "" && enableLogExplain != "0" {
 		config.EnableLogExplain = true
 	}
+	if enableSizeGrowth := os.Getenv("PGA_ENABLE_SIZE_GROWTH"); enableSizeGrowth != "" && enableSizeGrowth != "0" {
+		config.EnableSizeGrowth = true
+	}
+	if enableBufferCacheStats := os.Getenv("PGA_ENABLE_BUFFER_CACHE_STATS"); enableBufferCacheStats != "" && enableBufferCacheStats != "0" {
+		config.EnableBufferCacheStats = true
+	}
 	if dbURL := os.Getenv("DB_URL"); dbURL != "" {
 		config.DbURL = dbURL
 	}
@@ -81,12 +111,60 @@ func getDefaultConfig() *ServerConfig {
 	if dbSslMode := os.Getenv("DB_SSLMODE"); dbSslMode != "" {
 		config.DbSslMode = dbSslMode
 	}
+	if dbSslServerName := os.Getenv("DB_SSLSERVERNAME"); dbSslServerName != "" {
+		config.DbSslServerName = dbSslServerName
+	}
 	if dbSslRootCert := os.Getenv("DB_SSLROOTCERT"); dbSslRootCert != "" {
 		config.DbSslRootCert = dbSslRootCert
 	}
 	if dbSslRootCertContents := os.Getenv("DB_SSLROOTCERT_CONTENTS"); dbSslRootCertContents != "" {
 		config.DbSslRootCertContents = dbSslRootCertContents
 	}
+	if dbConnectTimeout := os.Getenv("DB_CONNECT_TIMEOUT"); dbConnectTimeout != "" {
+		config.DbConnectTimeout, _ = strconv.Atoi(dbConnectTimeout)
+	}
+	if dbKeepaliveInterval := os.Getenv("DB_KEEPALIVE_INTERVAL"); dbKeepaliveInterval != "" {
+		config.DbKeepaliveInterval, _ = strconv.Atoi(dbKeepaliveInterval)
+	}
+	if dbConnectRetries := os.Getenv("DB_CONNECT_RETRIES"); dbConnectRetries != "" {
+		config.DbConnectRetries, _ = strconv.Atoi(dbConnectRetries)
+	}
+	if dbConnMaxLifetime := os.Getenv("DB_CONN_MAX_LIFETIME"); dbConnMaxLifetime != "" {
+		config.DbConnMaxLifetime, _ = strconv.Atoi(dbConnMaxLifetime)
+	}
+	if collectionRetries := os.Getenv("PGA_COLLECTION_RETRIES"); collectionRetries != "" {
+		config.CollectionRetries, _ = strconv.Atoi(collectionRetries)
+	}
+	if dbConnectJitter := os.Getenv("DB_CONNECT_JITTER"); dbConnectJitter != "" {
+		config.DbConnectJitter, _ = strconv.Atoi(dbConnectJitter)
+	}
+	if sshTunnelHost := os.Getenv("PGA_SSH_TUNNEL_HOST"); sshTunnelHost != "" {
+		config.SSHTunnelHost = sshTunnelHost
+	}
+	if sshTunnelUser := os.Getenv("PGA_SSH_TUNNEL_USER"); sshTunnelUser != "" {
+		config.SSHTunnelUser = sshTunnelUser
+	}
+	if sshTunnelKeyFile := os.Getenv("PGA_SSH_TUNNEL_KEY_FILE"); sshTunnelKeyFile != "" {
+		config.SSHTunnelKeyFile = sshTunnelKeyFile
+	}
+	if sshTunnelKnownHostsFile := os.Getenv("PGA_SSH_TUNNEL_KNOWN_HOSTS_FILE"); sshTunnelKnownHostsFile != "" {
+		config.SSHTunnelKnownHostsFile = sshTunnelKnownHostsFile
+	}
+	if discoverDNSSRV := os.Getenv("PGA_DISCOVER_DNS_SRV"); discoverDNSSRV != "" {
+		config.DiscoverDNSSRV = discoverDNSSRV
+	}
+	if discoverConsulService := os.Getenv("PGA_DISCOVER_CONSUL_SERVICE"); discoverConsulService != "" {
+		config.DiscoverConsulService = discoverConsulService
+	}
+	if discoverConsulTag := os.Getenv("PGA_DISCOVER_CONSUL_TAG"); discoverConsulTag != "" {
+		config.DiscoverConsulTag = discoverConsulTag
+	}
+	if discoverConsulURL := os.Getenv("PGA_DISCOVER_CONSUL_URL"); discoverConsulURL != "" {
+		config.DiscoverConsulURL = discoverConsulURL
+	}
+	if discoverInterval := os.Getenv("PGA_DISCOVER_INTERVAL"); discoverInterval != "" {
+		config.DiscoverInterval, _ = strconv.Atoi(discoverInterval)
+	}
 	if awsRegion := os.Getenv("AWS_REGION"); awsRegion != "" {
 		config.AwsRegion = awsRegion
 	}
@@ -131,12 +209,105 @@ func getDefaultConfig() *ServerConfig {
 	// the approach for using pganalyze as a sidecar container alongside Postgres
 	// currently requires writing to a file and then mounting that as a volume
 	// inside the pganalyze container.
+	if maxLogLineLength := os.Getenv("MAX_LOG_LINE_LENGTH"); maxLogLineLength != "" {
+		config.MaxLogLineLength, _ = strconv.Atoi(maxLogLineLength)
+	}
+	if snapshotBufferDir := os.Getenv("SNAPSHOT_BUFFER_DIR"); snapshotBufferDir != "" {
+		config.SnapshotBufferDir = snapshotBufferDir
+	}
+	if snapshotBufferMaxCount := os.Getenv("SNAPSHOT_BUFFER_MAX_COUNT"); snapshotBufferMaxCount != "" {
+		config.SnapshotBufferMaxCount, _ = strconv.Atoi(snapshotBufferMaxCount)
+	}
+	if snapshotBufferMaxSizeMB := os.Getenv("SNAPSHOT_BUFFER_MAX_SIZE_MB"); snapshotBufferMaxSizeMB != "" {
+		config.SnapshotBufferMaxSizeMB, _ = strconv.Atoi(snapshotBufferMaxSizeMB)
+	}
+	if snapshotBufferMaxAgeHours := os.Getenv("SNAPSHOT_BUFFER_MAX_AGE_HOURS"); snapshotBufferMaxAgeHours != "" {
+		config.SnapshotBufferMaxAgeHours, _ = strconv.Atoi(snapshotBufferMaxAgeHours)
+	}
 	if ignoreTablePattern := os.Getenv("IGNORE_TABLE_PATTERN"); ignoreTablePattern != "" {
 		config.IgnoreTablePattern = ignoreTablePattern
 	}
+	if dbIncludePattern := os.Getenv("DB_INCLUDE_PATTERN"); dbIncludePattern != "" {
+		config.DbIncludePattern = dbIncludePattern
+	}
+	if dbExcludePattern := os.Getenv("DB_EXCLUDE_PATTERN"); dbExcludePattern != "" {
+		config.DbExcludePattern = dbExcludePattern
+	}
+	if dbMaxSizeMB := os.Getenv("DB_MAX_SIZE_MB"); dbMaxSizeMB != "" {
+		config.DbMaxSizeMB, _ = strconv.Atoi(dbMaxSizeMB)
+	}
+	if redactRelationPattern := os.Getenv("PGA_REDACT_RELATION_PATTERN"); redactRelationPattern != "" {
+		config.RedactRelationPattern = redactRelationPattern
+	}
+	if redactColumnPattern := os.Getenv("PGA_REDACT_COLUMN_PATTERN"); redactColumnPattern != "" {
+		config.RedactColumnPattern = redactColumnPattern
+	}
+	if redactSalt := os.Getenv("PGA_REDACT_SALT"); redactSalt != "" {
+		config.RedactSalt = redactSalt
+	}
+	if matviewRefreshTrackingTable := os.Getenv("PGA_MATVIEW_REFRESH_TRACKING_TABLE"); matviewRefreshTrackingTable != "" {
+		config.MatviewRefreshTrackingTable = matviewRefreshTrackingTable
+	}
 	if queryStatsInterval := os.Getenv("QUERY_STATS_INTERVAL"); queryStatsInterval != "" {
 		config.QueryStatsInterval, _ = strconv.Atoi(queryStatsInterval)
 	}
+	if additionalAPIDestinations := os.Getenv("PGA_ADDITIONAL_API_DESTINATIONS"); additionalAPIDestinations != "" {
+		config.AdditionalAPIDestinations = additionalAPIDestinations
+	}
+	if labels := os.Getenv("PGA_LABELS"); labels != "" {
+		config.Labels = labels
+	}
+	if labelsQuery := os.Getenv("PGA_LABELS_QUERY"); labelsQuery != "" {
+		config.LabelsQuery = labelsQuery
+	}
+	if fullSnapshotSchedule := os.Getenv("PGA_FULL_SNAPSHOT_SCHEDULE"); fullSnapshotSchedule != "" {
+		config.FullSnapshotSchedule = fullSnapshotSchedule
+	}
+	if statementSource := os.Getenv("PGA_STATEMENT_SOURCE"); statementSource != "" {
+		config.StatementSource = statementSource
+	}
+	if xminHorizonWarnAge := os.Getenv("PGA_XMIN_HORIZON_WARN_AGE"); xminHorizonWarnAge != "" {
+		config.XminHorizonWarnAge, _ = strconv.Atoi(xminHorizonWarnAge)
+	}
+	if subscriptionLagWarnSecs := os.Getenv("PGA_SUBSCRIPTION_LAG_WARN_SECS"); subscriptionLagWarnSecs != "" {
+		config.SubscriptionLagWarnSecs, _ = strconv.Atoi(subscriptionLagWarnSecs)
+	}
+	if connectionSaturationWarnPercent := os.Getenv("PGA_CONNECTION_SATURATION_WARN_PERCENT"); connectionSaturationWarnPercent != "" {
+		config.ConnectionSaturationWarnPercent, _ = strconv.Atoi(connectionSaturationWarnPercent)
+	}
+	if activityBackendTypes := os.Getenv("PGA_ACTIVITY_BACKEND_TYPES"); activityBackendTypes != "" {
+		config.ActivityBackendTypes = activityBackendTypes
+	}
+	if queryStatsMinCalls := os.Getenv("QUERY_STATS_MIN_CALLS"); queryStatsMinCalls != "" {
+		config.QueryStatsMinCalls, _ = strconv.Atoi(queryStatsMinCalls)
+	}
+	if queryStatsMaxStatements := os.Getenv("QUERY_STATS_MAX_STATEMENTS"); queryStatsMaxStatements != "" {
+		config.QueryStatsMaxStatements, _ = strconv.Atoi(queryStatsMaxStatements)
+	}
+	if queryTextMaxStatements := os.Getenv("QUERY_TEXT_MAX_STATEMENTS"); queryTextMaxStatements != "" {
+		config.QueryTextMaxStatements, _ = strconv.Atoi(queryTextMaxStatements)
+	}
+	if queryStatsRankBy := os.Getenv("QUERY_STATS_RANK_BY"); queryStatsRankBy != "" {
+		config.QueryStatsRankBy = queryStatsRankBy
+	}
+	if skipUnchangedSchema := os.Getenv("PGA_SKIP_UNCHANGED_SCHEMA"); skipUnchangedSchema != "" && skipUnchangedSchema != "0" {
+		config.SkipUnchangedSchema = true
+	}
+	if schemaRefreshInterval := os.Getenv("PGA_SCHEMA_REFRESH_INTERVAL"); schemaRefreshInterval != "" {
+		config.SchemaRefreshInterval, _ = strconv.Atoi(schemaRefreshInterval)
+	}
+	if forceFullSnapshotEvery := os.Getenv("PGA_FORCE_FULL_SNAPSHOT_EVERY"); forceFullSnapshotEvery != "" {
+		config.ForceFullSnapshotEvery, _ = strconv.Atoi(forceFullSnapshotEvery)
+	}
+	if sectionStatementTimeoutMs := os.Getenv("PGA_SECTION_STATEMENT_TIMEOUT_MS"); sectionStatementTimeoutMs != "" {
+		config.SectionStatementTimeoutMs, _ = strconv.Atoi(sectionStatementTimeoutMs)
+	}
+	if applicationName := os.Getenv("PGA_APPLICATION_NAME"); applicationName != "" {
+		config.ApplicationName = applicationName
+	}
+	if applicationNameSuffix := os.Getenv("PGA_APPLICATION_NAME_SUFFIX"); applicationNameSuffix != "" {
+		config.ApplicationNameSuffix = applicationNameSuffix
+	}
 	if maxCollectorConnections := os.Getenv("MAX_COLLECTOR_CONNECTION"); maxCollectorConnections != "" {
 		config.MaxCollectorConnections, _ = strconv.Atoi(maxCollectorConnections)
 	}
@@ -146,11 +317,26 @@ func getDefaultConfig() *ServerConfig {
 	if filterQuerySample := os.Getenv("FILTER_QUERY_SAMPLE"); filterQuerySample != "" {
 		config.FilterQuerySample = filterQuerySample
 	}
+	if explainAllowRegexp := os.Getenv("EXPLAIN_ALLOW_REGEXP"); explainAllowRegexp != "" {
+		config.ExplainAllowRegexp = explainAllowRegexp
+	}
+	if explainDenyRegexp := os.Getenv("EXPLAIN_DENY_REGEXP"); explainDenyRegexp != "" {
+		config.ExplainDenyRegexp = explainDenyRegexp
+	}
+	if explainAllowFingerprints := os.Getenv("EXPLAIN_ALLOW_FINGERPRINTS"); explainAllowFingerprints != "" {
+		config.ExplainAllowFingerprints = explainAllowFingerprints
+	}
+	if explainDenyFingerprints := os.Getenv("EXPLAIN_DENY_FINGERPRINTS"); explainDenyFingerprints != "" {
+		config.ExplainDenyFingerprints = explainDenyFingerprints
+	}
+	if explainSampleInterval := os.Getenv("EXPLAIN_SAMPLE_INTERVAL"); explainSampleInterval != "" {
+		config.ExplainSampleInterval, _ = strconv.Atoi(explainSampleInterval)
+	}
 
 	return config
 }
 
-func createHTTPClient(requireSSL bool) *http.Client {
+func createHTTPClient(requireSSL bool, tlsConfig *tls.Config, tlsSettings string) *http.Client {
 	transport := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
@@ -162,6 +348,7 @@ func createHTTPClient(requireSSL bool) *http.Client {
 		IdleConnTimeout:       90 * time.Second,
 		TLSHandshakeTimeout:   10 * time.Second,
 		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig:       tlsConfig,
 	}
 	if requireSSL {
 		transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
@@ -181,12 +368,16 @@ func createHTTPClient(requireSSL bool) *http.Client {
 			}
 			return (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, DualStack: true}).DialContext(ctx, network, addr)
 		}
-		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if tlsSettings != "" {
+		roundTripper = &tlsSettingsTransport{transport: transport, settings: tlsSettings}
 	}
 
 	return &http.Client{
 		Timeout:   120 * time.Second,
-		Transport: transport,
+		Transport: roundTripper,
 	}
 }
 
@@ -213,17 +404,226 @@ func autoDetectFromHostname(config *ServerConfig) *ServerConfig {
 	return config
 }
 
-// Read - Reads the configuration from the specified filename, or fall back to the default config
+// loadConfigFile - Loads the given config file, or when a directory is passed, all *.conf files
+// in it (in alphabetical order, with later files overriding settings of earlier ones)
+func loadConfigFile(filename string) (*ini.File, error) {
+	fi, err := os.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return ini.Load(filename)
+	}
+
+	filenames, err := filepath.Glob(filepath.Join(filename, "*.conf"))
+	if err != nil {
+		return nil, err
+	}
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("No *.conf files found in config directory %s", filename)
+	}
+	sort.Strings(filenames)
+
+	// Each server section may only be defined once, since merging them would
+	// silently combine the settings of two different servers
+	sectionFilenames := make(map[string]string)
+	sources := []interface{}{}
+	for _, f := range filenames {
+		configFile, err := ini.Load(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", f, err)
+		}
+		for _, name := range configFile.SectionStrings() {
+			if name == ini.DEFAULT_SECTION || name == "pganalyze" {
+				continue
+			}
+			if otherFilename, exists := sectionFilenames[name]; exists {
+				return nil, fmt.Errorf("Config section %s is defined in both %s and %s", name, otherFilename, f)
+			}
+			sectionFilenames[name] = f
+		}
+		sources = append(sources, f)
+	}
+
+	return ini.Load(sources[0], sources[1:]...)
+}
+
+// getControlServerConfig - Reads the control server settings from the [pganalyze]
+// section (if there is a config file), with environment variables taking precedence
+func getControlServerConfig(configFile *ini.File) (ControlServerConfig, error) {
+	var config ControlServerConfig
+
+	if configFile != nil {
+		err := configFile.Section("pganalyze").MapTo(&config)
+		if err != nil {
+			return config, err
+		}
+	}
+
+	if listenAddress := os.Getenv("PGA_CONTROL_LISTEN_ADDRESS"); listenAddress != "" {
+		config.ListenAddress = listenAddress
+	}
+	if tlsCertFile := os.Getenv("PGA_CONTROL_TLS_CERT_FILE"); tlsCertFile != "" {
+		config.TLSCertFile = tlsCertFile
+	}
+	if tlsKeyFile := os.Getenv("PGA_CONTROL_TLS_KEY_FILE"); tlsKeyFile != "" {
+		config.TLSKeyFile = tlsKeyFile
+	}
+	if tlsClientCAFile := os.Getenv("PGA_CONTROL_TLS_CLIENT_CA_FILE"); tlsClientCAFile != "" {
+		config.TLSClientCAFile = tlsClientCAFile
+	}
+
+	if config.ListenAddress == "" {
+		return config, nil
+	}
+
+	_, err := config.GetTLSConfig()
+	if err != nil {
+		return config, err
+	}
+
+	// Never serve the debug endpoints beyond the local machine without client authentication
+	host, _, err := net.SplitHostPort(config.ListenAddress)
+	if err != nil {
+		return config, fmt.Errorf("Invalid control_listen_address: %s", err)
+	}
+	ip := net.ParseIP(host)
+	if host != "localhost" && (ip == nil || !ip.IsLoopback()) && config.TLSClientCAFile == "" {
+		return config, fmt.Errorf("control_listen_address %s is not a loopback address, this requires control_tls_cert_file, control_tls_key_file and control_tls_client_ca_file", config.ListenAddress)
+	}
+
+	return config, nil
+}
+
+// getUploadMaxBytesPerSec - Reads the upload rate limit from the [pganalyze]
+// section (if there is a config file), with the environment variable taking precedence
+func getUploadMaxBytesPerSec(configFile *ini.File) int {
+	var uploadMaxBytesPerSec int
+
+	if configFile != nil {
+		uploadMaxBytesPerSec = configFile.Section("pganalyze").Key("upload_max_bytes_per_sec").MustInt(0)
+	}
+	if value := os.Getenv("PGA_UPLOAD_MAX_BYTES_PER_SEC"); value != "" {
+		uploadMaxBytesPerSec, _ = strconv.Atoi(value)
+	}
+
+	return uploadMaxBytesPerSec
+}
+
+const defaultActivityHistoryMaxBytes = 16 * 1024 * 1024
+
+// getActivityHistoryLimits - Reads the local activity history settings from the
+// [pganalyze] section (if there is a config file), with environment variables taking precedence
+func getActivityHistoryLimits(configFile *ini.File) (minutes int, maxBytes int) {
+	maxBytes = defaultActivityHistoryMaxBytes
+
+	if configFile != nil {
+		minutes = configFile.Section("pganalyze").Key("activity_history_minutes").MustInt(0)
+		maxBytes = configFile.Section("pganalyze").Key("activity_history_max_bytes").MustInt(defaultActivityHistoryMaxBytes)
+	}
+	if value := os.Getenv("PGA_ACTIVITY_HISTORY_MINUTES"); value != "" {
+		minutes, _ = strconv.Atoi(value)
+	}
+	if value := os.Getenv("PGA_ACTIVITY_HISTORY_MAX_BYTES"); value != "" {
+		maxBytes, _ = strconv.Atoi(value)
+	}
+
+	return
+}
+
+// getShutdownGracePeriod - Reads the shutdown grace period (in seconds) from the [pganalyze]
+// section (if there is a config file), with the environment variable taking precedence
+//
+// Returns -1 when not set (or invalid), which uses the default - 0 disables the grace period.
+func getShutdownGracePeriod(configFile *ini.File) int {
+	shutdownGracePeriod := -1
+
+	if configFile != nil {
+		shutdownGracePeriod = configFile.Section("pganalyze").Key("shutdown_grace_period").MustInt(-1)
+	}
+	if value := os.Getenv("PGA_SHUTDOWN_GRACE_PERIOD"); value != "" {
+		var err error
+		shutdownGracePeriod, err = strconv.Atoi(value)
+		if err != nil {
+			shutdownGracePeriod = -1
+		}
+	}
+
+	return shutdownGracePeriod
+}
+
+// addServer - Adds the given server config (unless it duplicates an existing one)
+func addServer(logger *util.Logger, servers []ServerConfig, config ServerConfig) []ServerConfig {
+	config = *autoDetectFromHostname(&config)
+	config.SystemType, config.SystemScope, config.SystemID = identifySystem(config)
+
+	config.Identifier = ServerIdentifier{
+		APIKey:      config.APIKey,
+		APIBaseURL:  config.APIBaseURL,
+		SystemID:    config.SystemID,
+		SystemType:  config.SystemType,
+		SystemScope: config.SystemScope,
+	}
+
+	if config.GetDbName() == "" {
+		return servers
+	}
+
+	// Ensure we have no duplicate identifiers within one collector
+	for _, server := range servers {
+		if config.Identifier == server.Identifier {
+			logger.PrintError("Skipping config section %s, detected as duplicate", config.SectionName)
+			return servers
+		}
+	}
+
+	return append(servers, config)
+}
+
+// addDiscoveredServers - Runs service discovery for the given config section,
+// and adds a server for each database endpoint found
+func addDiscoveredServers(logger *util.Logger, conf Config, template ServerConfig) Config {
+	endpoints, err := discoverEndpoints(template)
+	if err != nil {
+		// Keep the section around, so a later rediscovery can pick up the servers
+		logger.PrintError("Service discovery failed for config section %s: %s", template.SectionName, err)
+	} else {
+		logger.PrintVerbose("Discovered %d database(s) for config section %s", len(endpoints), template.SectionName)
+	}
+
+	conf.discoveryTemplates = append(conf.discoveryTemplates, discoveryTemplate{config: template, endpoints: endpoints})
+
+	for _, endpoint := range endpoints {
+		config, err := configForEndpoint(template, endpoint)
+		if err != nil {
+			logger.PrintError("Skipping discovered database %s for config section %s: %s", endpoint, template.SectionName, err)
+			continue
+		}
+		conf.Servers = addServer(logger, conf.Servers, config)
+	}
+
+	return conf
+}
+
+// Read - Reads the configuration from the specified filename (or directory), or fall back to the default config
 func Read(logger *util.Logger, filename string) (Config, error) {
 	var conf Config
 	var err error
 
 	if _, err = os.Stat(filename); err == nil {
-		configFile, err := ini.Load(filename)
+		configFile, err := loadConfigFile(filename)
 		if err != nil {
 			return conf, err
 		}
 
+		conf.ControlServer, err = getControlServerConfig(configFile)
+		if err != nil {
+			return conf, fmt.Errorf("Invalid control server configuration: %s", err)
+		}
+		conf.UploadMaxBytesPerSec = getUploadMaxBytesPerSec(configFile)
+		conf.ActivityHistoryMinutes, conf.ActivityHistoryMaxBytes = getActivityHistoryLimits(configFile)
+		conf.ShutdownGracePeriod = getShutdownGracePeriod(configFile)
+
 		defaultConfig := getDefaultConfig()
 
 		err = configFile.Section("pganalyze").MapTo(defaultConfig)
@@ -272,52 +672,136 @@ func Read(logger *util.Logger, filename string) (Config, error) {
 				config.AwsEndpointSigningRegion = config.AwsEndpointSigningRegionLegacy
 			}
 
-			config = autoDetectFromHostname(config)
 			config.SectionName = section.Name()
-			config.SystemType, config.SystemScope, config.SystemID = identifySystem(*config)
-
-			config.Identifier = ServerIdentifier{
-				APIKey:      config.APIKey,
-				APIBaseURL:  config.APIBaseURL,
-				SystemID:    config.SystemID,
-				SystemType:  config.SystemType,
-				SystemScope: config.SystemScope,
-			}
 
-			if config.GetDbName() != "" {
-				// Ensure we have no duplicate identifiers within one collector
-				skip := false
-				for _, server := range conf.Servers {
-					if config.Identifier == server.Identifier {
-						skip = true
-					}
-				}
-				if skip {
-					logger.PrintError("Skipping config section %s, detected as duplicate", config.SectionName)
-				} else {
-					conf.Servers = append(conf.Servers, *config)
-				}
+			if config.hasDiscovery() {
+				conf = addDiscoveredServers(logger, conf, *config)
+				continue
 			}
+
+			conf.Servers = addServer(logger, conf.Servers, *config)
 		}
 
-		if len(conf.Servers) == 0 {
+		if len(conf.Servers) == 0 && len(conf.discoveryTemplates) == 0 {
 			return conf, fmt.Errorf("Configuration file is empty, please edit %s and reload the collector", filename)
 		}
 	} else {
 		if os.Getenv("DYNO") != "" && os.Getenv("PORT") != "" {
 			conf = handleHeroku()
 		} else if os.Getenv("PGA_API_KEY") != "" {
+			conf.ControlServer, err = getControlServerConfig(nil)
+			if err != nil {
+				return conf, fmt.Errorf("Invalid control server configuration: %s", err)
+			}
+			conf.UploadMaxBytesPerSec = getUploadMaxBytesPerSec(nil)
+			conf.ActivityHistoryMinutes, conf.ActivityHistoryMaxBytes = getActivityHistoryLimits(nil)
+			conf.ShutdownGracePeriod = getShutdownGracePeriod(nil)
+
 			config := getDefaultConfig()
-			config = autoDetectFromHostname(config)
-			config.SystemType, config.SystemScope, config.SystemID = identifySystem(*config)
-			conf.Servers = append(conf.Servers, *config)
+			if config.hasDiscovery() {
+				conf = addDiscoveredServers(logger, conf, *config)
+			} else {
+				config = autoDetectFromHostname(config)
+				config.SystemType, config.SystemScope, config.SystemID = identifySystem(*config)
+				conf.Servers = append(conf.Servers, *config)
+			}
 		} else {
 			return conf, fmt.Errorf("No configuration file found at %s, and no environment variables set", filename)
 		}
 	}
 
+	return prepareServers(conf)
+}
+
+// ReadFromCommandLine - Builds the configuration for a single server specified on
+// the command line (without reading any config file), applied on top of the defaults
+func ReadFromCommandLine(logger *util.Logger, override ServerConfig) (Config, error) {
+	var conf Config
+
+	config := getDefaultConfig()
+	config.SectionName = "command-line"
+	if override.APIKey != "" {
+		config.APIKey = override.APIKey
+	}
+	if override.DbURL != "" {
+		config.DbURL = override.DbURL
+	}
+	if override.DbHost != "" {
+		config.DbHost = override.DbHost
+	}
+	if override.DbPort != 0 {
+		config.DbPort = override.DbPort
+	}
+	if override.DbName != "" {
+		config.DbName = override.DbName
+	}
+	if override.DbUsername != "" {
+		config.DbUsername = override.DbUsername
+	}
+	if override.DbPassword != "" {
+		config.DbPassword = override.DbPassword
+	}
+
+	conf.Servers = addServer(logger, conf.Servers, *config)
+	conf.ShutdownGracePeriod = getShutdownGracePeriod(nil)
+
+	return prepareServers(conf)
+}
+
+func validQueryStatsRankBy(metric string) bool {
+	for _, valid := range QueryStatsRankByMetrics {
+		if metric == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// prepareServers - Validates the server configs, and sets up their HTTP client and EXPLAIN filter
+func prepareServers(conf Config) (Config, error) {
 	for idx, server := range conf.Servers {
-		conf.Servers[idx].HTTPClient = createHTTPClient(server.APIBaseURL == defaultAPIBaseURL)
+		requireSSL := server.APIBaseURL == defaultAPIBaseURL
+		tlsConfig, err := server.GetAPITLSConfig(requireSSL)
+		if err != nil {
+			return conf, fmt.Errorf("Invalid TLS configuration in config section %s: %s", server.SectionName, err)
+		}
+		conf.Servers[idx].HTTPClient = createHTTPClient(requireSSL, tlsConfig, server.apiTLSSettings())
+
+		conf.Servers[idx].ExplainFilter, err = NewExplainFilter(server)
+		if err != nil {
+			return conf, fmt.Errorf("Invalid EXPLAIN filter in config section %s: %s", server.SectionName, err)
+		}
+
+		if server.StatementSource != "pg_stat_statements" && server.StatementSource != "pg_stat_monitor" {
+			return conf, fmt.Errorf("Invalid statement_source in config section %s: needs to be pg_stat_statements or pg_stat_monitor", server.SectionName)
+		}
+		if !validQueryStatsRankBy(server.GetQueryStatsRankBy()) {
+			return conf, fmt.Errorf("Invalid query_stats_rank_by in config section %s: needs to be one of %s", server.SectionName, strings.Join(QueryStatsRankByMetrics, ", "))
+		}
+		conf.Servers[idx].LabelMap, err = parseLabels(server.Labels)
+		if err != nil {
+			return conf, fmt.Errorf("Invalid labels in config section %s: %s", server.SectionName, err)
+		}
+		conf.Servers[idx].APIDestinations, err = parseAPIDestinations(server.AdditionalAPIDestinations)
+		if err != nil {
+			return conf, fmt.Errorf("Invalid additional_api_destinations in config section %s: %s", server.SectionName, err)
+		}
+		if server.FullSnapshotSchedule != "" {
+			if _, err = scheduler.ParseGroup(server.FullSnapshotSchedule); err != nil {
+				return conf, fmt.Errorf("Invalid full_snapshot_schedule in config section %s: %s", server.SectionName, err)
+			}
+		}
+		for _, pattern := range append(splitDatabasePatterns(server.DbIncludePattern), splitDatabasePatterns(server.DbExcludePattern)...) {
+			if _, err = filepath.Match(pattern, ""); err != nil {
+				return conf, fmt.Errorf("Invalid database pattern \"%s\" in config section %s: %s", pattern, server.SectionName, err)
+			}
+		}
+		if server.DbSslServerName != "" && server.GetDbSslMode() != "verify-ca" && server.GetDbSslMode() != "verify-full" {
+			return conf, fmt.Errorf("Invalid db_sslservername in config section %s: requires db_sslmode to be verify-ca or verify-full", server.SectionName)
+		}
+		if server.SSHTunnelHost != "" && (server.SSHTunnelUser == "" || server.SSHTunnelKeyFile == "") {
+			return conf, fmt.Errorf("Invalid SSH tunnel configuration in config section %s: ssh_tunnel_user and ssh_tunnel_key_file are required", server.SectionName)
+		}
 	}
 
 	return conf, nil
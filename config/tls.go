@@ -0,0 +1,136 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// Cipher suites that can be configured with api_cipher_suites (TLS 1.2 and older) -
+// only ECDHE key exchange (for forward secrecy) with AEAD ciphers is allowed, so RSA
+// key exchange and CBC mode suites are rejected. TLS 1.3 suites are always enabled by
+// Go itself.
+var tlsCipherSuites = map[string]uint16{
+	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256":         tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256":       tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384":         tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384":       tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305":          tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305":        tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256":   tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256": tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+}
+
+// GetAPITLSConfig - Builds the TLS configuration used for connections to the pganalyze API,
+// based on the minimum TLS version and cipher suites the user configured (if any)
+func (config ServerConfig) GetAPITLSConfig(requireSSL bool) (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+
+	if requireSSL {
+		tlsConfig.MinVersion = tls.VersionTLS12
+	}
+
+	if config.APIMinTLSVersion != "" {
+		minVersion, ok := tlsVersions[strings.TrimPrefix(config.APIMinTLSVersion, "TLS")]
+		if !ok {
+			return nil, fmt.Errorf("Unsupported api_min_tls_version \"%s\", needs to be one of 1.0, 1.1, 1.2 or 1.3", config.APIMinTLSVersion)
+		}
+		if minVersion > tlsConfig.MinVersion {
+			tlsConfig.MinVersion = minVersion
+		}
+	}
+
+	if config.APICipherSuites != "" {
+		for _, name := range strings.Split(config.APICipherSuites, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+			id, ok := tlsCipherSuites[name]
+			if !ok {
+				return nil, fmt.Errorf("Unknown or insecure cipher suite \"%s\" in api_cipher_suites", name)
+			}
+			tlsConfig.CipherSuites = append(tlsConfig.CipherSuites, id)
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// apiTLSSettings - Names of the settings that restrict TLS connections to the pganalyze API
+// (empty if none are set), used to point out which setting a failed TLS handshake is due to
+func (config ServerConfig) apiTLSSettings() string {
+	var settings []string
+	if config.APIMinTLSVersion != "" {
+		settings = append(settings, "api_min_tls_version")
+	}
+	if config.APICipherSuites != "" {
+		settings = append(settings, "api_cipher_suites")
+	}
+	return strings.Join(settings, " and ")
+}
+
+// tlsSettingsTransport - Explains TLS handshake failures that happen because of the
+// api_min_tls_version or api_cipher_suites settings, since the errors returned by
+// crypto/tls (e.g. "remote error: tls: handshake failure") don't make that obvious
+type tlsSettingsTransport struct {
+	transport http.RoundTripper
+	settings  string
+}
+
+func (t *tlsSettingsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil && strings.Contains(err.Error(), "tls: ") {
+		return nil, fmt.Errorf("%s (TLS handshake failed, the server may not support the TLS version or cipher suites required by %s)", err, t.settings)
+	}
+	return resp, err
+}
+
+// GetTLSConfig - Builds the TLS configuration for the local control server, or
+// returns nil if it should serve plaintext
+func (config ControlServerConfig) GetTLSConfig() (*tls.Config, error) {
+	if config.TLSCertFile == "" && config.TLSKeyFile == "" {
+		if config.TLSClientCAFile != "" {
+			return nil, fmt.Errorf("control_tls_client_ca_file requires control_tls_cert_file and control_tls_key_file to be set")
+		}
+		return nil, nil
+	}
+	if config.TLSCertFile == "" || config.TLSKeyFile == "" {
+		return nil, fmt.Errorf("control_tls_cert_file and control_tls_key_file need to be set together")
+	}
+
+	cert, err := tls.LoadX509KeyPair(config.TLSCertFile, config.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("Could not load control server certificate: %s", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if config.TLSClientCAFile != "" {
+		caPEM, err := ioutil.ReadFile(config.TLSClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("Could not read control server client CA: %s", err)
+		}
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("No certificates found in control server client CA file %s", config.TLSClientCAFile)
+		}
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
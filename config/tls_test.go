@@ -0,0 +1,109 @@
+package config
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var getAPITLSConfigTests = []struct {
+	name                 string
+	requireSSL           bool
+	minTLSVersion        string
+	cipherSuites         string
+	expectedMinVersion   uint16
+	expectedCipherSuites []uint16
+	err                  string
+}{
+	{"defaults", false, "", "", 0, nil, ""},
+	{"defaults with required SSL", true, "", "", tls.VersionTLS12, nil, ""},
+	{"min version", false, "1.1", "", tls.VersionTLS11, nil, ""},
+	{"min version with TLS prefix", false, "TLS1.3", "", tls.VersionTLS13, nil, ""},
+	{"min version doesn't lower required SSL", true, "1.0", "", tls.VersionTLS12, nil, ""},
+	{"min version raises required SSL", true, "1.3", "", tls.VersionTLS13, nil, ""},
+	{"unsupported min version", false, "1.4", "", 0, nil, "Unsupported api_min_tls_version \"1.4\""},
+	{
+		"cipher suites",
+		false,
+		"",
+		" TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,,TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256 ",
+		0,
+		[]uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305},
+		"",
+	},
+	{"unknown cipher suite", false, "", "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,TLS_FOO", 0, nil, "Unknown or insecure cipher suite \"TLS_FOO\""},
+	{"no forward secrecy", false, "", "TLS_RSA_WITH_AES_128_GCM_SHA256", 0, nil, "Unknown or insecure cipher suite \"TLS_RSA_WITH_AES_128_GCM_SHA256\""},
+	{"CBC mode", false, "", "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA", 0, nil, "Unknown or insecure cipher suite \"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA\""},
+	{"RC4", false, "", "TLS_ECDHE_RSA_WITH_RC4_128_SHA", 0, nil, "Unknown or insecure cipher suite \"TLS_ECDHE_RSA_WITH_RC4_128_SHA\""},
+}
+
+func TestGetAPITLSConfig(t *testing.T) {
+	for _, test := range getAPITLSConfigTests {
+		conf := ServerConfig{APIMinTLSVersion: test.minTLSVersion, APICipherSuites: test.cipherSuites}
+		tlsConfig, err := conf.GetAPITLSConfig(test.requireSSL)
+		if test.err != "" {
+			if err == nil || !strings.HasPrefix(err.Error(), test.err) {
+				t.Errorf("%s: expected error %q, got %v", test.name, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if tlsConfig.MinVersion != test.expectedMinVersion {
+			t.Errorf("%s: expected min version %x, got %x", test.name, test.expectedMinVersion, tlsConfig.MinVersion)
+		}
+		if !reflect.DeepEqual(tlsConfig.CipherSuites, test.expectedCipherSuites) {
+			t.Errorf("%s: expected cipher suites %v, got %v", test.name, test.expectedCipherSuites, tlsConfig.CipherSuites)
+		}
+	}
+}
+
+var apiTLSSettingsTests = []struct {
+	minTLSVersion string
+	cipherSuites  string
+	expected      string
+}{
+	{"", "", ""},
+	{"1.3", "", "api_min_tls_version"},
+	{"", "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "api_cipher_suites"},
+	{"1.2", "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "api_min_tls_version and api_cipher_suites"},
+}
+
+func TestAPITLSSettings(t *testing.T) {
+	for _, test := range apiTLSSettingsTests {
+		conf := ServerConfig{APIMinTLSVersion: test.minTLSVersion, APICipherSuites: test.cipherSuites}
+		if actual := conf.apiTLSSettings(); actual != test.expected {
+			t.Errorf("%q/%q: expected %q, got %q", test.minTLSVersion, test.cipherSuites, test.expected, actual)
+		}
+	}
+}
+
+func TestAPITLSHandshakeError(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	conf := ServerConfig{APIMinTLSVersion: "1.3"}
+	tlsConfig, err := conf.GetAPITLSConfig(false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	_, err = createHTTPClient(false, tlsConfig, conf.apiTLSSettings()).Get(server.URL)
+	if err == nil || !strings.Contains(err.Error(), "required by api_min_tls_version") {
+		t.Errorf("Expected handshake error to name api_min_tls_version, got %v", err)
+	}
+
+	// Other errors are returned unchanged
+	server.Close()
+	_, err = createHTTPClient(false, tlsConfig, conf.apiTLSSettings()).Get(server.URL)
+	if err == nil || strings.Contains(err.Error(), "api_min_tls_version") {
+		t.Errorf("Expected connection error without TLS hint, got %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Start - Starts the local control server (if configured), which serves Go's
+// pprof endpoints below /debug/pprof/, the recent activity history, and the
+// last submitted full snapshots
+//
+// The returned channel stops the server, and is nil if the server is disabled.
+func Start(conf config.ControlServerConfig, activityHistory *state.ActivityHistory, lastSnapshots *state.LastSnapshots, logger *util.Logger) chan<- bool {
+	if conf.ListenAddress == "" {
+		return nil
+	}
+
+	// This was already validated when reading the config
+	tlsConfig, err := conf.GetTLSConfig()
+	if err != nil {
+		logger.PrintError("Could not start control server: %s", err)
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", conf.ListenAddress)
+	if err != nil {
+		logger.PrintError("Could not start control server: %s", err)
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("/debug/activity_history", func(w http.ResponseWriter, r *http.Request) {
+		serveActivityHistory(w, r, activityHistory)
+	})
+	mux.HandleFunc("/debug/last_snapshot", func(w http.ResponseWriter, r *http.Request) {
+		serveLastSnapshot(w, r, lastSnapshots)
+	})
+
+	server := &http.Server{Handler: mux, TLSConfig: tlsConfig}
+
+	go func() {
+		var err error
+		if tlsConfig != nil {
+			logger.PrintVerbose("Control server listening on https://%s", conf.ListenAddress)
+			err = server.ServeTLS(listener, "", "")
+		} else {
+			logger.PrintVerbose("Control server listening on http://%s", conf.ListenAddress)
+			err = server.Serve(listener)
+		}
+		if err != nil && err != http.ErrServerClosed {
+			logger.PrintError("Control server stopped: %s", err)
+		}
+	}()
+
+	stop := make(chan bool)
+	go func() {
+		<-stop
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(ctx)
+	}()
+	return stop
+}
+
+// serveActivityHistory - Dumps the retained activity snapshots and collection
+// failures as JSON (optionally only for the server given as ?server=<section name>)
+func serveActivityHistory(w http.ResponseWriter, r *http.Request, activityHistory *state.ActivityHistory) {
+	if !activityHistory.Enabled() {
+		http.Error(w, "Activity history is disabled, set activity_history_minutes to enable it", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	activityHistory.WriteJSON(w, r.URL.Query().Get("server"))
+}
+
+// serveLastSnapshot - Dumps the full snapshot last submitted for the server given
+// as ?server=<section name> as JSON (the parameter is optional with only one server)
+func serveLastSnapshot(w http.ResponseWriter, r *http.Request, lastSnapshots *state.LastSnapshots) {
+	serverName := r.URL.Query().Get("server")
+	if serverName == "" {
+		names := lastSnapshots.ServerNames()
+		if len(names) != 1 {
+			http.Error(w, fmt.Sprintf("Specify ?server=<section name>, snapshots are available for: %s", strings.Join(names, ", ")), http.StatusBadRequest)
+			return
+		}
+		serverName = names[0]
+	}
+
+	data, exists := lastSnapshots.Get(serverName)
+	if !exists {
+		http.Error(w, "No full snapshot was submitted for this server yet", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
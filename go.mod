@@ -24,7 +24,7 @@ require (
 	github.com/kr/logfmt v0.0.0-20140226030751-b84e30acd515
 	github.com/kylelemons/godebug v0.0.0-20170224010052-a616ab194758
 	github.com/lfittl/pg_query_go v1.0.0
-	github.com/lib/pq v1.3.0
+	github.com/lib/pq v1.10.9
 	github.com/ogier/pflag v0.0.0-20160129220114-45c278ab3607
 	github.com/pkg/errors v0.8.2-0.20190227000051-27936f6d90f9
 	github.com/satori/go.uuid v0.0.0-20160713180306-0aa62d5ddceb
@@ -33,6 +33,7 @@ require (
 	github.com/smartystreets/assertions v0.0.0-20160707190355-2063fd1cc7c9 // indirect
 	github.com/smartystreets/goconvey v0.0.0-20160704134950-4622128e06c7 // indirect
 	github.com/stretchr/testify v1.3.0 // indirect
+	golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2
 	golang.org/x/net v0.0.0-20190301231341-16b79f2e4e95 // indirect
 	golang.org/x/sys v0.0.0-20190304154630-e844e0132e93 // indirect
 	golang.org/x/text v0.3.1-0.20180807135948-17ff2d5776d2 // indirect
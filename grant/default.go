@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
@@ -21,9 +22,10 @@ func GetDefaultGrant(server state.Server, globalCollectionOpts state.CollectionO
 	req.Header.Set("Pganalyze-System-Type", server.Config.SystemType)
 	req.Header.Set("Pganalyze-System-Scope", server.Config.SystemScope)
 	req.Header.Set("User-Agent", util.CollectorNameAndVersion)
+	req.Header.Set("Pganalyze-Snapshot-Schema-Version", strconv.Itoa(util.SnapshotSchemaVersion))
 	req.Header.Add("Accept", "application/json")
 
-	resp, err := server.Config.HTTPClient.Do(req)
+	resp, err := server.Config.HTTPClient.Do(req.WithContext(util.ShutdownContext()))
 	if err != nil {
 		return state.Grant{}, err
 	}
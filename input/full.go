@@ -7,10 +7,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guregu/null"
 	"github.com/pganalyze/collector/input/postgres"
 	"github.com/pganalyze/collector/input/system"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
+	"github.com/pkg/errors"
 )
 
 // CollectFull - Collects a "full" snapshot of all data we need on a regular interval
@@ -18,8 +20,15 @@ func CollectFull(server state.Server, connection *sql.DB, globalCollectionOpts s
 	systemType := server.Config.SystemType
 
 	ps.CollectedAt = time.Now()
+	ps.ExplainSampling = server.PrevState.ExplainSampling
+	ts.CollectionStatus = make(state.CollectionSectionStatusMap)
 
+	postgres.SetSectionStatementTimeout(connection, logger, server)
+	defer postgres.SetDefaultStatementTimeout(connection, logger, server)
+
+	start := time.Now()
 	ts.Version, err = postgres.GetPostgresVersion(logger, connection)
+	ts.CollectionStatus.Record("version", start, err)
 	if err != nil {
 		logger.PrintError("Error collecting Postgres Version")
 		return
@@ -30,65 +39,249 @@ func CollectFull(server state.Server, connection *sql.DB, globalCollectionOpts s
 		return
 	}
 
+	ts.Privileges = postgres.GetPrivileges(logger, connection, server, ts.Version)
+	ts.HasPrivileges = true
+
+	start = time.Now()
+	ps.InRecovery, err = postgres.GetIsInRecovery(connection)
+	ts.CollectionStatus.Record("recovery", start, err)
+	if err != nil {
+		logger.PrintError("Error checking whether server is in recovery")
+		return
+	}
+
+	start = time.Now()
 	ts.Roles, err = postgres.GetRoles(logger, connection, ts.Version)
+	ts.CollectionStatus.Record("roles", start, err)
 	if err != nil {
 		logger.PrintError("Error collecting pg_roles")
 		return
 	}
 
+	start = time.Now()
 	ts.Databases, err = postgres.GetDatabases(logger, connection, ts.Version)
+	ts.CollectionStatus.Record("databases", start, err)
 	if err != nil {
 		logger.PrintError("Error collecting pg_databases")
 		return
 	}
 
+	if server.Config.DbAllNames && server.Config.DbMaxSizeMB > 0 {
+		start = time.Now()
+		ts.DatabaseSizes, err = postgres.GetDatabaseSizes(connection)
+		ts.CollectionStatus.Record("database_sizes", start, err)
+		if err != nil {
+			logger.PrintWarning("Error collecting database sizes, not skipping any databases based on db_max_size_mb: %s", err)
+			err = nil
+		}
+	}
+
+	statementSource, err := postgres.GetStatementSource(server.Config.StatementSource)
+	if err != nil {
+		return
+	}
+	ts.QuerySource = statementSource.Name()
+
+	start = time.Now()
+	ts.Tablespaces, err = postgres.GetTablespaces(connection, ts.Version)
+	ts.CollectionStatus.Record("tablespaces", start, err)
+	if err != nil {
+		logger.PrintWarning("Error collecting tablespaces: %s", err)
+		err = nil
+	}
+
 	ps.LastStatementStatsAt = time.Now()
+	start = time.Now()
 	postgres.SetQueryTextStatementTimeout(connection, logger, server)
-	ts.Statements, ts.StatementTexts, ps.StatementStats, err = postgres.GetStatements(logger, connection, globalCollectionOpts, ts.Version, true, systemType)
-	postgres.SetDefaultStatementTimeout(connection, logger, server)
-	if err != nil {
-		err = fmt.Errorf("Error collecting pg_stat_statements: %s", err)
+	ts.Statements, ts.StatementTexts, ps.StatementStats, ps.StatementSourceCursor, err = statementSource.GetStatements(logger, connection, globalCollectionOpts, ts.Version, true, systemType, server.PrevState)
+	postgres.SetSectionStatementTimeout(connection, logger, server)
+	ts.CollectionStatus.Record("statements", start, err)
+	statementsTimedOut := sectionTimedOut(ts, "statements")
+	if statementsTimedOut {
+		logger.PrintWarning("Timed out collecting %s, skipping query statistics for this snapshot: %s", statementSource.Name(), err)
+		err = nil
+		// Keep the previous totals, so the next snapshot reports the statistics
+		// accumulated across both intervals instead of starting over
+		ps.StatementStats = server.PrevState.StatementStats
+		ps.StatementSourceCursor = server.PrevState.StatementSourceCursor
+		ps.LastStatementStatsAt = server.PrevState.LastStatementStatsAt
+	} else if err != nil {
+		err = errors.Wrapf(err, "Error collecting %s", statementSource.Name())
 		return
 	}
 
+	if statementSource.Name() == "pg_stat_statements" {
+		start = time.Now()
+		ps.StatementStatsInfo, ts.HasStatementStatsInfo, err = postgres.GetStatementStatsInfo(connection, ts.Version)
+		ts.CollectionStatus.Record("statements_info", start, err)
+		if err != nil {
+			logger.PrintWarning("Error collecting pg_stat_statements_info: %s", err)
+			err = nil
+		} else if ts.HasStatementStatsInfo {
+			ts.StatementStatsInfo = ps.StatementStatsInfo
+			ts.StatementDeallocDiff = diffStatementDealloc(server.PrevState.StatementStatsInfo, ps.StatementStatsInfo)
+			if ts.StatementDeallocDiff.Int64 > 0 {
+				logger.PrintWarning("pg_stat_statements evicted entries %d times since the last snapshot, query statistics are incomplete (consider raising pg_stat_statements.max)", ts.StatementDeallocDiff.Int64)
+			}
+		}
+	}
+
 	ps.StatementResetCounter = server.PrevState.StatementResetCounter + 1
-	if server.Grant.Config.Features.StatementResetFrequency != 0 && ps.StatementResetCounter >= server.Grant.Config.Features.StatementResetFrequency {
+	if !statementsTimedOut && server.Grant.Config.Features.StatementResetFrequency != 0 && ps.StatementResetCounter >= server.Grant.Config.Features.StatementResetFrequency {
 		ps.StatementResetCounter = 0
-		err = postgres.ResetStatements(logger, connection, systemType)
+		start = time.Now()
+		err = statementSource.ResetStatements(logger, connection, systemType)
 		if err != nil {
-			logger.PrintError("Error calling pg_stat_statements_reset() as requested: %s", err)
+			ts.CollectionStatus.Record("statements_reset", start, err)
+			logger.PrintError("Error resetting %s as requested: %s", statementSource.Name(), err)
 			return
 		}
-		_, _, ts.ResetStatementStats, err = postgres.GetStatements(logger, connection, globalCollectionOpts, ts.Version, false, systemType)
+		_, _, ts.ResetStatementStats, ps.StatementSourceCursor, err = statementSource.GetStatements(logger, connection, globalCollectionOpts, ts.Version, false, systemType, ps)
+		ts.CollectionStatus.Record("statements_reset", start, err)
 		if err != nil {
-			err = fmt.Errorf("Error collecting pg_stat_statements: %s", err)
+			err = errors.Wrapf(err, "Error collecting %s", statementSource.Name())
 			return
 		}
 	}
 
 	if globalCollectionOpts.CollectPostgresSettings {
+		start = time.Now()
 		ts.Settings, err = postgres.GetSettings(connection, ts.Version)
-		if err != nil {
+		ts.CollectionStatus.Record("settings", start, err)
+		if sectionTimedOut(ts, "settings") {
+			logger.PrintWarning("Timed out collecting config settings: %s", err)
+			err = nil
+		} else if err != nil {
 			logger.PrintError("Error collecting config settings")
 			return
 		}
 	}
 
+	start = time.Now()
 	ts.Replication, err = postgres.GetReplication(logger, connection, ts.Version, systemType)
+	ts.CollectionStatus.Record("replication", start, err)
 	if err != nil {
 		logger.PrintWarning("Error collecting replication statistics: %s", err)
 		// We intentionally accept this as a non-fatal issue (at least for now)
 		err = nil
 	}
 
-	ts.BackendCounts, err = postgres.GetBackendCounts(logger, connection, ts.Version, server.Config.SystemType)
+	if ps.InRecovery {
+		start = time.Now()
+		ps.RecoveryConflicts, err = postgres.GetRecoveryConflicts(connection)
+		ts.CollectionStatus.Record("recovery_conflicts", start, err)
+		if err != nil {
+			logger.PrintWarning("Error collecting recovery conflict statistics: %s", err)
+			err = nil
+		}
+	}
+
+	start = time.Now()
+	ps.SlruStats, err = postgres.GetSlruStats(connection, ts.Version)
+	ts.CollectionStatus.Record("slru_stats", start, err)
+	if err != nil {
+		logger.PrintWarning("Error collecting SLRU statistics: %s", err)
+		err = nil
+	}
+
+	start = time.Now()
+	ps.BgwriterStats, err = postgres.GetBgwriterStats(connection)
+	ts.CollectionStatus.Record("bgwriter_stats", start, err)
+	if err != nil {
+		logger.PrintWarning("Error collecting background writer statistics: %s", err)
+		err = nil
+	}
+
+	start = time.Now()
+	ts.LogicalReplication.Subscriptions, err = postgres.GetSubscriptions(logger, connection, ts.Version, server.Config.SubscriptionLagWarnSecs)
+	ts.CollectionStatus.Record("subscriptions", start, err)
+	if err != nil {
+		logger.PrintWarning("Error collecting logical replication subscriptions: %s", err)
+		err = nil
+	}
+
+	start = time.Now()
+	ts.LogicalReplication.Slots, ps.LogicalSlotWalHistory, err = postgres.GetLogicalReplicationSlots(logger, connection, ts.Version, server.PrevState.LogicalSlotWalHistory, ps.CollectedAt)
+	ts.CollectionStatus.Record("logical_replication_slots", start, err)
+	if err != nil {
+		logger.PrintWarning("Error collecting logical replication slots: %s", err)
+		err = nil
+	}
+
+	if server.Config.LabelsQuery != "" {
+		start = time.Now()
+		ts.DynamicLabels, ts.RelationLabels, err = postgres.GetDynamicLabels(connection, server.Config)
+		if err == nil {
+			ts.RelationLabelsDatabaseOid, err = postgres.CurrentDatabaseOid(connection)
+		}
+		ts.CollectionStatus.Record("labels_query", start, err)
+		if err != nil {
+			logger.PrintWarning("Error running labels_query: %s", err)
+			err = nil
+		}
+	}
+
+	start = time.Now()
+	ts.HbaRules, err = postgres.GetHbaRules(connection, ts.Version)
+	ts.CollectionStatus.Record("hba_rules", start, err)
 	if err != nil {
+		logger.PrintWarning("Error collecting pg_hba.conf rules: %s", err)
+		err = nil
+	}
+
+	start = time.Now()
+	ts.BackendCounts, err = postgres.GetBackendCounts(logger, connection, ts.Version, server.Config.SystemType)
+	ts.CollectionStatus.Record("backend_counts", start, err)
+	if sectionTimedOut(ts, "backend_counts") {
+		logger.PrintWarning("Timed out collecting backend counts: %s", err)
+		err = nil
+	} else if err != nil {
 		logger.PrintError("Error collecting backend counts: %s", err)
 		return
 	}
 
+	start = time.Now()
+	ts.ConnectionSaturation, ps.ConnectionPeakHistory, err = postgres.GetConnectionSaturation(logger, connection, ts.Version, server.PrevState.ConnectionPeak, server.PrevState.ConnectionPeakHistory, ps.CollectedAt, server.Config.ConnectionSaturationWarnPercent)
+	ts.CollectionStatus.Record("connection_saturation", start, err)
+	if err != nil {
+		logger.PrintWarning("Error collecting connection usage: %s", err)
+		ps.ConnectionPeak = server.PrevState.ConnectionPeak // Keep it for the next full snapshot
+		err = nil
+	} else {
+		ts.HasConnectionSaturation = true
+	}
+
+	start = time.Now()
+	ts.XminHorizon, ts.HasXminHorizon, err = postgres.GetXminHorizon(logger, connection, ts.Version, server.Config.XminHorizonWarnAge)
+	ts.CollectionStatus.Record("xmin_horizon", start, err)
+	if err != nil {
+		logger.PrintWarning("Error collecting xmin horizon: %s", err)
+		err = nil
+	}
+
+	if server.Config.EnableBufferCacheStats {
+		start = time.Now()
+		ts.BufferCacheStats, ts.HasBufferCacheStats, err = postgres.GetBufferCacheStats(logger, connection)
+		ts.CollectionStatus.Record("buffercache", start, err)
+		if err != nil {
+			logger.PrintWarning("Error collecting buffer cache statistics: %s", err)
+			err = nil
+		}
+	}
+
 	ps, ts = postgres.CollectAllSchemas(server, globalCollectionOpts, logger, ps, ts, systemType)
 
+	// The connection sat idle while the schema was collected over separate connections,
+	// and may have been dropped in the meantime (e.g. by a server restart or a firewall)
+	reconnected, err := postgres.ValidateConnection(connection, logger)
+	if err != nil {
+		err = errors.Wrap(err, "Lost database connection after collecting schema information")
+		return
+	}
+	if reconnected {
+		postgres.SetSectionStatementTimeout(connection, logger, server)
+	}
+
 	if server.Config.IgnoreTablePattern != "" {
 		var filteredRelations []state.PostgresRelation
 		patterns := strings.Split(server.Config.IgnoreTablePattern, ",")
@@ -107,11 +300,60 @@ func CollectFull(server state.Server, connection *sql.DB, globalCollectionOpts s
 		ps.Relations = filteredRelations
 	}
 
+	ts.DuplicateIndices = postgres.FindDuplicateIndices(logger, ps.Relations, ps.IndexStats)
+
+	if server.Config.RedactRelationPattern != "" || server.Config.RedactColumnPattern != "" {
+		ps.Relations = redactRelations(ps.Relations, server.Config)
+	}
+
+	// This runs after redaction, since it includes column names in the suggested index
+	ts.MissingForeignKeyIndices = postgres.FindMissingForeignKeyIndices(logger, ps.Relations)
+
+	start = time.Now()
+	ts.Wraparound, err = postgres.GetWraparound(logger, connection, ts.Databases, ps.Relations)
+	ts.CollectionStatus.Record("wraparound", start, err)
+	if err != nil {
+		logger.PrintWarning("Error collecting transaction ID wraparound statistics: %s", err)
+		err = nil
+	} else {
+		ts.HasWraparound = true
+	}
+
+	start = time.Now()
+	ts.AutovacuumForecast, err = postgres.GetAutovacuumForecast(connection, ps.Relations, ps.RelationStats)
+	ts.CollectionStatus.Record("autovacuum_forecast", start, err)
+	if err != nil {
+		logger.PrintWarning("Error collecting autovacuum settings: %s", err)
+		err = nil
+	} else {
+		ts.HasAutovacuumForecast = true
+	}
+
 	if globalCollectionOpts.CollectSystemInformation {
+		start = time.Now()
 		ps.System = system.GetSystemState(server.Config, logger)
+		ts.CollectionStatus.Record("system", start, nil)
 	}
 
 	ps.CollectorStats = getCollectorStats()
 
 	return
 }
+
+// sectionTimedOut - Whether the given section failed due to the section statement
+// timeout, in which case we continue with the rest of the collection
+func sectionTimedOut(ts state.TransientState, name string) bool {
+	return ts.CollectionStatus[name].TimedOut
+}
+
+// diffStatementDealloc - Determines how often pg_stat_statements evicted entries since
+// the previous snapshot (the counter starts over when pg_stat_statements gets reset)
+func diffStatementDealloc(prev state.PostgresStatementStatsInfo, current state.PostgresStatementStatsInfo) null.Int {
+	if prev.StatsReset.IsZero() {
+		return null.Int{}
+	}
+	if !prev.StatsReset.Equal(current.StatsReset) || current.Dealloc < prev.Dealloc {
+		return null.IntFrom(current.Dealloc)
+	}
+	return null.IntFrom(current.Dealloc - prev.Dealloc)
+}
@@ -19,7 +19,7 @@ func DownloadLogs(server state.Server, connection *sql.DB, collectionOpts state.
 
 	// TODO: Correctly pass connection for the logs runner case (on an interval)
 	if server.Config.EnableLogExplain && connection != nil {
-		ls.QuerySamples = postgres.RunExplain(connection, server.Config.GetDbName(), querySamples)
+		ls.QuerySamples = postgres.RunExplain(connection, server, collectionOpts, querySamples)
 	} else {
 		ls.QuerySamples = querySamples
 	}
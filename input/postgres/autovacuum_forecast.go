@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pganalyze/collector/state"
+)
+
+const autovacuumSettingsSQL string = `
+SELECT pg_catalog.current_setting('autovacuum') = 'on',
+			 pg_catalog.current_setting('autovacuum_vacuum_threshold')::bigint,
+			 pg_catalog.current_setting('autovacuum_vacuum_scale_factor')::float8,
+			 pg_catalog.current_setting('autovacuum_analyze_threshold')::bigint,
+			 pg_catalog.current_setting('autovacuum_analyze_scale_factor')::float8`
+
+// GetAutovacuumForecast - Determines the effective autovacuum settings of each table (the
+// server-wide settings overlayed with its storage parameters), and how many dead rows
+// (and modified rows) are needed for autovacuum to process it, based on the previously
+// collected relations and their statistics
+func GetAutovacuumForecast(db *sql.DB, relations []state.PostgresRelation, relationStats state.PostgresRelationStatsMap) (state.PostgresAutovacuumForecast, error) {
+	var forecast state.PostgresAutovacuumForecast
+
+	err := db.QueryRow(QueryMarkerSQL+autovacuumSettingsSQL).Scan(
+		&forecast.Settings.Enabled, &forecast.Settings.VacuumThreshold, &forecast.Settings.VacuumScaleFactor,
+		&forecast.Settings.AnalyzeThreshold, &forecast.Settings.AnalyzeScaleFactor)
+	if err != nil {
+		return forecast, fmt.Errorf("AutovacuumSettings/Query: %s", err)
+	}
+
+	for _, relation := range relations {
+		// Autovacuum doesn't process views or partitioned tables themselves
+		if relation.RelationType != "r" && relation.RelationType != "m" {
+			continue
+		}
+		stats, exists := relationStats[relation.Oid]
+		if !exists {
+			continue
+		}
+
+		settings, overridden := effectiveAutovacuumSettings(forecast.Settings, relation.Options)
+
+		// Tables that were never vacuumed or analyzed report -1 on Postgres 14+,
+		// which autovacuum treats as empty
+		tuples := relation.Tuples
+		if tuples < 0 {
+			tuples = 0
+		}
+
+		forecast.Relations = append(forecast.Relations, state.PostgresRelationAutovacuumForecast{
+			DatabaseOid:             relation.DatabaseOid,
+			RelationOid:             relation.Oid,
+			Settings:                settings,
+			Overridden:              overridden,
+			DeadTuples:              stats.NDeadTup,
+			VacuumTriggerDeadTuples: settings.VacuumThreshold + int64(math.Floor(settings.VacuumScaleFactor*tuples)),
+			ModsSinceAnalyze:        stats.NModSinceAnalyze,
+			AnalyzeTriggerMods:      settings.AnalyzeThreshold + int64(math.Floor(settings.AnalyzeScaleFactor*tuples)),
+		})
+	}
+
+	return forecast, nil
+}
+
+// effectiveAutovacuumSettings - Overlays the autovacuum storage parameters of a table
+// on the server-wide settings (invalid values are ignored, Postgres rejects them anyway)
+func effectiveAutovacuumSettings(settings state.PostgresAutovacuumSettings, options map[string]string) (state.PostgresAutovacuumSettings, bool) {
+	overridden := false
+
+	for name, value := range options {
+		switch name {
+		case "autovacuum_enabled":
+			if enabled, err := parseBoolOption(value); err == nil {
+				// Disabling autovacuum server-wide can't be overridden per table
+				settings.Enabled = settings.Enabled && enabled
+				overridden = true
+			}
+		case "autovacuum_vacuum_threshold":
+			if threshold, err := strconv.ParseInt(value, 10, 64); err == nil {
+				settings.VacuumThreshold = threshold
+				overridden = true
+			}
+		case "autovacuum_vacuum_scale_factor":
+			if scaleFactor, err := strconv.ParseFloat(value, 64); err == nil {
+				settings.VacuumScaleFactor = scaleFactor
+				overridden = true
+			}
+		case "autovacuum_analyze_threshold":
+			if threshold, err := strconv.ParseInt(value, 10, 64); err == nil {
+				settings.AnalyzeThreshold = threshold
+				overridden = true
+			}
+		case "autovacuum_analyze_scale_factor":
+			if scaleFactor, err := strconv.ParseFloat(value, 64); err == nil {
+				settings.AnalyzeScaleFactor = scaleFactor
+				overridden = true
+			}
+		}
+	}
+
+	return settings, overridden
+}
+
+// parseBoolOption - Parses a boolean storage parameter the way Postgres does (any
+// unique prefix of true/false/yes/no/on/off, or 1/0)
+func parseBoolOption(value string) (bool, error) {
+	value = strings.ToLower(value)
+	switch {
+	case value == "1", value == "on", value != "" && strings.HasPrefix("true", value), value != "" && strings.HasPrefix("yes", value):
+		return true, nil
+	case value == "0", value == "of", value == "off", value != "" && strings.HasPrefix("false", value), value != "" && strings.HasPrefix("no", value):
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean value: %s", value)
+}
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/pganalyze/collector/state"
+)
+
+var parseBoolOptionTests = []struct {
+	value     string
+	expected  bool
+	expectErr bool
+}{
+	{"true", true, false},
+	{"TRUE", true, false},
+	{"t", true, false},
+	{"yes", true, false},
+	{"y", true, false},
+	{"on", true, false},
+	{"1", true, false},
+	{"false", false, false},
+	{"f", false, false},
+	{"no", false, false},
+	{"n", false, false},
+	{"off", false, false},
+	{"of", false, false},
+	{"0", false, false},
+	{"o", false, true},
+	{"", false, true},
+	{"truee", false, true},
+	{"2", false, true},
+}
+
+func TestParseBoolOption(t *testing.T) {
+	for _, test := range parseBoolOptionTests {
+		actual, err := parseBoolOption(test.value)
+		if (err != nil) != test.expectErr {
+			t.Errorf("%q: expected error: %t, got %v", test.value, test.expectErr, err)
+		} else if actual != test.expected {
+			t.Errorf("%q: expected %t, got %t", test.value, test.expected, actual)
+		}
+	}
+}
+
+var effectiveAutovacuumSettingsTests = []struct {
+	name               string
+	serverEnabled      bool
+	options            map[string]string
+	expected           state.PostgresAutovacuumSettings
+	expectedOverridden bool
+}{
+	{
+		"no options",
+		true,
+		nil,
+		state.PostgresAutovacuumSettings{Enabled: true, VacuumThreshold: 50, VacuumScaleFactor: 0.2, AnalyzeThreshold: 50, AnalyzeScaleFactor: 0.1},
+		false,
+	},
+	{
+		"unrelated options",
+		true,
+		map[string]string{"fillfactor": "70", "toast.autovacuum_enabled": "off"},
+		state.PostgresAutovacuumSettings{Enabled: true, VacuumThreshold: 50, VacuumScaleFactor: 0.2, AnalyzeThreshold: 50, AnalyzeScaleFactor: 0.1},
+		false,
+	},
+	{
+		"all overridden",
+		true,
+		map[string]string{
+			"autovacuum_enabled":              "on",
+			"autovacuum_vacuum_threshold":     "1000",
+			"autovacuum_vacuum_scale_factor":  "0.01",
+			"autovacuum_analyze_threshold":    "500",
+			"autovacuum_analyze_scale_factor": "0.005",
+		},
+		state.PostgresAutovacuumSettings{Enabled: true, VacuumThreshold: 1000, VacuumScaleFactor: 0.01, AnalyzeThreshold: 500, AnalyzeScaleFactor: 0.005},
+		true,
+	},
+	{
+		"disabled for table",
+		true,
+		map[string]string{"autovacuum_enabled": "false"},
+		state.PostgresAutovacuumSettings{Enabled: false, VacuumThreshold: 50, VacuumScaleFactor: 0.2, AnalyzeThreshold: 50, AnalyzeScaleFactor: 0.1},
+		true,
+	},
+	{
+		"enabled for table, disabled for server",
+		false,
+		map[string]string{"autovacuum_enabled": "true"},
+		state.PostgresAutovacuumSettings{Enabled: false, VacuumThreshold: 50, VacuumScaleFactor: 0.2, AnalyzeThreshold: 50, AnalyzeScaleFactor: 0.1},
+		true,
+	},
+	{
+		"invalid values ignored",
+		true,
+		map[string]string{"autovacuum_enabled": "maybe", "autovacuum_vacuum_threshold": "1e3", "autovacuum_vacuum_scale_factor": "abc"},
+		state.PostgresAutovacuumSettings{Enabled: true, VacuumThreshold: 50, VacuumScaleFactor: 0.2, AnalyzeThreshold: 50, AnalyzeScaleFactor: 0.1},
+		false,
+	},
+}
+
+func TestEffectiveAutovacuumSettings(t *testing.T) {
+	for _, test := range effectiveAutovacuumSettingsTests {
+		settings := state.PostgresAutovacuumSettings{Enabled: test.serverEnabled, VacuumThreshold: 50, VacuumScaleFactor: 0.2, AnalyzeThreshold: 50, AnalyzeScaleFactor: 0.1}
+		actual, overridden := effectiveAutovacuumSettings(settings, test.options)
+		if actual != test.expected {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.expected, actual)
+		}
+		if overridden != test.expectedOverridden {
+			t.Errorf("%s: expected overridden %t, got %t", test.name, test.expectedOverridden, overridden)
+		}
+	}
+}
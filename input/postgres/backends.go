@@ -18,9 +18,24 @@ const activitySQL string = `SELECT (extract(epoch from COALESCE(backend_start, p
 				datid, datname, usesysid, usename, pid, application_name, client_addr::text, client_port,
 				backend_start, xact_start, query_start, state_change, %s, state, query
 	 FROM %s
-	WHERE pid IS NOT NULL`
+	WHERE pid IS NOT NULL
+				AND COALESCE(application_name, '') <> $1`
+
+const trackActivityQuerySizeSQL string = `SELECT setting::int FROM pg_catalog.pg_settings WHERE name = 'track_activity_query_size'`
+
+// queryTextTruncated - Whether the query text was likely cut off at track_activity_query_size
+//
+// Postgres keeps at most track_activity_query_size - 1 bytes of the query text, and
+// might clip a few more bytes to avoid splitting a multi-byte character
+func queryTextTruncated(query string, trackActivityQuerySize int) bool {
+	const maxMultibyteClip = 3
+	return trackActivityQuerySize > 0 && len(query) >= trackActivityQuerySize-1-maxMultibyteClip
+}
 
-func GetBackends(logger *util.Logger, db *sql.DB, postgresVersion state.PostgresVersion, systemType string) ([]state.PostgresBackend, error) {
+// GetBackends - Gets all backends from pg_stat_activity, except for the collector's
+// own connections (identified by the given application_name), and backends whose
+// type is not included in includeBackendTypes (if set) - these are counted by type
+func GetBackends(logger *util.Logger, db *sql.DB, postgresVersion state.PostgresVersion, systemType string, collectorApplicationName string, includeBackendTypes map[string]bool) ([]state.PostgresBackend, map[string]int32, error) {
 	var optionalFields string
 	var sourceTable string
 
@@ -40,21 +55,26 @@ func GetBackends(logger *util.Logger, db *sql.DB, postgresVersion state.Postgres
 		sourceTable = "pg_catalog.pg_stat_activity"
 	}
 
+	// Only used for detecting truncated query texts, so not fatal if it fails
+	var trackActivityQuerySize int
+	db.QueryRow(QueryMarkerSQL + trackActivityQuerySizeSQL).Scan(&trackActivityQuerySize)
+
 	stmt, err := db.Prepare(QueryMarkerSQL + fmt.Sprintf(activitySQL, optionalFields, sourceTable))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer stmt.Close()
 
-	rows, err := stmt.Query()
+	rows, err := stmt.Query(collectorApplicationName)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rows.Close()
 
 	var activities []state.PostgresBackend
+	excludedCounts := make(map[string]int32)
 
 	for rows.Next() {
 		var row state.PostgresBackend
@@ -65,7 +85,7 @@ func GetBackends(logger *util.Logger, db *sql.DB, postgresVersion state.Postgres
 			&row.StateChange, &row.Waiting, &row.BackendXid, &row.BackendXmin,
 			&row.WaitEventType, &row.WaitEvent, &row.BackendType, &row.State, &row.Query)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		// Special case to avoid errors for certain backends with weird names
@@ -73,8 +93,47 @@ func GetBackends(logger *util.Logger, db *sql.DB, postgresVersion state.Postgres
 			row.BackendType.String = strings.ToValidUTF8(row.BackendType.String, "")
 		}
 
+		if row.Query.Valid {
+			row.QueryTruncated = queryTextTruncated(row.Query.String, trackActivityQuerySize)
+		}
+
+		if includeBackendTypes != nil {
+			backendType := guessBackendType(row)
+			if !includeBackendTypes[backendType] {
+				excludedCounts[backendType]++
+				continue
+			}
+		}
+
 		activities = append(activities, row)
 	}
 
-	return activities, nil
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	for backendType, count := range excludedCounts {
+		logger.PrintVerbose("Excluded %d backend(s) of type \"%s\" from activity snapshot", count, backendType)
+	}
+
+	return activities, excludedCounts, nil
+}
+
+// guessBackendType - Returns the backend_type of the backend, or (for Postgres
+// versions before 10, that don't have it) a best guess based on the query and
+// database of the backend
+func guessBackendType(backend state.PostgresBackend) string {
+	if backend.BackendType.Valid {
+		return backend.BackendType.String
+	}
+
+	// Before Postgres 10 only regular backends, walsenders and autovacuum workers
+	// are visible in pg_stat_activity
+	if backend.Query.Valid && strings.HasPrefix(backend.Query.String, "autovacuum: ") {
+		return "autovacuum worker"
+	}
+	if !backend.DatabaseOid.Valid || backend.DatabaseOid.Int64 == 0 {
+		return "walsender"
+	}
+	return "client backend"
 }
@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+)
+
+const bgwriterStatsSQL string = `
+SELECT checkpoints_timed, checkpoints_req, checkpoint_write_time, checkpoint_sync_time,
+			 buffers_checkpoint, buffers_clean, maxwritten_clean, buffers_backend,
+			 buffers_backend_fsync, buffers_alloc, stats_reset
+	FROM pg_catalog.pg_stat_bgwriter`
+
+// GetBgwriterStats - Gets the activity of the background writer and checkpointer
+func GetBgwriterStats(db *sql.DB) (*state.PostgresBgwriterStats, error) {
+	var stats state.PostgresBgwriterStats
+	var statsReset null.Time
+
+	err := db.QueryRow(QueryMarkerSQL+bgwriterStatsSQL).Scan(
+		&stats.CheckpointsTimed, &stats.CheckpointsReq, &stats.CheckpointWriteTime,
+		&stats.CheckpointSyncTime, &stats.BuffersCheckpoint, &stats.BuffersClean,
+		&stats.MaxwrittenClean, &stats.BuffersBackend, &stats.BuffersBackendFsync,
+		&stats.BuffersAlloc, &statsReset)
+	if err != nil {
+		return nil, fmt.Errorf("BgwriterStats/Query: %s", err)
+	}
+	stats.StatsReset = statsReset.Time
+
+	return &stats, nil
+}
@@ -67,19 +67,48 @@ func getSharedBufferBytes(db *sql.DB) int64 {
 	return bytes * multiplier
 }
 
-func GetBuffercache(logger *util.Logger, db *sql.DB, systemType string) (report state.PostgresBuffercache, err error) {
-	var sourceTable string
+const buffercacheExtensionSchemaSQL string = `
+SELECT n.nspname
+  FROM pg_catalog.pg_extension e
+       JOIN pg_catalog.pg_namespace n ON (n.oid = e.extnamespace)
+ WHERE e.extname = 'pg_buffercache'
+`
 
+// buffercacheSourceTable - Determines where to read shared buffer contents from,
+// preferring the stats helper over the pg_buffercache view (in whichever schema
+// the extension was installed) - returns "" if neither is available
+func buffercacheSourceTable(logger *util.Logger, db *sql.DB) (string, error) {
 	if statsHelperExists(db, "get_buffercache") {
 		logger.PrintVerbose("Found pganalyze.get_buffercache() stats helper")
-		sourceTable = "pganalyze.get_buffercache()"
-	} else {
+		return "pganalyze.get_buffercache()", nil
+	}
+
+	var schemaName string
+	err := db.QueryRow(QueryMarkerSQL + buffercacheExtensionSchemaSQL).Scan(&schemaName)
+	if err == sql.ErrNoRows {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("BuffercacheExtension/Query: %s", err)
+	}
+
+	return pq.QuoteIdentifier(schemaName) + ".pg_buffercache", nil
+}
+
+func GetBuffercache(logger *util.Logger, db *sql.DB, systemType string) (report state.PostgresBuffercache, err error) {
+	sourceTable, err := buffercacheSourceTable(logger, db)
+	if err != nil {
+		return
+	}
+
+	if sourceTable != "pganalyze.get_buffercache()" {
 		if !connectedAsSuperUser(db, systemType) && !connectedAsMonitoringRole(db) {
 			logger.PrintInfo("Warning: You are not connecting as superuser. Please setup" +
 				" the monitoring helper functions (https://github.com/pganalyze/collector#setting-up-a-restricted-monitoring-user)" +
 				" or connect as superuser to run the buffercache report.")
 		}
-		sourceTable = "public.pg_buffercache"
+		if sourceTable == "" {
+			sourceTable = "public.pg_buffercache"
+		}
 	}
 
 	rows, err := db.Query(QueryMarkerSQL + fmt.Sprintf(buffercacheSQL, sourceTable))
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// bufferCacheTopRelations - How many relations (ordered by buffer count) to include
+const bufferCacheTopRelations = 50
+
+const bufferCacheDatabaseOidSQL string = `
+SELECT oid FROM pg_catalog.pg_database WHERE datname = pg_catalog.current_database()
+`
+
+const bufferCacheUsageCountSQL string = `
+SELECT usagecount, pg_catalog.count(*)
+  FROM %s
+ GROUP BY 1
+`
+
+const bufferCacheRelationsSQL string = `
+SELECT c.oid,
+       pg_catalog.count(*),
+       pg_catalog.sum(CASE WHEN b.isdirty THEN 1 ELSE 0 END)
+  FROM %s b
+       JOIN pg_catalog.pg_class c ON (b.relfilenode = pg_catalog.pg_relation_filenode(c.oid))
+ WHERE b.reldatabase IN (0, $1)
+       AND c.relkind IN ('r', 'm', 'i')
+ GROUP BY c.oid
+ ORDER BY 2 DESC
+ LIMIT %d
+`
+
+// GetBufferCacheStats - Aggregates the contents of shared buffers per relation, if pg_buffercache is available
+func GetBufferCacheStats(logger *util.Logger, db *sql.DB) (stats state.PostgresBufferCacheStats, exists bool, err error) {
+	sourceTable, err := buffercacheSourceTable(logger, db)
+	if err != nil {
+		return
+	}
+	if sourceTable == "" {
+		logger.PrintVerbose("Skipping buffer cache statistics: pg_buffercache extension is not installed" +
+			" (run \"CREATE EXTENSION pg_buffercache\" to enable, or disable enable_buffer_cache_stats)")
+		return
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + fmt.Sprintf(bufferCacheUsageCountSQL, sourceTable))
+	if err != nil {
+		err = fmt.Errorf("BufferCacheUsageCount/Query: %s", err)
+		return
+	}
+	defer rows.Close()
+
+	stats.UsageCountBuffers = make([]int64, 6)
+	for rows.Next() {
+		var usageCount null.Int
+		var buffers int64
+
+		err = rows.Scan(&usageCount, &buffers)
+		if err != nil {
+			err = fmt.Errorf("BufferCacheUsageCount/Scan: %s", err)
+			return
+		}
+
+		stats.TotalBuffers += buffers
+		if !usageCount.Valid { // Unused buffer
+			continue
+		}
+		stats.UsedBuffers += buffers
+		if usageCount.Int64 >= 0 && usageCount.Int64 < int64(len(stats.UsageCountBuffers)) {
+			stats.UsageCountBuffers[usageCount.Int64] += buffers
+		}
+	}
+
+	err = db.QueryRow(QueryMarkerSQL + bufferCacheDatabaseOidSQL).Scan(&stats.DatabaseOid)
+	if err != nil {
+		err = fmt.Errorf("BufferCacheDatabaseOid/Query: %s", err)
+		return
+	}
+
+	relationRows, err := db.Query(QueryMarkerSQL+fmt.Sprintf(bufferCacheRelationsSQL, sourceTable, bufferCacheTopRelations), stats.DatabaseOid)
+	if err != nil {
+		err = fmt.Errorf("BufferCacheRelations/Query: %s", err)
+		return
+	}
+	defer relationRows.Close()
+
+	for relationRows.Next() {
+		var row state.PostgresBufferCacheRelation
+
+		err = relationRows.Scan(&row.RelationOid, &row.Buffers, &row.DirtyBuffers)
+		if err != nil {
+			err = fmt.Errorf("BufferCacheRelations/Scan: %s", err)
+			return
+		}
+
+		stats.Relations = append(stats.Relations, row)
+	}
+
+	exists = true
+	return
+}
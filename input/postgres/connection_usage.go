@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const connectionUsageSQL string = `
+SELECT COALESCE(application_name, ''), COALESCE(usename, ''), pg_catalog.count(*)
+	FROM %s
+ WHERE %s
+ GROUP BY 1, 2`
+
+const connectionUsageFilterDefault = "COALESCE(query, '') NOT LIKE 'autovacuum: %'"
+const connectionUsageFilterPg10 = "backend_type = 'client backend'"
+
+const maxConnectionsSQL string = `
+SELECT pg_catalog.current_setting('max_connections')::int - pg_catalog.current_setting('superuser_reserved_connections')::int`
+
+// GetConnectionSaturation - Samples the client connections by application and
+// user, and compares the peak since the last full snapshot to max_connections
+//
+// The peak includes the usage seen by activity snapshots since then (prevPeak),
+// since single samples easily miss short connection spikes.
+func GetConnectionSaturation(logger *util.Logger, db *sql.DB, postgresVersion state.PostgresVersion, prevPeak state.PostgresConnectionUsage, prevHistory state.ConnectionPeakHistory, collectedAt time.Time, warnPercent int) (state.PostgresConnectionSaturation, state.ConnectionPeakHistory, error) {
+	var saturation state.PostgresConnectionSaturation
+
+	var sourceTable string
+	if statsHelperExists(db, "get_stat_activity") {
+		sourceTable = "pganalyze.get_stat_activity()"
+	} else {
+		sourceTable = "pg_catalog.pg_stat_activity"
+	}
+
+	filter := connectionUsageFilterDefault
+	if postgresVersion.Numeric >= state.PostgresVersion10 {
+		filter = connectionUsageFilterPg10
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + fmt.Sprintf(connectionUsageSQL, sourceTable, filter))
+	if err != nil {
+		return saturation, prevHistory, fmt.Errorf("ConnectionUsage/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var counts []state.PostgresConnectionCount
+	for rows.Next() {
+		var count state.PostgresConnectionCount
+		err = rows.Scan(&count.ApplicationName, &count.RoleName, &count.Count)
+		if err != nil {
+			return saturation, prevHistory, fmt.Errorf("ConnectionUsage/Scan: %s", err)
+		}
+		counts = append(counts, count)
+	}
+	if err = rows.Err(); err != nil {
+		return saturation, prevHistory, fmt.Errorf("ConnectionUsage/Rows: %s", err)
+	}
+
+	err = db.QueryRow(QueryMarkerSQL + maxConnectionsSQL).Scan(&saturation.MaxConnections)
+	if err != nil {
+		return saturation, prevHistory, fmt.Errorf("MaxConnections/Query: %s", err)
+	}
+
+	saturation.Current = state.NewConnectionUsage(counts, collectedAt)
+	saturation.Peak = state.HigherConnectionUsage(saturation.Current, prevPeak)
+	saturation.PeakHistory = prevHistory.Add(state.ConnectionPeakSample{CollectedAt: collectedAt, Connections: saturation.Peak.Connections})
+
+	if warnPercent > 0 && saturation.MaxConnections > 0 && saturation.PeakRatio()*100 >= float64(warnPercent) {
+		saturation.ExceedsWarnPercent = true
+
+		var topClients []string
+		for _, client := range saturation.Peak.TopClients {
+			topClients = append(topClients, fmt.Sprintf("%d by %s/%s", client.Count, client.ApplicationName, client.RoleName))
+		}
+		logger.PrintWarning("Peak client connections since the last snapshot (%d) reached %.0f%% of the %d available connections (most used: %s)", saturation.Peak.Connections, saturation.PeakRatio()*100, saturation.MaxConnections, strings.Join(topClients, ", "))
+	}
+
+	return saturation, saturation.PeakHistory, nil
+}
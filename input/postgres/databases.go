@@ -23,6 +23,7 @@ SELECT oid,
 			 datallowconn,
 			 datconnlimit,
 			 datfrozenxid,
+			 pg_catalog.age(datfrozenxid),
 			 %s
 	FROM pg_catalog.pg_database`
 
@@ -55,7 +56,7 @@ func GetDatabases(logger *util.Logger, db *sql.DB, postgresVersion state.Postgre
 		var d state.PostgresDatabase
 
 		err := rows.Scan(&d.Oid, &d.Name, &d.OwnerRoleOid, &d.Encoding, &d.Collate, &d.CType,
-			&d.IsTemplate, &d.AllowConnections, &d.ConnectionLimit, &d.FrozenXID, &d.MinimumMultixactXID)
+			&d.IsTemplate, &d.AllowConnections, &d.ConnectionLimit, &d.FrozenXID, &d.FrozenXIDAge, &d.MinimumMultixactXID)
 		if err != nil {
 			return nil, err
 		}
@@ -65,3 +66,35 @@ func GetDatabases(logger *util.Logger, db *sql.DB, postgresVersion state.Postgre
 
 	return databases, nil
 }
+
+// Only databases we're allowed to connect to, pg_database_size errors out otherwise
+const databaseSizesSQL string = `
+SELECT oid, pg_catalog.pg_database_size(oid)
+	FROM pg_catalog.pg_database
+ WHERE datallowconn AND pg_catalog.has_database_privilege(oid, 'CONNECT')`
+
+// GetDatabaseSizes - Gets the on-disk size of all databases (in bytes), used to
+// skip overly large databases when collecting all databases (see db_max_size_mb)
+func GetDatabaseSizes(db *sql.DB) (map[state.Oid]int64, error) {
+	rows, err := db.Query(QueryMarkerSQL + databaseSizesSQL)
+	if err != nil {
+		return nil, fmt.Errorf("DatabaseSizes/Query: %s", err)
+	}
+	defer rows.Close()
+
+	sizes := make(map[state.Oid]int64)
+	for rows.Next() {
+		var oid state.Oid
+		var size int64
+		err = rows.Scan(&oid, &size)
+		if err != nil {
+			return nil, fmt.Errorf("DatabaseSizes/Scan: %s", err)
+		}
+		sizes[oid] = size
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("DatabaseSizes/Rows: %s", err)
+	}
+
+	return sizes, nil
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// FindDuplicateIndices - Finds indices that are either identical to another index on
+// the same table, or whose key columns are a prefix of another (btree) index
+//
+// Both indices need to have the same predicate, expressions and (for the shared key
+// columns) operator classes, orderings and collations. Indices that back a constraint,
+// and unique indices that enforce something the other index doesn't, are never
+// reported as redundant.
+func FindDuplicateIndices(logger *util.Logger, relations []state.PostgresRelation, indexStats state.PostgresIndexStatsMap) []state.PostgresDuplicateIndex {
+	var duplicates []state.PostgresDuplicateIndex
+
+	for _, relation := range relations {
+		for _, index := range relation.Indices {
+			for _, other := range relation.Indices {
+				if index.IndexOid == other.IndexOid {
+					continue
+				}
+				exact, redundant := indexRedundantWith(index, other)
+				if !redundant {
+					continue
+				}
+				// Only report one of two identical indices
+				if exact && !preferDroppingIndex(index, other) {
+					continue
+				}
+
+				duplicate := state.PostgresDuplicateIndex{
+					DatabaseOid:      relation.DatabaseOid,
+					RelationOid:      relation.Oid,
+					IndexOid:         index.IndexOid,
+					CoveringIndexOid: other.IndexOid,
+					Exact:            exact,
+					ReclaimableBytes: indexStats[index.IndexOid].SizeBytes,
+				}
+				duplicates = append(duplicates, duplicate)
+
+				if exact {
+					logger.PrintVerbose("Index %s.%s on table %s is a duplicate of index %s, dropping it would free %d bytes", relation.SchemaName, index.Name, relation.RelationName, other.Name, duplicate.ReclaimableBytes)
+				} else {
+					logger.PrintVerbose("Index %s.%s on table %s is covered by index %s, dropping it would free %d bytes", relation.SchemaName, index.Name, relation.RelationName, other.Name, duplicate.ReclaimableBytes)
+				}
+				break
+			}
+		}
+	}
+
+	return duplicates
+}
+
+func usableIndex(index state.PostgresIndex) bool {
+	return index.IsValid && index.IsReady && !index.IsBeingBuilt
+}
+
+// indexRedundantWith - Whether index can be replaced by other, and if so, whether
+// both have the same definition
+func indexRedundantWith(index state.PostgresIndex, other state.PostgresIndex) (exact bool, redundant bool) {
+	if !usableIndex(index) || !usableIndex(other) || index.IndexType != other.IndexType {
+		return false, false
+	}
+	if index.Predicate != other.Predicate || index.Expressions != other.Expressions {
+		return false, false
+	}
+
+	keyColumns := indexKeyColumns(index)
+	otherKeyColumns := indexKeyColumns(other)
+	if len(keyColumns) > len(otherKeyColumns) || len(index.OpClasses) > len(other.OpClasses) {
+		return false, false
+	}
+	for i := range keyColumns {
+		if keyColumns[i] != otherKeyColumns[i] ||
+			!sameAt(index.OpClasses, other.OpClasses, i) ||
+			!sameAt32(index.ColumnOptions, other.ColumnOptions, i) ||
+			!sameAt(index.Collations, other.Collations, i) {
+			return false, false
+		}
+	}
+
+	// INCLUDE columns of the redundant index need to be part of the other index
+	otherColumns := make(map[int32]bool)
+	for _, column := range other.Columns {
+		otherColumns[column] = true
+	}
+	for _, column := range index.Columns[len(keyColumns):] {
+		if !otherColumns[column] {
+			return false, false
+		}
+	}
+
+	exact = len(keyColumns) == len(otherKeyColumns) && len(index.Columns) == len(other.Columns)
+	if !exact {
+		// Only btree indices can use a prefix of their key columns efficiently, and
+		// expression indices are only compared for exact duplicates
+		if index.IndexType != "btree" || index.Expressions.Valid {
+			return false, false
+		}
+		// A unique index on fewer columns enforces a stricter constraint
+		if index.IsUnique {
+			return false, false
+		}
+	} else if index.IsUnique && !other.IsUnique {
+		return false, false
+	}
+
+	if index.ConstraintDef.Valid && !(exact && other.ConstraintDef.Valid) {
+		return false, false
+	}
+
+	return exact, true
+}
+
+// preferDroppingIndex - For two identical indices, decides whether the first one is
+// the one to drop (keeping those that back a constraint, and otherwise the older one)
+func preferDroppingIndex(index state.PostgresIndex, other state.PostgresIndex) bool {
+	if index.IsPrimary != other.IsPrimary {
+		return other.IsPrimary
+	}
+	if index.ConstraintDef.Valid != other.ConstraintDef.Valid {
+		return other.ConstraintDef.Valid
+	}
+	if index.IsUnique != other.IsUnique {
+		return other.IsUnique
+	}
+	return index.IndexOid > other.IndexOid
+}
+
+func indexKeyColumns(index state.PostgresIndex) []int32 {
+	keyColumnCount := int(index.KeyColumnCount)
+	if keyColumnCount <= 0 || keyColumnCount > len(index.Columns) {
+		return index.Columns
+	}
+	return index.Columns[:keyColumnCount]
+}
+
+func sameAt(a []state.Oid, b []state.Oid, i int) bool {
+	return i < len(a) && i < len(b) && a[i] == b[i]
+}
+
+func sameAt32(a []int32, b []int32, i int) bool {
+	return i < len(a) && i < len(b) && a[i] == b[i]
+}
@@ -0,0 +1,180 @@
+package postgres_test
+
+import (
+	"io/ioutil"
+	"log"
+	"testing"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+func duplicateTestIndex(oid state.Oid, columns ...int32) state.PostgresIndex {
+	index := state.PostgresIndex{
+		IndexOid:       oid,
+		Name:           "index",
+		IndexType:      "btree",
+		Columns:        columns,
+		KeyColumnCount: int32(len(columns)),
+		IsValid:        true,
+		IsReady:        true,
+	}
+	for range columns {
+		index.OpClasses = append(index.OpClasses, 1978)
+		index.ColumnOptions = append(index.ColumnOptions, 0)
+		index.Collations = append(index.Collations, 0)
+	}
+	return index
+}
+
+type duplicateIndexResult struct {
+	IndexOid         state.Oid
+	CoveringIndexOid state.Oid
+	Exact            bool
+}
+
+var findDuplicateIndicesTests = []struct {
+	name     string
+	indices  func() []state.PostgresIndex
+	expected []duplicateIndexResult
+}{
+	{
+		"identical indices, newer one reported",
+		func() []state.PostgresIndex {
+			return []state.PostgresIndex{duplicateTestIndex(10, 1, 2), duplicateTestIndex(11, 1, 2)}
+		},
+		[]duplicateIndexResult{{11, 10, true}},
+	},
+	{
+		"identical indices, primary key kept",
+		func() []state.PostgresIndex {
+			pkey := duplicateTestIndex(11, 1)
+			pkey.IsPrimary = true
+			pkey.IsUnique = true
+			pkey.ConstraintDef = null.StringFrom("PRIMARY KEY (id)")
+			unique := duplicateTestIndex(10, 1)
+			unique.IsUnique = true
+			return []state.PostgresIndex{unique, pkey}
+		},
+		[]duplicateIndexResult{{10, 11, true}},
+	},
+	{
+		"prefix of another index",
+		func() []state.PostgresIndex {
+			return []state.PostgresIndex{duplicateTestIndex(10, 1), duplicateTestIndex(11, 1, 2)}
+		},
+		[]duplicateIndexResult{{10, 11, false}},
+	},
+	{
+		"different column order",
+		func() []state.PostgresIndex {
+			return []state.PostgresIndex{duplicateTestIndex(10, 2), duplicateTestIndex(11, 1, 2)}
+		},
+		nil,
+	},
+	{
+		"unique prefix",
+		func() []state.PostgresIndex {
+			unique := duplicateTestIndex(10, 1)
+			unique.IsUnique = true
+			return []state.PostgresIndex{unique, duplicateTestIndex(11, 1, 2)}
+		},
+		nil,
+	},
+	{
+		"unique and non-unique with same columns",
+		func() []state.PostgresIndex {
+			unique := duplicateTestIndex(10, 1)
+			unique.IsUnique = true
+			return []state.PostgresIndex{unique, duplicateTestIndex(11, 1)}
+		},
+		[]duplicateIndexResult{{11, 10, true}},
+	},
+	{
+		"prefix of a hash index",
+		func() []state.PostgresIndex {
+			index := duplicateTestIndex(10, 1)
+			index.IndexType = "hash"
+			other := duplicateTestIndex(11, 1, 2)
+			other.IndexType = "hash"
+			return []state.PostgresIndex{index, other}
+		},
+		nil,
+	},
+	{
+		"different predicate",
+		func() []state.PostgresIndex {
+			partial := duplicateTestIndex(10, 1)
+			partial.Predicate = null.StringFrom("(deleted_at IS NULL)")
+			return []state.PostgresIndex{partial, duplicateTestIndex(11, 1)}
+		},
+		nil,
+	},
+	{
+		"different ordering",
+		func() []state.PostgresIndex {
+			desc := duplicateTestIndex(10, 1)
+			desc.ColumnOptions[0] = 3
+			return []state.PostgresIndex{desc, duplicateTestIndex(11, 1, 2)}
+		},
+		nil,
+	},
+	{
+		"include column not covered",
+		func() []state.PostgresIndex {
+			include := duplicateTestIndex(10, 1, 3)
+			include.KeyColumnCount = 1
+			include.OpClasses = include.OpClasses[:1]
+			return []state.PostgresIndex{include, duplicateTestIndex(11, 1, 2)}
+		},
+		nil,
+	},
+	{
+		"include column covered",
+		func() []state.PostgresIndex {
+			include := duplicateTestIndex(10, 1, 2)
+			include.KeyColumnCount = 1
+			include.OpClasses = include.OpClasses[:1]
+			return []state.PostgresIndex{include, duplicateTestIndex(11, 1, 2)}
+		},
+		[]duplicateIndexResult{{10, 11, false}},
+	},
+	{
+		"invalid index",
+		func() []state.PostgresIndex {
+			invalid := duplicateTestIndex(11, 1)
+			invalid.IsValid = false
+			return []state.PostgresIndex{duplicateTestIndex(10, 1), invalid}
+		},
+		nil,
+	},
+}
+
+func TestFindDuplicateIndices(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+	indexStats := state.PostgresIndexStatsMap{10: {SizeBytes: 8192}, 11: {SizeBytes: 16384}}
+
+	for _, test := range findDuplicateIndicesTests {
+		relations := []state.PostgresRelation{{Oid: 1, DatabaseOid: 2, SchemaName: "public", RelationName: "t", Indices: test.indices()}}
+		duplicates := postgres.FindDuplicateIndices(logger, relations, indexStats)
+
+		var actual []duplicateIndexResult
+		for _, d := range duplicates {
+			actual = append(actual, duplicateIndexResult{d.IndexOid, d.CoveringIndexOid, d.Exact})
+			if d.DatabaseOid != 2 || d.RelationOid != 1 || d.ReclaimableBytes != indexStats[d.IndexOid].SizeBytes {
+				t.Errorf("%s: unexpected details for index %d: %+v", test.name, d.IndexOid, d)
+			}
+		}
+		if len(actual) != len(test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+			continue
+		}
+		for idx := range actual {
+			if actual[idx] != test.expected[idx] {
+				t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+			}
+		}
+	}
+}
@@ -1,16 +1,30 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
+	"github.com/pkg/errors"
 )
 
 func EstablishConnection(server state.Server, logger *util.Logger, globalCollectionOpts state.CollectionOpts, databaseName string) (connection *sql.DB, err error) {
+	if server.Config.DbConnectJitter > 0 && !globalCollectionOpts.TestRun {
+		waitForInitialConnectJitter(server.Config, logger, databaseName)
+	}
+
 	connection, err = connectToDb(server.Config, logger, globalCollectionOpts, databaseName)
 	if err != nil {
 		if err.Error() == "pq: SSL is not enabled on the server" && (server.Config.DbSslMode == "prefer" || server.Config.DbSslMode == "") {
@@ -23,7 +37,7 @@ func EstablishConnection(server state.Server, logger *util.Logger, globalCollect
 		return
 	}
 
-	err = validateConnectionCount(connection, logger, server.Config.MaxCollectorConnections, globalCollectionOpts)
+	err = validateConnectionCount(connection, logger, server.Config.MaxCollectorConnections, server.Config.GetApplicationName(globalCollectionOpts.CollectorApplicationName))
 	if err != nil {
 		connection.Close()
 		return
@@ -36,31 +50,217 @@ func EstablishConnection(server state.Server, logger *util.Logger, globalCollect
 
 func connectToDb(config config.ServerConfig, logger *util.Logger, globalCollectionOpts state.CollectionOpts, databaseName string) (*sql.DB, error) {
 	connectString := config.GetPqOpenString(databaseName)
-	connectString += " application_name=" + globalCollectionOpts.CollectorApplicationName
+	connectString += fmt.Sprintf(" application_name='%s'", strings.Replace(config.GetApplicationName(globalCollectionOpts.CollectorApplicationName), "'", "\\'", -1))
 
 	// logger.PrintVerbose("sql.Open(\"postgres\", \"%s\")", connectString)
 
-	db, err := sql.Open("postgres", connectString)
+	connector, err := pq.NewConnector(connectString)
 	if err != nil {
 		return nil, err
 	}
 
+	dialer := keepaliveDialer{d: net.Dialer{KeepAlive: config.GetDbKeepaliveInterval()}}
+	if config.GetDbSslServerName() != "" {
+		dialer.address = config.GetDbDialAddress()
+	}
+	connector.Dialer(dialer)
+
+	// Surface notices and warnings raised by our queries (pq discards them otherwise)
+	dbName := databaseName
+	if dbName == "" {
+		dbName = config.GetDbName()
+	}
+	db := sql.OpenDB(pq.ConnectorWithNoticeHandler(connector, func(notice *pq.Error) {
+		logger.PrintVerbose("Postgres %s in database %s: %s", notice.Severity, dbName, formatNotice(notice))
+	}))
+
 	db.SetMaxOpenConns(1)
-	db.SetConnMaxLifetime(30 * time.Second)
+	connMaxLifetime := config.DbConnMaxLifetime
+	if connMaxLifetime <= 0 {
+		connMaxLifetime = 30
+	}
+	db.SetConnMaxLifetime(time.Duration(connMaxLifetime) * time.Second)
 
-	err = db.Ping()
-	if err != nil {
-		db.Close()
-		return nil, err
+	connectTimeout := time.Duration(config.GetDbConnectTimeout()) * time.Second
+	backoff := connectRetryInitialBackoff
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+		err = db.PingContext(ctx)
+		cancel()
+		if err == nil {
+			break
+		}
+		if attempt >= config.DbConnectRetries || !isTransientConnectError(err) {
+			db.Close()
+			return nil, err
+		}
+		logger.PrintVerbose("Could not connect to database, retrying in %s: %s", backoff, err)
+		time.Sleep(backoff)
+		backoff *= 2
 	}
 
 	return db, nil
 }
 
-func validateConnectionCount(connection *sql.DB, logger *util.Logger, maxCollectorConnections int, globalCollectionOpts state.CollectionOpts) error {
+const connectRetryInitialBackoff = 1 * time.Second
+
+// keepaliveDialer - Connects to the database with the configured TCP keepalive
+// interval (pq's own dialer always uses the Go default)
+//
+// When address is set, all connections go there instead of to the host pq was
+// given, which is then only used for certificate verification
+type keepaliveDialer struct {
+	d       net.Dialer
+	address string
+}
+
+func (d keepaliveDialer) Dial(network, address string) (net.Conn, error) {
+	return d.d.Dial(network, d.dialAddress(address))
+}
+
+func (d keepaliveDialer) DialTimeout(network, address string, timeout time.Duration) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return d.d.DialContext(ctx, network, d.dialAddress(address))
+}
+
+func (d keepaliveDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	return d.d.DialContext(ctx, network, d.dialAddress(address))
+}
+
+func (d keepaliveDialer) dialAddress(address string) string {
+	if d.address != "" {
+		return d.address
+	}
+	return address
+}
+
+var initialConnectJitter = struct {
+	sync.Mutex
+	rand *rand.Rand
+	done map[string]bool
+}{
+	rand: rand.New(rand.NewSource(time.Now().UnixNano() + int64(os.Getpid()))),
+	done: make(map[string]bool),
+}
+
+// waitForInitialConnectJitter - Sleeps for a random delay (up to db_connect_jitter)
+// the first time this process connects to the given database, so that collectors
+// restarted at the same time don't all connect at once
+//
+// Later connections (including after a config reload) are not delayed.
+func waitForInitialConnectJitter(config config.ServerConfig, logger *util.Logger, databaseName string) {
+	key := config.SectionName + "/" + databaseName
+
+	initialConnectJitter.Lock()
+	if initialConnectJitter.done[key] {
+		initialConnectJitter.Unlock()
+		return
+	}
+	initialConnectJitter.done[key] = true
+	delay := time.Duration(initialConnectJitter.rand.Int63n(int64(config.DbConnectJitter) * int64(time.Second)))
+	initialConnectJitter.Unlock()
+
+	logger.PrintVerbose("Delaying initial connection by %s (db_connect_jitter = %ds)", delay.Round(time.Millisecond), config.DbConnectJitter)
+	time.Sleep(delay)
+}
+
+// isTransientConnectError - Whether connecting might succeed when retried, i.e. the
+// error is a network issue, or the server is not ready to accept connections yet
+func isTransientConnectError(err error) bool {
+	if err == context.DeadlineExceeded || err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+	if _, ok := err.(net.Error); ok {
+		return true
+	}
+	if pqErr, ok := err.(*pq.Error); ok {
+		// cannot_connect_now (e.g. "the database system is starting up") and too_many_connections
+		return pqErr.Code == "57P03" || pqErr.Code == "53300"
+	}
+	return false
+}
+
+// IsTransientError - Whether a failed collection might succeed when retried, i.e.
+// the error is a connection issue, a temporary conflict within the database, or
+// the server shutting down or starting up. Queries canceled by statement_timeout
+// are not retried.
+//
+// This is decided on the SQLSTATE of the underlying error (see errors.Cause), so
+// errors need to be wrapped with errors.Wrap to be recognized. Errors that will keep
+// happening (e.g. missing permissions or syntax errors) are not transient.
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+	err = errors.Cause(err)
+	if isTransientConnectError(err) || err == driver.ErrBadConn {
+		return true
+	}
+	if pqErr, ok := err.(*pq.Error); ok {
+		// Class 08 (connection exception) and 40 (transaction rollback, e.g. serialization
+		// failures, deadlocks and queries on a standby canceled due to a recovery conflict)
+		switch pqErr.Code.Class() {
+		case "08", "40":
+			return true
+		}
+		// The server shutting down or not accepting connections yet (but not 57014
+		// query_canceled, which is what statement_timeout raises - a retry would
+		// only run into the same timeout)
+		switch pqErr.Code {
+		case "57P01", "57P02", "57P03":
+			return true
+		}
+	}
+	return false
+}
+
+func formatNotice(notice *pq.Error) string {
+	message := notice.Message
+	if notice.Detail != "" {
+		message += " (" + notice.Detail + ")"
+	}
+	if notice.Hint != "" {
+		message += " - Hint: " + notice.Hint
+	}
+	return message
+}
+
+const connectionValidationTimeout = 5 * time.Second
+
+func checkConnection(connection *sql.DB) error {
+	var one int
+
+	ctx, cancel := context.WithTimeout(context.Background(), connectionValidationTimeout)
+	defer cancel()
+
+	return connection.QueryRowContext(ctx, QueryMarkerSQL+"SELECT 1").Scan(&one)
+}
+
+// ValidateConnection - Checks that a connection that sat idle still works using a cheap
+// query, and otherwise discards it, so the next query transparently uses a new connection
+//
+// Returns whether the connection was replaced, in which case session settings (e.g.
+// the statement timeout) need to be re-applied.
+func ValidateConnection(connection *sql.DB, logger *util.Logger) (bool, error) {
+	err := checkConnection(connection)
+	if err == nil {
+		return false, nil
+	}
+
+	logger.PrintVerbose("Database connection is no longer usable, reconnecting: %s", err)
+
+	// Closes the broken (idle) connection, the next query then opens a new one
+	connection.SetMaxIdleConns(0)
+	connection.SetMaxIdleConns(1)
+
+	return true, checkConnection(connection)
+}
+
+func validateConnectionCount(connection *sql.DB, logger *util.Logger, maxCollectorConnections int, applicationName string) error {
 	var connectionCount int
 
-	connection.QueryRow(QueryMarkerSQL + "SELECT pg_catalog.count(*) FROM pg_catalog.pg_stat_activity WHERE application_name = '" + globalCollectionOpts.CollectorApplicationName + "'").Scan(&connectionCount)
+	connection.QueryRow(QueryMarkerSQL+"SELECT pg_catalog.count(*) FROM pg_catalog.pg_stat_activity WHERE application_name = $1", applicationName).Scan(&connectionCount)
 
 	if connectionCount > maxCollectorConnections {
 		return fmt.Errorf("Too many open monitoring connections (current: %d, maximum allowed: %d), exiting", connectionCount, maxCollectorConnections)
@@ -92,6 +292,21 @@ func SetDefaultStatementTimeout(connection *sql.DB, logger *util.Logger, server
 	return
 }
 
+// SetSectionStatementTimeout - Sets the (shorter) statement timeout used for the
+// diagnostic queries of a full snapshot, falling back to the default timeout when
+// section_statement_timeout_ms is disabled
+func SetSectionStatementTimeout(connection *sql.DB, logger *util.Logger, server state.Server) {
+	sectionStatementTimeoutMs := server.Config.SectionStatementTimeoutMs
+	if sectionStatementTimeoutMs <= 0 {
+		SetDefaultStatementTimeout(connection, logger, server)
+		return
+	}
+
+	SetStatementTimeout(connection, int32(sectionStatementTimeoutMs))
+
+	return
+}
+
 func SetQueryTextStatementTimeout(connection *sql.DB, logger *util.Logger, server state.Server) {
 	queryTextStatementTimeoutMs := server.Grant.Config.Features.StatementTimeoutMsQueryText
 	if queryTextStatementTimeoutMs == 0 { // Default value
@@ -0,0 +1,45 @@
+package postgres_test
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pkg/errors"
+)
+
+var isTransientErrorTests = []struct {
+	name     string
+	err      error
+	expected bool
+}{
+	{"no error", nil, false},
+	{"serialization failure", &pq.Error{Code: "40001"}, true},
+	{"wrapped deadlock", errors.Wrap(&pq.Error{Code: "40P01"}, "Error collecting pg_stat_statements"), true},
+	{"admin shutdown", &pq.Error{Code: "57P01"}, true},
+	{"crash shutdown", &pq.Error{Code: "57P02"}, true},
+	{"cannot connect now", &pq.Error{Code: "57P03"}, true},
+	{"statement timeout", errors.Wrap(&pq.Error{Code: "57014"}, "Settings/Query"), false},
+	{"database dropped", &pq.Error{Code: "57P04"}, false},
+	{"recovery conflict", &pq.Error{Code: "40001"}, true},
+	{"connection failure", &pq.Error{Code: "08006"}, true},
+	{"too many connections", &pq.Error{Code: "53300"}, true},
+	{"insufficient privilege", &pq.Error{Code: "42501"}, false},
+	{"syntax error", errors.Wrap(&pq.Error{Code: "42601"}, "Settings/Query"), false},
+	{"cause lost by fmt.Errorf", fmt.Errorf("Settings/Query: %s", &pq.Error{Code: "40001", Message: "could not serialize access"}), false},
+	{"bad connection", driver.ErrBadConn, true},
+	{"wrapped EOF", errors.Wrap(io.EOF, "Failed to connect to database"), true},
+	{"other error", errors.New("Unsupported statement source"), false},
+}
+
+func TestIsTransientError(t *testing.T) {
+	for _, test := range isTransientErrorTests {
+		actual := postgres.IsTransientError(test.err)
+		if actual != test.expected {
+			t.Errorf("%s: expected %t, got %t", test.name, test.expected, actual)
+		}
+	}
+}
@@ -4,15 +4,21 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/lib/pq"
 	pg_query "github.com/lfittl/pg_query_go"
 	pg_query_nodes "github.com/lfittl/pg_query_go/nodes"
 	"github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
 )
 
-func RunExplain(db *sql.DB, connectedDbName string, inputs []state.PostgresQuerySample) (outputs []state.PostgresQuerySample) {
+func RunExplain(db *sql.DB, server state.Server, collectionOpts state.CollectionOpts, inputs []state.PostgresQuerySample) (outputs []state.PostgresQuerySample) {
+	connectedDbName := server.Config.GetDbName()
+	filter := server.Config.ExplainFilter
+	sampleInterval := time.Duration(server.Config.ExplainSampleInterval) * time.Minute
+
 	for _, sample := range inputs {
 		// EXPLAIN was already collected, e.g. from auto_explain
 		if sample.HasExplain {
@@ -37,10 +43,23 @@ func RunExplain(db *sql.DB, connectedDbName string, inputs []state.PostgresQuery
 			stmt := parsetree.Statements[0].(pg_query_nodes.RawStmt).Stmt
 			switch stmt.(type) {
 			case pg_query_nodes.SelectStmt, pg_query_nodes.InsertStmt, pg_query_nodes.UpdateStmt, pg_query_nodes.DeleteStmt:
+				skipReason := filter.SkipReason(sample.Query)
+
+				// Only explain a query again once explain_sample_interval has passed
+				fingerprint := util.FingerprintQuery(sample.Query)
+				if skipReason == "" && sampleInterval > 0 && !server.PrevState.ExplainSampling.Allow(fingerprint, sampleInterval, time.Now()) {
+					break
+				}
+
 				sample.HasExplain = true
 				sample.ExplainSource = pganalyze_collector.QuerySample_STATEMENT_LOG_EXPLAIN_SOURCE
 				sample.ExplainFormat = pganalyze_collector.QuerySample_JSON_EXPLAIN_FORMAT
 
+				if skipReason != "" {
+					sample.ExplainError = skipReason
+					break
+				}
+
 				if len(sample.Parameters) > 0 {
 					_, err = db.Exec(QueryMarkerSQL + "PREPARE pganalyze_explain AS " + sample.Query)
 					if err != nil {
@@ -64,6 +83,14 @@ func RunExplain(db *sql.DB, connectedDbName string, inputs []state.PostgresQuery
 						sample.ExplainError = fmt.Sprintf("%s", err)
 					}
 				}
+
+				if sample.ExplainError == "" {
+					sample.ExplainSummary, err = state.ParseExplainSummary(sample.ExplainOutput)
+					sample.HasExplainSummary = err == nil
+					if sampleInterval > 0 {
+						server.PrevState.ExplainSampling.Record(fingerprint, sampleInterval, time.Now())
+					}
+				}
 			}
 		}
 
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/guregu/null"
+	"github.com/lib/pq"
+	"github.com/pganalyze/collector/state"
+)
+
+const hbaRulesSQL string = `
+SELECT line_number, COALESCE(type, ''), database::text, user_name::text, COALESCE(address, ''),
+			 COALESCE(netmask, ''), COALESCE(auth_method, ''), options::text, COALESCE(error, '')
+	FROM pg_catalog.pg_hba_file_rules
+ ORDER BY line_number`
+
+// Authentication options that contain secrets, and are never sent
+var hbaSecretOptions = []string{"ldapbindpasswd", "radiussecret", "radiussecrets"}
+
+var hbaPasswordAuthMethods = map[string]bool{"password": true, "md5": true, "scram-sha-256": true}
+
+// GetHbaRules - Collects the rules of pg_hba.conf (PG10+), flagging ones that are
+// overly permissive
+//
+// pg_hba_file_rules is only readable by superusers by default - when it can't be
+// read this returns no rules (and no error), since its entirely optional.
+func GetHbaRules(db *sql.DB, postgresVersion state.PostgresVersion) ([]state.PostgresHbaRule, error) {
+	if postgresVersion.Numeric < state.PostgresVersion10 {
+		return nil, nil
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + hbaRulesSQL)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && (pqErr.Code == "42501" || pqErr.Code == "42P01") { // insufficient_privilege, undefined_table
+			return nil, nil
+		}
+		return nil, fmt.Errorf("HbaRules/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var rules []state.PostgresHbaRule
+	for rows.Next() {
+		var r state.PostgresHbaRule
+		var databases, userNames, options null.String
+
+		err = rows.Scan(&r.LineNumber, &r.Type, &databases, &userNames, &r.Address,
+			&r.Netmask, &r.AuthMethod, &options, &r.Error)
+		if err != nil {
+			return nil, fmt.Errorf("HbaRules/Scan: %s", err)
+		}
+		r.Databases = unpackPostgresStringArray(databases)
+		r.UserNames = unpackPostgresStringArray(userNames)
+		r.Options = redactHbaOptions(unpackPostgresStringArray(options))
+		r.PermissiveReasons = hbaPermissiveReasons(r)
+
+		rules = append(rules, r)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("HbaRules/Rows: %s", err)
+	}
+
+	return rules, nil
+}
+
+func redactHbaOptions(options []string) []string {
+	for idx, option := range options {
+		for _, name := range hbaSecretOptions {
+			if strings.HasPrefix(option, name+"=") {
+				options[idx] = name + "=<redacted>"
+			}
+		}
+	}
+	return options
+}
+
+func hbaPermissiveReasons(r state.PostgresHbaRule) (reasons []string) {
+	if r.Error != "" {
+		return
+	}
+
+	anyAddress := r.Address == "all" || r.Netmask == "0.0.0.0" || r.Netmask == "::"
+	if r.AuthMethod == "trust" {
+		if r.Type == "local" {
+			reasons = append(reasons, "trust authentication allows any local user to connect without a password")
+		} else {
+			reasons = append(reasons, "trust authentication allows connecting over the network without a password")
+		}
+	}
+	if r.Type != "local" && anyAddress && hbaPasswordAuthMethods[r.AuthMethod] {
+		reasons = append(reasons, "password authentication is allowed from any address")
+	}
+	if (r.Type == "host" || r.Type == "hostnossl") && r.AuthMethod == "password" {
+		reasons = append(reasons, "passwords can be sent in clear text over unencrypted connections")
+	}
+
+	return
+}
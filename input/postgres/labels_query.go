@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+)
+
+const defaultLabelsQueryTimeoutMs = 5000
+
+// GetDynamicLabels - Runs the labels_query, returning the labels for the whole
+// snapshot, and those for individual tables (keyed by the table OID in the
+// database we're connected to)
+func GetDynamicLabels(db *sql.DB, config config.ServerConfig) (labels map[string]string, relationLabels map[state.Oid]map[string]string, err error) {
+	timeoutMs := config.SectionStatementTimeoutMs
+	if timeoutMs <= 0 {
+		timeoutMs = defaultLabelsQueryTimeoutMs
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutMs)*time.Millisecond)
+	defer cancel()
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, nil, fmt.Errorf("LabelsQuery/Begin: %s", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("%sSET LOCAL statement_timeout = %d", QueryMarkerSQL, timeoutMs))
+	if err != nil {
+		return nil, nil, fmt.Errorf("LabelsQuery/Timeout: %s", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, QueryMarkerSQL+config.LabelsQuery)
+	if err != nil {
+		return nil, nil, fmt.Errorf("LabelsQuery/Query: %s", err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, nil, fmt.Errorf("LabelsQuery/Columns: %s", err)
+	}
+	if len(columns) != 2 && len(columns) != 3 {
+		return nil, nil, fmt.Errorf("LabelsQuery: needs to return two columns (key, value), or three (key, value, table OID), got %d", len(columns))
+	}
+
+	labels = make(map[string]string)
+	relationLabels = make(map[state.Oid]map[string]string)
+	for rows.Next() {
+		var key, value null.String
+		var relationOid null.Int
+
+		if len(columns) == 3 {
+			err = rows.Scan(&key, &value, &relationOid)
+		} else {
+			err = rows.Scan(&key, &value)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("LabelsQuery/Scan: %s", err)
+		}
+		if !key.Valid || !value.Valid {
+			continue
+		}
+
+		if relationOid.Valid {
+			oid := state.Oid(relationOid.Int64)
+			if relationLabels[oid] == nil {
+				relationLabels[oid] = make(map[string]string)
+			}
+			relationLabels[oid][key.String] = value.String
+		} else {
+			labels[key.String] = value.String
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, nil, fmt.Errorf("LabelsQuery/Rows: %s", err)
+	}
+
+	return labels, relationLabels, nil
+}
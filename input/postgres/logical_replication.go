@@ -0,0 +1,231 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const publicationsSQL string = `
+SELECT p.pubname, p.puballtables, p.pubinsert, p.pubupdate, p.pubdelete
+	FROM pg_catalog.pg_publication p`
+
+const publicationTablesSQL string = `
+SELECT pt.pubname, c.oid
+	FROM pg_catalog.pg_publication_tables pt
+	JOIN pg_catalog.pg_namespace n ON (n.nspname = pt.schemaname)
+	JOIN pg_catalog.pg_class c ON (c.relnamespace = n.oid AND c.relname = pt.tablename)`
+
+// Only the apply worker (relid IS NULL) is relevant, table synchronization
+// workers are short-lived. Note that subconninfo is not readable by regular users.
+const subscriptionsSQL string = `
+SELECT s.subdbid, s.subname, s.subenabled, st.pid, st.received_lsn::text, st.latest_end_lsn::text,
+			 EXTRACT(epoch FROM pg_catalog.now() - st.latest_end_time)::float
+	FROM pg_catalog.pg_subscription s
+	LEFT JOIN pg_catalog.pg_stat_subscription st ON (st.subid = s.oid AND st.relid IS NULL)`
+
+// On a standby the slots can't advance beyond what was replayed, so use that instead
+const logicalReplicationSlotsSQL string = `
+SELECT s.datoid, s.slot_name, s.plugin, s.active, s.active_pid, s.restart_lsn::text,
+			 s.confirmed_flush_lsn::text, %s(%s, s.restart_lsn)::bigint
+	FROM pg_catalog.pg_replication_slots s
+ WHERE s.slot_type = 'logical'`
+
+const logicalReplicationSlotsCurrentLsnPg10 string = "CASE WHEN pg_catalog.pg_is_in_recovery() THEN pg_catalog.pg_last_wal_replay_lsn() ELSE pg_catalog.pg_current_wal_insert_lsn() END"
+const logicalReplicationSlotsCurrentLsnPg96 string = "CASE WHEN pg_catalog.pg_is_in_recovery() THEN pg_catalog.pg_last_xlog_replay_location() ELSE pg_catalog.pg_current_xlog_insert_location() END"
+
+// logicalSlotWalHistorySize - How many full snapshots the retained WAL trend is based on
+const logicalSlotWalHistorySize = 6
+
+// GetPublications - Collects the publications of the current database (PG10+)
+func GetPublications(db *sql.DB, postgresVersion state.PostgresVersion, currentDatabaseOid state.Oid) ([]state.PostgresPublication, error) {
+	if postgresVersion.Numeric < state.PostgresVersion10 {
+		return nil, nil
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + publicationsSQL)
+	if err != nil {
+		return nil, fmt.Errorf("Publications/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var publications []state.PostgresPublication
+	publicationIdx := make(map[string]int)
+	for rows.Next() {
+		publication := state.PostgresPublication{DatabaseOid: currentDatabaseOid}
+		err = rows.Scan(&publication.Name, &publication.AllTables, &publication.Insert,
+			&publication.Update, &publication.Delete)
+		if err != nil {
+			return nil, fmt.Errorf("Publications/Scan: %s", err)
+		}
+		publicationIdx[publication.Name] = len(publications)
+		publications = append(publications, publication)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("Publications/Rows: %s", err)
+	}
+
+	if len(publications) == 0 {
+		return nil, nil
+	}
+
+	rows, err = db.Query(QueryMarkerSQL + publicationTablesSQL)
+	if err != nil {
+		return nil, fmt.Errorf("PublicationTables/Query: %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		var relationOid state.Oid
+		err = rows.Scan(&name, &relationOid)
+		if err != nil {
+			return nil, fmt.Errorf("PublicationTables/Scan: %s", err)
+		}
+		if idx, exists := publicationIdx[name]; exists {
+			publications[idx].RelationOids = append(publications[idx].RelationOids, relationOid)
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("PublicationTables/Rows: %s", err)
+	}
+
+	return publications, nil
+}
+
+// GetSubscriptions - Collects all subscriptions on the server (PG10+), and warns
+// about subscriptions whose apply worker is down or lagging behind
+func GetSubscriptions(logger *util.Logger, db *sql.DB, postgresVersion state.PostgresVersion, lagWarnSecs int) ([]state.PostgresSubscription, error) {
+	if postgresVersion.Numeric < state.PostgresVersion10 {
+		return nil, nil
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + subscriptionsSQL)
+	if err != nil {
+		return nil, fmt.Errorf("Subscriptions/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []state.PostgresSubscription
+	for rows.Next() {
+		var s state.PostgresSubscription
+		err = rows.Scan(&s.DatabaseOid, &s.Name, &s.Enabled, &s.WorkerPid, &s.ReceivedLsn,
+			&s.LatestEndLsn, &s.LagSecs)
+		if err != nil {
+			return nil, fmt.Errorf("Subscriptions/Scan: %s", err)
+		}
+
+		if s.Enabled && !s.WorkerPid.Valid {
+			s.WorkerDown = true
+			logger.PrintWarning("Subscription %s is enabled, but its apply worker is not running", s.Name)
+		} else if lagWarnSecs > 0 && s.LagSecs.Valid && s.LagSecs.Float64 > float64(lagWarnSecs) {
+			s.LagExceeded = true
+			logger.PrintWarning("Subscription %s is lagging behind the publisher (last reported %.0f seconds ago)", s.Name, s.LagSecs.Float64)
+		}
+
+		subscriptions = append(subscriptions, s)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("Subscriptions/Rows: %s", err)
+	}
+
+	return subscriptions, nil
+}
+
+// GetLogicalReplicationSlots - Collects all logical replication slots (PG9.6+) with
+// the WAL they retain, and warns about inactive slots whose retained WAL keeps growing
+//
+// The returned history includes this run's samples, and should be persisted for
+// determining the trend on the next run (on errors, the previous history is kept).
+func GetLogicalReplicationSlots(logger *util.Logger, db *sql.DB, postgresVersion state.PostgresVersion, prevHistory state.LogicalSlotWalHistory, collectedAt time.Time) ([]state.PostgresLogicalReplicationSlot, state.LogicalSlotWalHistory, error) {
+	var query string
+	if postgresVersion.Numeric >= state.PostgresVersion10 {
+		query = fmt.Sprintf(logicalReplicationSlotsSQL, "pg_catalog.pg_wal_lsn_diff", logicalReplicationSlotsCurrentLsnPg10)
+	} else if postgresVersion.Numeric >= state.PostgresVersion96 {
+		query = fmt.Sprintf(logicalReplicationSlotsSQL, "pg_catalog.pg_xlog_location_diff", logicalReplicationSlotsCurrentLsnPg96)
+	} else {
+		return nil, nil, nil
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + query)
+	if err != nil {
+		return nil, prevHistory, fmt.Errorf("LogicalReplicationSlots/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var slots []state.PostgresLogicalReplicationSlot
+	history := make(state.LogicalSlotWalHistory)
+	for rows.Next() {
+		var s state.PostgresLogicalReplicationSlot
+		err = rows.Scan(&s.DatabaseOid, &s.SlotName, &s.Plugin, &s.Active, &s.ActivePid,
+			&s.RestartLsn, &s.ConfirmedFlushLsn, &s.RetainedWalBytes)
+		if err != nil {
+			return nil, prevHistory, fmt.Errorf("LogicalReplicationSlots/Scan: %s", err)
+		}
+
+		// Slots without a restart_lsn don't retain any WAL (yet)
+		if s.RetainedWalBytes.Valid {
+			// Copied, since the previous history is still referenced by the previous state
+			samples := append([]state.LogicalSlotWalSample{}, prevHistory[s.SlotName]...)
+			samples = append(samples, state.LogicalSlotWalSample{CollectedAt: collectedAt, RetainedWalBytes: s.RetainedWalBytes.Int64})
+			if len(samples) > logicalSlotWalHistorySize {
+				samples = samples[len(samples)-logicalSlotWalHistorySize:]
+			}
+			history[s.SlotName] = samples
+			s.RetainedWalBytesPerSec, s.RetainedWalGrowing = logicalSlotWalTrend(samples)
+		}
+
+		if !s.Active && s.RetainedWalGrowing {
+			s.InactiveRetainingWal = true
+			logger.PrintWarning("Logical replication slot %s is inactive, and retains a growing amount of WAL (%d MB, growing by %.1f MB per hour)",
+				s.SlotName, s.RetainedWalBytes.Int64/1024/1024, s.RetainedWalBytesPerSec.Float64*3600/1024/1024)
+		}
+
+		slots = append(slots, s)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, prevHistory, fmt.Errorf("LogicalReplicationSlots/Rows: %s", err)
+	}
+
+	return slots, history, nil
+}
+
+// logicalSlotWalTrend - Determines how fast the retained WAL grew over the given
+// samples, and whether it grew on every one of them (requires at least three samples)
+func logicalSlotWalTrend(samples []state.LogicalSlotWalSample) (bytesPerSec null.Float, growing bool) {
+	if len(samples) < 2 {
+		return
+	}
+
+	first := samples[0]
+	last := samples[len(samples)-1]
+	secs := last.CollectedAt.Sub(first.CollectedAt).Seconds()
+	if secs <= 0 {
+		return
+	}
+	bytesPerSec = null.FloatFrom(float64(last.RetainedWalBytes-first.RetainedWalBytes) / secs)
+
+	if len(samples) < 3 {
+		return
+	}
+	for idx := 1; idx < len(samples); idx++ {
+		if samples[idx].RetainedWalBytes <= samples[idx-1].RetainedWalBytes {
+			return
+		}
+	}
+	growing = true
+
+	return
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/guregu/null"
+	"github.com/lib/pq"
+	"github.com/pganalyze/collector/state"
+)
+
+const materializedViewsSQL string = `
+	WITH locked_relids AS (SELECT DISTINCT relation relid FROM pg_catalog.pg_locks WHERE mode = 'AccessExclusiveLock')
+SELECT c.oid,
+			 c.relispopulated,
+			 pg_catalog.pg_total_relation_size(c.oid)
+	FROM pg_catalog.pg_class c
+	JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+ WHERE c.relkind = 'm'
+			 AND n.nspname NOT IN ('pg_catalog','pg_toast','information_schema')
+			 AND c.oid NOT IN (SELECT relid FROM locked_relids)`
+
+// The tracking table is expected to have a "matview" column (name of the view,
+// optionally schema-qualified) and a "refreshed_at" timestamp column
+const materializedViewRefreshesSQL string = `
+SELECT c.oid,
+			 t.refreshed_at
+	FROM (SELECT matview::text AS matview, pg_catalog.max(refreshed_at) AS refreshed_at FROM %s GROUP BY 1) t
+	JOIN pg_catalog.pg_class c ON (c.relkind = 'm')
+	JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+ WHERE t.matview = n.nspname || '.' || c.relname
+			 OR (t.matview = c.relname AND pg_catalog.pg_table_is_visible(c.oid))`
+
+// GetMaterializedViews - Collects freshness information for all materialized views
+// in the current database, using the given tracking table (if any) for refresh times
+func GetMaterializedViews(db *sql.DB, postgresVersion state.PostgresVersion, currentDatabaseOid state.Oid, trackingTable string) ([]state.PostgresMaterializedView, error) {
+	if postgresVersion.Numeric < state.PostgresVersion93 {
+		return nil, nil
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + materializedViewsSQL)
+	if err != nil {
+		return nil, fmt.Errorf("MaterializedViews/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var views []state.PostgresMaterializedView
+	viewIdx := make(map[state.Oid]int)
+	for rows.Next() {
+		view := state.PostgresMaterializedView{DatabaseOid: currentDatabaseOid}
+		err = rows.Scan(&view.RelationOid, &view.IsPopulated, &view.SizeBytes)
+		if err != nil {
+			return nil, fmt.Errorf("MaterializedViews/Scan: %s", err)
+		}
+		viewIdx[view.RelationOid] = len(views)
+		views = append(views, view)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("MaterializedViews/Rows: %s", err)
+	}
+
+	if trackingTable == "" || len(views) == 0 {
+		return views, nil
+	}
+
+	rows, err = db.Query(QueryMarkerSQL + fmt.Sprintf(materializedViewRefreshesSQL, quoteQualifiedName(trackingTable)))
+	if err != nil {
+		return nil, fmt.Errorf("MaterializedViewRefreshes/Query: %s", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var oid state.Oid
+		var refreshedAt null.Time
+		err = rows.Scan(&oid, &refreshedAt)
+		if err != nil {
+			return nil, fmt.Errorf("MaterializedViewRefreshes/Scan: %s", err)
+		}
+		if idx, exists := viewIdx[oid]; exists {
+			views[idx].LastRefreshedAt = refreshedAt
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("MaterializedViewRefreshes/Rows: %s", err)
+	}
+
+	return views, nil
+}
+
+func quoteQualifiedName(name string) string {
+	parts := strings.Split(name, ".")
+	for idx, part := range parts {
+		parts[idx] = pq.QuoteIdentifier(part)
+	}
+	return strings.Join(parts, ".")
+}
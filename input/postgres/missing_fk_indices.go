@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// FindMissingForeignKeyIndices - Finds foreign keys whose referencing columns are not
+// covered by an index, i.e. there is no valid btree index whose leading key columns
+// are exactly the referencing columns
+//
+// The order of the referencing columns within the leading key columns doesn't matter,
+// since looking up rows requires an equality match on all of them. Indices with
+// additional key columns after those still cover the foreign key, but indices on
+// only some of the referencing columns (or with other columns in front) don't.
+// Partial and expression indices are not considered either.
+func FindMissingForeignKeyIndices(logger *util.Logger, relations []state.PostgresRelation) []state.PostgresMissingForeignKeyIndex {
+	var missing []state.PostgresMissingForeignKeyIndex
+
+	for _, relation := range relations {
+		// We don't know the indices of locked tables
+		if relation.ExclusivelyLocked {
+			continue
+		}
+
+		for _, constraint := range relation.Constraints {
+			if constraint.Type != "f" || len(constraint.Columns) == 0 {
+				continue
+			}
+			if foreignKeyCovered(constraint.Columns, relation.Indices) {
+				continue
+			}
+
+			columnNames := relationColumnNames(relation, constraint.Columns)
+			if columnNames == nil {
+				continue
+			}
+
+			m := state.PostgresMissingForeignKeyIndex{
+				DatabaseOid:    relation.DatabaseOid,
+				RelationOid:    relation.Oid,
+				ConstraintName: constraint.Name,
+				ColumnNames:    columnNames,
+				SuggestedIndex: suggestedForeignKeyIndex(relation, columnNames),
+			}
+			missing = append(missing, m)
+
+			logger.PrintVerbose("Foreign key %s on table %s.%s has no supporting index, consider: %s", constraint.Name, relation.SchemaName, relation.RelationName, m.SuggestedIndex)
+		}
+	}
+
+	return missing
+}
+
+func foreignKeyCovered(columns []int32, indices []state.PostgresIndex) bool {
+	for _, index := range indices {
+		if !usableIndex(index) || index.IndexType != "btree" || index.Predicate.Valid {
+			continue
+		}
+		keyColumns := indexKeyColumns(index)
+		if len(keyColumns) < len(columns) {
+			continue
+		}
+		if sameColumnSet(keyColumns[:len(columns)], columns) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameColumnSet - Whether both lists contain the same columns, ignoring their order
+// (expression columns, which are 0, never match)
+func sameColumnSet(a []int32, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[int32]int)
+	for _, column := range a {
+		if column == 0 {
+			return false
+		}
+		counts[column]++
+	}
+	for _, column := range b {
+		if counts[column] == 0 {
+			return false
+		}
+		counts[column]--
+	}
+	return true
+}
+
+// relationColumnNames - Looks up the names of the given column numbers, returns nil
+// if any of them is unknown
+func relationColumnNames(relation state.PostgresRelation, positions []int32) []string {
+	var names []string
+	for _, position := range positions {
+		found := false
+		for _, column := range relation.Columns {
+			if column.Position == position {
+				names = append(names, column.Name)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil
+		}
+	}
+	return names
+}
+
+func suggestedForeignKeyIndex(relation state.PostgresRelation, columnNames []string) string {
+	var quotedColumns []string
+	for _, name := range columnNames {
+		quotedColumns = append(quotedColumns, pq.QuoteIdentifier(name))
+	}
+
+	// Partitioned tables don't support building indices concurrently
+	createIndex := "CREATE INDEX CONCURRENTLY"
+	if relation.RelationType == "p" {
+		createIndex = "CREATE INDEX"
+	}
+
+	return fmt.Sprintf("%s ON %s.%s (%s)", createIndex, pq.QuoteIdentifier(relation.SchemaName), pq.QuoteIdentifier(relation.RelationName), strings.Join(quotedColumns, ", "))
+}
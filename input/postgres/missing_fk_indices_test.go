@@ -0,0 +1,201 @@
+package postgres_test
+
+import (
+	"io/ioutil"
+	"log"
+	"testing"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+func fkTestIndex(columns ...int32) state.PostgresIndex {
+	return state.PostgresIndex{
+		IndexOid:       10,
+		IndexType:      "btree",
+		Columns:        columns,
+		KeyColumnCount: int32(len(columns)),
+		IsValid:        true,
+		IsReady:        true,
+	}
+}
+
+var findMissingForeignKeyIndicesTests = []struct {
+	name              string
+	relationType      string
+	constraintColumns []int32
+	indices           func() []state.PostgresIndex
+	expectedIndex     string // Empty if the foreign key is covered
+}{
+	{
+		"no index",
+		"r",
+		[]int32{2},
+		func() []state.PostgresIndex { return nil },
+		`CREATE INDEX CONCURRENTLY ON "public"."orders" ("customer_id")`,
+	},
+	{
+		"exact index",
+		"r",
+		[]int32{2},
+		func() []state.PostgresIndex { return []state.PostgresIndex{fkTestIndex(2)} },
+		"",
+	},
+	{
+		"index with additional columns after",
+		"r",
+		[]int32{2},
+		func() []state.PostgresIndex { return []state.PostgresIndex{fkTestIndex(2, 1)} },
+		"",
+	},
+	{
+		"index with other column in front",
+		"r",
+		[]int32{2},
+		func() []state.PostgresIndex { return []state.PostgresIndex{fkTestIndex(1, 2)} },
+		`CREATE INDEX CONCURRENTLY ON "public"."orders" ("customer_id")`,
+	},
+	{
+		"multi-column key, same column order",
+		"r",
+		[]int32{2, 3},
+		func() []state.PostgresIndex { return []state.PostgresIndex{fkTestIndex(2, 3)} },
+		"",
+	},
+	{
+		"multi-column key, different column order",
+		"r",
+		[]int32{2, 3},
+		func() []state.PostgresIndex { return []state.PostgresIndex{fkTestIndex(3, 2, 1)} },
+		"",
+	},
+	{
+		"multi-column key, index on only some of the columns",
+		"r",
+		[]int32{2, 3},
+		func() []state.PostgresIndex { return []state.PostgresIndex{fkTestIndex(2), fkTestIndex(3, 1)} },
+		`CREATE INDEX CONCURRENTLY ON "public"."orders" ("customer_id", "Region")`,
+	},
+	{
+		"multi-column key, column only in INCLUDE",
+		"r",
+		[]int32{2, 3},
+		func() []state.PostgresIndex {
+			index := fkTestIndex(2, 3)
+			index.KeyColumnCount = 1
+			return []state.PostgresIndex{index}
+		},
+		`CREATE INDEX CONCURRENTLY ON "public"."orders" ("customer_id", "Region")`,
+	},
+	{
+		"partial index",
+		"r",
+		[]int32{2},
+		func() []state.PostgresIndex {
+			index := fkTestIndex(2)
+			index.Predicate = null.StringFrom("(customer_id IS NOT NULL)")
+			return []state.PostgresIndex{index}
+		},
+		`CREATE INDEX CONCURRENTLY ON "public"."orders" ("customer_id")`,
+	},
+	{
+		"expression index",
+		"r",
+		[]int32{2},
+		func() []state.PostgresIndex {
+			index := fkTestIndex(0)
+			index.Expressions = null.StringFrom("(customer_id + 0)")
+			return []state.PostgresIndex{index}
+		},
+		`CREATE INDEX CONCURRENTLY ON "public"."orders" ("customer_id")`,
+	},
+	{
+		"hash index",
+		"r",
+		[]int32{2},
+		func() []state.PostgresIndex {
+			index := fkTestIndex(2)
+			index.IndexType = "hash"
+			return []state.PostgresIndex{index}
+		},
+		`CREATE INDEX CONCURRENTLY ON "public"."orders" ("customer_id")`,
+	},
+	{
+		"invalid index",
+		"r",
+		[]int32{2},
+		func() []state.PostgresIndex {
+			index := fkTestIndex(2)
+			index.IsValid = false
+			return []state.PostgresIndex{index}
+		},
+		`CREATE INDEX CONCURRENTLY ON "public"."orders" ("customer_id")`,
+	},
+	{
+		"partitioned table",
+		"p",
+		[]int32{3, 2},
+		func() []state.PostgresIndex { return nil },
+		`CREATE INDEX ON "public"."orders" ("Region", "customer_id")`,
+	},
+}
+
+func TestFindMissingForeignKeyIndices(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+
+	for _, test := range findMissingForeignKeyIndicesTests {
+		relations := []state.PostgresRelation{{
+			Oid:          1,
+			DatabaseOid:  2,
+			SchemaName:   "public",
+			RelationName: "orders",
+			RelationType: test.relationType,
+			Columns: []state.PostgresColumn{
+				{Name: "id", Position: 1},
+				{Name: "customer_id", Position: 2},
+				{Name: "Region", Position: 3},
+			},
+			Indices: test.indices(),
+			Constraints: []state.PostgresConstraint{
+				{Name: "orders_pkey", Type: "p", Columns: []int32{1}},
+				{Name: "orders_customer_fkey", Type: "f", Columns: test.constraintColumns, ForeignOid: 3},
+			},
+		}}
+		missing := postgres.FindMissingForeignKeyIndices(logger, relations)
+
+		if test.expectedIndex == "" {
+			if len(missing) != 0 {
+				t.Errorf("%s: expected foreign key to be covered, got %+v", test.name, missing)
+			}
+			continue
+		}
+		if len(missing) != 1 {
+			t.Errorf("%s: expected 1 missing index, got %+v", test.name, missing)
+			continue
+		}
+		if missing[0].SuggestedIndex != test.expectedIndex {
+			t.Errorf("%s: expected suggested index %s, got %s", test.name, test.expectedIndex, missing[0].SuggestedIndex)
+		}
+		if missing[0].ConstraintName != "orders_customer_fkey" || missing[0].DatabaseOid != 2 || missing[0].RelationOid != 1 || len(missing[0].ColumnNames) != len(test.constraintColumns) {
+			t.Errorf("%s: unexpected details: %+v", test.name, missing[0])
+		}
+	}
+}
+
+func TestFindMissingForeignKeyIndicesLockedTable(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+	relations := []state.PostgresRelation{{
+		SchemaName:        "public",
+		RelationName:      "orders",
+		ExclusivelyLocked: true,
+		Columns:           []state.PostgresColumn{{Name: "customer_id", Position: 1}},
+		Constraints:       []state.PostgresConstraint{{Name: "orders_customer_fkey", Type: "f", Columns: []int32{1}}},
+	}}
+
+	missing := postgres.FindMissingForeignKeyIndices(logger, relations)
+	if len(missing) != 0 {
+		t.Errorf("Expected foreign keys of locked tables to be skipped, got %+v", missing)
+	}
+}
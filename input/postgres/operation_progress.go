@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pganalyze/collector/state"
+)
+
+// Each of these returns: pid, relid, command, phase, work done, work total
+const createIndexProgressSQL string = `
+SELECT pid, relid, command, phase,
+			 CASE WHEN blocks_total > 0 THEN blocks_done ELSE tuples_done END,
+			 CASE WHEN blocks_total > 0 THEN blocks_total ELSE tuples_total END
+	FROM pg_catalog.pg_stat_progress_create_index`
+
+const clusterProgressSQL string = `
+SELECT pid, relid, command, phase, heap_blks_scanned, heap_blks_total
+	FROM pg_catalog.pg_stat_progress_cluster`
+
+const analyzeProgressSQL string = `
+SELECT pid, relid, 'ANALYZE', phase, sample_blks_scanned, sample_blks_total
+	FROM pg_catalog.pg_stat_progress_analyze`
+
+const basebackupProgressSQL string = `
+SELECT pid, NULL::oid, 'BASE BACKUP', phase, backup_streamed, COALESCE(backup_total, 0)
+	FROM pg_catalog.pg_stat_progress_basebackup`
+
+const copyProgressSQL string = `
+SELECT pid, relid, command, '', bytes_processed, bytes_total
+	FROM pg_catalog.pg_stat_progress_copy`
+
+const operationProgressSQL string = `
+SELECT (EXTRACT(epoch FROM COALESCE(a.backend_start, pg_catalog.pg_postmaster_start_time()))::int::text || pg_catalog.to_char(p.pid, 'FM0000000'))::bigint,
+			 p.command,
+			 COALESCE(p.phase, ''),
+			 COALESCE(a.datname, ''),
+			 n.nspname,
+			 c.relname,
+			 COALESCE(a.usename, ''),
+			 a.query_start,
+			 COALESCE(p.work_done, 0),
+			 COALESCE(p.work_total, 0)
+	FROM (%s) p (pid, relid, command, phase, work_done, work_total)
+			 JOIN %s a USING (pid)
+			 LEFT JOIN pg_catalog.pg_class c ON (c.oid = COALESCE(p.relid, 0))
+			 LEFT JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)`
+
+// GetOperationProgress - Gets the progress of currently running operations that have
+// a pg_stat_progress_* view (except VACUUM, see GetVacuumProgress), depending on which
+// views exist in this Postgres version
+func GetOperationProgress(db *sql.DB, postgresVersion state.PostgresVersion) ([]state.PostgresOperationProgress, error) {
+	var progressSQLs []string
+	var activitySourceTable string
+
+	if postgresVersion.Numeric >= state.PostgresVersion12 {
+		progressSQLs = append(progressSQLs, createIndexProgressSQL, clusterProgressSQL)
+	}
+	if postgresVersion.Numeric >= state.PostgresVersion13 {
+		progressSQLs = append(progressSQLs, analyzeProgressSQL, basebackupProgressSQL)
+	}
+	if postgresVersion.Numeric >= state.PostgresVersion14 {
+		progressSQLs = append(progressSQLs, copyProgressSQL)
+	}
+	if len(progressSQLs) == 0 {
+		return nil, nil
+	}
+
+	if statsHelperExists(db, "get_stat_activity") {
+		activitySourceTable = "pganalyze.get_stat_activity()"
+	} else {
+		activitySourceTable = "pg_catalog.pg_stat_activity"
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + fmt.Sprintf(operationProgressSQL, strings.Join(progressSQLs, "\n UNION ALL"), activitySourceTable))
+	if err != nil {
+		return nil, fmt.Errorf("OperationProgress/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var operations []state.PostgresOperationProgress
+	for rows.Next() {
+		var row state.PostgresOperationProgress
+
+		err = rows.Scan(&row.BackendIdentity, &row.Command, &row.Phase, &row.DatabaseName,
+			&row.SchemaName, &row.RelationName, &row.RoleName, &row.StartedAt,
+			&row.WorkDone, &row.WorkTotal)
+		if err != nil {
+			return nil, fmt.Errorf("OperationProgress/Scan: %s", err)
+		}
+
+		operations = append(operations, row)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("OperationProgress/Rows: %s", err)
+	}
+
+	return operations, nil
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/pganalyze/collector/state"
+)
+
+const recoveryConflictsSQL string = `
+SELECT datid, confl_tablespace, confl_lock, confl_snapshot, confl_bufferpin, confl_deadlock
+	FROM pg_catalog.pg_stat_database_conflicts
+ WHERE datid IS NOT NULL`
+
+// GetRecoveryConflicts - Gets the per-database counts of queries cancelled due to recovery conflicts
+//
+// These are only tracked on standbys, so this should not be called on a primary.
+func GetRecoveryConflicts(db *sql.DB) (state.PostgresRecoveryConflictStatsMap, error) {
+	stmt, err := db.Prepare(QueryMarkerSQL + recoveryConflictsSQL)
+	if err != nil {
+		return nil, fmt.Errorf("RecoveryConflicts/Prepare: %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, fmt.Errorf("RecoveryConflicts/Query: %s", err)
+	}
+	defer rows.Close()
+
+	conflicts := make(state.PostgresRecoveryConflictStatsMap)
+	for rows.Next() {
+		var databaseOid state.Oid
+		var stats state.PostgresRecoveryConflictStats
+
+		err = rows.Scan(&databaseOid, &stats.ConflTablespace, &stats.ConflLock, &stats.ConflSnapshot,
+			&stats.ConflBufferpin, &stats.ConflDeadlock)
+		if err != nil {
+			return nil, fmt.Errorf("RecoveryConflicts/Scan: %s", err)
+		}
+		conflicts[databaseOid] = stats
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("RecoveryConflicts/Rows: %s", err)
+	}
+
+	return conflicts, nil
+}
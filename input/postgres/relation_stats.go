@@ -17,6 +17,10 @@ SELECT c.oid,
 			    FROM pg_catalog.pg_inherits
 				 WHERE inhparent = c.oid), 0) AS size_bytes,
 			 CASE c.reltoastrelid WHEN NULL THEN 0 ELSE COALESCE(pg_catalog.pg_total_relation_size(c.reltoastrelid), 0) END AS toast_bytes,
+			 COALESCE(pg_catalog.pg_relation_size(c.oid, 'main'), 0) AS main_bytes,
+			 COALESCE(pg_catalog.pg_relation_size(c.oid, 'fsm'), 0) AS fsm_bytes,
+			 COALESCE(pg_catalog.pg_relation_size(c.oid, 'vm'), 0) AS vm_bytes,
+			 COALESCE(pg_catalog.pg_indexes_size(c.oid), 0) AS indexes_bytes,
 			 COALESCE(s.seq_scan, 0),
 			 COALESCE(s.seq_tup_read, 0),
 			 COALESCE(s.idx_scan, 0),
@@ -95,6 +99,7 @@ func GetRelationStats(db *sql.DB, postgresVersion state.PostgresVersion) (relSta
 		var stats state.PostgresRelationStats
 
 		err = rows.Scan(&oid, &stats.SizeBytes, &stats.ToastSizeBytes,
+			&stats.MainSizeBytes, &stats.FsmSizeBytes, &stats.VmSizeBytes, &stats.IndexesSizeBytes,
 			&stats.SeqScan, &stats.SeqTupRead,
 			&stats.IdxScan, &stats.IdxTupFetch, &stats.NTupIns,
 			&stats.NTupUpd, &stats.NTupDel, &stats.NTupHotUpd,
@@ -38,6 +38,11 @@ func handleRelationStatsExt(db *sql.DB, relStats state.PostgresRelationStatsMap,
 			s := relStats[oid]
 			s.SizeBytes = sizeBytes
 			s.ToastSizeBytes = 0
+			// The size breakdown is only known for the (usually empty) table on the coordinator
+			s.MainSizeBytes = 0
+			s.FsmSizeBytes = 0
+			s.VmSizeBytes = 0
+			s.IndexesSizeBytes = 0
 			relStats[oid] = s
 		}
 	}
@@ -27,7 +27,9 @@ const relationsSQL string = `
 				c.relhassubclass AS relation_has_inheritance_children,
 				c.reltoastrelid IS NOT NULL AS relation_has_toast,
 				c.relfrozenxid AS relation_frozen_xid,
+				CASE WHEN c.relkind IN ('r','m') THEN pg_catalog.age(c.relfrozenxid) ELSE 0 END AS relation_frozen_xid_age,
 				%s,
+				c.reltuples,
 				locked_relids.relid IS NOT NULL
 	 FROM pg_catalog.pg_class c
 	 LEFT JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
@@ -61,6 +63,9 @@ const columnsSQL string = `
 			 AND c.oid NOT IN (SELECT relid FROM locked_relids)
  ORDER BY a.attnum`
 
+const indicesSQLDefaultKeyColumnCount = "i.indnatts"
+const indicesSQLpg11KeyColumnCount = "i.indnkeyatts"
+
 const indicesSQL string = `
 	WITH locked_relids AS (SELECT DISTINCT relation relid FROM pg_catalog.pg_locks WHERE mode = 'AccessExclusiveLock')
 SELECT c.oid,
@@ -70,10 +75,17 @@ SELECT c.oid,
 			 i.indisprimary,
 			 i.indisunique,
 			 i.indisvalid,
+			 i.indisready,
 			 pg_catalog.pg_get_indexdef(i.indexrelid, 0, TRUE),
 			 pg_catalog.pg_get_constraintdef(con.oid, TRUE),
 			 c2.reloptions,
-			 (SELECT a.amname FROM pg_catalog.pg_am a JOIN pg_catalog.pg_opclass o ON (a.oid = o.opcmethod) WHERE o.oid = i.indclass[0])
+			 (SELECT a.amname FROM pg_catalog.pg_am a JOIN pg_catalog.pg_opclass o ON (a.oid = o.opcmethod) WHERE o.oid = i.indclass[0]),
+			 %s,
+			 i.indclass::text,
+			 i.indoption::text,
+			 i.indcollation::text,
+			 pg_catalog.pg_get_expr(i.indexprs, i.indrelid, TRUE),
+			 pg_catalog.pg_get_expr(i.indpred, i.indrelid, TRUE)
 	FROM pg_catalog.pg_class c
 	JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
 	JOIN pg_catalog.pg_index i ON (c.oid = i.indrelid)
@@ -87,6 +99,14 @@ SELECT c.oid,
 			 AND c.oid NOT IN (SELECT relid FROM locked_relids)
 			 AND c2.oid NOT IN (SELECT relid FROM locked_relids)`
 
+// Indices currently being built by CREATE INDEX (CONCURRENTLY) or REINDEX, so
+// we can tell these apart from invalid indices left behind by a failed build
+const indicesBeingBuiltSQL string = `
+SELECT index_relid
+	FROM pg_catalog.pg_stat_progress_create_index
+ WHERE datid = (SELECT oid FROM pg_catalog.pg_database WHERE datname = pg_catalog.current_database())
+			 AND index_relid <> 0`
+
 const constraintsSQL string = `
 	WITH locked_relids AS (SELECT DISTINCT relation relid FROM pg_catalog.pg_locks WHERE mode = 'AccessExclusiveLock')
 SELECT c.oid,
@@ -149,7 +169,7 @@ func GetRelations(db *sql.DB, postgresVersion state.PostgresVersion, currentData
 
 		err = rows.Scan(&row.Oid, &row.SchemaName, &row.RelationName, &row.RelationType,
 			&options, &row.HasOids, &row.PersistenceType, &row.HasInheritanceChildren,
-			&row.HasToast, &row.FrozenXID, &row.MinimumMultixactXID, &row.ExclusivelyLocked)
+			&row.HasToast, &row.FrozenXID, &row.FrozenXIDAge, &row.MinimumMultixactXID, &row.Tuples, &row.ExclusivelyLocked)
 		if err != nil {
 			err = fmt.Errorf("Relations/Scan: %s", err)
 			return nil, err
@@ -193,7 +213,14 @@ func GetRelations(db *sql.DB, postgresVersion state.PostgresVersion, currentData
 	}
 
 	// Indices
-	rows, err = db.Query(QueryMarkerSQL + indicesSQL)
+	var keyColumnCount string
+	if postgresVersion.Numeric >= state.PostgresVersion11 {
+		keyColumnCount = indicesSQLpg11KeyColumnCount
+	} else {
+		keyColumnCount = indicesSQLDefaultKeyColumnCount
+	}
+
+	rows, err = db.Query(QueryMarkerSQL + fmt.Sprintf(indicesSQL, keyColumnCount))
 	if err != nil {
 		err = fmt.Errorf("Indices/Query: %s", err)
 		return nil, err
@@ -203,11 +230,12 @@ func GetRelations(db *sql.DB, postgresVersion state.PostgresVersion, currentData
 
 	for rows.Next() {
 		var row state.PostgresIndex
-		var columns string
+		var columns, opClasses, columnOptions, collations string
 		var options null.String
 
 		err = rows.Scan(&row.RelationOid, &row.IndexOid, &columns, &row.Name, &row.IsPrimary,
-			&row.IsUnique, &row.IsValid, &row.IndexDef, &row.ConstraintDef, &options, &row.IndexType)
+			&row.IsUnique, &row.IsValid, &row.IsReady, &row.IndexDef, &row.ConstraintDef, &options, &row.IndexType,
+			&row.KeyColumnCount, &opClasses, &columnOptions, &collations, &row.Expressions, &row.Predicate)
 		if err != nil {
 			err = fmt.Errorf("Indices/Scan: %s", err)
 			return nil, err
@@ -217,6 +245,18 @@ func GetRelations(db *sql.DB, postgresVersion state.PostgresVersion, currentData
 			cint, _ := strconv.Atoi(cstr)
 			row.Columns = append(row.Columns, int32(cint))
 		}
+		for _, cstr := range strings.Fields(opClasses) {
+			coid, _ := strconv.ParseUint(cstr, 10, 64)
+			row.OpClasses = append(row.OpClasses, state.Oid(coid))
+		}
+		for _, cstr := range strings.Fields(columnOptions) {
+			cint, _ := strconv.Atoi(cstr)
+			row.ColumnOptions = append(row.ColumnOptions, int32(cint))
+		}
+		for _, cstr := range strings.Fields(collations) {
+			coid, _ := strconv.ParseUint(cstr, 10, 64)
+			row.Collations = append(row.Collations, state.Oid(coid))
+		}
 
 		row.Options = make(map[string]string)
 		if options.Valid {
@@ -231,6 +271,42 @@ func GetRelations(db *sql.DB, postgresVersion state.PostgresVersion, currentData
 		relations[row.RelationOid] = relation
 	}
 
+	if postgresVersion.Numeric >= state.PostgresVersion12 {
+		indicesBeingBuilt := make(map[state.Oid]bool)
+		rows, err = db.Query(QueryMarkerSQL + indicesBeingBuiltSQL)
+		if err != nil {
+			err = fmt.Errorf("IndicesBeingBuilt/Query: %s", err)
+			return nil, err
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			var indexOid state.Oid
+			err = rows.Scan(&indexOid)
+			if err != nil {
+				err = fmt.Errorf("IndicesBeingBuilt/Scan: %s", err)
+				return nil, err
+			}
+			indicesBeingBuilt[indexOid] = true
+		}
+
+		err = rows.Err()
+		if err != nil {
+			err = fmt.Errorf("IndicesBeingBuilt/Rows: %s", err)
+			return nil, err
+		}
+
+		for oid, relation := range relations {
+			for idx, index := range relation.Indices {
+				if indicesBeingBuilt[index.IndexOid] {
+					relation.Indices[idx].IsBeingBuilt = true
+				}
+			}
+			relations[oid] = relation
+		}
+	}
+
 	// Constraints
 	rows, err = db.Query(QueryMarkerSQL + constraintsSQL)
 	if err != nil {
@@ -74,6 +74,12 @@ SELECT client_addr,
 	FROM %s
  WHERE client_addr IS NOT NULL`
 
+// GetIsInRecovery - Determines whether the server is currently a standby (or recovering)
+func GetIsInRecovery(db *sql.DB) (inRecovery bool, err error) {
+	err = db.QueryRow(QueryMarkerSQL + "SELECT pg_catalog.pg_is_in_recovery()").Scan(&inRecovery)
+	return
+}
+
 func GetReplication(logger *util.Logger, db *sql.DB, postgresVersion state.PostgresVersion, systemType string) (state.PostgresReplication, error) {
 	var err error
 	var repl state.PostgresReplication
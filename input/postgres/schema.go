@@ -2,6 +2,10 @@ package postgres
 
 import (
 	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
@@ -11,11 +15,7 @@ func CollectAllSchemas(server state.Server, collectionOpts state.CollectionOpts,
 	schemaDbNames := []string{}
 
 	if server.Config.DbAllNames {
-		for _, database := range ts.Databases {
-			if !database.IsTemplate && database.AllowConnections && !(systemType == "amazon_rds" && database.Name == "rdsadmin") {
-				schemaDbNames = append(schemaDbNames, database.Name)
-			}
-		}
+		schemaDbNames = expandAllDatabases(server, logger, ts, systemType)
 	} else {
 		schemaDbNames = append(schemaDbNames, server.Config.DbName)
 		schemaDbNames = append(schemaDbNames, server.Config.DbExtraNames...)
@@ -27,20 +27,42 @@ func CollectAllSchemas(server state.Server, collectionOpts state.CollectionOpts,
 	ps.Functions = []state.PostgresFunction{}
 
 	for _, dbName := range schemaDbNames {
+		start := time.Now()
+		sectionName := "schema:" + dbName
+
 		schemaConnection, err := EstablishConnection(server, logger, collectionOpts, dbName)
 		if err != nil {
 			logger.PrintVerbose("Failed to connect to database %s to retrieve schema: %s", dbName, err)
+			ts.CollectionStatus.Record(sectionName, start, err)
 			continue
 		}
 
 		databaseOid, err := CurrentDatabaseOid(schemaConnection)
 		if err != nil {
 			logger.PrintError("Error getting OID of database %s", dbName)
+			ts.CollectionStatus.Record(sectionName, start, err)
 			schemaConnection.Close()
 			continue
 		}
 
-		ps = collectSchemaData(collectionOpts, logger, schemaConnection, ps, databaseOid, ts.Version)
+		ps, err = collectSchemaData(collectionOpts, logger, schemaConnection, ps, databaseOid, ts.Version)
+		ts.CollectionStatus.Record(sectionName, start, err)
+
+		if err == nil && collectionOpts.CollectPostgresRelations {
+			views, err := GetMaterializedViews(schemaConnection, ts.Version, databaseOid, server.Config.MatviewRefreshTrackingTable)
+			if err != nil {
+				logger.PrintWarning("Error collecting materialized view information for database %s: %s", dbName, err)
+			} else {
+				ts.MaterializedViews = append(ts.MaterializedViews, views...)
+			}
+		}
+
+		publications, err := GetPublications(schemaConnection, ts.Version, databaseOid)
+		if err != nil {
+			logger.PrintWarning("Error collecting publications for database %s: %s", dbName, err)
+		} else {
+			ts.LogicalReplication.Publications = append(ts.LogicalReplication.Publications, publications...)
+		}
 		ts.DatabaseOidsWithLocalCatalog = append(ts.DatabaseOidsWithLocalCatalog, databaseOid)
 
 		schemaConnection.Close()
@@ -49,19 +71,59 @@ func CollectAllSchemas(server state.Server, collectionOpts state.CollectionOpts,
 	return ps, ts
 }
 
-func collectSchemaData(collectionOpts state.CollectionOpts, logger *util.Logger, db *sql.DB, ps state.PersistedState, databaseOid state.Oid, postgresVersion state.PostgresVersion) state.PersistedState {
+var expandedDatabasesLogged = struct {
+	sync.Mutex
+	servers map[string]string
+}{servers: make(map[string]string)}
+
+// expandAllDatabases - Determines the databases to collect when db_name includes "*"
+//
+// Which databases were collected or excluded (and why) gets logged on the first
+// full snapshot, and again whenever that changes.
+func expandAllDatabases(server state.Server, logger *util.Logger, ts state.TransientState, systemType string) []string {
+	var dbNames []string
+	var excluded []string
+
+	for _, database := range ts.Databases {
+		if database.IsTemplate || !database.AllowConnections || (systemType == "amazon_rds" && database.Name == "rdsadmin") {
+			continue
+		}
+		if reason := server.Config.DatabaseExcludedReason(database.Name, ts.DatabaseSizes[database.Oid]); reason != "" {
+			excluded = append(excluded, fmt.Sprintf("%s (%s)", database.Name, reason))
+			continue
+		}
+		dbNames = append(dbNames, database.Name)
+	}
+
+	summary := fmt.Sprintf("Collecting all databases: %s", strings.Join(dbNames, ", "))
+	if len(excluded) > 0 {
+		summary += fmt.Sprintf("; excluded: %s", strings.Join(excluded, ", "))
+	}
+
+	expandedDatabasesLogged.Lock()
+	if expandedDatabasesLogged.servers[server.Config.SectionName] != summary {
+		expandedDatabasesLogged.servers[server.Config.SectionName] = summary
+		logger.PrintInfo("%s", summary)
+	}
+	expandedDatabasesLogged.Unlock()
+
+	return dbNames
+}
+
+func collectSchemaData(collectionOpts state.CollectionOpts, logger *util.Logger, db *sql.DB, ps state.PersistedState, databaseOid state.Oid, postgresVersion state.PostgresVersion) (state.PersistedState, error) {
 	if collectionOpts.CollectPostgresRelations {
 		newRelations, err := GetRelations(db, postgresVersion, databaseOid)
 		if err != nil {
 			logger.PrintError("Error collecting relation/index information: %s", err)
-			return ps
+			return ps, err
 		}
 		ps.Relations = append(ps.Relations, newRelations...)
+		logInvalidIndices(logger, newRelations)
 
 		newRelationStats, err := GetRelationStats(db, postgresVersion)
 		if err != nil {
 			logger.PrintError("Error collecting relation stats: %s", err)
-			return ps
+			return ps, err
 		}
 		for k, v := range newRelationStats {
 			ps.RelationStats[k] = v
@@ -70,7 +132,7 @@ func collectSchemaData(collectionOpts state.CollectionOpts, logger *util.Logger,
 		newIndexStats, err := GetIndexStats(db, postgresVersion)
 		if err != nil {
 			logger.PrintError("Error collecting index stats: %s", err)
-			return ps
+			return ps, err
 		}
 		for k, v := range newIndexStats {
 			ps.IndexStats[k] = v
@@ -81,10 +143,22 @@ func collectSchemaData(collectionOpts state.CollectionOpts, logger *util.Logger,
 		newFunctions, err := GetFunctions(db, postgresVersion, databaseOid)
 		if err != nil {
 			logger.PrintError("Error collecting stored procedures")
-			return ps
+			return ps, err
 		}
 		ps.Functions = append(ps.Functions, newFunctions...)
 	}
 
-	return ps
+	return ps, nil
+}
+
+// logInvalidIndices - Warns about indices left invalid (e.g. by a failed CREATE
+// INDEX CONCURRENTLY), since the planner silently ignores them
+func logInvalidIndices(logger *util.Logger, relations []state.PostgresRelation) {
+	for _, relation := range relations {
+		for _, index := range relation.Indices {
+			if !index.IsValid && !index.IsBeingBuilt {
+				logger.PrintWarning("Index %s.%s on table %s is invalid and not used by queries, consider running REINDEX or dropping it", relation.SchemaName, index.Name, relation.RelationName)
+			}
+		}
+	}
 }
@@ -2,9 +2,9 @@ package postgres
 
 import (
 	"database/sql"
-	"fmt"
 
 	"github.com/pganalyze/collector/state"
+	"github.com/pkg/errors"
 )
 
 const settingsSQL string = `
@@ -21,7 +21,7 @@ SELECT name,
 func GetSettings(db *sql.DB, postgresVersion state.PostgresVersion) ([]state.PostgresSetting, error) {
 	stmt, err := db.Prepare(QueryMarkerSQL + settingsSQL)
 	if err != nil {
-		err = fmt.Errorf("Settings/Prepare: %s", err)
+		err = errors.Wrap(err, "Settings/Prepare")
 		return nil, err
 	}
 
@@ -29,7 +29,7 @@ func GetSettings(db *sql.DB, postgresVersion state.PostgresVersion) ([]state.Pos
 
 	rows, err := stmt.Query()
 	if err != nil {
-		err = fmt.Errorf("Settings/Query: %s", err)
+		err = errors.Wrap(err, "Settings/Query")
 		return nil, err
 	}
 
@@ -43,7 +43,7 @@ func GetSettings(db *sql.DB, postgresVersion state.PostgresVersion) ([]state.Pos
 		err := rows.Scan(&row.Name, &row.CurrentValue, &row.Unit, &row.BootValue,
 			&row.ResetValue, &row.Source, &row.SourceFile, &row.SourceLine)
 		if err != nil {
-			err = fmt.Errorf("Settings/Scan: %s", err)
+			err = errors.Wrap(err, "Settings/Scan")
 			return nil, err
 		}
 
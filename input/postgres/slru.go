@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+)
+
+const slruStatsSQL string = `
+SELECT name, blks_zeroed, blks_hit, blks_read, blks_written, truncates, stats_reset
+	FROM pg_catalog.pg_stat_slru`
+
+// GetSlruStats - Gets the activity of the SLRU caches, returns nil for Postgres
+// versions before 13 (which don't have pg_stat_slru)
+func GetSlruStats(db *sql.DB, postgresVersion state.PostgresVersion) (state.PostgresSlruStatsMap, error) {
+	if postgresVersion.Numeric < state.PostgresVersion13 {
+		return nil, nil
+	}
+
+	stmt, err := db.Prepare(QueryMarkerSQL + slruStatsSQL)
+	if err != nil {
+		return nil, fmt.Errorf("SlruStats/Prepare: %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, fmt.Errorf("SlruStats/Query: %s", err)
+	}
+	defer rows.Close()
+
+	slruStats := make(state.PostgresSlruStatsMap)
+	for rows.Next() {
+		var name string
+		var stats state.PostgresSlruStats
+		var statsReset null.Time
+
+		err = rows.Scan(&name, &stats.BlksZeroed, &stats.BlksHit, &stats.BlksRead,
+			&stats.BlksWritten, &stats.Truncates, &statsReset)
+		if err != nil {
+			return nil, fmt.Errorf("SlruStats/Scan: %s", err)
+		}
+		stats.StatsReset = statsReset.Time
+		slruStats[name] = stats
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("SlruStats/Rows: %s", err)
+	}
+
+	return slruStats, nil
+}
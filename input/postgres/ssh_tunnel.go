@@ -0,0 +1,247 @@
+package postgres
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os/user"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const sshTunnelKeepaliveInterval = 30 * time.Second
+
+type sshTunnel struct {
+	logger       *util.Logger
+	bastionAddr  string
+	remoteAddr   string
+	clientConfig *ssh.ClientConfig
+	listener     net.Listener
+
+	// The SSH connection is (re-)established on demand, and reset when it drops
+	clientMutex sync.Mutex
+	client      *ssh.Client
+}
+
+func newSSHTunnel(config config.ServerConfig, logger *util.Logger) (*sshTunnel, error) {
+	key, err := ioutil.ReadFile(config.SSHTunnelKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read SSH key: %s", err)
+	}
+	signer, err := ssh.ParsePrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse SSH key: %s", err)
+	}
+
+	knownHostsFile := config.SSHTunnelKnownHostsFile
+	if knownHostsFile == "" {
+		usr, err := user.Current()
+		if err != nil {
+			return nil, fmt.Errorf("Could not determine home directory for known_hosts file: %s", err)
+		}
+		knownHostsFile = filepath.Join(usr.HomeDir, ".ssh", "known_hosts")
+	}
+	hostKeyCallback, err := knownhosts.New(knownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read known_hosts file: %s", err)
+	}
+
+	bastionAddr := config.SSHTunnelHost
+	if _, _, err := net.SplitHostPort(bastionAddr); err != nil {
+		bastionAddr = net.JoinHostPort(bastionAddr, "22")
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("Could not listen on local port: %s", err)
+	}
+
+	return &sshTunnel{
+		logger:      logger,
+		bastionAddr: bastionAddr,
+		remoteAddr:  config.GetSSHTunnelRemoteAddr(),
+		clientConfig: &ssh.ClientConfig{
+			User:            config.SSHTunnelUser,
+			Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+			HostKeyCallback: hostKeyCallback,
+			Timeout:         10 * time.Second,
+		},
+		listener: listener,
+	}, nil
+}
+
+func (t *sshTunnel) localPort() int {
+	return t.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (t *sshTunnel) getClient() (*ssh.Client, error) {
+	t.clientMutex.Lock()
+	defer t.clientMutex.Unlock()
+
+	if t.client != nil {
+		return t.client, nil
+	}
+
+	client, err := ssh.Dial("tcp", t.bastionAddr, t.clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	t.logger.PrintVerbose("Established SSH tunnel via %s to %s", t.bastionAddr, t.remoteAddr)
+	t.client = client
+
+	go func() {
+		err := client.Wait()
+		t.resetClient(client)
+		t.logger.PrintVerbose("SSH tunnel via %s closed: %v", t.bastionAddr, err)
+	}()
+
+	return client, nil
+}
+
+func (t *sshTunnel) resetClient(client *ssh.Client) {
+	t.clientMutex.Lock()
+	defer t.clientMutex.Unlock()
+
+	if t.client == client {
+		t.client = nil
+	}
+	client.Close()
+}
+
+func (t *sshTunnel) dialRemote() (net.Conn, error) {
+	client, err := t.getClient()
+	if err != nil {
+		return nil, err
+	}
+
+	remoteConn, err := client.Dial("tcp", t.remoteAddr)
+	if err == nil {
+		return remoteConn, nil
+	}
+
+	// The SSH connection might have silently dropped, retry once with a new one
+	t.resetClient(client)
+	client, err = t.getClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.Dial("tcp", t.remoteAddr)
+}
+
+func (t *sshTunnel) forward(localConn net.Conn) {
+	defer localConn.Close()
+
+	remoteConn, err := t.dialRemote()
+	if err != nil {
+		t.logger.PrintError("Could not connect to %s through SSH tunnel via %s: %s", t.remoteAddr, t.bastionAddr, err)
+		return
+	}
+	defer remoteConn.Close()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(remoteConn, localConn)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(localConn, remoteConn)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
+func (t *sshTunnel) keepalive() {
+	t.clientMutex.Lock()
+	client := t.client
+	t.clientMutex.Unlock()
+
+	if client == nil {
+		return
+	}
+
+	_, _, err := client.SendRequest("keepalive@openssh.com", true, nil)
+	if err != nil {
+		t.logger.PrintVerbose("SSH tunnel keepalive via %s failed, reconnecting on next use: %s", t.bastionAddr, err)
+		t.resetClient(client)
+	}
+}
+
+func (t *sshTunnel) run(stop <-chan bool) {
+	go func() {
+		for {
+			localConn, err := t.listener.Accept()
+			if err != nil {
+				return // Listener was closed
+			}
+			go t.forward(localConn)
+		}
+	}()
+
+	ticker := time.NewTicker(sshTunnelKeepaliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			t.keepalive()
+		case <-stop:
+			t.listener.Close()
+			t.clientMutex.Lock()
+			if t.client != nil {
+				t.client.Close()
+				t.client = nil
+			}
+			t.clientMutex.Unlock()
+			return
+		}
+	}
+}
+
+// SetupSSHTunnels - Starts a local SSH port forward for all servers that have
+// ssh_tunnel_host set, and points their database connection at it
+//
+// Servers whose tunnel could not be set up are left unchanged, and will fail
+// to connect with a regular connection error.
+func SetupSSHTunnels(servers []state.Server, logger *util.Logger) chan<- bool {
+	var stops []chan bool
+
+	for idx, server := range servers {
+		if server.Config.SSHTunnelHost == "" {
+			continue
+		}
+
+		prefixedLogger := logger.WithPrefix(server.Config.SectionName)
+		tunnel, err := newSSHTunnel(server.Config, prefixedLogger)
+		if err != nil {
+			prefixedLogger.PrintError("Could not set up SSH tunnel via %s: %s", server.Config.SSHTunnelHost, err)
+			continue
+		}
+
+		servers[idx].Config.SSHTunnelLocalPort = tunnel.localPort()
+
+		stop := make(chan bool)
+		go tunnel.run(stop)
+		stops = append(stops, stop)
+	}
+
+	if len(stops) == 0 {
+		return nil
+	}
+
+	stopAll := make(chan bool)
+	go func() {
+		<-stopAll
+		for _, stop := range stops {
+			stop <- true
+		}
+	}()
+	return stopAll
+}
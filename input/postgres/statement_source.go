@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// StatementSource - Provides per-query statistics, in the form of counters that
+// only ever increase (until they are reset), like pg_stat_statements does
+type StatementSource interface {
+	// Name - Label for the source of the statistics, submitted as query_source
+	Name() string
+
+	// GetStatements - Gets the current statistics (and query texts, if showtext
+	// is set). The returned cursor needs to be persisted and passed to the next
+	// call as part of the previous state.
+	GetStatements(logger *util.Logger, db *sql.DB, globalCollectionOpts state.CollectionOpts, postgresVersion state.PostgresVersion, showtext bool, systemType string, prevState state.PersistedState) (state.PostgresStatementMap, state.PostgresStatementTextMap, state.PostgresStatementStatsMap, time.Time, error)
+
+	// ResetStatements - Resets the statistics kept by the source
+	ResetStatements(logger *util.Logger, db *sql.DB, systemType string) error
+}
+
+// GetStatementSource - Returns the statement source with the given name (as
+// configured in statement_source), defaulting to pg_stat_statements
+func GetStatementSource(name string) (StatementSource, error) {
+	switch name {
+	case "", "pg_stat_statements":
+		return pgStatStatementsSource{}, nil
+	case "pg_stat_monitor":
+		return pgStatMonitorSource{}, nil
+	}
+	return nil, fmt.Errorf("Unsupported statement source \"%s\", needs to be pg_stat_statements or pg_stat_monitor", name)
+}
+
+type pgStatStatementsSource struct{}
+
+func (pgStatStatementsSource) Name() string {
+	return "pg_stat_statements"
+}
+
+func (pgStatStatementsSource) GetStatements(logger *util.Logger, db *sql.DB, globalCollectionOpts state.CollectionOpts, postgresVersion state.PostgresVersion, showtext bool, systemType string, prevState state.PersistedState) (state.PostgresStatementMap, state.PostgresStatementTextMap, state.PostgresStatementStatsMap, time.Time, error) {
+	statements, statementTexts, statementStats, err := GetStatements(logger, db, globalCollectionOpts, postgresVersion, showtext, systemType)
+	if err == nil && len(statementStats) > 0 {
+		addKcacheStats(logger, db, postgresVersion, statementStats)
+	}
+	return statements, statementTexts, statementStats, time.Time{}, err
+}
+
+func (pgStatStatementsSource) ResetStatements(logger *util.Logger, db *sql.DB, systemType string) error {
+	return ResetStatements(logger, db, systemType)
+}
@@ -17,10 +17,11 @@ const statementSQLpg94OptionalFields = "queryid, NULL, NULL, NULL, NULL"
 const statementSQLpg95OptionalFields = "queryid, min_time, max_time, mean_time, stddev_time"
 
 const statementSQL string = `
-SELECT dbid, userid, query, calls, total_time, rows, shared_blks_hit, shared_blks_read,
-			 shared_blks_dirtied, shared_blks_written, local_blks_hit, local_blks_read,
-			 local_blks_dirtied, local_blks_written, temp_blks_read, temp_blks_written,
-			 blk_read_time, blk_write_time, %s
+SELECT dbid, userid, query, COALESCE(calls, 0), COALESCE(total_time, 0), COALESCE(rows, 0),
+			 COALESCE(shared_blks_hit, 0), COALESCE(shared_blks_read, 0), COALESCE(shared_blks_dirtied, 0),
+			 COALESCE(shared_blks_written, 0), COALESCE(local_blks_hit, 0), COALESCE(local_blks_read, 0),
+			 COALESCE(local_blks_dirtied, 0), COALESCE(local_blks_written, 0), COALESCE(temp_blks_read, 0),
+			 COALESCE(temp_blks_written, 0), COALESCE(blk_read_time, 0), COALESCE(blk_write_time, 0), %s
 	FROM %s`
 
 const statementStatsHelperSQL string = `
@@ -169,6 +170,15 @@ func GetStatements(logger *util.Logger, db *sql.DB, globalCollectionOpts state.C
 			return nil, nil, nil, err
 		}
 
+		// Guard against special float values, e.g. stddev_time on freshly reset stats
+		stats.TotalTime = util.FiniteFloatOrZero(stats.TotalTime)
+		stats.BlkReadTime = util.FiniteFloatOrZero(stats.BlkReadTime)
+		stats.BlkWriteTime = util.FiniteFloatOrZero(stats.BlkWriteTime)
+		stats.MinTime = util.FiniteNullFloat(stats.MinTime)
+		stats.MaxTime = util.FiniteNullFloat(stats.MaxTime)
+		stats.MeanTime = util.FiniteNullFloat(stats.MeanTime)
+		stats.StddevTime = util.FiniteNullFloat(stats.StddevTime)
+
 		if queryID.Valid {
 			key.QueryID = queryID.Int64
 		} else if receivedQuery.Valid {
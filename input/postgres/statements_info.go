@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/pganalyze/collector/state"
+)
+
+const statementStatsInfoExistsSQL string = `
+SELECT pg_catalog.to_regclass('public.pg_stat_statements_info') IS NOT NULL`
+
+const statementStatsInfoSQL string = `
+SELECT dealloc, stats_reset
+	FROM public.pg_stat_statements_info`
+
+// GetStatementStatsInfo - Collects the eviction counter of pg_stat_statements, returns
+// false if pg_stat_statements_info is not available (before Postgres 14 or extension version 1.9)
+func GetStatementStatsInfo(db *sql.DB, postgresVersion state.PostgresVersion) (state.PostgresStatementStatsInfo, bool, error) {
+	var info state.PostgresStatementStatsInfo
+	var exists bool
+
+	if postgresVersion.Numeric < state.PostgresVersion14 {
+		return info, false, nil
+	}
+
+	err := db.QueryRow(QueryMarkerSQL + statementStatsInfoExistsSQL).Scan(&exists)
+	if err != nil {
+		return info, false, fmt.Errorf("StatementStatsInfo/Exists: %s", err)
+	}
+	if !exists {
+		return info, false, nil
+	}
+
+	err = db.QueryRow(QueryMarkerSQL+statementStatsInfoSQL).Scan(&info.Dealloc, &info.StatsReset)
+	if err != nil {
+		return info, false, fmt.Errorf("StatementStatsInfo/Query: %s", err)
+	}
+
+	return info, true, nil
+}
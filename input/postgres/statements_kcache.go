@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const kcacheExtensionSQL string = `
+SELECT pg_catalog.quote_ident(n.nspname), e.extversion
+	FROM pg_catalog.pg_extension e
+	JOIN pg_catalog.pg_namespace n ON (n.oid = e.extnamespace)
+ WHERE e.extname = 'pg_stat_kcache'`
+
+// Times are reported in seconds, and summed up across top-level and nested
+// statements (2.2+ reports them separately, pg_stat_statements keys don't)
+const kcacheSQL string = `
+SELECT queryid, userid, dbid,
+			 COALESCE(SUM(%s), 0) * 1000, COALESCE(SUM(%s), 0) * 1000,
+			 COALESCE(SUM(%s), 0), COALESCE(SUM(%s), 0)
+	FROM %s.pg_stat_kcache()
+ GROUP BY queryid, userid, dbid`
+
+// addKcacheStats - Adds CPU time and filesystem I/O from pg_stat_kcache to the given
+// statement statistics (matching on query ID), if the extension is installed
+//
+// Errors are only logged, since the statistics are still useful without this data.
+func addKcacheStats(logger *util.Logger, db *sql.DB, postgresVersion state.PostgresVersion, statementStats state.PostgresStatementStatsMap) {
+	var schemaName, extVersion string
+
+	// pg_stat_kcache requires the query ID of pg_stat_statements
+	if postgresVersion.Numeric < state.PostgresVersion94 {
+		return
+	}
+
+	err := db.QueryRow(QueryMarkerSQL+kcacheExtensionSQL).Scan(&schemaName, &extVersion)
+	if err == sql.ErrNoRows {
+		return
+	} else if err != nil {
+		logger.PrintVerbose("Could not check for pg_stat_kcache: %s", err)
+		return
+	}
+
+	// 2.1 renamed the columns to have an exec_ prefix (adding plan_ columns)
+	var userTime, systemTime, reads, writes string
+	major, minor := parseExtensionVersion(extVersion)
+	if major > 2 || (major == 2 && minor >= 1) {
+		userTime, systemTime, reads, writes = "exec_user_time", "exec_system_time", "exec_reads", "exec_writes"
+	} else if major == 2 {
+		userTime, systemTime, reads, writes = "user_time", "system_time", "reads", "writes"
+	} else {
+		logger.PrintVerbose("Ignoring pg_stat_kcache, version %s is not supported (requires 2.0 or newer)", extVersion)
+		return
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + fmt.Sprintf(kcacheSQL, userTime, systemTime, reads, writes, schemaName))
+	if err != nil {
+		logger.PrintWarning("Could not collect pg_stat_kcache statistics: %s", err)
+		return
+	}
+	defer rows.Close()
+
+	kcacheStats := make(map[state.PostgresStatementKey]state.PostgresStatementStats)
+	for rows.Next() {
+		var key state.PostgresStatementKey
+		var stats state.PostgresStatementStats
+
+		err = rows.Scan(&key.QueryID, &key.UserOid, &key.DatabaseOid, &stats.UserCPUTime, &stats.SysCPUTime, &stats.FsReadBytes, &stats.FsWriteBytes)
+		if err != nil {
+			logger.PrintWarning("Could not collect pg_stat_kcache statistics: %s", err)
+			return
+		}
+		kcacheStats[key] = stats
+	}
+	if err = rows.Err(); err != nil {
+		logger.PrintWarning("Could not collect pg_stat_kcache statistics: %s", err)
+		return
+	}
+
+	for key, stats := range statementStats {
+		kcache, exists := kcacheStats[key]
+		if !exists {
+			continue
+		}
+		stats.HasKcache = true
+		stats.UserCPUTime = util.FiniteFloatOrZero(kcache.UserCPUTime)
+		stats.SysCPUTime = util.FiniteFloatOrZero(kcache.SysCPUTime)
+		stats.FsReadBytes = kcache.FsReadBytes
+		stats.FsWriteBytes = kcache.FsWriteBytes
+		statementStats[key] = stats
+	}
+}
+
+// parseExtensionVersion - Gets the major and minor version from an extension
+// version string (e.g. "2.1.3"), returning zeros if it can't be parsed
+func parseExtensionVersion(version string) (major int, minor int) {
+	parts := strings.Split(version, ".")
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0
+	}
+	if len(parts) > 1 {
+		minor, _ = strconv.Atoi(parts[1])
+	}
+	return major, minor
+}
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+	"github.com/pkg/errors"
+)
+
+// pg_stat_monitor (2.0+) keeps statistics per time bucket instead of ever-increasing
+// counters, so we add up all buckets that completed since the last run, on top of
+// the totals from the previous run
+const statementPgStatMonitorSQL string = `
+SELECT dbid, userid, queryid, pg_catalog.max(query), pg_catalog.sum(calls), pg_catalog.sum(total_exec_time),
+			 pg_catalog.sum(rows), pg_catalog.sum(shared_blks_hit), pg_catalog.sum(shared_blks_read),
+			 pg_catalog.sum(shared_blks_dirtied), pg_catalog.sum(shared_blks_written), pg_catalog.sum(local_blks_hit),
+			 pg_catalog.sum(local_blks_read), pg_catalog.sum(local_blks_dirtied), pg_catalog.sum(local_blks_written),
+			 pg_catalog.sum(temp_blks_read), pg_catalog.sum(temp_blks_written), pg_catalog.sum(blk_read_time),
+			 pg_catalog.sum(blk_write_time), pg_catalog.max(bucket_start_time)
+	FROM public.pg_stat_monitor
+ WHERE bucket_done AND bucket_start_time > $1 AND queryid IS NOT NULL
+ GROUP BY dbid, userid, queryid`
+
+type pgStatMonitorSource struct{}
+
+func (pgStatMonitorSource) Name() string {
+	return "pg_stat_monitor"
+}
+
+func (pgStatMonitorSource) GetStatements(logger *util.Logger, db *sql.DB, globalCollectionOpts state.CollectionOpts, postgresVersion state.PostgresVersion, showtext bool, systemType string, prevState state.PersistedState) (state.PostgresStatementMap, state.PostgresStatementTextMap, state.PostgresStatementStatsMap, time.Time, error) {
+	cursor := prevState.StatementSourceCursor
+
+	statements := make(state.PostgresStatementMap)
+	statementTexts := make(state.PostgresStatementTextMap)
+	statementStats := make(state.PostgresStatementStatsMap)
+
+	// Build on the totals of the previous run - but only if these were
+	// produced by pg_stat_monitor as well (which always sets the cursor)
+	//
+	// Only queries that ran since then are kept, otherwise the totals of all
+	// queries ever seen would accumulate in the state. Queries that show up
+	// again later are diffed against zero, which yields the same result.
+	var prevStatementStats state.PostgresStatementStatsMap
+	if !cursor.IsZero() {
+		prevStatementStats = prevState.StatementStats
+	}
+
+	rows, err := db.Query(QueryMarkerSQL+statementPgStatMonitorSQL, cursor)
+	if err != nil {
+		return nil, nil, nil, cursor, errors.Wrap(err, "PgStatMonitor/Query")
+	}
+	defer rows.Close()
+
+	newCursor := cursor
+	for rows.Next() {
+		var key state.PostgresStatementKey
+		var receivedQuery null.String
+		var stats state.PostgresStatementStats
+		var bucketStart time.Time
+
+		err = rows.Scan(&key.DatabaseOid, &key.UserOid, &key.QueryID, &receivedQuery, &stats.Calls, &stats.TotalTime, &stats.Rows,
+			&stats.SharedBlksHit, &stats.SharedBlksRead, &stats.SharedBlksDirtied, &stats.SharedBlksWritten,
+			&stats.LocalBlksHit, &stats.LocalBlksRead, &stats.LocalBlksDirtied, &stats.LocalBlksWritten,
+			&stats.TempBlksRead, &stats.TempBlksWritten, &stats.BlkReadTime, &stats.BlkWriteTime, &bucketStart)
+		if err != nil {
+			return nil, nil, nil, cursor, fmt.Errorf("PgStatMonitor/Scan: %s", err)
+		}
+
+		if bucketStart.After(newCursor) {
+			newCursor = bucketStart
+		}
+
+		prevStats := prevStatementStats[key]
+		statementStats[key] = state.PostgresStatementStats{
+			Calls:             prevStats.Calls + stats.Calls,
+			TotalTime:         prevStats.TotalTime + util.FiniteFloatOrZero(stats.TotalTime),
+			Rows:              prevStats.Rows + stats.Rows,
+			SharedBlksHit:     prevStats.SharedBlksHit + stats.SharedBlksHit,
+			SharedBlksRead:    prevStats.SharedBlksRead + stats.SharedBlksRead,
+			SharedBlksDirtied: prevStats.SharedBlksDirtied + stats.SharedBlksDirtied,
+			SharedBlksWritten: prevStats.SharedBlksWritten + stats.SharedBlksWritten,
+			LocalBlksHit:      prevStats.LocalBlksHit + stats.LocalBlksHit,
+			LocalBlksRead:     prevStats.LocalBlksRead + stats.LocalBlksRead,
+			LocalBlksDirtied:  prevStats.LocalBlksDirtied + stats.LocalBlksDirtied,
+			LocalBlksWritten:  prevStats.LocalBlksWritten + stats.LocalBlksWritten,
+			TempBlksRead:      prevStats.TempBlksRead + stats.TempBlksRead,
+			TempBlksWritten:   prevStats.TempBlksWritten + stats.TempBlksWritten,
+			BlkReadTime:       prevStats.BlkReadTime + util.FiniteFloatOrZero(stats.BlkReadTime),
+			BlkWriteTime:      prevStats.BlkWriteTime + util.FiniteFloatOrZero(stats.BlkWriteTime),
+		}
+
+		if showtext && receivedQuery.Valid {
+			fp := util.FingerprintQuery(receivedQuery.String)
+			stmt := state.PostgresStatement{Fingerprint: fp}
+			if insufficientPrivilege(receivedQuery.String) {
+				stmt.InsufficientPrivilege = true
+			} else if collectorStatement(receivedQuery.String) {
+				stmt.Collector = true
+				stmt.Fingerprint = util.FingerprintQuery("<pganalyze-collector>")
+			} else if _, ok := statementTexts[fp]; !ok {
+				statementTexts[fp] = receivedQuery.String
+			}
+			statements[key] = stmt
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, nil, nil, cursor, errors.Wrap(err, "PgStatMonitor/Rows")
+	}
+
+	// The first run only establishes the starting point, the same way a first
+	// pg_stat_statements run does not produce any diffs
+	if cursor.IsZero() && newCursor.IsZero() {
+		newCursor = time.Now()
+	}
+
+	for fp, text := range statementTexts {
+		statementTexts[fp] = util.NormalizeQuery(text)
+	}
+
+	return statements, statementTexts, statementStats, newCursor, nil
+}
+
+func (pgStatMonitorSource) ResetStatements(logger *util.Logger, db *sql.DB, systemType string) error {
+	_, err := db.Exec(QueryMarkerSQL + "SELECT public.pg_stat_monitor_reset()")
+	return err
+}
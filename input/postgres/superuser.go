@@ -3,6 +3,11 @@ package postgres
 import (
 	"database/sql"
 	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
 )
 
 const connectedAsSuperUserSQL string = `SELECT current_setting('is_superuser') = 'on'`
@@ -86,3 +91,63 @@ func statsHelperExists(db *sql.DB, statsHelper string) bool {
 
 	return enabled
 }
+
+const connectedAsReadAllStatsRoleSQL string = `
+SELECT pg_has_role(oid, 'MEMBER') FROM pg_roles WHERE rolname = 'pg_read_all_stats'
+`
+
+// permissionLimitedSections - Sections that only include other roles' data with
+// pg_read_all_stats (or as superuser), unless the given helper function is installed
+var permissionLimitedSections = []struct {
+	name   string
+	helper string
+}{
+	{"statements", "get_stat_statements"},
+	{"activity", "get_stat_activity"},
+	{"replication", "get_stat_replication"},
+}
+
+var privilegeWarningsShown = struct {
+	sync.Mutex
+	servers map[string]bool
+}{servers: make(map[string]bool)}
+
+// GetPrivileges - Determines whether the collector's role can see all statistics,
+// and which sections are missing data otherwise - this warns once per process about
+// the missing privileges, to avoid data that is quietly incomplete
+func GetPrivileges(logger *util.Logger, db *sql.DB, server state.Server, postgresVersion state.PostgresVersion) state.PostgresPrivileges {
+	var p state.PostgresPrivileges
+
+	p.Superuser = connectedAsSuperUser(db, server.Config.SystemType)
+	if postgresVersion.Numeric >= state.PostgresVersion10 {
+		p.MonitoringRole = connectedAsMonitoringRole(db)
+		err := db.QueryRow(QueryMarkerSQL + connectedAsReadAllStatsRoleSQL).Scan(&p.ReadAllStats)
+		if err != nil {
+			logger.PrintVerbose("Could not determine whether the collector's role is a member of pg_read_all_stats: %s", err)
+		}
+	}
+	if p.CanReadAllStats() {
+		return p
+	}
+
+	for _, section := range permissionLimitedSections {
+		if !statsHelperExists(db, section.helper) {
+			p.PermissionLimitedSections = append(p.PermissionLimitedSections, section.name)
+		}
+	}
+	if len(p.PermissionLimitedSections) == 0 || server.Config.SystemType == "heroku" {
+		return p
+	}
+
+	privilegeWarningsShown.Lock()
+	shown := privilegeWarningsShown.servers[server.Config.SectionName]
+	privilegeWarningsShown.servers[server.Config.SectionName] = true
+	privilegeWarningsShown.Unlock()
+	if !shown {
+		logger.PrintWarning("Warning: The collector's role is not a member of pg_monitor (or pg_read_all_stats), data will be incomplete for: %s."+
+			" Please grant pg_monitor, or setup the monitoring helper functions (https://github.com/pganalyze/collector#setting-up-a-restricted-monitoring-user)",
+			strings.Join(p.PermissionLimitedSections, ", "))
+	}
+
+	return p
+}
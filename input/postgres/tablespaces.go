@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+)
+
+const tablespacesSQLDefaultSizePrivilege = "false"
+const tablespacesSQLpg10SizePrivilege = "pg_catalog.pg_has_role('pg_read_all_stats', 'USAGE')"
+
+// pg_tablespace_size errors out when lacking privileges, so only call it when
+// it's expected to succeed (CREATE privilege, or the current database's default)
+const tablespacesSQL string = `
+SELECT t.oid,
+			 t.spcname,
+			 t.spcowner,
+			 t.spcoptions,
+			 pg_catalog.pg_tablespace_location(t.oid),
+			 CASE WHEN %s
+						OR pg_catalog.has_tablespace_privilege(t.oid, 'CREATE')
+						OR t.oid = (SELECT dattablespace FROM pg_catalog.pg_database WHERE datname = pg_catalog.current_database())
+			 THEN pg_catalog.pg_tablespace_size(t.oid)
+			 END
+	FROM pg_catalog.pg_tablespace t`
+
+// GetTablespaces - Collects all tablespaces, their location and size
+func GetTablespaces(db *sql.DB, postgresVersion state.PostgresVersion) ([]state.PostgresTablespace, error) {
+	var sizePrivilege string
+
+	if postgresVersion.Numeric >= state.PostgresVersion10 {
+		sizePrivilege = tablespacesSQLpg10SizePrivilege
+	} else {
+		sizePrivilege = tablespacesSQLDefaultSizePrivilege
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + fmt.Sprintf(tablespacesSQL, sizePrivilege))
+	if err != nil {
+		return nil, fmt.Errorf("Tablespaces/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var tablespaces []state.PostgresTablespace
+	for rows.Next() {
+		var t state.PostgresTablespace
+		var config null.String
+
+		err = rows.Scan(&t.Oid, &t.Name, &t.OwnerOid, &config, &t.Location, &t.SizeBytes)
+		if err != nil {
+			return nil, fmt.Errorf("Tablespaces/Scan: %s", err)
+		}
+		t.Config = unpackPostgresStringArray(config)
+
+		tablespaces = append(tablespaces, t)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("Tablespaces/Rows: %s", err)
+	}
+
+	return tablespaces, nil
+}
@@ -2,9 +2,13 @@ package postgres
 
 import (
 	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
+	"github.com/pkg/errors"
 )
 
 // GetPostgresVersion - Reads the version of the connected PostgreSQL server
@@ -34,7 +38,92 @@ func GetPostgresVersion(logger *util.Logger, db *sql.DB) (version state.Postgres
 		return
 	}
 
+	err = getBuildSettings(db, &version)
+	if err != nil {
+		return
+	}
+
 	logger.PrintVerbose("Detected PostgreSQL Version %d (%s)", version.Numeric, version.Full)
 
 	return
 }
+
+const buildSettingsSQL string = `
+SELECT name, setting::bigint, COALESCE(unit, '')
+  FROM pg_catalog.pg_settings
+ WHERE name IN ('block_size', 'wal_block_size', 'wal_segment_size', 'segment_size')
+`
+
+// getBuildSettings - Reads compile-time settings, converted to bytes (units differ between Postgres versions)
+func getBuildSettings(db *sql.DB, version *state.PostgresVersion) error {
+	rows, err := db.Query(QueryMarkerSQL + buildSettingsSQL)
+	if err != nil {
+		return errors.Wrap(err, "BuildSettings/Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name, unit string
+		var value int64
+
+		err = rows.Scan(&name, &value, &unit)
+		if err != nil {
+			return errors.Wrap(err, "BuildSettings/Scan")
+		}
+
+		multiplier, ok := settingUnitBytes(unit)
+		if !ok {
+			return fmt.Errorf("BuildSettings/Unit: unknown unit \"%s\" for %s", unit, name)
+		}
+		value *= multiplier
+
+		switch name {
+		case "block_size":
+			version.BlockSize = value
+		case "wal_block_size":
+			version.WalBlockSize = value
+		case "wal_segment_size":
+			version.WalSegmentSize = value
+		case "segment_size":
+			version.SegmentSize = value
+		}
+	}
+
+	return rows.Err()
+}
+
+var settingUnitSuffixes = []struct {
+	suffix string
+	bytes  int64
+}{
+	{"kB", 1024},
+	{"MB", 1024 * 1024},
+	{"GB", 1024 * 1024 * 1024},
+	{"TB", 1024 * 1024 * 1024 * 1024},
+	{"B", 1},
+}
+
+// settingUnitBytes - Number of bytes represented by one unit of a memory setting,
+// as reported in pg_settings.unit (e.g. "8kB" or "16MB", or "" for plain bytes)
+func settingUnitBytes(unit string) (int64, bool) {
+	if unit == "" {
+		return 1, true
+	}
+
+	for _, s := range settingUnitSuffixes {
+		if !strings.HasSuffix(unit, s.suffix) {
+			continue
+		}
+		count := int64(1)
+		if prefix := strings.TrimSuffix(unit, s.suffix); prefix != "" {
+			var err error
+			count, err = strconv.ParseInt(prefix, 10, 64)
+			if err != nil {
+				return 0, false
+			}
+		}
+		return count * s.bytes, true
+	}
+
+	return 0, false
+}
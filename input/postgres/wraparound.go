@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"database/sql"
+	"sort"
+	"strconv"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Number of tables closest to a forced anti-wraparound VACUUM to report
+const wraparoundRelationLimit = 20
+
+const autovacuumFreezeMaxAgeSQL = "SELECT pg_catalog.current_setting('autovacuum_freeze_max_age')::bigint"
+
+// GetWraparound - Determines how close databases and tables are to a forced anti-wraparound VACUUM,
+// based on the previously collected databases and relations
+func GetWraparound(logger *util.Logger, db *sql.DB, databases []state.PostgresDatabase, relations []state.PostgresRelation) (state.PostgresWraparound, error) {
+	var wraparound state.PostgresWraparound
+
+	err := db.QueryRow(QueryMarkerSQL + autovacuumFreezeMaxAgeSQL).Scan(&wraparound.AutovacuumFreezeMaxAge)
+	if err != nil {
+		return wraparound, err
+	}
+
+	for _, database := range databases {
+		age := int64(database.FrozenXIDAge)
+		wraparound.Databases = append(wraparound.Databases, state.PostgresDatabaseWraparound{
+			DatabaseOid:     database.Oid,
+			XidAge:          age,
+			ForcedVacuumPct: percentOf(age, wraparound.AutovacuumFreezeMaxAge),
+			WraparoundPct:   percentOf(age, state.MaxSafeXidAge),
+		})
+		if age > state.MaxSafeXidAge/2 {
+			logger.PrintWarning("Database %s has used %.0f%% of the transaction ID wraparound budget, make sure VACUUM can freeze its tables", database.Name, percentOf(age, state.MaxSafeXidAge))
+		}
+	}
+
+	for _, relation := range relations {
+		if relation.FrozenXIDAge == 0 {
+			continue
+		}
+
+		// Per-table settings can only lower the freeze max age, larger values are ignored by autovacuum
+		freezeMaxAge := wraparound.AutovacuumFreezeMaxAge
+		if value, exists := relation.Options["autovacuum_freeze_max_age"]; exists {
+			tableFreezeMaxAge, err := strconv.ParseInt(value, 10, 64)
+			if err == nil && tableFreezeMaxAge < freezeMaxAge {
+				freezeMaxAge = tableFreezeMaxAge
+			}
+		}
+
+		age := int64(relation.FrozenXIDAge)
+		wraparound.Relations = append(wraparound.Relations, state.PostgresRelationWraparound{
+			DatabaseOid:     relation.DatabaseOid,
+			RelationOid:     relation.Oid,
+			XidAge:          age,
+			FreezeMaxAge:    freezeMaxAge,
+			ForcedVacuumPct: percentOf(age, freezeMaxAge),
+			WraparoundPct:   percentOf(age, state.MaxSafeXidAge),
+			Flagged:         age > freezeMaxAge,
+		})
+	}
+
+	sort.SliceStable(wraparound.Relations, func(i, j int) bool {
+		return wraparound.Relations[i].ForcedVacuumPct > wraparound.Relations[j].ForcedVacuumPct
+	})
+	if len(wraparound.Relations) > wraparoundRelationLimit {
+		wraparound.Relations = wraparound.Relations[:wraparoundRelationLimit]
+	}
+
+	return wraparound, nil
+}
+
+func percentOf(value int64, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(value) / float64(total) * 100
+}
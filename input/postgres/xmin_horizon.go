@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const xminHorizonSQL string = `
+SELECT source, identifier, xmin, xmin_age, age_secs
+	FROM (
+		SELECT 'backend' AS source, pid::text AS identifier, backend_xmin AS xmin,
+					 pg_catalog.age(backend_xmin) AS xmin_age,
+					 EXTRACT(epoch FROM pg_catalog.now() - xact_start)::int AS age_secs
+			FROM %s
+		 WHERE backend_xmin IS NOT NULL AND pid <> pg_catalog.pg_backend_pid()
+		UNION ALL
+		SELECT 'prepared_xact', gid, transaction, pg_catalog.age(transaction),
+					 EXTRACT(epoch FROM pg_catalog.now() - prepared)::int
+			FROM pg_catalog.pg_prepared_xacts
+		UNION ALL
+		SELECT 'replication_slot', slot_name::text, xmin, pg_catalog.age(xmin), NULL::int
+			FROM pg_catalog.pg_replication_slots
+		 WHERE xmin IS NOT NULL
+		UNION ALL
+		SELECT 'replication_slot', slot_name::text, catalog_xmin, pg_catalog.age(catalog_xmin), NULL::int
+			FROM pg_catalog.pg_replication_slots
+		 WHERE catalog_xmin IS NOT NULL
+	) horizons
+ ORDER BY xmin_age DESC
+ LIMIT 1`
+
+// GetXminHorizon - Finds the oldest xmin held by a backend, prepared transaction or replication slot
+//
+// Returns false if nothing currently holds back the xmin horizon, or the Postgres
+// version is too old to determine it (backend_xmin and replication slots require 9.4+)
+func GetXminHorizon(logger *util.Logger, db *sql.DB, postgresVersion state.PostgresVersion, warnAge int) (state.PostgresXminHorizon, bool, error) {
+	var horizon state.PostgresXminHorizon
+
+	if postgresVersion.Numeric < state.PostgresVersion94 {
+		return horizon, false, nil
+	}
+
+	var sourceTable string
+	if statsHelperExists(db, "get_stat_activity") {
+		sourceTable = "pganalyze.get_stat_activity()"
+	} else {
+		sourceTable = "pg_catalog.pg_stat_activity"
+	}
+
+	err := db.QueryRow(QueryMarkerSQL+fmt.Sprintf(xminHorizonSQL, sourceTable)).Scan(
+		&horizon.Source, &horizon.Identifier, &horizon.Xmin, &horizon.XminAge, &horizon.AgeSecs,
+	)
+	if err == sql.ErrNoRows {
+		return horizon, false, nil
+	}
+	if err != nil {
+		return horizon, false, fmt.Errorf("XminHorizon/Query: %s", err)
+	}
+
+	if warnAge > 0 && horizon.XminAge > int64(warnAge) {
+		horizon.ExceedsWarnAge = true
+		logger.PrintWarning("Oldest xmin is %d transactions old (held by %s %s), this prevents VACUUM from removing dead rows", horizon.XminAge, horizon.Source, horizon.Identifier)
+	}
+
+	return horizon, true, nil
+}
@@ -0,0 +1,95 @@
+package input
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"strings"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+)
+
+const redactedTokenPrefix = "redacted_"
+
+// redactRelations - Replaces table and column names matching the redact_*_pattern
+// settings with tokens, and removes definitions that would reveal them (e.g. index
+// and view definitions)
+func redactRelations(relations []state.PostgresRelation, config config.ServerConfig) []state.PostgresRelation {
+	relationPatterns := splitPatterns(config.RedactRelationPattern)
+	columnPatterns := splitPatterns(config.RedactColumnPattern)
+
+	for idx, relation := range relations {
+		qualifiedName := relation.SchemaName + "." + relation.RelationName
+		redactRelation := matchesAny(relationPatterns, qualifiedName)
+		redactedAny := redactRelation
+
+		columns := make([]state.PostgresColumn, len(relation.Columns))
+		for colIdx, column := range relation.Columns {
+			if redactRelation || matchesAny(columnPatterns, qualifiedName+"."+column.Name) {
+				column.Name = redactedToken(config.RedactSalt, qualifiedName+"."+column.Name)
+				column.DefaultValue = null.String{}
+				redactedAny = true
+			}
+			columns[colIdx] = column
+		}
+		relation.Columns = columns
+
+		if !redactedAny {
+			continue
+		}
+
+		if redactRelation {
+			relation.RelationName = redactedToken(config.RedactSalt, qualifiedName)
+		}
+		relation.ViewDefinition = ""
+
+		indices := make([]state.PostgresIndex, len(relation.Indices))
+		for indexIdx, index := range relation.Indices {
+			if redactRelation {
+				index.Name = redactedToken(config.RedactSalt, relation.SchemaName+"."+index.Name)
+			}
+			index.IndexDef = ""
+			index.ConstraintDef = null.String{}
+			indices[indexIdx] = index
+		}
+		relation.Indices = indices
+
+		constraints := make([]state.PostgresConstraint, len(relation.Constraints))
+		for constraintIdx, constraint := range relation.Constraints {
+			if redactRelation {
+				constraint.Name = redactedToken(config.RedactSalt, qualifiedName+"."+constraint.Name)
+			}
+			constraint.ConstraintDef = ""
+			constraints[constraintIdx] = constraint
+		}
+		relation.Constraints = constraints
+
+		relations[idx] = relation
+	}
+
+	return relations
+}
+
+func redactedToken(salt string, name string) string {
+	sum := sha256.Sum256([]byte(salt + ":" + name))
+	return redactedTokenPrefix + hex.EncodeToString(sum[:8])
+}
+
+func splitPatterns(patterns string) []string {
+	if patterns == "" {
+		return nil
+	}
+	return strings.Split(patterns, ",")
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		matched, _ := filepath.Match(strings.TrimSpace(pattern), name)
+		if matched {
+			return true
+		}
+	}
+	return false
+}
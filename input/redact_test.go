@@ -0,0 +1,96 @@
+package input
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+)
+
+func redactTestRelation() state.PostgresRelation {
+	return state.PostgresRelation{
+		SchemaName:     "public",
+		RelationName:   "customers",
+		ViewDefinition: "SELECT 1",
+		Columns: []state.PostgresColumn{
+			{Name: "id", DefaultValue: null.StringFrom("nextval('customers_id_seq')")},
+			{Name: "ssn", DefaultValue: null.StringFrom("''")},
+		},
+		Indices: []state.PostgresIndex{
+			{Name: "customers_pkey", IndexDef: "CREATE UNIQUE INDEX customers_pkey ON public.customers USING btree (id)", ConstraintDef: null.StringFrom("PRIMARY KEY (id)")},
+		},
+		Constraints: []state.PostgresConstraint{
+			{Name: "customers_ssn_check", ConstraintDef: "CHECK (ssn <> '')"},
+		},
+	}
+}
+
+var redactTests = []struct {
+	name              string
+	relationPattern   string
+	columnPattern     string
+	redactRelation    bool
+	redactColumns     []bool
+	redactDefinitions bool
+}{
+	{"no match", "secret.*", "*.password", false, []bool{false, false}, false},
+	{"relation", "public.cust*", "", true, []bool{true, true}, true},
+	{"relation in list", "billing.*, public.customers", "", true, []bool{true, true}, true},
+	{"column", "", "public.customers.ssn", false, []bool{false, true}, true},
+	{"column with wildcard", "", "other.*, *.*.s?n", false, []bool{false, true}, true},
+}
+
+func TestRedactRelations(t *testing.T) {
+	for _, test := range redactTests {
+		conf := config.ServerConfig{RedactRelationPattern: test.relationPattern, RedactColumnPattern: test.columnPattern, RedactSalt: "salt"}
+		original := redactTestRelation()
+		relation := redactRelations([]state.PostgresRelation{redactTestRelation()}, conf)[0]
+
+		if isRedacted(relation.RelationName) != test.redactRelation {
+			t.Errorf("%s: expected relation to be redacted: %t, got name %s", test.name, test.redactRelation, relation.RelationName)
+		}
+		if relation.SchemaName != "public" {
+			t.Errorf("%s: expected schema name to be kept, got %s", test.name, relation.SchemaName)
+		}
+		if isRedacted(relation.Indices[0].Name) != test.redactRelation || isRedacted(relation.Constraints[0].Name) != test.redactRelation {
+			t.Errorf("%s: expected index and constraint names to be redacted: %t, got %s and %s", test.name, test.redactRelation, relation.Indices[0].Name, relation.Constraints[0].Name)
+		}
+		for idx, column := range relation.Columns {
+			if isRedacted(column.Name) != test.redactColumns[idx] {
+				t.Errorf("%s: expected column %s to be redacted: %t, got %s", test.name, original.Columns[idx].Name, test.redactColumns[idx], column.Name)
+			}
+			if column.DefaultValue.Valid == test.redactColumns[idx] {
+				t.Errorf("%s: expected default value of column %s to be removed: %t", test.name, original.Columns[idx].Name, test.redactColumns[idx])
+			}
+		}
+		definitionsRemoved := relation.ViewDefinition == "" && relation.Indices[0].IndexDef == "" && !relation.Indices[0].ConstraintDef.Valid && relation.Constraints[0].ConstraintDef == ""
+		definitionsKept := relation.ViewDefinition == original.ViewDefinition && relation.Indices[0].IndexDef == original.Indices[0].IndexDef && relation.Indices[0].ConstraintDef == original.Indices[0].ConstraintDef && relation.Constraints[0].ConstraintDef == original.Constraints[0].ConstraintDef
+		if (test.redactDefinitions && !definitionsRemoved) || (!test.redactDefinitions && !definitionsKept) {
+			t.Errorf("%s: expected definitions to be removed: %t, got %+v", test.name, test.redactDefinitions, relation)
+		}
+	}
+}
+
+func TestRedactRelationsConsistentTokens(t *testing.T) {
+	conf := config.ServerConfig{RedactRelationPattern: "public.customers", RedactSalt: "salt"}
+	first := redactRelations([]state.PostgresRelation{redactTestRelation()}, conf)[0]
+	second := redactRelations([]state.PostgresRelation{redactTestRelation()}, conf)[0]
+	if first.RelationName != second.RelationName || first.Columns[1].Name != second.Columns[1].Name {
+		t.Errorf("Expected the same tokens across collections, got %s/%s and %s/%s", first.RelationName, first.Columns[1].Name, second.RelationName, second.Columns[1].Name)
+	}
+	if first.Columns[0].Name == first.Columns[1].Name {
+		t.Errorf("Expected different columns to get different tokens, got %s", first.Columns[0].Name)
+	}
+
+	conf.RedactSalt = "other"
+	salted := redactRelations([]state.PostgresRelation{redactTestRelation()}, conf)[0]
+	if salted.RelationName == first.RelationName {
+		t.Errorf("Expected a different salt to result in different tokens, got %s", salted.RelationName)
+	}
+}
+
+func isRedacted(name string) bool {
+	return strings.HasPrefix(name, redactedTokenPrefix)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/grant"
 	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/logs"
 	"github.com/pganalyze/collector/output"
 	"github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/runner/stream"
@@ -135,7 +136,8 @@ func processLogLine(timestamp time.Time, backendPid int64, logLevel string, cont
 	logLine.CollectedAt = time.Now()
 	logLine.OccurredAt = timestamp
 	logLine.BackendPid = int32(backendPid)
-	logLine.Content = content
+	// Logplex already limits the line length, but we still need to ensure valid UTF-8
+	logLine.Content, _, _ = logs.SanitizeContent(content, 0)
 	logLine.UUID = uuid.NewV4()
 
 	if logLevel != "" { // Append-lines don't have a log level
@@ -93,7 +93,11 @@ func DownloadLogFiles(config config.ServerConfig, logger *util.Logger) (result [
 
 			var newLogLines []state.LogLine
 			var newSamples []state.PostgresQuerySample
-			newLogLines, newSamples, currentByteStart = logs.ParseAndAnalyzeBuffer(*resp.LogFileData, currentByteStart, linesNewerThan)
+			var sanitizeStats logs.SanitizeStats
+			newLogLines, newSamples, currentByteStart, sanitizeStats = logs.ParseAndAnalyzeBuffer(*resp.LogFileData, currentByteStart, linesNewerThan, config.MaxLogLineLength)
+			if sanitizeStats.TruncatedLines > 0 || sanitizeStats.InvalidUTF8Lines > 0 {
+				logger.PrintVerbose("Rds/Logs: Truncated %d log lines longer than %d bytes, replaced invalid UTF-8 in %d log lines", sanitizeStats.TruncatedLines, config.MaxLogLineLength, sanitizeStats.InvalidUTF8Lines)
+			}
 			logFile.LogLines = append(logFile.LogLines, newLogLines...)
 			samples = append(samples, newSamples...)
 
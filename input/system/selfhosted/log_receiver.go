@@ -411,6 +411,15 @@ func logReceiver(server state.Server, globalCollectionOpts state.CollectionOpts,
 				// We ignore failures here since we want the per-backend stitching logic
 				// that runs later on (and any other parsing errors will just be ignored)
 				logLine, _ := logs.ParseLogLineWithPrefix("", line)
+				var truncatedBytes int
+				var invalidUTF8 bool
+				logLine.Content, truncatedBytes, invalidUTF8 = logs.SanitizeContent(logLine.Content, server.Config.MaxLogLineLength)
+				if truncatedBytes > 0 {
+					prefixedLogger.PrintVerbose("Truncated log line longer than %d bytes (%d bytes removed)", server.Config.MaxLogLineLength, truncatedBytes)
+				}
+				if invalidUTF8 {
+					prefixedLogger.PrintVerbose("Replaced invalid UTF-8 in log line")
+				}
 				logLine.CollectedAt = time.Now()
 				logLine.UUID = uuid.NewV4()
 
@@ -343,6 +343,10 @@ var lockWait = analyzeGroup{
 		secrets: []state.LogSecretKind{0, 0},
 	},
 }
+
+// Relation (and database) a lock is waited on/acquired for, if the lock refers to one
+var lockRelation = regexp.MustCompile(`relation (\d+)(?: of database (\d+))?`)
+
 var deadlock = analyzeGroup{
 	classification: pganalyze_collector.LogLineInformation_LOCK_DEADLOCK_DETECTED,
 	primary: match{
@@ -1121,6 +1125,21 @@ var autoExplainTextPlanDetailsRegexp = regexp.MustCompile(`^Query Text: (.+)\s+(
 
 var parallelWorkerProcessTextRegexp = regexp.MustCompile(`^parallel worker for PID (\d+)`)
 
+// addLockRelationDetails - Adds the OIDs of the relation (and its database) a lock
+// message refers to, e.g. "relation 16384 of database 16385"
+func addLockRelationDetails(details map[string]interface{}, lockMessage string) {
+	parts := lockRelation.FindStringSubmatch(lockMessage)
+	if parts == nil {
+		return
+	}
+	relationOid, _ := strconv.ParseInt(parts[1], 10, 64)
+	details["relation_oid"] = relationOid
+	if parts[2] != "" {
+		databaseOid, _ := strconv.ParseInt(parts[2], 10, 64)
+		details["database_oid"] = databaseOid
+	}
+}
+
 func AnalyzeLogLines(logLinesIn []state.LogLine) (logLinesOut []state.LogLine, samples []state.PostgresQuerySample) {
 	// Split log lines by backend to ensure we have the right context
 	backendLogLines := make(map[int32][]state.LogLine)
@@ -1369,6 +1388,7 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 				"lock_type": parts[2],
 				"after_ms":  afterMs,
 			}
+			addLockRelationDetails(logLine.Details, parts[0])
 			contextLine = matchOtherContextLogLine(contextLine)
 			return logLine, statementLine, detailLine, contextLine, hintLine, samples
 		}
@@ -1394,6 +1414,7 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 			}
 			afterMs, _ := strconv.ParseFloat(parts[4], 64)
 			logLine.Details = map[string]interface{}{"lock_mode": parts[2], "lock_type": lockType, "after_ms": afterMs}
+			addLockRelationDetails(logLine.Details, parts[0])
 			if detailLine.Content != "" {
 				detailLine, parts = matchLogLine(detailLine, lockWait.detail)
 				if len(parts) == 3 {
@@ -1467,6 +1488,8 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 							// Reformat JSON so its the same as when using EXPLAIN (FORMAT JSON)
 							ExplainOutput: "[{\"Plan\":" + string(explainJSON) + "}]",
 						}
+						sample.ExplainSummary, err = state.ParseExplainSummary(sample.ExplainOutput)
+						sample.HasExplainSummary = err == nil
 						samples = append(samples, sample)
 					}
 				}
@@ -747,9 +747,11 @@ var tests = []testpair{
 			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
 			Query:          "ALTER TABLE x ADD COLUMN y text;",
 			Details: map[string]interface{}{
-				"after_ms":  2175.443,
-				"lock_mode": "AccessExclusiveLock",
-				"lock_type": "relation",
+				"after_ms":     2175.443,
+				"lock_mode":    "AccessExclusiveLock",
+				"lock_type":    "relation",
+				"relation_oid": int64(185044),
+				"database_oid": int64(16384),
 			},
 			UUID:               uuid.UUID{1},
 			ReviewedForSecrets: true,
@@ -768,9 +770,11 @@ var tests = []testpair{
 			Classification: pganalyze_collector.LogLineInformation_LOCK_ACQUIRED,
 			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
 			Details: map[string]interface{}{
-				"after_ms":  1129279.295,
-				"lock_mode": "ExclusiveLock",
-				"lock_type": "tuple",
+				"after_ms":     1129279.295,
+				"lock_mode":    "ExclusiveLock",
+				"lock_type":    "tuple",
+				"relation_oid": int64(16421),
+				"database_oid": int64(16385),
 			},
 			ReviewedForSecrets: true,
 		}},
@@ -785,9 +789,11 @@ var tests = []testpair{
 			Classification: pganalyze_collector.LogLineInformation_LOCK_ACQUIRED,
 			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
 			Details: map[string]interface{}{
-				"after_ms":  1003.994,
-				"lock_mode": "ExclusiveLock",
-				"lock_type": "extension",
+				"after_ms":     1003.994,
+				"lock_mode":    "ExclusiveLock",
+				"lock_type":    "extension",
+				"relation_oid": int64(419652),
+				"database_oid": int64(16400),
 			},
 			ReviewedForSecrets: true,
 		}},
@@ -888,6 +894,7 @@ var tests = []testpair{
 				"after_ms":     123.456,
 				"lock_mode":    "AccessExclusiveLock",
 				"lock_type":    "relation",
+				"relation_oid": int64(999),
 			},
 			RelatedPids:        []int32{583, 123, 2078},
 			UUID:               uuid.UUID{1},
@@ -907,9 +914,11 @@ var tests = []testpair{
 			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
 			Classification: pganalyze_collector.LogLineInformation_LOCK_DEADLOCK_DETECTED,
 			Details: map[string]interface{}{
-				"lock_mode": "AccessExclusiveLock",
-				"lock_type": "extend",
-				"after_ms":  456.0,
+				"lock_mode":    "AccessExclusiveLock",
+				"lock_type":    "extend",
+				"relation_oid": int64(666),
+				"database_oid": int64(123),
+				"after_ms":     456.0,
 			},
 			ReviewedForSecrets: true,
 		}},
@@ -3192,12 +3201,21 @@ var tests = []testpair{
 			}},
 		}},
 		[]state.PostgresQuerySample{{
-			Query:         "SELECT abalance FROM pgbench_accounts WHERE aid = 2262632;",
-			RuntimeMs:     2334.085,
-			HasExplain:    true,
-			ExplainSource: pganalyze_collector.QuerySample_AUTO_EXPLAIN_EXPLAIN_SOURCE,
-			ExplainFormat: pganalyze_collector.QuerySample_JSON_EXPLAIN_FORMAT,
-			ExplainOutput: "[{\"Plan\":{\"Actual Loops\":1,\"Actual Rows\":1,\"Alias\":\"pgbench_accounts\",\"I/O Read Time\":0,\"I/O Write Time\":0,\"Index Cond\":\"(pgbench_accounts.aid = 2262632)\",\"Index Name\":\"pgbench_accounts_pkey\",\"Local Dirtied Blocks\":0,\"Local Hit Blocks\":0,\"Local Read Blocks\":0,\"Local Written Blocks\":0,\"Node Type\":\"Index Scan\",\"Output\":[\"abalance\"],\"Parallel Aware\":false,\"Plan Rows\":1,\"Plan Width\":4,\"Relation Name\":\"pgbench_accounts\",\"Rows Removed by Index Recheck\":0,\"Scan Direction\":\"Forward\",\"Schema\":\"public\",\"Shared Dirtied Blocks\":0,\"Shared Hit Blocks\":4,\"Shared Read Blocks\":0,\"Shared Written Blocks\":0,\"Startup Cost\":0.43,\"Temp Read Blocks\":0,\"Temp Written Blocks\":0,\"Total Cost\":8.45}}]",
+			Query:             "SELECT abalance FROM pgbench_accounts WHERE aid = 2262632;",
+			RuntimeMs:         2334.085,
+			HasExplain:        true,
+			ExplainSource:     pganalyze_collector.QuerySample_AUTO_EXPLAIN_EXPLAIN_SOURCE,
+			ExplainFormat:     pganalyze_collector.QuerySample_JSON_EXPLAIN_FORMAT,
+			ExplainOutput:     "[{\"Plan\":{\"Actual Loops\":1,\"Actual Rows\":1,\"Alias\":\"pgbench_accounts\",\"I/O Read Time\":0,\"I/O Write Time\":0,\"Index Cond\":\"(pgbench_accounts.aid = 2262632)\",\"Index Name\":\"pgbench_accounts_pkey\",\"Local Dirtied Blocks\":0,\"Local Hit Blocks\":0,\"Local Read Blocks\":0,\"Local Written Blocks\":0,\"Node Type\":\"Index Scan\",\"Output\":[\"abalance\"],\"Parallel Aware\":false,\"Plan Rows\":1,\"Plan Width\":4,\"Relation Name\":\"pgbench_accounts\",\"Rows Removed by Index Recheck\":0,\"Scan Direction\":\"Forward\",\"Schema\":\"public\",\"Shared Dirtied Blocks\":0,\"Shared Hit Blocks\":4,\"Shared Read Blocks\":0,\"Shared Written Blocks\":0,\"Startup Cost\":0.43,\"Temp Read Blocks\":0,\"Temp Written Blocks\":0,\"Total Cost\":8.45}}]",
+			HasExplainSummary: true,
+			ExplainSummary: state.PostgresExplainSummary{
+				NodeType:      "Index Scan",
+				StartupCost:   0.43,
+				TotalCost:     8.45,
+				PlanRows:      1,
+				HasActualRows: true,
+				ActualRows:    1,
+			},
 		}},
 	},
 	{
@@ -0,0 +1,62 @@
+package logs
+
+import (
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+// ExtractLockEvents - Collects lock waits, as well as lock_timeout and
+// statement_timeout cancellations, from already analyzed log lines (see
+// AnalyzeLogLines)
+func ExtractLockEvents(logLines []state.LogLine) []state.PostgresLockEvent {
+	var events []state.PostgresLockEvent
+
+	for _, logLine := range logLines {
+		var event state.PostgresLockEvent
+		switch logLine.Classification {
+		case pganalyze_collector.LogLineInformation_LOCK_WAITING:
+			event.Kind = state.LockEventWaiting
+		case pganalyze_collector.LogLineInformation_LOCK_ACQUIRED:
+			event.Kind = state.LockEventAcquired
+		case pganalyze_collector.LogLineInformation_LOCK_TIMEOUT:
+			event.Kind = state.LockEventLockTimeout
+		case pganalyze_collector.LogLineInformation_STATEMENT_CANCELED_TIMEOUT:
+			event.Kind = state.LockEventStatementTimeout
+		default:
+			continue
+		}
+
+		event.OccurredAt = logLine.OccurredAt
+		event.LogLineUUID = logLine.UUID
+		event.Username = logLine.Username
+		event.Database = logLine.Database
+		event.BackendPid = logLine.BackendPid
+		event.Query = logLine.Query
+
+		if lockMode, ok := logLine.Details["lock_mode"].(string); ok {
+			event.LockMode = lockMode
+		}
+		if lockType, ok := logLine.Details["lock_type"].(string); ok {
+			event.LockType = lockType
+		}
+		if relationOid, ok := logLine.Details["relation_oid"].(int64); ok {
+			event.RelationOid = null.IntFrom(relationOid)
+		}
+		if databaseOid, ok := logLine.Details["database_oid"].(int64); ok {
+			event.DatabaseOid = null.IntFrom(databaseOid)
+		}
+		if afterMs, ok := logLine.Details["after_ms"].(float64); ok {
+			event.WaitedMs = null.FloatFrom(afterMs)
+		}
+		if lockHolders, ok := logLine.Details["lock_holders"].([]int64); ok {
+			for _, pid := range lockHolders {
+				event.BlockingPids = append(event.BlockingPids, int32(pid))
+			}
+		}
+
+		events = append(events, event)
+	}
+
+	return events
+}
@@ -0,0 +1,80 @@
+package logs_test
+
+import (
+	"testing"
+
+	"github.com/guregu/null"
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	uuid "github.com/satori/go.uuid"
+)
+
+type lockEventsTestpair struct {
+	logLinesIn []state.LogLine
+	eventsOut  []state.PostgresLockEvent
+}
+
+var lockEventsTests = []lockEventsTestpair{
+	{
+		[]state.LogLine{{
+			Content:  "process 2078 still waiting for ShareLock on tuple (0,1) of relation 16421 of database 16385 after 1000.100 ms",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content:  "Process holding the lock: 583. Wait queue: 2078.",
+			LogLevel: pganalyze_collector.LogLineInformation_DETAIL,
+		}, {
+			Content:  "UPDATE mytable SET y = 2 WHERE x = 1",
+			LogLevel: pganalyze_collector.LogLineInformation_STATEMENT,
+		}},
+		[]state.PostgresLockEvent{{
+			Kind:         state.LockEventWaiting,
+			LogLineUUID:  uuid.UUID{1},
+			Query:        "UPDATE mytable SET y = 2 WHERE x = 1",
+			LockMode:     "ShareLock",
+			LockType:     "tuple",
+			RelationOid:  null.IntFrom(16421),
+			DatabaseOid:  null.IntFrom(16385),
+			WaitedMs:     null.FloatFrom(1000.1),
+			BlockingPids: []int32{583},
+		}},
+	},
+	{
+		[]state.LogLine{{
+			Content:  "canceling statement due to statement timeout",
+			LogLevel: pganalyze_collector.LogLineInformation_ERROR,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content:  "SELECT pg_sleep(10)",
+			LogLevel: pganalyze_collector.LogLineInformation_STATEMENT,
+		}},
+		[]state.PostgresLockEvent{{
+			Kind:        state.LockEventStatementTimeout,
+			LogLineUUID: uuid.UUID{1},
+			Query:       "SELECT pg_sleep(10)",
+		}},
+	},
+	{
+		[]state.LogLine{{
+			Content:  "duration: 3205.800 ms  statement: SELECT 1",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		nil,
+	},
+}
+
+func TestExtractLockEvents(t *testing.T) {
+	for _, pair := range lockEventsTests {
+		logLines, _ := logs.AnalyzeLogLines(pair.logLinesIn)
+		events := logs.ExtractLockEvents(logLines)
+
+		cfg := pretty.CompareConfig
+		cfg.SkipZeroFields = true
+
+		if diff := cfg.Compare(pair.eventsOut, events); diff != "" {
+			t.Errorf("For %v: lock events diff: (-want +got)\n%s", pair.logLinesIn, diff)
+		}
+	}
+}
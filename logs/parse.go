@@ -286,8 +286,14 @@ func ParseLogLineWithPrefix(prefix string, line string) (logLine state.LogLine,
 	return
 }
 
-func ParseAndAnalyzeBuffer(buffer string, initialByteStart int64, linesNewerThan time.Time) ([]state.LogLine, []state.PostgresQuerySample, int64) {
+// ParseAndAnalyzeBuffer - Parses and analyzes all complete log lines in the buffer
+//
+// Log line content (including continuation lines) is truncated to maxLineLength bytes (0 = no limit).
+// Byte offsets always refer to the complete line in the buffer, since they are used to
+// redact the original log file contents.
+func ParseAndAnalyzeBuffer(buffer string, initialByteStart int64, linesNewerThan time.Time, maxLineLength int) ([]state.LogLine, []state.PostgresQuerySample, int64, SanitizeStats) {
 	var logLines []state.LogLine
+	var stats SanitizeStats
 	currentByteStart := initialByteStart
 	reader := bufio.NewReader(strings.NewReader(buffer))
 
@@ -310,8 +316,20 @@ func ParseAndAnalyzeBuffer(buffer string, initialByteStart int64, linesNewerThan
 			// Assume that a parsing error in a follow-on line means that we actually
 			// got additional data for the previous line
 			if len(logLines) > 0 && logLine.Content != "" {
-				logLines[len(logLines)-1].Content += logLine.Content
-				logLines[len(logLines)-1].ByteEnd += int64(len(logLine.Content))
+				prevLine := &logLines[len(logLines)-1]
+				prevLine.ByteEnd += int64(len(logLine.Content))
+				// Once truncated, any further content has to be left out too, to keep the
+				// content contiguous with the byte offsets (the length doesn't tell, since
+				// truncation may have stopped short of a multi-byte character)
+				if prevLine.ByteTruncatedStart > 0 {
+					continue
+				}
+				content, truncatedBytes, invalidUTF8 := SanitizeContent(prevLine.Content+logLine.Content, maxLineLength)
+				stats.add(truncatedBytes, invalidUTF8)
+				prevLine.Content = content
+				if truncatedBytes > 0 {
+					prevLine.ByteTruncatedStart = prevLine.ByteContentStart + int64(len(content))
+				}
 			}
 			continue
 		}
@@ -325,6 +343,14 @@ func ParseAndAnalyzeBuffer(buffer string, initialByteStart int64, linesNewerThan
 		logLine.ByteContentStart = byteStart + int64(len(line)-len(logLine.Content))
 		logLine.ByteEnd = byteStart + int64(len(line)) - 1
 
+		var truncatedBytes int
+		var invalidUTF8 bool
+		logLine.Content, truncatedBytes, invalidUTF8 = SanitizeContent(logLine.Content, maxLineLength)
+		stats.add(truncatedBytes, invalidUTF8)
+		if truncatedBytes > 0 {
+			logLine.ByteTruncatedStart = logLine.ByteContentStart + int64(len(logLine.Content))
+		}
+
 		// Generate unique ID that can be used to reference this line
 		logLine.UUID = uuid.NewV4()
 
@@ -332,5 +358,5 @@ func ParseAndAnalyzeBuffer(buffer string, initialByteStart int64, linesNewerThan
 	}
 
 	newLogLines, newSamples := AnalyzeLogLines(logLines)
-	return newLogLines, newSamples, currentByteStart
+	return newLogLines, newSamples, currentByteStart, stats
 }
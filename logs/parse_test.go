@@ -284,3 +284,85 @@ func TestParseLogLineWithPrefix(t *testing.T) {
 		}
 	}
 }
+
+type sanitizeTestpair struct {
+	contentIn      string
+	maxLength      int
+	contentOut     string
+	truncatedBytes int
+	invalidUTF8    bool
+}
+
+var sanitizeTests = []sanitizeTestpair{
+	{"duration: 1.234 ms", 0, "duration: 1.234 ms", 0, false},
+	{"duration: 1.234 ms", 8, "duration", 10, false},
+	{"SELECT '\xff\xfe'", 0, "SELECT '??'", 0, true},
+	{"SELECT 'ü'", 9, "SELECT '", 3, false},
+	{"SELECT '\xffü'", 10, "SELECT '?", 3, true},
+}
+
+func TestSanitizeContent(t *testing.T) {
+	for _, pair := range sanitizeTests {
+		content, truncatedBytes, invalidUTF8 := logs.SanitizeContent(pair.contentIn, pair.maxLength)
+
+		if content != pair.contentOut {
+			t.Errorf("For %q (max %d): expected content %q, but was %q\n", pair.contentIn, pair.maxLength, pair.contentOut, content)
+		}
+		if truncatedBytes != pair.truncatedBytes {
+			t.Errorf("For %q (max %d): expected %d truncated bytes, but was %d\n", pair.contentIn, pair.maxLength, pair.truncatedBytes, truncatedBytes)
+		}
+		if invalidUTF8 != pair.invalidUTF8 {
+			t.Errorf("For %q (max %d): expected invalid UTF-8 to be %v, but was %v\n", pair.contentIn, pair.maxLength, pair.invalidUTF8, invalidUTF8)
+		}
+	}
+}
+
+type parseBufferTruncationTestpair struct {
+	input              string
+	maxLineLength      int
+	byteTruncatedStart int64
+	truncatedLines     int
+}
+
+var parseBufferTruncationTests = []parseBufferTruncationTestpair{
+	{
+		"2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:ERROR:  could not process: abc\npassword = 'secret'\n",
+		0,
+		0,
+		0,
+	},
+	{
+		// Content is exactly maxLineLength, the continuation line is left out
+		"2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:ERROR:  could not process: abc\npassword = 'secret'\n",
+		23,
+		74,
+		1,
+	},
+	{
+		// Truncation stops short of a multi-byte character, continuation lines are left out
+		"2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:ERROR:  could not process: 'ü'\npassword = 'secret'\nx\n",
+		21,
+		71,
+		1,
+	},
+}
+
+func TestParseAndAnalyzeBufferTruncation(t *testing.T) {
+	for _, pair := range parseBufferTruncationTests {
+		logLines, _, _, stats := logs.ParseAndAnalyzeBuffer(pair.input, 0, time.Time{}, pair.maxLineLength)
+
+		if len(logLines) != 1 {
+			t.Errorf("For %q (max %d): expected 1 log line, but got %d\n", pair.input, pair.maxLineLength, len(logLines))
+			continue
+		}
+		if logLines[0].ByteTruncatedStart != pair.byteTruncatedStart {
+			t.Errorf("For %q (max %d): expected truncation at byte %d, but was %d\n", pair.input, pair.maxLineLength, pair.byteTruncatedStart, logLines[0].ByteTruncatedStart)
+		}
+		if logLines[0].ByteEnd != int64(len(pair.input)-1) {
+			t.Errorf("For %q (max %d): expected line to end at byte %d, but was %d\n", pair.input, pair.maxLineLength, len(pair.input)-1, logLines[0].ByteEnd)
+		}
+		if stats.TruncatedLines != pair.truncatedLines {
+			t.Errorf("For %q (max %d): expected %d truncated lines, but was %d\n", pair.input, pair.maxLineLength, pair.truncatedLines, stats.TruncatedLines)
+		}
+	}
+}
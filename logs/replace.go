@@ -25,6 +25,12 @@ func ReplaceSecrets(input []byte, logLines []state.LogLine, filterLogSecret []st
 	}
 
 	for _, logLine := range logLines {
+		// Content that was truncated before analysis was not reviewed for secrets
+		reviewedEnd := logLine.ByteEnd
+		if logLine.ByteTruncatedStart > 0 {
+			reviewedEnd = logLine.ByteTruncatedStart
+		}
+
 		goodRanges = append(goodRanges, logRange{start: logLine.ByteStart, end: logLine.ByteContentStart})
 		if logLine.ReviewedForSecrets {
 			sort.Slice(logLine.SecretMarkers, func(i, j int) bool {
@@ -43,8 +49,15 @@ func ReplaceSecrets(input []byte, logLines []state.LogLine, filterLogSecret []st
 					lastGood = int64(m.ByteEnd)
 				}
 			}
-			if lastGood < (logLine.ByteEnd - logLine.ByteContentStart) {
-				goodRanges = append(goodRanges, logRange{start: logLine.ByteContentStart + lastGood, end: logLine.ByteEnd})
+			// A truncated remainder is kept only if what it continues wasn't a secret
+			keepTruncated := !filterUnidentified
+			if lastGood < (reviewedEnd - logLine.ByteContentStart) {
+				goodRanges = append(goodRanges, logRange{start: logLine.ByteContentStart + lastGood, end: reviewedEnd})
+			} else {
+				keepTruncated = false
+			}
+			if reviewedEnd < logLine.ByteEnd && keepTruncated {
+				goodRanges = append(goodRanges, logRange{start: reviewedEnd, end: logLine.ByteEnd})
 			}
 		} else if !filterUnidentified {
 			goodRanges = append(goodRanges, logRange{start: logLine.ByteContentStart, end: logLine.ByteEnd})
@@ -11,6 +11,7 @@ import (
 
 type replaceTestpair struct {
 	filterLogSecret string
+	maxLineLength   int
 	input           string
 	output          string
 }
@@ -56,11 +57,43 @@ var replaceTests = []replaceTestpair{
 		input:           "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 4079.697 ms  execute <unnamed>: \nSELECT * FROM x WHERE y = $1 LIMIT $2\n2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:DETAIL:  parameters: $1 = 'long string', $2 = '1'\n",
 		output:          "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 4079.697 ms  execute <unnamed>: \nXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX\n2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:DETAIL:  parameters: $1 = 'XXXXXXXXXXX', $2 = 'X'\n",
 	},
+	{
+		filterLogSecret: "statement_text",
+		maxLineLength:   40,
+		input:           "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 1242.570 ms  statement: SELECT 1, 2, 3\n",
+		output:          "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 1242.570 ms  statement: XXXXXXXXXXXXXX\n",
+	},
+	{
+		filterLogSecret: "none",
+		maxLineLength:   40,
+		input:           "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 1242.570 ms  statement: SELECT 1, 2, 3\n",
+		output:          "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 1242.570 ms  statement: SELECT 1, 2, 3\n",
+	},
+	{
+		filterLogSecret: "unidentified",
+		maxLineLength:   20,
+		input:           "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:ERROR:  division by zero\nUnknown Data\n2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:ERROR:  division by zero\n",
+		output:          "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:ERROR:  division by zeroXXXXXXXXXXXXX\n2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:ERROR:  division by zero\n",
+	},
+	{
+		// Content is exactly maxLineLength, so the continuation line is truncated
+		filterLogSecret: "statement_text",
+		maxLineLength:   43,
+		input:           "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 1242.570 ms  statement: SELECT 1\nWHERE password = 'secret'\n",
+		output:          "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 1242.570 ms  statement: XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX\n",
+	},
+	{
+		// Truncation cuts before a multi-byte character, followed by a continuation line
+		filterLogSecret: "statement_text",
+		maxLineLength:   43,
+		input:           "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 1242.570 ms  statement: SELECT 'ü'\nWHERE password = 'secret'\n",
+		output:          "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  duration: 1242.570 ms  statement: XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX\n",
+	},
 }
 
 func TestReplaceSecrets(t *testing.T) {
 	for _, pair := range replaceTests {
-		logLines, _, _ := logs.ParseAndAnalyzeBuffer(string(pair.input), 0, time.Time{})
+		logLines, _, _, _ := logs.ParseAndAnalyzeBuffer(string(pair.input), 0, time.Time{}, pair.maxLineLength)
 		output := logs.ReplaceSecrets([]byte(pair.input), logLines, state.ParseFilterLogSecret(pair.filterLogSecret))
 
 		cfg := pretty.CompareConfig
@@ -0,0 +1,53 @@
+package logs
+
+import (
+	"unicode/utf8"
+)
+
+// SanitizeStats - Number of log lines that had to be modified before being processed
+type SanitizeStats struct {
+	TruncatedLines   int
+	InvalidUTF8Lines int
+}
+
+// SanitizeContent - Replaces invalid UTF-8 bytes with "?" (which keeps byte offsets intact), and
+// truncates the content to at most maxLength bytes (0 = no limit)
+//
+// Returns the number of bytes that were removed by truncation, and whether invalid UTF-8 was found.
+func SanitizeContent(content string, maxLength int) (string, int, bool) {
+	var invalidUTF8 bool
+
+	if !utf8.ValidString(content) {
+		invalidUTF8 = true
+		b := []byte(content)
+		for i := 0; i < len(b); {
+			r, size := utf8.DecodeRune(b[i:])
+			if r == utf8.RuneError && size == 1 {
+				b[i] = '?'
+			}
+			i += size
+		}
+		content = string(b)
+	}
+
+	if maxLength <= 0 || len(content) <= maxLength {
+		return content, 0, invalidUTF8
+	}
+
+	// Avoid cutting a multi-byte character in half
+	cut := maxLength
+	for cut > 0 && !utf8.RuneStart(content[cut]) {
+		cut--
+	}
+
+	return content[:cut], len(content) - cut, invalidUTF8
+}
+
+func (stats *SanitizeStats) add(truncatedBytes int, invalidUTF8 bool) {
+	if truncatedBytes > 0 {
+		stats.TruncatedLines++
+	}
+	if invalidUTF8 {
+		stats.InvalidUTF8Lines++
+	}
+}
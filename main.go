@@ -20,9 +20,12 @@ import (
 	flag "github.com/ogier/pflag"
 
 	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/control"
+	"github.com/pganalyze/collector/input/postgres"
 	"github.com/pganalyze/collector/input/system/heroku"
 	"github.com/pganalyze/collector/input/system/selfhosted"
 	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/output"
 	"github.com/pganalyze/collector/runner"
 	"github.com/pganalyze/collector/scheduler"
 	"github.com/pganalyze/collector/state"
@@ -31,7 +34,7 @@ import (
 	_ "github.com/lib/pq" // Enable database package to use Postgres
 )
 
-func run(wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, configFilename string) (keepRunning bool, reloadOkay bool, statsStop chan<- bool, reportsStop chan<- bool, logsTailStop chan<- bool, logsDownloadStop chan<- bool, activityStop chan<- bool, queriesStop chan<- bool) {
+func run(wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, configFilename string, reloadRequests chan<- os.Signal) (keepRunning bool, reloadOkay bool, statsStop chan<- bool, reportsStop chan<- bool, logsTailStop chan<- bool, logsDownloadStop chan<- bool, activityStop chan<- bool, queriesStop chan<- bool, sshTunnelsStop chan<- bool, discoveryStop chan<- bool, controlStop chan<- bool) {
 	var servers []state.Server
 
 	keepRunning = false
@@ -43,13 +46,31 @@ func run(wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *
 		return
 	}
 
-	conf, err := config.Read(logger, configFilename)
+	if globalCollectionOpts.CollectInterval != 0 {
+		logger.PrintWarning("Collecting full snapshots every %s due to --collect-interval - this is intended for testing and debugging only", globalCollectionOpts.CollectInterval)
+		schedulerGroups["stats"] = scheduler.FixedIntervalGroup(globalCollectionOpts.CollectInterval)
+	}
+
+	var conf config.Config
+	if globalCollectionOpts.CommandLineServer != nil {
+		conf, err = config.ReadFromCommandLine(logger, *globalCollectionOpts.CommandLineServer)
+	} else {
+		conf, err = config.Read(logger, configFilename)
+	}
+	if err == nil {
+		conf.Servers, err = config.FilterServers(conf.Servers, globalCollectionOpts.OnlySections, globalCollectionOpts.ExcludeSections)
+	}
 	if err != nil {
 		logger.PrintError("Config Error: %s", err)
 		keepRunning = !globalCollectionOpts.TestRun
 		return
 	}
 
+	output.SetUploadRateLimit(conf.UploadMaxBytesPerSec)
+	util.SetShutdownGracePeriod(time.Duration(conf.ShutdownGracePeriod) * time.Second)
+	globalCollectionOpts.ActivityHistory.SetLimits(time.Duration(conf.ActivityHistoryMinutes)*time.Minute, conf.ActivityHistoryMaxBytes)
+	globalCollectionOpts.LastSnapshots.SetEnabled(conf.ControlServer.ListenAddress != "")
+
 	// Avoid even running the scheduler when we already know its not needed
 	hasAnyLogsEnabled := false
 	hasAnyReportsEnabled := false
@@ -57,7 +78,7 @@ func run(wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *
 
 	serverConfigs := conf.Servers
 	for _, config := range serverConfigs {
-		servers = append(servers, state.Server{Config: config, StateMutex: &sync.Mutex{}})
+		servers = append(servers, state.Server{Config: config, StateMutex: &sync.Mutex{}, PrevState: state.PersistedState{ExplainSampling: &state.ExplainSampling{}}})
 		if config.EnableReports {
 			hasAnyReportsEnabled = true
 		}
@@ -69,6 +90,8 @@ func run(wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *
 		}
 	}
 
+	sshTunnelsStop = postgres.SetupSSHTunnels(servers, logger)
+
 	runner.ReadStateFile(servers, globalCollectionOpts, logger)
 
 	// We intentionally don't do a test-run in the normal mode, since we're fine with
@@ -147,6 +170,21 @@ func run(wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *
 		}
 	}
 
+	if globalCollectionOpts.CommandLineServer != nil {
+		reloadOkay = runner.CollectAllServers(servers, globalCollectionOpts, logger)
+		return
+	}
+
+	if globalCollectionOpts.BackfillSnapshotPath != "" {
+		reloadOkay = runner.BackfillFullSnapshots(servers, globalCollectionOpts, logger, globalCollectionOpts.BackfillSnapshotPath)
+		return
+	}
+
+	if globalCollectionOpts.ReplaySnapshotPath != "" {
+		reloadOkay = runner.BackfillFullSnapshots(servers, globalCollectionOpts, logger, globalCollectionOpts.ReplaySnapshotPath)
+		return
+	}
+
 	if globalCollectionOpts.DebugLogs {
 		selfhosted.SetupLogTails(servers, globalCollectionOpts, logger)
 
@@ -160,11 +198,7 @@ func run(wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *
 		return
 	}
 
-	statsStop = schedulerGroups["stats"].Schedule(func() {
-		wg.Add(1)
-		runner.CollectAllServers(servers, globalCollectionOpts, logger)
-		wg.Done()
-	}, logger, "full snapshot of all servers")
+	statsStop = scheduleFullSnapshots(wg, servers, schedulerGroups["stats"], globalCollectionOpts, logger)
 
 	if hasAnyReportsEnabled {
 		reportsStop = schedulerGroups["reports"].Schedule(func() {
@@ -220,6 +254,17 @@ func run(wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *
 		wg.Done()
 	}, logger, "high frequency query statistics of all servers", schedulerGroups["stats"])
 
+	controlStop = control.Start(conf.ControlServer, globalCollectionOpts.ActivityHistory, globalCollectionOpts.LastSnapshots, logger)
+
+	if reloadRequests != nil {
+		discoveryStop = config.WatchDiscovery(conf, logger, func() {
+			select {
+			case reloadRequests <- syscall.SIGHUP:
+			default: // A reload is already pending
+			}
+		})
+	}
+
 	keepRunning = true
 	return
 }
@@ -227,6 +272,58 @@ func run(wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *
 const defaultConfigFile = "/etc/pganalyze-collector.conf"
 const defaultStateFile = "/var/lib/pganalyze-collector/state"
 
+// scheduleFullSnapshots - Schedules full snapshots with an independent runner for
+// each distinct full_snapshot_schedule, so that slow servers only delay servers on
+// the same schedule
+func scheduleFullSnapshots(wg *sync.WaitGroup, servers []state.Server, defaultGroup scheduler.Group, globalCollectionOpts state.CollectionOpts, logger *util.Logger) chan<- bool {
+	var schedules []string
+	indicesBySchedule := make(map[string][]int)
+	for idx, server := range servers {
+		schedule := server.Config.FullSnapshotSchedule
+		if globalCollectionOpts.CollectInterval != 0 {
+			schedule = "" // --collect-interval overrides any configured schedule
+		}
+		if _, exists := indicesBySchedule[schedule]; !exists {
+			schedules = append(schedules, schedule)
+		}
+		indicesBySchedule[schedule] = append(indicesBySchedule[schedule], idx)
+	}
+
+	if len(schedules) == 0 || (len(schedules) == 1 && schedules[0] == "") {
+		return defaultGroup.Schedule(func() {
+			wg.Add(1)
+			runner.CollectAllServers(servers, globalCollectionOpts, logger)
+			wg.Done()
+		}, logger, "full snapshot of all servers")
+	}
+
+	var stops []chan bool
+	for _, schedule := range schedules {
+		group := defaultGroup
+		logName := "full snapshot of servers on default schedule"
+		if schedule != "" {
+			// Already validated when reading the config
+			group, _ = scheduler.ParseGroup(schedule)
+			logName = fmt.Sprintf("full snapshot of servers on schedule \"%s\"", schedule)
+		}
+		indices := indicesBySchedule[schedule]
+		stops = append(stops, group.Schedule(func() {
+			wg.Add(1)
+			runner.CollectServers(servers, indices, globalCollectionOpts, logger)
+			wg.Done()
+		}, logger, logName))
+	}
+
+	stopAll := make(chan bool)
+	go func() {
+		<-stopAll
+		for _, stop := range stops {
+			stop <- true
+		}
+	}()
+	return stopAll
+}
+
 func main() {
 	var showVersion bool
 	var dryRun bool
@@ -239,10 +336,16 @@ func main() {
 	var testRun bool
 	var testReport string
 	var testRunLogs bool
+	var backfillSnapshotPath string
+	var collectInterval time.Duration
+	var recordSnapshotsDir string
+	var commandLineServer config.ServerConfig
+	var replaySnapshotPath string
 	var forceStateUpdate bool
 	var configFilename string
 	var stateFilename string
 	var pidFilename string
+	var noPidfileCheck bool
 	var noPostgresSettings, noPostgresLocks, noPostgresFunctions, noPostgresBloat, noPostgresViews bool
 	var noPostgresRelations, noLogs, noExplain, noSystemInformation bool
 	var writeHeapProfile bool
@@ -250,6 +353,7 @@ func main() {
 	var logToSyslog bool
 	var logNoTimestamps bool
 	var reloadRun bool
+	var onlySections, excludeSections string
 
 	logFlags := log.LstdFlags
 	logger := &util.Logger{}
@@ -258,6 +362,19 @@ func main() {
 	flag.BoolVarP(&testRun, "test", "t", false, "Tests whether we can successfully collect statistics (including log data if configured), submits it to the server, and exits afterwards")
 	flag.StringVar(&testReport, "test-report", "", "Tests a particular report and returns its output as JSON")
 	flag.BoolVar(&testRunLogs, "test-logs", false, "Tests whether log collection works (does not test privilege dropping for local log collection, use --test for that)")
+	flag.StringVar(&backfillSnapshotPath, "backfill-snapshot", "", "Submits a previously written snapshot file (or all files in the given directory) with its original collection time, and exits")
+	flag.StringVar(&recordSnapshotsDir, "record-snapshots", "", "Saves every collected full snapshot as JSON to the given directory (for testing/debugging only)")
+	flag.StringVar(&replaySnapshotPath, "replay", "", "Submits a snapshot saved with --record-snapshots (or all files in the given directory) as-is, keeping its original collection time, and exits")
+	flag.StringVar(&onlySections, "only", "", "Only collects from the given config sections (comma-separated), e.g. for a --test run against some of the configured databases")
+	flag.StringVar(&excludeSections, "exclude", "", "Doesn't collect from the given config sections (comma-separated)")
+	flag.StringVar(&commandLineServer.DbURL, "db-url", "", "Collects once from the database with the given URL (instead of using the config file), and exits")
+	flag.StringVar(&commandLineServer.DbHost, "host", "", "Collects once from the database on the given host (instead of using the config file), and exits")
+	flag.IntVar(&commandLineServer.DbPort, "port", 0, "Database port to use together with --host (default 5432)")
+	flag.StringVar(&commandLineServer.DbName, "dbname", "", "Database name to use together with --host or --db-url")
+	flag.StringVar(&commandLineServer.DbUsername, "user", "", "Database user to use together with --host or --db-url")
+	flag.StringVar(&commandLineServer.DbPassword, "password", "", "Database password to use together with --host or --db-url")
+	flag.StringVar(&commandLineServer.APIKey, "api-key", "", "pganalyze API key to submit the data collected with --host or --db-url (not needed with --dry-run)")
+	flag.DurationVar(&collectInterval, "collect-interval", 0, "Overrides the full snapshot schedule with a fixed interval, e.g. 10s (for testing/debugging only)")
 	flag.BoolVar(&reloadRun, "reload", false, "Reloads the collector daemon thats running on the host")
 	flag.BoolVarP(&logger.Verbose, "verbose", "v", false, "Outputs additional debugging information, use this if you're encoutering errors or other problems")
 	flag.BoolVar(&logToSyslog, "syslog", false, "Write all log output to syslog instead of stderr (disabled by default)")
@@ -281,9 +398,10 @@ func main() {
 	flag.BoolVar(&noSystemInformation, "no-system-information", false, "Don't collect OS level performance data")
 	flag.BoolVar(&writeHeapProfile, "write-heap-profile", false, "Write a Go memory heap profile to ~/pganalyze_collector.mprof when SIGHUP is received (disabled by default, only useful for debugging)")
 	flag.BoolVar(&testRunAndTrace, "trace", false, "Write a Go trace file to ~/pganalyze_collector.trace for a single test run (only useful for debugging)")
-	flag.StringVar(&configFilename, "config", defaultConfigFile, "Specify alternative path for config file")
+	flag.StringVar(&configFilename, "config", defaultConfigFile, "Specify alternative path for config file (or a directory, to read all *.conf files in it)")
 	flag.StringVar(&stateFilename, "statefile", defaultStateFile, "Specify alternative path for state file")
 	flag.StringVar(&pidFilename, "pidfile", "", "Specifies a path that a pidfile should be written to (default is no pidfile being written)")
+	flag.BoolVar(&noPidfileCheck, "no-pidfile-check", false, "Overwrites an existing pidfile even if it points to a running collector process (for environments that recycle PIDs)")
 	flag.Parse()
 
 	if showVersion {
@@ -327,6 +445,10 @@ func main() {
 		TestRunLogs:              testRunLogs || dryRunLogs,
 		DebugLogs:                debugLogs,
 		DiscoverLogLocation:      discoverLogLocation,
+		BackfillSnapshotPath:     backfillSnapshotPath,
+		CollectInterval:          collectInterval,
+		RecordSnapshotsDir:       recordSnapshotsDir,
+		ReplaySnapshotPath:       replaySnapshotPath,
 		CollectPostgresRelations: !noPostgresRelations,
 		CollectPostgresSettings:  !noPostgresSettings,
 		CollectPostgresLocks:     !noPostgresLocks,
@@ -337,8 +459,21 @@ func main() {
 		CollectExplain:           !noExplain,
 		CollectSystemInformation: !noSystemInformation,
 		StateFilename:            stateFilename,
-		WriteStateUpdate:         (!dryRun && !dryRunLogs && !testRun) || forceStateUpdate,
+		WriteStateUpdate:         (!dryRun && !dryRunLogs && !testRun && backfillSnapshotPath == "" && replaySnapshotPath == "") || forceStateUpdate,
 		ForceEmptyGrant:          dryRun || dryRunLogs,
+		ActivityHistory:          &state.ActivityHistory{},
+		LastSnapshots:            &state.LastSnapshots{},
+		OnlySections:             config.SplitSectionNames(onlySections),
+		ExcludeSections:          config.SplitSectionNames(excludeSections),
+	}
+
+	if commandLineServer.DbURL != "" || commandLineServer.DbHost != "" {
+		if commandLineServer.APIKey == "" && !dryRun {
+			logger.PrintError("Error: --api-key is required when collecting from --host or --db-url (unless using --dry-run)")
+			return
+		}
+		globalCollectionOpts.CommandLineServer = &commandLineServer
+		globalCollectionOpts.WriteStateUpdate = false
 	}
 
 	if reloadRun && !testRun {
@@ -365,7 +500,7 @@ func main() {
 			fmt.Printf("ERROR: %s\n", err)
 			return
 		}
-		logLines, samples, _ := logs.ParseAndAnalyzeBuffer(string(content), 0, time.Time{})
+		logLines, samples, _, _ := logs.ParseAndAnalyzeBuffer(string(content), 0, time.Time{}, 0)
 		logs.PrintDebugInfo(string(content), logLines, samples)
 		return
 	}
@@ -376,7 +511,7 @@ func main() {
 			fmt.Printf("ERROR: %s\n", err)
 			return
 		}
-		logLines, _, _ := logs.ParseAndAnalyzeBuffer(string(content), 0, time.Time{})
+		logLines, _, _, _ := logs.ParseAndAnalyzeBuffer(string(content), 0, time.Time{}, 0)
 		output := logs.ReplaceSecrets(content, logLines, state.ParseFilterLogSecret(filterLogSecret))
 		fmt.Printf("%s", output)
 		return
@@ -393,19 +528,19 @@ func main() {
 			panic(err)
 		}
 		trace.Start(f)
-		run(&sync.WaitGroup{}, globalCollectionOpts, logger, configFilename)
+		run(&sync.WaitGroup{}, globalCollectionOpts, logger, configFilename, nil)
 		trace.Stop()
 		f.Close()
 		return
 	}
 
 	if pidFilename != "" {
-		pid := os.Getpid()
-		err := ioutil.WriteFile(pidFilename, []byte(strconv.Itoa(pid)), 0644)
+		err := util.WritePidfile(pidFilename, noPidfileCheck)
 		if err != nil {
-			logger.PrintError("Could not write pidfile to \"%s\" as requested, exiting.", pidFilename)
+			logger.PrintError("Could not write pidfile to \"%s\" as requested, exiting: %s", pidFilename, err)
 			return
 		}
+		defer util.RemovePidfile(pidFilename)
 	}
 
 	sigs := make(chan os.Signal, 1)
@@ -414,7 +549,7 @@ func main() {
 	wg := sync.WaitGroup{}
 
 ReadConfigAndRun:
-	keepRunning, reloadOkay, statsStop, reportsStop, logsTailStop, logsDownloadStop, activityStop, queriesStop := run(&wg, globalCollectionOpts, logger, configFilename)
+	keepRunning, reloadOkay, statsStop, reportsStop, logsTailStop, logsDownloadStop, activityStop, queriesStop, sshTunnelsStop, discoveryStop, controlStop := run(&wg, globalCollectionOpts, logger, configFilename, sigs)
 	if !keepRunning {
 		if reloadRun {
 			if reloadOkay {
@@ -449,6 +584,12 @@ ReadConfigAndRun:
 	if queriesStop != nil {
 		queriesStop <- true
 	}
+	if discoveryStop != nil {
+		discoveryStop <- true
+	}
+	if controlStop != nil {
+		controlStop <- true
+	}
 
 	if s == syscall.SIGHUP {
 		if writeHeapProfile {
@@ -465,11 +606,18 @@ ReadConfigAndRun:
 		}
 		logger.PrintInfo("Reloading configuration...")
 		wg.Wait()
+		if sshTunnelsStop != nil {
+			sshTunnelsStop <- true
+		}
 		goto ReadConfigAndRun
 	}
 
 	signal.Stop(sigs)
 
 	logger.PrintInfo("Exiting...")
+	util.BeginShutdown(logger)
 	wg.Wait()
+	if sshTunnelsStop != nil {
+		sshTunnelsStop <- true
+	}
 }
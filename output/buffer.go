@@ -0,0 +1,193 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Buffered snapshots are named "<collected_at in unix nanoseconds>_<snapshot UUID>", so that
+// sorting by name returns them in the order they were collected
+var bufferedSnapshotRegexp = regexp.MustCompile(`^(\d{20})_([0-9a-f-]{36})$`)
+
+type bufferedSnapshot struct {
+	filename     string
+	size         int64
+	collectedAt  time.Time
+	snapshotUUID string
+}
+
+func snapshotBufferDir(server state.Server) string {
+	// Each server has its own directory, since snapshots must be submitted with the server's API key
+	return filepath.Join(server.Config.SnapshotBufferDir, server.Config.SectionName)
+}
+
+func readSnapshotBuffer(dir string) ([]bufferedSnapshot, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var snapshots []bufferedSnapshot
+	for _, f := range files {
+		parts := bufferedSnapshotRegexp.FindStringSubmatch(f.Name())
+		if !f.Mode().IsRegular() || parts == nil {
+			continue
+		}
+		collectedAtNanos, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		snapshots = append(snapshots, bufferedSnapshot{
+			filename:     filepath.Join(dir, f.Name()),
+			size:         f.Size(),
+			collectedAt:  time.Unix(0, collectedAtNanos),
+			snapshotUUID: parts[2],
+		})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].filename < snapshots[j].filename
+	})
+
+	return snapshots, nil
+}
+
+// ErrSnapshotBuffered - Returned when a full snapshot was not submitted, but kept in the snapshot
+// buffer instead (it will be submitted later, so its statistics must not be sent again)
+var ErrSnapshotBuffered = errors.New("snapshot stored in buffer for later submission")
+
+// snapshotBufferEnabled - Whether full snapshots that can't be submitted right now should be buffered
+func snapshotBufferEnabled(server state.Server, collectionOpts state.CollectionOpts) bool {
+	return server.Config.SnapshotBufferDir != "" && collectionOpts.SubmitCollectedData && !collectionOpts.TestRun
+}
+
+// bufferSnapshot - Keeps a snapshot that could not be submitted on disk, so it can be re-submitted later
+//
+// Returns ErrSnapshotBuffered if the snapshot was stored successfully.
+func bufferSnapshot(server state.Server, logger *util.Logger, compressedData bytes.Buffer, snapshotUUID string, collectedAt time.Time, reason error) error {
+	dir := snapshotBufferDir(server)
+
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return fmt.Errorf("Could not create snapshot buffer directory: %s (snapshot not submitted: %s)", err, reason)
+	}
+
+	filename := filepath.Join(dir, fmt.Sprintf("%020d_%s", collectedAt.UnixNano(), snapshotUUID))
+	err = ioutil.WriteFile(filename, compressedData.Bytes(), 0600)
+	if err != nil {
+		return fmt.Errorf("Could not write snapshot to buffer: %s (snapshot not submitted: %s)", err, reason)
+	}
+
+	snapshots, err := pruneSnapshotBuffer(server, logger, time.Now())
+	if err != nil {
+		logger.PrintError("Could not clean up snapshot buffer: %s", err)
+	}
+
+	logger.PrintWarning("Stored snapshot in buffer for later submission (%d snapshot(s) buffered): %s", len(snapshots), reason)
+	return ErrSnapshotBuffered
+}
+
+// pruneSnapshotBuffer - Drops snapshots older than snapshot_buffer_max_age_hours, as well as the
+// oldest ones once the configured count or size limits are exceeded, and returns the remaining ones
+func pruneSnapshotBuffer(server state.Server, logger *util.Logger, now time.Time) ([]bufferedSnapshot, error) {
+	snapshots, err := readSnapshotBuffer(snapshotBufferDir(server))
+	if err != nil {
+		return nil, err
+	}
+
+	var totalBytes int64
+	for _, s := range snapshots {
+		totalBytes += s.size
+	}
+
+	maxBytes := int64(server.Config.SnapshotBufferMaxSizeMB) * 1024 * 1024
+	maxAge := time.Duration(server.Config.SnapshotBufferMaxAgeHours) * time.Hour
+	dropped := 0
+	expired := 0
+	for len(snapshots) > 0 {
+		isExpired := maxAge > 0 && now.Sub(snapshots[0].collectedAt) > maxAge
+		if !isExpired && len(snapshots) <= server.Config.SnapshotBufferMaxCount && totalBytes <= maxBytes {
+			break
+		}
+		err = os.Remove(snapshots[0].filename)
+		if err != nil {
+			return snapshots, err
+		}
+		totalBytes -= snapshots[0].size
+		snapshots = snapshots[1:]
+		if isExpired {
+			expired++
+		} else {
+			dropped++
+		}
+	}
+
+	if expired > 0 {
+		logger.PrintWarning("Dropped %d buffered snapshot(s) older than %d hours", expired, server.Config.SnapshotBufferMaxAgeHours)
+	}
+	if dropped > 0 {
+		logger.PrintWarning("Snapshot buffer is full, dropped %d oldest snapshot(s)", dropped)
+	}
+
+	return snapshots, nil
+}
+
+// flushSnapshotBuffer - Re-submits full snapshots that previously failed to submit, in the order they
+// were collected, and returns whether all of them were submitted
+//
+// Newer snapshots should only be submitted once this succeeded, so the server receives them in order.
+func flushSnapshotBuffer(server state.Server, collectionOpts state.CollectionOpts, logger *util.Logger) bool {
+	snapshots, err := pruneSnapshotBuffer(server, logger, time.Now())
+	if err != nil {
+		logger.PrintError("Could not read snapshot buffer: %s", err)
+		return false
+	}
+
+	submitted := 0
+	defer func() {
+		if submitted > 0 {
+			logger.PrintInfo("Submitted %d buffered snapshot(s)", submitted)
+		}
+	}()
+
+	for _, s := range snapshots {
+		data, err := ioutil.ReadFile(s.filename)
+		if err != nil {
+			logger.PrintError("Could not read buffered snapshot: %s", err)
+			return false
+		}
+
+		s3Location, err := uploadSnapshot(server.Config.HTTPClient, server.Grant, logger, *bytes.NewBuffer(data), s.snapshotUUID)
+		if err == nil {
+			err = submitSnapshot(server, collectionOpts, logger, s3Location, s.collectedAt, true)
+		}
+		if err != nil {
+			// Keep this and all later snapshots, so we retry them in order on the next run
+			logger.PrintError("Could not submit buffered snapshot collected at %s: %s", s.collectedAt.Format(time.RFC3339), err)
+			return false
+		}
+
+		err = os.Remove(s.filename)
+		if err != nil {
+			logger.PrintError("Could not remove buffered snapshot: %s", err)
+			return false
+		}
+		submitted++
+	}
+
+	return true
+}
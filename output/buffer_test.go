@@ -0,0 +1,257 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+var bufferTestUUIDs = []string{
+	"00000000-0000-0000-0000-000000000001",
+	"00000000-0000-0000-0000-000000000002",
+	"00000000-0000-0000-0000-000000000003",
+}
+
+func bufferTestServer(t *testing.T, apiBaseURL string) (state.Server, func()) {
+	dir, err := ioutil.TempDir("", "snapshot_buffer_test")
+	if err != nil {
+		t.Fatalf("Could not create temporary directory: %s", err)
+	}
+
+	server := state.Server{
+		Config: config.ServerConfig{
+			SectionName:               "test",
+			APIBaseURL:                apiBaseURL,
+			HTTPClient:                &http.Client{Timeout: 5 * time.Second},
+			SnapshotBufferDir:         filepath.Join(dir, "buffer"),
+			SnapshotBufferMaxCount:    10,
+			SnapshotBufferMaxSizeMB:   10,
+			SnapshotBufferMaxAgeHours: 24,
+		},
+		Grant: state.Grant{Valid: true, LocalDir: filepath.Join(dir, "uploads") + "/"},
+	}
+
+	return server, func() { os.RemoveAll(dir) }
+}
+
+func bufferTestLogger() *util.Logger {
+	return &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+}
+
+func bufferedCollectedAts(t *testing.T, server state.Server) []time.Time {
+	snapshots, err := readSnapshotBuffer(snapshotBufferDir(server))
+	if err != nil {
+		t.Fatalf("Could not read snapshot buffer: %s", err)
+	}
+	var collectedAts []time.Time
+	for _, s := range snapshots {
+		collectedAts = append(collectedAts, s.collectedAt)
+	}
+	return collectedAts
+}
+
+func TestBufferSnapshot(t *testing.T) {
+	server, cleanup := bufferTestServer(t, "")
+	defer cleanup()
+
+	collectedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	err := bufferSnapshot(server, bufferTestLogger(), *bytes.NewBufferString("data"), bufferTestUUIDs[0], collectedAt, nil)
+	if err != ErrSnapshotBuffered {
+		t.Fatalf("Expected ErrSnapshotBuffered, got: %v", err)
+	}
+
+	snapshots, err := readSnapshotBuffer(snapshotBufferDir(server))
+	if err != nil {
+		t.Fatalf("Could not read snapshot buffer: %s", err)
+	}
+	if len(snapshots) != 1 {
+		t.Fatalf("Expected 1 buffered snapshot, got %d", len(snapshots))
+	}
+	if !snapshots[0].collectedAt.Equal(collectedAt) || snapshots[0].snapshotUUID != bufferTestUUIDs[0] || snapshots[0].size != 4 {
+		t.Errorf("Unexpected buffered snapshot: %+v", snapshots[0])
+	}
+}
+
+func TestPruneSnapshotBuffer(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	tests := []struct {
+		name         string
+		maxCount     int
+		maxAgeHours  int
+		collectedAts []time.Time
+		expected     []time.Time
+	}{
+		{
+			name:         "within limits",
+			maxCount:     3,
+			maxAgeHours:  24,
+			collectedAts: []time.Time{now.Add(-2 * time.Hour), now.Add(-time.Hour)},
+			expected:     []time.Time{now.Add(-2 * time.Hour), now.Add(-time.Hour)},
+		},
+		{
+			name:         "max count drops oldest",
+			maxCount:     2,
+			maxAgeHours:  24,
+			collectedAts: []time.Time{now.Add(-3 * time.Hour), now.Add(-2 * time.Hour), now.Add(-time.Hour)},
+			expected:     []time.Time{now.Add(-2 * time.Hour), now.Add(-time.Hour)},
+		},
+		{
+			name:         "expired",
+			maxCount:     3,
+			maxAgeHours:  24,
+			collectedAts: []time.Time{now.Add(-25 * time.Hour), now.Add(-time.Hour)},
+			expected:     []time.Time{now.Add(-time.Hour)},
+		},
+		{
+			name:         "no max age",
+			maxCount:     3,
+			maxAgeHours:  0,
+			collectedAts: []time.Time{now.Add(-25 * time.Hour), now.Add(-time.Hour)},
+			expected:     []time.Time{now.Add(-25 * time.Hour), now.Add(-time.Hour)},
+		},
+	}
+
+	for _, test := range tests {
+		server, cleanup := bufferTestServer(t, "")
+		server.Config.SnapshotBufferMaxCount = test.maxCount
+		server.Config.SnapshotBufferMaxAgeHours = test.maxAgeHours
+
+		os.MkdirAll(snapshotBufferDir(server), 0700)
+		for idx, collectedAt := range test.collectedAts {
+			filename := fmt.Sprintf("%020d_%s", collectedAt.UnixNano(), bufferTestUUIDs[idx])
+			ioutil.WriteFile(filepath.Join(snapshotBufferDir(server), filename), []byte("data"), 0600)
+		}
+
+		_, err := pruneSnapshotBuffer(server, bufferTestLogger(), now)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+		}
+		actual := bufferedCollectedAts(t, server)
+		if len(actual) != len(test.expected) {
+			t.Errorf("%s: expected %d buffered snapshots, got %d", test.name, len(test.expected), len(actual))
+		} else {
+			for idx := range actual {
+				if !actual[idx].Equal(test.expected[idx]) {
+					t.Errorf("%s: expected snapshot %d collected at %s, got %s", test.name, idx, test.expected[idx], actual[idx])
+				}
+			}
+		}
+
+		cleanup()
+	}
+}
+
+func TestFlushSnapshotBuffer(t *testing.T) {
+	var submittedAts []string
+	failSubmit := true
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failSubmit {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		r.ParseForm()
+		submittedAts = append(submittedAts, r.Form.Get("collected_at"))
+	}))
+	defer api.Close()
+
+	server, cleanup := bufferTestServer(t, api.URL)
+	defer cleanup()
+	logger := bufferTestLogger()
+	collectionOpts := state.CollectionOpts{SubmitCollectedData: true}
+
+	now := time.Now().Truncate(time.Second)
+	first := now.Add(-2 * time.Hour)
+	second := now.Add(-time.Hour)
+	bufferSnapshot(server, logger, *bytes.NewBufferString("first"), bufferTestUUIDs[1], first, nil)
+	bufferSnapshot(server, logger, *bytes.NewBufferString("second"), bufferTestUUIDs[0], second, nil)
+
+	if flushSnapshotBuffer(server, collectionOpts, logger) {
+		t.Errorf("Expected flush to fail while the API is unavailable")
+	}
+	if len(bufferedCollectedAts(t, server)) != 2 {
+		t.Errorf("Expected snapshots to remain buffered after a failed flush")
+	}
+
+	failSubmit = false
+	if !flushSnapshotBuffer(server, collectionOpts, logger) {
+		t.Errorf("Expected flush to succeed")
+	}
+	if len(bufferedCollectedAts(t, server)) != 0 {
+		t.Errorf("Expected snapshot buffer to be empty after flushing")
+	}
+	expected := []string{strconv.FormatInt(first.Unix(), 10), strconv.FormatInt(second.Unix(), 10)}
+	if len(submittedAts) != 2 || submittedAts[0] != expected[0] || submittedAts[1] != expected[1] {
+		t.Errorf("Expected snapshots to be submitted oldest first (%v), got %v", expected, submittedAts)
+	}
+}
+
+func TestSubmitFullBuffering(t *testing.T) {
+	var submittedAts []string
+	failSubmit := false
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failSubmit {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		r.ParseForm()
+		submittedAts = append(submittedAts, r.Form.Get("collected_at"))
+	}))
+	defer api.Close()
+
+	server, cleanup := bufferTestServer(t, api.URL)
+	defer cleanup()
+	logger := bufferTestLogger()
+	collectionOpts := state.CollectionOpts{SubmitCollectedData: true}
+
+	now := time.Now().Truncate(time.Second)
+	collectedAts := []time.Time{now.Add(-3 * time.Minute), now.Add(-2 * time.Minute), now.Add(-time.Minute)}
+
+	// No grant (e.g. the grant request failed because the API is down)
+	invalidGrantServer := server
+	invalidGrantServer.Grant = state.Grant{}
+	err := submitFull(snapshot.FullSnapshot{}, invalidGrantServer, collectionOpts, logger, collectedAts[0], true, true, nil)
+	if err != ErrSnapshotBuffered {
+		t.Errorf("Expected snapshot without grant to be buffered, got: %v", err)
+	}
+
+	// Submission fails
+	failSubmit = true
+	err = submitFull(snapshot.FullSnapshot{}, server, collectionOpts, logger, collectedAts[1], true, true, nil)
+	if err != ErrSnapshotBuffered {
+		t.Errorf("Expected snapshot that failed to submit to be buffered, got: %v", err)
+	}
+	if len(bufferedCollectedAts(t, server)) != 2 {
+		t.Errorf("Expected 2 buffered snapshots, got %d", len(bufferedCollectedAts(t, server)))
+	}
+
+	// Submission works again, older snapshots are sent first
+	failSubmit = false
+	err = submitFull(snapshot.FullSnapshot{}, server, collectionOpts, logger, collectedAts[2], true, true, nil)
+	if err != nil {
+		t.Errorf("Expected snapshot to be submitted, got: %v", err)
+	}
+	if len(bufferedCollectedAts(t, server)) != 0 {
+		t.Errorf("Expected snapshot buffer to be empty")
+	}
+	var expected []string
+	for _, collectedAt := range collectedAts {
+		expected = append(expected, strconv.FormatInt(collectedAt.Unix(), 10))
+	}
+	if len(submittedAts) != 3 || submittedAts[0] != expected[0] || submittedAts[1] != expected[1] || submittedAts[2] != expected[2] {
+		t.Errorf("Expected snapshots to be submitted in order (%v), got %v", expected, submittedAts)
+	}
+}
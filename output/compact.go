@@ -49,6 +49,16 @@ func uploadAndSubmitCompactSnapshot(s pganalyze_collector.CompactSnapshot, grant
 		return nil
 	}
 
+	// Log snapshots reference log files uploaded with the primary destination's
+	// encryption key, so these can't be sent elsewhere as-is
+	if kind != "logs" {
+		defer submitToAdditionalDestinations(server, collectionOpts, logger, snapshotUUID.String(), func(destinationServer state.Server) (bytes.Buffer, error) {
+			return compressedData, nil
+		}, func(destinationServer state.Server, s3Location string) error {
+			return submitCompactSnapshot(destinationServer, collectionOpts, logger, s3Location, collectedAt, true, kind)
+		})
+	}
+
 	s3Location, err := uploadSnapshot(server.Config.HTTPClient, grant, logger, compressedData, snapshotUUID.String())
 	if err != nil {
 		logger.PrintError("Error uploading to S3: %s", err)
@@ -114,7 +124,7 @@ func submitCompactSnapshot(server state.Server, collectionOpts state.CollectionO
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Add("Accept", "application/json,text/plain")
 
-	resp, err := server.Config.HTTPClient.Do(req)
+	resp, err := server.Config.HTTPClient.Do(req.WithContext(util.ShutdownContext()))
 	// TODO: We could consider re-running on error (e.g. if it was a temporary server issue)
 	if err != nil {
 		return err
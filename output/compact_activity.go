@@ -1,6 +1,7 @@
 package output
 
 import (
+	"github.com/guregu/null"
 	pg_query "github.com/lfittl/pg_query_go"
 	"github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/output/transform"
@@ -25,3 +26,24 @@ func SubmitCompactActivitySnapshot(server state.Server, grant state.Grant, colle
 	}
 	return uploadAndSubmitCompactSnapshot(s, grant, server, collectionOpts, logger, activityState.CollectedAt, false, "activity")
 }
+
+// FilterActivityQueryTexts - Returns a copy of the activity with its query texts
+// normalized when filter_query_sample is "all" (like submitted activity snapshots),
+// for keeping it around locally, e.g. in the activity history
+func FilterActivityQueryTexts(server state.Server, activity state.ActivityState) state.ActivityState {
+	if server.Config.FilterQuerySample != "all" {
+		return activity
+	}
+
+	backends := make([]state.PostgresBackend, len(activity.Backends))
+	for idx, backend := range activity.Backends {
+		if backend.Query.Valid && backend.Query.String != "" {
+			normalized, _ := pg_query.Normalize(backend.Query.String)
+			backend.Query = null.StringFrom(normalized)
+		}
+		backends[idx] = backend
+	}
+	activity.Backends = backends
+
+	return activity
+}
@@ -1,6 +1,7 @@
 package output
 
 import (
+	"github.com/pganalyze/collector/logs"
 	"github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/output/transform"
 	"github.com/pganalyze/collector/state"
@@ -17,6 +18,10 @@ func UploadAndSendLogs(server state.Server, grant state.GrantLogs, collectionOpt
 		logState.QuerySamples = []state.PostgresQuerySample{}
 	}
 
+	for _, logFile := range logState.LogFiles {
+		logState.LockEvents = append(logState.LockEvents, logs.ExtractLockEvents(logFile.LogLines)...)
+	}
+
 	if collectionOpts.SubmitCollectedData && grant.EncryptionKey.CiphertextBlob != "" {
 		logState.LogFiles = EncryptAndUploadLogfiles(server.Config.HTTPClient, grant.Logdata, grant.EncryptionKey, logger, logState.LogFiles)
 	}
@@ -0,0 +1,57 @@
+package output
+
+import (
+	"bytes"
+
+	"github.com/pganalyze/collector/grant"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// destinationSnapshotFunc - Returns the full snapshot to send to an additional
+// destination (instead of the one sent to the primary destination), and an optional
+// function to call once it was submitted there successfully
+type destinationSnapshotFunc func(destinationServer state.Server) (snapshot.FullSnapshot, func())
+
+// submitToAdditionalDestinations - Uploads and submits a compressed snapshot to each
+// of the server's additional API destinations
+//
+// Each destination grants its own upload location, and failures are only logged,
+// so that one unavailable destination doesn't block the others (or the primary one).
+// The snapshot data is requested separately for each destination, which has its
+// grant set, since the data sent may depend on the features it supports.
+func submitToAdditionalDestinations(server state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, filename string, compressedData func(destinationServer state.Server) (bytes.Buffer, error), submit func(destinationServer state.Server, s3Location string) error) {
+	for _, destination := range server.Config.APIDestinations {
+		destinationServer := server
+		destinationServer.Config.APIKey = destination.APIKey
+		destinationServer.Config.APIBaseURL = destination.APIBaseURL
+
+		destinationGrant, err := grant.GetDefaultGrant(destinationServer, collectionOpts, logger)
+		if err != nil {
+			logger.PrintWarning("Could not submit snapshot to %s: could not get grant: %s", destination.APIBaseURL, err)
+			continue
+		}
+		destinationServer.Grant = destinationGrant
+
+		data, err := compressedData(destinationServer)
+		if err != nil {
+			logger.PrintWarning("Could not submit snapshot to %s: %s", destination.APIBaseURL, err)
+			continue
+		}
+
+		s3Location, err := uploadSnapshot(server.Config.HTTPClient, destinationGrant, logger, data, filename)
+		if err != nil {
+			logger.PrintWarning("Could not submit snapshot to %s: error uploading to S3: %s", destination.APIBaseURL, err)
+			continue
+		}
+
+		err = submit(destinationServer, s3Location)
+		if err != nil {
+			logger.PrintWarning("Could not submit snapshot to %s: %s", destination.APIBaseURL, err)
+			continue
+		}
+
+		logger.PrintVerbose("Submitted snapshot to %s successfully", destination.APIBaseURL)
+	}
+}
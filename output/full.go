@@ -9,12 +9,14 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/output/pganalyze_collector"
 	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/output/transform"
@@ -27,50 +29,199 @@ func SendFull(server state.Server, collectionOpts state.CollectionOpts, logger *
 	s := transform.StateToSnapshot(newState, diffState, transientState)
 	s.CollectedIntervalSecs = collectedIntervalSecs
 	s.CollectorErrors = logger.ErrorMessages
+	s.Labels = server.Config.LabelMap
+	if len(transientState.DynamicLabels) > 0 {
+		var skipped []string
+		s.Labels, skipped = config.MergeDynamicLabels(server.Config.LabelMap, transientState.DynamicLabels)
+		for _, reason := range skipped {
+			logger.PrintWarning("Ignoring label returned by labels_query: %s", reason)
+		}
+	}
+	s.SchemaHash = newState.SchemaHash
+	s.ForcedFullSnapshot = transientState.ForcedFullSnapshot
+	withSchema := s
+	if transientState.SchemaUnchanged {
+		omitUnchangedSchema(&s)
+	}
+	omitUnsupportedSections(&s, server.Grant.Config.Features)
+
+	if collectionOpts.RecordSnapshotsDir != "" {
+		// Recorded with the metadata it gets submitted with, so a replay is identical
+		setFullSnapshotMetadata(&s, uuid.NewV4().String(), newState.CollectedAt)
+		recordSnapshot(server, collectionOpts, logger, s, newState.CollectedAt)
+	}
 
-	return submitFull(s, server, collectionOpts, logger, newState.CollectedAt, false)
+	// Additional destinations may have missed the last schema we sent, or not support
+	// leaving it out, so this is decided separately for each of them
+	forDestination := func(destinationServer state.Server) (snapshot.FullSnapshot, func()) {
+		ds := withSchema
+		apiBaseURL := destinationServer.Config.APIBaseURL
+		features := destinationServer.Grant.Config.Features
+		schemaUnchanged := !transientState.ForcedFullSnapshot && state.SchemaUnchanged(server.Config, features, server.PrevState.SchemaHash, newState.SchemaHash, newState.DestinationSchemaSentAt[apiBaseURL], newState.CollectedAt)
+		if schemaUnchanged {
+			omitUnchangedSchema(&ds)
+		}
+		omitUnsupportedSections(&ds, features)
+		if schemaUnchanged || newState.DestinationSchemaSentAt == nil {
+			return ds, nil
+		}
+		return ds, func() { newState.DestinationSchemaSentAt[apiBaseURL] = newState.CollectedAt }
+	}
+
+	return submitFull(s, server, collectionOpts, logger, newState.CollectedAt, false, true, forDestination)
 }
 
 func SendFailedFull(server state.Server, collectionOpts state.CollectionOpts, logger *util.Logger) error {
-	s := snapshot.FullSnapshot{FailedRun: true, CollectorErrors: logger.ErrorMessages}
-	return submitFull(s, server, collectionOpts, logger, time.Now(), true)
+	s := snapshot.FullSnapshot{FailedRun: true, CollectorErrors: logger.ErrorMessages, Labels: server.Config.LabelMap}
+	return submitFull(s, server, collectionOpts, logger, time.Now(), true, false, nil)
 }
 
-func submitFull(s snapshot.FullSnapshot, server state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, collectedAt time.Time, quiet bool) error {
+// maxBackfillClockSkew - How far in the future a backfilled snapshot may be, to allow for small clock differences
+const maxBackfillClockSkew = 10 * time.Minute
+
+// SendFullFromFile - Re-submits a previously written full snapshot, preserving its original collected_at and interval
+//
+// Files ending in .json are expected to be recorded with --record-snapshots, all
+// others to be compressed protocol buffers (e.g. from the snapshot buffer).
+func SendFullFromFile(server state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, filename string) error {
+	if strings.HasSuffix(filename, ".json") {
+		s, err := readRecordedSnapshot(filename)
+		if err != nil {
+			return err
+		}
+		return sendFullSnapshotFromFile(server, collectionOpts, logger, s)
+	}
+
+	compressedData, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	r, err := zlib.NewReader(bytes.NewReader(compressedData))
+	if err != nil {
+		return fmt.Errorf("Failed to decompress protocol buffers: %s", err)
+	}
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("Failed to decompress protocol buffers: %s", err)
+	}
+
+	s := snapshot.FullSnapshot{}
+	if err = proto.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("Failed to read protocol buffers: %s", err)
+	}
+
+	return sendFullSnapshotFromFile(server, collectionOpts, logger, s)
+}
+
+func sendFullSnapshotFromFile(server state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, s snapshot.FullSnapshot) error {
+	if s.CollectedAt == nil {
+		return fmt.Errorf("Snapshot file is missing collected_at")
+	}
+	collectedAt, err := ptypes.Timestamp(s.CollectedAt)
+	if err != nil {
+		return fmt.Errorf("Snapshot file has invalid collected_at: %s", err)
+	}
+	if collectedAt.After(time.Now().Add(maxBackfillClockSkew)) {
+		return fmt.Errorf("Snapshot file has collected_at in the future (%s), refusing to submit", collectedAt.Format(time.RFC3339))
+	}
+
+	logger.PrintVerbose("Re-submitting snapshot collected at %s (interval %d seconds)", collectedAt.Format(time.RFC3339), s.CollectedIntervalSecs)
+
+	return submitFull(s, server, collectionOpts, logger, collectedAt, false, false, nil)
+}
+
+// submitFull - Submits the full snapshot to the primary destination, and any
+// additional ones (forDestination may be nil to send them the same snapshot)
+//
+// Snapshots that already have a snapshot UUID (e.g. ones that got recorded, or are
+// replayed from a file) are submitted as-is, otherwise the metadata gets set here.
+func submitFull(s snapshot.FullSnapshot, server state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, collectedAt time.Time, quiet bool, bufferOnError bool, forDestination destinationSnapshotFunc) error {
 	var err error
-	var data []byte
 
-	snapshotUUID := uuid.NewV4()
+	if s.SnapshotUuid == "" {
+		setFullSnapshotMetadata(&s, uuid.NewV4().String(), collectedAt)
+	}
+	snapshotUUID := s.SnapshotUuid
 
-	s.SnapshotVersionMajor = 1
-	s.SnapshotVersionMinor = 0
-	s.CollectorVersion = util.CollectorNameAndVersion
-	s.SnapshotUuid = snapshotUUID.String()
-	s.CollectedAt, _ = ptypes.TimestampProto(collectedAt)
+	if collectionOpts.LastSnapshots.Enabled() {
+		rememberLastSnapshot(server, collectionOpts, logger, s)
+	}
 
-	data, err = proto.Marshal(&s)
+	compressedData, err := compressFullSnapshot(s)
 	if err != nil {
 		logger.PrintError("Error marshaling protocol buffers")
 		return err
 	}
 
-	var compressedData bytes.Buffer
-	w := zlib.NewWriter(&compressedData)
-	w.Write(data)
-	w.Close()
-
 	if !collectionOpts.SubmitCollectedData {
 		debugOutputAsJSON(logger, compressedData)
 		return nil
 	}
 
-	s3Location, err := uploadSnapshot(server.Config.HTTPClient, server.Grant, logger, compressedData, snapshotUUID.String())
+	bufferOnError = bufferOnError && snapshotBufferEnabled(server, collectionOpts)
+	if bufferOnError && !server.Grant.Valid {
+		err = bufferSnapshot(server, logger, compressedData, snapshotUUID, collectedAt, fmt.Errorf("no valid snapshot grant"))
+	} else if bufferOnError && !flushSnapshotBuffer(server, collectionOpts, logger) {
+		// Snapshots need to arrive in order, so this one has to wait for the older ones
+		err = bufferSnapshot(server, logger, compressedData, snapshotUUID, collectedAt, fmt.Errorf("older buffered snapshots could not be submitted yet"))
+	} else {
+		var s3Location string
+		s3Location, err = uploadSnapshot(server.Config.HTTPClient, server.Grant, logger, compressedData, snapshotUUID)
+		if err != nil {
+			logger.PrintError("Error uploading to S3: %s", err)
+		} else {
+			err = submitSnapshot(server, collectionOpts, logger, s3Location, collectedAt, quiet)
+		}
+		if err != nil && bufferOnError {
+			err = bufferSnapshot(server, logger, compressedData, snapshotUUID, collectedAt, err)
+		}
+	}
+
+	// Set for the destination currently being submitted to (they are submitted one at a time)
+	var destinationSubmitted func()
+	submitToAdditionalDestinations(server, collectionOpts, logger, snapshotUUID, func(destinationServer state.Server) (bytes.Buffer, error) {
+		if forDestination == nil {
+			return compressedData, nil
+		}
+		var ds snapshot.FullSnapshot
+		ds, destinationSubmitted = forDestination(destinationServer)
+		setFullSnapshotMetadata(&ds, snapshotUUID, collectedAt)
+		return compressFullSnapshot(ds)
+	}, func(destinationServer state.Server, s3Location string) error {
+		submitErr := submitSnapshot(destinationServer, collectionOpts, logger, s3Location, collectedAt, true)
+		if submitErr == nil && destinationSubmitted != nil {
+			destinationSubmitted()
+		}
+		return submitErr
+	})
+
+	return err
+}
+
+func setFullSnapshotMetadata(s *snapshot.FullSnapshot, snapshotUUID string, collectedAt time.Time) {
+	s.SnapshotVersionMajor = 1
+	s.SnapshotVersionMinor = 0
+	s.CollectorVersion = util.CollectorNameAndVersion
+	s.SnapshotUuid = snapshotUUID
+	s.CollectedAt, _ = ptypes.TimestampProto(collectedAt)
+}
+
+func compressFullSnapshot(s snapshot.FullSnapshot) (bytes.Buffer, error) {
+	var compressedData bytes.Buffer
+
+	data, err := proto.Marshal(&s)
 	if err != nil {
-		logger.PrintError("Error uploading to S3: %s", err)
-		return err
+		return compressedData, err
 	}
 
-	return submitSnapshot(server, collectionOpts, logger, s3Location, collectedAt, quiet)
+	w := zlib.NewWriter(&compressedData)
+	w.Write(data)
+	w.Close()
+
+	return compressedData, nil
 }
 
 func debugOutputAsJSON(logger *util.Logger, compressedData bytes.Buffer) {
@@ -112,8 +263,10 @@ func submitSnapshot(server state.Server, collectionOpts state.CollectionOpts, lo
 	}
 
 	data := url.Values{
-		"s3_location":  {s3Location},
-		"collected_at": {fmt.Sprintf("%d", collectedAt.Unix())},
+		"s3_location":    {s3Location},
+		"collected_at":   {fmt.Sprintf("%d", collectedAt.Unix())},
+		"query_source":   {server.Config.StatementSource},
+		"schema_version": {strconv.Itoa(util.SnapshotSchemaVersion)},
 	}
 
 	req, err := http.NewRequest("POST", requestURL, strings.NewReader(data.Encode()))
@@ -129,7 +282,7 @@ func submitSnapshot(server state.Server, collectionOpts state.CollectionOpts, lo
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Add("Accept", "application/json,text/plain")
 
-	resp, err := server.Config.HTTPClient.Do(req)
+	resp, err := server.Config.HTTPClient.Do(req.WithContext(util.ShutdownContext()))
 	// TODO: We could consider re-running on error (e.g. if it was a temporary server issue)
 	if err != nil {
 		return err
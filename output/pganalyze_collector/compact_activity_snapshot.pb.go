@@ -74,7 +74,7 @@ func (x Backend_WaitEventType) String() string {
 }
 
 func (Backend_WaitEventType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a0f94e9081e673de, []int{1, 0}
+	return fileDescriptor_a0f94e9081e673de, []int{2, 0}
 }
 
 // ! When changing this, also update mappings/wait_event.json
@@ -762,7 +762,7 @@ func (x Backend_WaitEvent) String() string {
 }
 
 func (Backend_WaitEvent) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a0f94e9081e673de, []int{1, 1}
+	return fileDescriptor_a0f94e9081e673de, []int{2, 1}
 }
 
 type VacuumProgressStatistic_VacuumPhase int32
@@ -802,7 +802,7 @@ func (x VacuumProgressStatistic_VacuumPhase) String() string {
 }
 
 func (VacuumProgressStatistic_VacuumPhase) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a0f94e9081e673de, []int{3, 0}
+	return fileDescriptor_a0f94e9081e673de, []int{5, 0}
 }
 
 type CompactActivitySnapshot struct {
@@ -813,9 +813,13 @@ type CompactActivitySnapshot struct {
 	PrevActivitySnapshotAt     *timestamp.Timestamp         `protobuf:"bytes,3,opt,name=prev_activity_snapshot_at,json=prevActivitySnapshotAt,proto3" json:"prev_activity_snapshot_at,omitempty"`
 	VacuumProgressInformations []*VacuumProgressInformation `protobuf:"bytes,10,rep,name=vacuum_progress_informations,json=vacuumProgressInformations,proto3" json:"vacuum_progress_informations,omitempty"`
 	VacuumProgressStatistics   []*VacuumProgressStatistic   `protobuf:"bytes,11,rep,name=vacuum_progress_statistics,json=vacuumProgressStatistics,proto3" json:"vacuum_progress_statistics,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}                     `json:"-"`
-	XXX_unrecognized           []byte                       `json:"-"`
-	XXX_sizecache              int32                        `json:"-"`
+	// Other operations reporting progress, e.g. CREATE INDEX, CLUSTER, ANALYZE, COPY or base backups
+	Progress []*OperationProgress `protobuf:"bytes,12,rep,name=progress,proto3" json:"progress,omitempty"`
+	// Backends left out of this snapshot due to their type (see activity_backend_types)
+	ExcludedBackendCounts []*ExcludedBackendCount `protobuf:"bytes,13,rep,name=excluded_backend_counts,json=excludedBackendCounts,proto3" json:"excluded_backend_counts,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                `json:"-"`
+	XXX_unrecognized      []byte                  `json:"-"`
+	XXX_sizecache         int32                   `json:"-"`
 }
 
 func (m *CompactActivitySnapshot) Reset()         { *m = CompactActivitySnapshot{} }
@@ -878,38 +882,101 @@ func (m *CompactActivitySnapshot) GetVacuumProgressStatistics() []*VacuumProgres
 	return nil
 }
 
+func (m *CompactActivitySnapshot) GetProgress() []*OperationProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+func (m *CompactActivitySnapshot) GetExcludedBackendCounts() []*ExcludedBackendCount {
+	if m != nil {
+		return m.ExcludedBackendCounts
+	}
+	return nil
+}
+
+type ExcludedBackendCount struct {
+	BackendType          string   `protobuf:"bytes,1,opt,name=backend_type,json=backendType,proto3" json:"backend_type,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExcludedBackendCount) Reset()         { *m = ExcludedBackendCount{} }
+func (m *ExcludedBackendCount) String() string { return proto.CompactTextString(m) }
+func (*ExcludedBackendCount) ProtoMessage()    {}
+func (*ExcludedBackendCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0f94e9081e673de, []int{1}
+}
+
+func (m *ExcludedBackendCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExcludedBackendCount.Unmarshal(m, b)
+}
+func (m *ExcludedBackendCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExcludedBackendCount.Marshal(b, m, deterministic)
+}
+func (m *ExcludedBackendCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExcludedBackendCount.Merge(m, src)
+}
+func (m *ExcludedBackendCount) XXX_Size() int {
+	return xxx_messageInfo_ExcludedBackendCount.Size(m)
+}
+func (m *ExcludedBackendCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExcludedBackendCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExcludedBackendCount proto.InternalMessageInfo
+
+func (m *ExcludedBackendCount) GetBackendType() string {
+	if m != nil {
+		return m.BackendType
+	}
+	return ""
+}
+
+func (m *ExcludedBackendCount) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 type Backend struct {
-	Identity             uint64               `protobuf:"varint,1,opt,name=identity,proto3" json:"identity,omitempty"`
-	Pid                  int32                `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
-	HasRoleIdx           bool                 `protobuf:"varint,3,opt,name=has_role_idx,json=hasRoleIdx,proto3" json:"has_role_idx,omitempty"`
-	RoleIdx              int32                `protobuf:"varint,4,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`
-	HasDatabaseIdx       bool                 `protobuf:"varint,5,opt,name=has_database_idx,json=hasDatabaseIdx,proto3" json:"has_database_idx,omitempty"`
-	DatabaseIdx          int32                `protobuf:"varint,6,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
-	HasQueryIdx          bool                 `protobuf:"varint,7,opt,name=has_query_idx,json=hasQueryIdx,proto3" json:"has_query_idx,omitempty"`
-	QueryIdx             int32                `protobuf:"varint,8,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
-	QueryText            string               `protobuf:"bytes,9,opt,name=query_text,json=queryText,proto3" json:"query_text,omitempty"`
-	ApplicationName      string               `protobuf:"bytes,10,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
-	ClientAddr           string               `protobuf:"bytes,11,opt,name=client_addr,json=clientAddr,proto3" json:"client_addr,omitempty"`
-	ClientPort           int32                `protobuf:"varint,12,opt,name=client_port,json=clientPort,proto3" json:"client_port,omitempty"`
-	BackendStart         *timestamp.Timestamp `protobuf:"bytes,13,opt,name=backend_start,json=backendStart,proto3" json:"backend_start,omitempty"`
-	XactStart            *timestamp.Timestamp `protobuf:"bytes,14,opt,name=xact_start,json=xactStart,proto3" json:"xact_start,omitempty"`
-	QueryStart           *timestamp.Timestamp `protobuf:"bytes,15,opt,name=query_start,json=queryStart,proto3" json:"query_start,omitempty"`
-	StateChange          *timestamp.Timestamp `protobuf:"bytes,16,opt,name=state_change,json=stateChange,proto3" json:"state_change,omitempty"`
-	Waiting              bool                 `protobuf:"varint,17,opt,name=waiting,proto3" json:"waiting,omitempty"`
-	State                string               `protobuf:"bytes,18,opt,name=state,proto3" json:"state,omitempty"`
-	WaitEventType        string               `protobuf:"bytes,19,opt,name=wait_event_type,json=waitEventType,proto3" json:"wait_event_type,omitempty"`
-	WaitEvent            string               `protobuf:"bytes,20,opt,name=wait_event,json=waitEvent,proto3" json:"wait_event,omitempty"`
-	BackendType          string               `protobuf:"bytes,21,opt,name=backend_type,json=backendType,proto3" json:"backend_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Identity        uint64               `protobuf:"varint,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Pid             int32                `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	HasRoleIdx      bool                 `protobuf:"varint,3,opt,name=has_role_idx,json=hasRoleIdx,proto3" json:"has_role_idx,omitempty"`
+	RoleIdx         int32                `protobuf:"varint,4,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`
+	HasDatabaseIdx  bool                 `protobuf:"varint,5,opt,name=has_database_idx,json=hasDatabaseIdx,proto3" json:"has_database_idx,omitempty"`
+	DatabaseIdx     int32                `protobuf:"varint,6,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	HasQueryIdx     bool                 `protobuf:"varint,7,opt,name=has_query_idx,json=hasQueryIdx,proto3" json:"has_query_idx,omitempty"`
+	QueryIdx        int32                `protobuf:"varint,8,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
+	QueryText       string               `protobuf:"bytes,9,opt,name=query_text,json=queryText,proto3" json:"query_text,omitempty"`
+	ApplicationName string               `protobuf:"bytes,10,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ClientAddr      string               `protobuf:"bytes,11,opt,name=client_addr,json=clientAddr,proto3" json:"client_addr,omitempty"`
+	ClientPort      int32                `protobuf:"varint,12,opt,name=client_port,json=clientPort,proto3" json:"client_port,omitempty"`
+	BackendStart    *timestamp.Timestamp `protobuf:"bytes,13,opt,name=backend_start,json=backendStart,proto3" json:"backend_start,omitempty"`
+	XactStart       *timestamp.Timestamp `protobuf:"bytes,14,opt,name=xact_start,json=xactStart,proto3" json:"xact_start,omitempty"`
+	QueryStart      *timestamp.Timestamp `protobuf:"bytes,15,opt,name=query_start,json=queryStart,proto3" json:"query_start,omitempty"`
+	StateChange     *timestamp.Timestamp `protobuf:"bytes,16,opt,name=state_change,json=stateChange,proto3" json:"state_change,omitempty"`
+	Waiting         bool                 `protobuf:"varint,17,opt,name=waiting,proto3" json:"waiting,omitempty"`
+	State           string               `protobuf:"bytes,18,opt,name=state,proto3" json:"state,omitempty"`
+	WaitEventType   string               `protobuf:"bytes,19,opt,name=wait_event_type,json=waitEventType,proto3" json:"wait_event_type,omitempty"`
+	WaitEvent       string               `protobuf:"bytes,20,opt,name=wait_event,json=waitEvent,proto3" json:"wait_event,omitempty"`
+	BackendType     string               `protobuf:"bytes,21,opt,name=backend_type,json=backendType,proto3" json:"backend_type,omitempty"`
+	// Query text was cut off at track_activity_query_size
+	QueryTruncated       bool     `protobuf:"varint,22,opt,name=query_truncated,json=queryTruncated,proto3" json:"query_truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Backend) Reset()         { *m = Backend{} }
 func (m *Backend) String() string { return proto.CompactTextString(m) }
 func (*Backend) ProtoMessage()    {}
 func (*Backend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a0f94e9081e673de, []int{1}
+	return fileDescriptor_a0f94e9081e673de, []int{2}
 }
 
 func (m *Backend) XXX_Unmarshal(b []byte) error {
@@ -1077,6 +1144,140 @@ func (m *Backend) GetBackendType() string {
 	return ""
 }
 
+func (m *Backend) GetQueryTruncated() bool {
+	if m != nil {
+		return m.QueryTruncated
+	}
+	return false
+}
+
+type OperationProgress struct {
+	BackendIdentity      uint64               `protobuf:"varint,1,opt,name=backend_identity,json=backendIdentity,proto3" json:"backend_identity,omitempty"`
+	Command              string               `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Phase                string               `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	RoleIdx              int32                `protobuf:"varint,4,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`
+	DatabaseIdx          int32                `protobuf:"varint,5,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	HasRelationIdx       bool                 `protobuf:"varint,6,opt,name=has_relation_idx,json=hasRelationIdx,proto3" json:"has_relation_idx,omitempty"`
+	RelationIdx          int32                `protobuf:"varint,7,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	StartedAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	WorkDone             int64                `protobuf:"varint,9,opt,name=work_done,json=workDone,proto3" json:"work_done,omitempty"`
+	WorkTotal            int64                `protobuf:"varint,10,opt,name=work_total,json=workTotal,proto3" json:"work_total,omitempty"`
+	HasPercentDone       bool                 `protobuf:"varint,11,opt,name=has_percent_done,json=hasPercentDone,proto3" json:"has_percent_done,omitempty"`
+	PercentDone          float64              `protobuf:"fixed64,12,opt,name=percent_done,json=percentDone,proto3" json:"percent_done,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OperationProgress) Reset()         { *m = OperationProgress{} }
+func (m *OperationProgress) String() string { return proto.CompactTextString(m) }
+func (*OperationProgress) ProtoMessage()    {}
+func (*OperationProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0f94e9081e673de, []int{3}
+}
+
+func (m *OperationProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperationProgress.Unmarshal(m, b)
+}
+func (m *OperationProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperationProgress.Marshal(b, m, deterministic)
+}
+func (m *OperationProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationProgress.Merge(m, src)
+}
+func (m *OperationProgress) XXX_Size() int {
+	return xxx_messageInfo_OperationProgress.Size(m)
+}
+func (m *OperationProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationProgress proto.InternalMessageInfo
+
+func (m *OperationProgress) GetBackendIdentity() uint64 {
+	if m != nil {
+		return m.BackendIdentity
+	}
+	return 0
+}
+
+func (m *OperationProgress) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *OperationProgress) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *OperationProgress) GetRoleIdx() int32 {
+	if m != nil {
+		return m.RoleIdx
+	}
+	return 0
+}
+
+func (m *OperationProgress) GetDatabaseIdx() int32 {
+	if m != nil {
+		return m.DatabaseIdx
+	}
+	return 0
+}
+
+func (m *OperationProgress) GetHasRelationIdx() bool {
+	if m != nil {
+		return m.HasRelationIdx
+	}
+	return false
+}
+
+func (m *OperationProgress) GetRelationIdx() int32 {
+	if m != nil {
+		return m.RelationIdx
+	}
+	return 0
+}
+
+func (m *OperationProgress) GetStartedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartedAt
+	}
+	return nil
+}
+
+func (m *OperationProgress) GetWorkDone() int64 {
+	if m != nil {
+		return m.WorkDone
+	}
+	return 0
+}
+
+func (m *OperationProgress) GetWorkTotal() int64 {
+	if m != nil {
+		return m.WorkTotal
+	}
+	return 0
+}
+
+func (m *OperationProgress) GetHasPercentDone() bool {
+	if m != nil {
+		return m.HasPercentDone
+	}
+	return false
+}
+
+func (m *OperationProgress) GetPercentDone() float64 {
+	if m != nil {
+		return m.PercentDone
+	}
+	return 0
+}
+
 type VacuumProgressInformation struct {
 	VacuumIdentity       uint64               `protobuf:"varint,1,opt,name=vacuum_identity,json=vacuumIdentity,proto3" json:"vacuum_identity,omitempty"`
 	RoleIdx              int32                `protobuf:"varint,2,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`
@@ -1095,7 +1296,7 @@ func (m *VacuumProgressInformation) Reset()         { *m = VacuumProgressInforma
 func (m *VacuumProgressInformation) String() string { return proto.CompactTextString(m) }
 func (*VacuumProgressInformation) ProtoMessage()    {}
 func (*VacuumProgressInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a0f94e9081e673de, []int{2}
+	return fileDescriptor_a0f94e9081e673de, []int{4}
 }
 
 func (m *VacuumProgressInformation) XXX_Unmarshal(b []byte) error {
@@ -1190,7 +1391,7 @@ func (m *VacuumProgressStatistic) Reset()         { *m = VacuumProgressStatistic
 func (m *VacuumProgressStatistic) String() string { return proto.CompactTextString(m) }
 func (*VacuumProgressStatistic) ProtoMessage()    {}
 func (*VacuumProgressStatistic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a0f94e9081e673de, []int{3}
+	return fileDescriptor_a0f94e9081e673de, []int{5}
 }
 
 func (m *VacuumProgressStatistic) XXX_Unmarshal(b []byte) error {
@@ -1272,7 +1473,9 @@ func init() {
 	proto.RegisterEnum("pganalyze.collector.Backend_WaitEvent", Backend_WaitEvent_name, Backend_WaitEvent_value)
 	proto.RegisterEnum("pganalyze.collector.VacuumProgressStatistic_VacuumPhase", VacuumProgressStatistic_VacuumPhase_name, VacuumProgressStatistic_VacuumPhase_value)
 	proto.RegisterType((*CompactActivitySnapshot)(nil), "pganalyze.collector.CompactActivitySnapshot")
+	proto.RegisterType((*ExcludedBackendCount)(nil), "pganalyze.collector.ExcludedBackendCount")
 	proto.RegisterType((*Backend)(nil), "pganalyze.collector.Backend")
+	proto.RegisterType((*OperationProgress)(nil), "pganalyze.collector.OperationProgress")
 	proto.RegisterType((*VacuumProgressInformation)(nil), "pganalyze.collector.VacuumProgressInformation")
 	proto.RegisterType((*VacuumProgressStatistic)(nil), "pganalyze.collector.VacuumProgressStatistic")
 }
@@ -1280,239 +1483,251 @@ func init() {
 func init() { proto.RegisterFile("compact_activity_snapshot.proto", fileDescriptor_a0f94e9081e673de) }
 
 var fileDescriptor_a0f94e9081e673de = []byte{
-	// 3729 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x9a, 0xe9, 0x7b, 0xdb, 0xc6,
-	0x99, 0xc0, 0x43, 0x53, 0x34, 0xe5, 0x91, 0x64, 0x4d, 0x26, 0x4e, 0x42, 0x3b, 0x87, 0x1d, 0x35,
-	0x71, 0xec, 0xc4, 0x55, 0x5a, 0x37, 0xcf, 0x36, 0x79, 0x76, 0xf7, 0xd9, 0x67, 0x08, 0x8c, 0x48,
-	0x54, 0x20, 0x00, 0x0f, 0x06, 0x92, 0xd5, 0x2f, 0x78, 0x60, 0x11, 0xb1, 0xd8, 0x48, 0x24, 0x43,
-	0x42, 0xae, 0xdc, 0xbd, 0xda, 0xdd, 0xa6, 0x77, 0x53, 0x3b, 0xe9, 0x99, 0x5e, 0x49, 0x7a, 0xef,
-	0x76, 0xef, 0x7b, 0xb7, 0x57, 0x7a, 0xa6, 0x77, 0xbb, 0x57, 0x9b, 0x9e, 0xf9, 0x23, 0xf6, 0xbe,
-	0x9f, 0x99, 0x01, 0x40, 0x70, 0x00, 0x8a, 0xde, 0x6f, 0xd2, 0xcc, 0x6f, 0xde, 0x79, 0xdf, 0x77,
-	0xe6, 0x7d, 0xe7, 0x9d, 0x21, 0xc0, 0xf1, 0xcd, 0xde, 0x4e, 0x3f, 0xd8, 0x8c, 0xfc, 0x60, 0x33,
-	0xea, 0x5c, 0xea, 0x44, 0x97, 0xfd, 0x61, 0x37, 0xe8, 0x0f, 0xb7, 0x7a, 0xd1, 0x72, 0x7f, 0xd0,
-	0x8b, 0x7a, 0xe8, 0x86, 0xfe, 0xc5, 0xa0, 0x1b, 0x6c, 0x5f, 0x7e, 0x4d, 0xb8, 0xbc, 0xd9, 0xdb,
-	0xde, 0x0e, 0x37, 0xa3, 0xde, 0xe0, 0xd8, 0xf1, 0x8b, 0xbd, 0xde, 0xc5, 0xed, 0xf0, 0x3e, 0x81,
-	0x5c, 0xd8, 0x7d, 0xe8, 0xbe, 0xa8, 0xb3, 0x13, 0x0e, 0xa3, 0x60, 0xa7, 0x2f, 0x47, 0x1d, 0x9b,
-	0x1f, 0x6e, 0x05, 0x83, 0xb0, 0x2d, 0xff, 0x5b, 0x7a, 0xbe, 0x0c, 0x6e, 0xd6, 0xe4, 0x3c, 0x38,
-	0x9e, 0xc6, 0x8d, 0x67, 0x41, 0x36, 0x80, 0xfd, 0xde, 0x30, 0xba, 0x38, 0x08, 0x87, 0xfe, 0xa5,
-	0x70, 0x30, 0xec, 0xf4, 0xba, 0xb5, 0xd2, 0x89, 0xd2, 0xa9, 0xb9, 0xb3, 0x77, 0x2e, 0x17, 0x4c,
-	0xbd, 0xec, 0xc4, 0xf0, 0x9a, 0x64, 0xe9, 0x62, 0x7f, 0xbc, 0x01, 0x3d, 0x00, 0x66, 0x2f, 0x04,
-	0x9b, 0x0f, 0x87, 0xdd, 0xf6, 0xb0, 0x76, 0xe0, 0x44, 0xf9, 0xd4, 0xdc, 0xd9, 0x5b, 0x0b, 0x05,
-	0xd5, 0x25, 0x44, 0x53, 0x1a, 0x79, 0xe0, 0x68, 0x7f, 0x10, 0x5e, 0xca, 0xbb, 0xc2, 0x0f, 0xa2,
-	0x5a, 0x59, 0xe8, 0x74, 0x6c, 0x59, 0x5a, 0xbe, 0x9c, 0x58, 0xbe, 0xcc, 0x12, 0xcb, 0xe9, 0x4d,
-	0x7c, 0xb0, 0x6a, 0x1f, 0x8e, 0x50, 0x1f, 0xdc, 0x7a, 0x29, 0xd8, 0xdc, 0xdd, 0xdd, 0xf1, 0xfb,
-	0x83, 0x1e, 0xd7, 0x74, 0xe8, 0x77, 0xba, 0x0f, 0xf5, 0x06, 0x3b, 0x41, 0xd4, 0xe9, 0x75, 0x87,
-	0x35, 0x20, 0x94, 0x5c, 0x2e, 0x54, 0x72, 0x4d, 0x0c, 0x74, 0xe2, 0x71, 0xc6, 0x68, 0x18, 0x3d,
-	0x76, 0x69, 0x52, 0xd7, 0x10, 0xbd, 0x0a, 0x1c, 0x53, 0x67, 0x1c, 0x46, 0x41, 0xd4, 0x19, 0x46,
-	0x9d, 0xcd, 0x61, 0x6d, 0x4e, 0xcc, 0x77, 0xe6, 0x1a, 0xe6, 0x73, 0x93, 0x41, 0xb4, 0x76, 0xa9,
-	0xb8, 0x63, 0xb8, 0xf4, 0xd8, 0x2a, 0xa8, 0xc6, 0xae, 0x44, 0xc7, 0xc0, 0x6c, 0xa7, 0x1d, 0x76,
-	0xa3, 0x4e, 0x74, 0x59, 0xac, 0xe1, 0x0c, 0x4d, 0xff, 0x47, 0x10, 0x94, 0xfb, 0x9d, 0x76, 0xed,
-	0xc0, 0x89, 0xd2, 0xa9, 0x0a, 0xe5, 0x7f, 0xa2, 0x13, 0x60, 0x7e, 0x2b, 0x18, 0xfa, 0x83, 0xde,
-	0x76, 0xe8, 0x77, 0xda, 0x7b, 0xc2, 0xc3, 0xb3, 0x14, 0x6c, 0x05, 0x43, 0xda, 0xdb, 0x0e, 0x8d,
-	0xf6, 0x1e, 0x3a, 0x0a, 0x66, 0xd3, 0xde, 0x19, 0x31, 0xb0, 0x3a, 0x88, 0xbb, 0x4e, 0x01, 0xc8,
-	0x07, 0xb7, 0x83, 0x28, 0xb8, 0x10, 0x0c, 0x25, 0x52, 0x11, 0x02, 0x0e, 0x6f, 0x05, 0x43, 0x3d,
-	0x6e, 0xe6, 0xe4, 0x1d, 0x60, 0x7e, 0x8c, 0x3a, 0x28, 0x04, 0xcd, 0xb5, 0x33, 0xc8, 0x12, 0x58,
-	0xe0, 0xc2, 0x1e, 0xd9, 0x0d, 0x07, 0x97, 0x05, 0x53, 0x15, 0x92, 0xe6, 0xb6, 0x82, 0xe1, 0x39,
-	0xde, 0xc6, 0x99, 0x5b, 0xc0, 0xa1, 0x51, 0xff, 0xac, 0x90, 0x31, 0xfb, 0x48, 0xd2, 0x79, 0x1b,
-	0x00, 0xb2, 0x33, 0x0a, 0xf7, 0xa2, 0xda, 0xa1, 0x13, 0xa5, 0x53, 0x87, 0xa8, 0xc4, 0x59, 0xb8,
-	0x17, 0xa1, 0xd3, 0x00, 0x06, 0xfd, 0xfe, 0x76, 0x67, 0x53, 0xac, 0x8f, 0xdf, 0x0d, 0x76, 0xc2,
-	0x1a, 0x10, 0xd0, 0x62, 0xa6, 0xdd, 0x0a, 0x76, 0x42, 0x74, 0x1c, 0xcc, 0x6d, 0x6e, 0x77, 0xc2,
-	0x6e, 0xe4, 0x07, 0xed, 0xf6, 0xa0, 0x36, 0x27, 0x28, 0x20, 0x9b, 0x70, 0xbb, 0x3d, 0xc8, 0x00,
-	0xfd, 0xde, 0x20, 0xaa, 0xcd, 0x0b, 0x4d, 0x62, 0xc0, 0xe9, 0x0d, 0x22, 0xf4, 0x2b, 0x60, 0x21,
-	0xde, 0xd1, 0x7c, 0xd1, 0x07, 0x51, 0x6d, 0x61, 0xea, 0xce, 0x9d, 0x8f, 0x07, 0xb8, 0x9c, 0x47,
-	0x0f, 0x02, 0xb0, 0xc7, 0x33, 0x82, 0x1c, 0x7d, 0x78, 0xea, 0xe8, 0x43, 0x9c, 0x96, 0x43, 0x7f,
-	0x11, 0xcc, 0x49, 0x3f, 0xc8, 0xb1, 0x8b, 0x53, 0xc7, 0x4a, 0xb7, 0xc9, 0xc1, 0xbf, 0x0c, 0xe6,
-	0xf9, 0x2e, 0x0d, 0xfd, 0xcd, 0xad, 0xa0, 0x7b, 0x31, 0xac, 0xc1, 0xa9, 0xa3, 0xe7, 0x04, 0xaf,
-	0x09, 0x1c, 0xd5, 0x40, 0xf5, 0xd5, 0x41, 0x27, 0xea, 0x74, 0x2f, 0xd6, 0xae, 0x17, 0xcb, 0x97,
-	0xfc, 0x8b, 0x8e, 0x80, 0x8a, 0x00, 0x6b, 0x48, 0x78, 0x53, 0xfe, 0x83, 0x4e, 0x82, 0x45, 0x0e,
-	0xf8, 0xe1, 0x25, 0xee, 0xcc, 0xe8, 0x72, 0x3f, 0xac, 0xdd, 0x20, 0xfa, 0x17, 0x78, 0x33, 0xe1,
-	0xad, 0xec, 0x72, 0x3f, 0xe4, 0x6b, 0x3b, 0xe2, 0x6a, 0x47, 0xe4, 0xda, 0xa6, 0x08, 0xdf, 0x5e,
-	0x89, 0xbb, 0x85, 0x8c, 0x1b, 0x05, 0x30, 0x17, 0xb7, 0x71, 0x09, 0x4b, 0x57, 0x0f, 0x80, 0x85,
-	0xf5, 0x31, 0x99, 0x37, 0x82, 0xeb, 0x9d, 0x86, 0xbf, 0x8e, 0x0d, 0xe6, 0x7b, 0x96, 0x4e, 0x56,
-	0x0c, 0x8b, 0xe8, 0xf0, 0x3a, 0x54, 0x03, 0x47, 0x92, 0x66, 0x73, 0xdd, 0xb4, 0xb5, 0x55, 0xdf,
-	0xc2, 0x2d, 0xa2, 0xc3, 0x12, 0x3a, 0x06, 0x6e, 0x52, 0x7a, 0x18, 0xc5, 0x96, 0xd6, 0x24, 0xf0,
-	0x00, 0x82, 0x60, 0x3e, 0xed, 0xb3, 0xb5, 0x55, 0x58, 0x46, 0x37, 0x01, 0x94, 0xb4, 0xd4, 0xbd,
-	0x95, 0x15, 0x42, 0x7d, 0xc7, 0xb0, 0xe0, 0x0c, 0x42, 0xe0, 0xf0, 0xb8, 0x14, 0x58, 0x41, 0x47,
-	0x00, 0x4c, 0xda, 0xb0, 0xc6, 0x8c, 0x35, 0x83, 0x6d, 0xc0, 0x83, 0x59, 0x52, 0x33, 0x0d, 0x62,
-	0x31, 0x58, 0xcd, 0x2a, 0x4d, 0xce, 0x33, 0x62, 0xb9, 0x86, 0x6d, 0xc1, 0x59, 0xb4, 0x08, 0xe6,
-	0x92, 0x66, 0xc3, 0xd1, 0xe0, 0x21, 0x74, 0x03, 0x58, 0x4c, 0x1a, 0x98, 0xd1, 0x22, 0xb6, 0xc7,
-	0x20, 0x40, 0x87, 0x01, 0x48, 0x29, 0x1b, 0xce, 0x2d, 0xfd, 0xa0, 0x0e, 0x0e, 0xa5, 0x3e, 0xe1,
-	0x0a, 0x4b, 0xb9, 0x6b, 0xc4, 0xe2, 0x2e, 0x59, 0xb5, 0xec, 0x75, 0x0b, 0x5e, 0x87, 0x4e, 0x82,
-	0xa5, 0x4c, 0x7b, 0x6c, 0xb9, 0xdb, 0x6c, 0x91, 0x96, 0x6f, 0x58, 0x3a, 0x39, 0x2f, 0x0d, 0x0e,
-	0xd1, 0x12, 0xb8, 0x3d, 0xcf, 0xd9, 0x86, 0xee, 0x37, 0x88, 0x25, 0x99, 0x87, 0x8a, 0x99, 0xf3,
-	0x59, 0xe6, 0x22, 0xba, 0x0b, 0xdc, 0x91, 0x67, 0x1c, 0x6a, 0x6b, 0x3e, 0xa6, 0x14, 0x6f, 0x48,
-	0x6c, 0x0b, 0xdd, 0x0d, 0x5e, 0x54, 0xa0, 0x96, 0x6f, 0x58, 0x6b, 0xd8, 0xf4, 0x29, 0xc1, 0xba,
-	0x04, 0x3b, 0xe8, 0x14, 0xb8, 0x73, 0x32, 0xb8, 0x4e, 0x0d, 0x46, 0x24, 0xf9, 0x2a, 0x74, 0x0f,
-	0x38, 0x99, 0x27, 0xd7, 0xb1, 0xc9, 0x17, 0xd0, 0x6f, 0x61, 0xc7, 0x31, 0xac, 0x86, 0x64, 0x1f,
-	0x46, 0x77, 0x82, 0x13, 0xc5, 0x6c, 0x46, 0xe2, 0x76, 0xb1, 0x92, 0x9a, 0x6d, 0x31, 0x6a, 0x9b,
-	0xfe, 0x8a, 0x61, 0xc6, 0xe0, 0x4e, 0xb1, 0xd1, 0x5a, 0x93, 0x68, 0xab, 0x8e, 0x6d, 0x58, 0xf1,
-	0xa6, 0xea, 0x16, 0xdb, 0xa2, 0xf9, 0xa6, 0xdd, 0x48, 0xa5, 0x0a, 0xb2, 0x87, 0xee, 0x05, 0x77,
-	0x17, 0x58, 0xed, 0xd5, 0xf9, 0x96, 0x75, 0xc7, 0xe1, 0x3e, 0x3a, 0x0d, 0xee, 0xca, 0xc3, 0x2d,
-	0xcf, 0x64, 0x86, 0x7f, 0x1e, 0x6b, 0x6c, 0xb4, 0x3a, 0x8f, 0xa0, 0xfb, 0xc1, 0x4b, 0xf6, 0x45,
-	0xed, 0x95, 0x15, 0x97, 0xb0, 0xf1, 0x09, 0x06, 0x53, 0x47, 0xb5, 0x48, 0xab, 0x4e, 0xe8, 0xf8,
-	0xa8, 0x61, 0xb1, 0x5a, 0x94, 0x98, 0xbe, 0x86, 0xb5, 0x26, 0xf1, 0x0d, 0x2b, 0x89, 0xb6, 0x08,
-	0x9d, 0x01, 0xa7, 0xf6, 0xf3, 0x9f, 0x90, 0xdd, 0x6a, 0x49, 0x7a, 0xb7, 0x78, 0xa1, 0xd9, 0xba,
-	0xed, 0x3b, 0x4d, 0xec, 0x12, 0xdf, 0x65, 0x38, 0x59, 0xc2, 0x4b, 0xc5, 0x92, 0x19, 0xae, 0x9b,
-	0xc4, 0x75, 0xb0, 0x46, 0x7c, 0x8d, 0x92, 0x94, 0x7e, 0x75, 0xf1, 0x82, 0xd7, 0x19, 0x25, 0xc4,
-	0x5f, 0xc3, 0x9a, 0xe7, 0xc5, 0x2a, 0xec, 0x15, 0xaf, 0x0f, 0xd6, 0x75, 0xc3, 0x4a, 0x63, 0x2b,
-	0xb1, 0xee, 0x72, 0xf1, 0xee, 0xc0, 0x1e, 0xb3, 0xb3, 0x32, 0x5f, 0x83, 0x96, 0xc1, 0x3d, 0xfb,
-	0x62, 0xae, 0xd6, 0x24, 0xba, 0x97, 0x6c, 0xba, 0x5f, 0x2d, 0xde, 0xc3, 0xee, 0x86, 0xa5, 0xf9,
-	0xae, 0x86, 0xe3, 0x15, 0xff, 0xb5, 0x62, 0x4d, 0x29, 0x31, 0x31, 0x33, 0x6c, 0x6b, 0x3c, 0x2c,
-	0x7e, 0xbd, 0x58, 0x24, 0x16, 0x32, 0x35, 0x16, 0x2f, 0xec, 0x6f, 0x14, 0xa7, 0x14, 0x49, 0x9d,
-	0xf3, 0x88, 0x17, 0x2b, 0xf8, 0x9b, 0xe8, 0x2c, 0x78, 0x71, 0x81, 0x82, 0x84, 0x1a, 0xd8, 0x34,
-	0x5e, 0xc9, 0x97, 0x40, 0xee, 0x9e, 0x26, 0x76, 0x9b, 0x72, 0xc8, 0x6b, 0x4b, 0xe8, 0x17, 0xc0,
-	0x4b, 0xa7, 0x8c, 0x59, 0x31, 0x2c, 0xc3, 0x6d, 0x12, 0xdd, 0x37, 0x0d, 0x37, 0x76, 0xf1, 0xeb,
-	0x4a, 0xe8, 0x97, 0xc0, 0xcb, 0xa7, 0x8c, 0x73, 0x28, 0xd1, 0x0d, 0x2d, 0x59, 0xec, 0xcc, 0xe8,
-	0xdf, 0x2a, 0xa1, 0xbb, 0x8b, 0x2c, 0xb2, 0x4d, 0x9d, 0x4b, 0x10, 0x09, 0x4e, 0x80, 0xbf, 0x5d,
-	0x42, 0x77, 0x82, 0xe3, 0x13, 0x7c, 0x4e, 0x89, 0x23, 0xa9, 0xd7, 0x97, 0xd0, 0x8b, 0x8b, 0x36,
-	0x5d, 0x1d, 0x6b, 0xab, 0x0d, 0x6a, 0x7b, 0x96, 0xee, 0xaf, 0xdb, 0x74, 0x95, 0x50, 0x89, 0x3f,
-	0x5a, 0x42, 0x0f, 0x80, 0x97, 0xe5, 0x71, 0x7d, 0xc3, 0xc2, 0x2d, 0x43, 0xf3, 0xdd, 0x26, 0xa6,
-	0x3a, 0x8f, 0x30, 0x9b, 0x6e, 0x8c, 0x47, 0xd8, 0x1b, 0x4a, 0xe8, 0xae, 0xc2, 0xf5, 0xf2, 0x98,
-	0x9d, 0xc9, 0x4e, 0x6f, 0x2c, 0xa1, 0x97, 0x83, 0xb3, 0x45, 0x7b, 0xc0, 0x31, 0xb9, 0x3f, 0xf8,
-	0x36, 0x70, 0x4d, 0x9b, 0xf9, 0xd8, 0x34, 0xed, 0xf8, 0x7f, 0x31, 0xf0, 0x4d, 0x25, 0x74, 0x3f,
-	0xb8, 0xef, 0x1a, 0x06, 0x8e, 0x69, 0xf5, 0xe6, 0x09, 0xe6, 0xf3, 0x00, 0xe6, 0xc7, 0x99, 0x92,
-	0xbd, 0xde, 0x32, 0xc1, 0x88, 0x11, 0x2e, 0xb0, 0xb7, 0x96, 0xd0, 0x32, 0x38, 0xbd, 0xbf, 0x2e,
-	0x36, 0x35, 0x1a, 0x46, 0xac, 0xfb, 0xdb, 0x4a, 0xe8, 0xa5, 0xe0, 0xcc, 0xbe, 0x49, 0x8b, 0x51,
-	0xcf, 0xca, 0x9a, 0xfb, 0xf6, 0x09, 0x43, 0xc4, 0x36, 0xb0, 0xb0, 0xe3, 0x36, 0x6d, 0x79, 0x1a,
-	0xf3, 0xa0, 0x91, 0x43, 0x1e, 0x2b, 0xa1, 0x7b, 0x8a, 0x92, 0x1c, 0x5f, 0x6a, 0x62, 0xe9, 0x3e,
-	0xc5, 0x96, 0x6e, 0xc7, 0xf1, 0xfd, 0x8e, 0x09, 0x16, 0x98, 0x76, 0xc3, 0xd0, 0xc4, 0x99, 0xe7,
-	0x8c, 0xed, 0x8b, 0x2b, 0x25, 0x74, 0x6f, 0x51, 0x9e, 0xd3, 0xf8, 0x69, 0xa1, 0xea, 0x7e, 0xb5,
-	0x84, 0x4e, 0x2a, 0x49, 0x26, 0x2e, 0x6e, 0x24, 0x2f, 0x4b, 0x18, 0x17, 0x3e, 0x9e, 0x57, 0x38,
-	0xe5, 0x84, 0xc3, 0x99, 0x9b, 0xb2, 0x4f, 0x4c, 0x66, 0xd3, 0x83, 0x28, 0x61, 0xdf, 0x99, 0x5f,
-	0xf4, 0x84, 0x6d, 0x71, 0x67, 0xc7, 0xc7, 0x4a, 0x82, 0xbf, 0x6b, 0x0a, 0x1e, 0x9f, 0x27, 0x09,
-	0xfe, 0xee, 0x7c, 0x80, 0x26, 0xb8, 0xcc, 0x3a, 0x09, 0xf8, 0x9e, 0xbc, 0xcf, 0x12, 0xd0, 0x36,
-	0x75, 0x97, 0x50, 0x1e, 0xca, 0x09, 0xfc, 0xde, 0x7c, 0x34, 0x27, 0x30, 0x2f, 0x04, 0x0c, 0xcb,
-	0x25, 0x94, 0xc1, 0xf7, 0x95, 0xd0, 0x29, 0xe5, 0x50, 0x48, 0xa8, 0xb8, 0x2e, 0xe4, 0xdb, 0x99,
-	0x57, 0x77, 0x4f, 0x96, 0xd0, 0x7d, 0x4a, 0x06, 0x57, 0x48, 0xc3, 0xf6, 0x0d, 0x8b, 0xd7, 0x42,
-	0x0d, 0x4a, 0x5c, 0x17, 0xbe, 0xbf, 0x84, 0xce, 0x28, 0xc9, 0x39, 0x19, 0x90, 0xdf, 0xd6, 0xf0,
-	0x03, 0x25, 0xf4, 0x20, 0xb8, 0x7f, 0x2a, 0x2d, 0x02, 0x52, 0x99, 0xe8, 0x83, 0x25, 0x74, 0x3b,
-	0x38, 0x5a, 0x38, 0x94, 0x17, 0x66, 0xf0, 0x43, 0x53, 0x6d, 0x8c, 0x8f, 0x09, 0xf8, 0xe1, 0xc9,
-	0xfb, 0x4c, 0x86, 0x17, 0xb6, 0x70, 0x83, 0x50, 0xf8, 0x54, 0x09, 0xbd, 0x04, 0xdc, 0x3b, 0x61,
-	0xc6, 0xb1, 0x34, 0x9c, 0x8c, 0x78, 0x7a, 0xb2, 0x33, 0x1c, 0x4c, 0xb1, 0x69, 0x12, 0x53, 0x1e,
-	0x14, 0xaf, 0xb0, 0x0d, 0x0b, 0x3e, 0x73, 0x0d, 0xf4, 0x39, 0x8f, 0xd0, 0x0d, 0x5f, 0x77, 0x31,
-	0xfc, 0x48, 0x3e, 0xc7, 0xa4, 0x3b, 0x99, 0xb8, 0xbc, 0x06, 0x17, 0xd8, 0x47, 0xf3, 0x11, 0xaa,
-	0x62, 0x94, 0x68, 0x36, 0xd5, 0x65, 0xfd, 0x00, 0x3f, 0x36, 0x9d, 0x67, 0x1b, 0x4e, 0xcb, 0x4e,
-	0xf8, 0x8f, 0x4f, 0xde, 0x9d, 0x3c, 0xc9, 0x13, 0xdd, 0x67, 0x9e, 0x63, 0x12, 0x97, 0xd9, 0x94,
-	0xc0, 0x4f, 0x94, 0xd0, 0x6d, 0xa0, 0x56, 0x08, 0xb3, 0x7a, 0x0b, 0x7e, 0xb2, 0x84, 0x4e, 0x2b,
-	0xc5, 0x64, 0xce, 0x01, 0xd8, 0x71, 0x88, 0xa5, 0xc3, 0x4f, 0x95, 0xd0, 0x09, 0x70, 0x4b, 0x16,
-	0xb5, 0xb5, 0x55, 0x86, 0x1b, 0x69, 0x11, 0x00, 0x9f, 0xcb, 0xc5, 0x97, 0x42, 0xc8, 0xcb, 0x8a,
-	0x0e, 0xbf, 0x5e, 0x42, 0xb7, 0x82, 0x9b, 0x0b, 0x40, 0x07, 0x37, 0x08, 0xfc, 0x46, 0x4e, 0xe5,
-	0xb8, 0x57, 0x98, 0x05, 0xbf, 0x59, 0x42, 0x2f, 0x1a, 0xbf, 0x3f, 0x24, 0xdd, 0x3c, 0x95, 0xf0,
-	0x8b, 0x93, 0x6d, 0xc1, 0x6f, 0xe5, 0x92, 0x4e, 0x0c, 0xad, 0x19, 0x94, 0x79, 0xd8, 0xcc, 0xb2,
-	0xdf, 0xce, 0xf9, 0x20, 0x66, 0x5d, 0x87, 0x68, 0x1e, 0xd7, 0x7c, 0x8d, 0xf8, 0xcc, 0x5e, 0x25,
-	0x16, 0xfc, 0x4e, 0x2e, 0x02, 0x62, 0xd4, 0xae, 0xbf, 0x82, 0x68, 0x0c, 0x7e, 0x77, 0x92, 0x8f,
-	0x3c, 0x97, 0x50, 0x91, 0x61, 0xbf, 0x37, 0x89, 0xc0, 0xfa, 0x9a, 0xe1, 0xda, 0x74, 0x03, 0x7e,
-	0x9f, 0x5f, 0x31, 0x6f, 0xcc, 0x10, 0x99, 0x7b, 0xe3, 0xa7, 0x0f, 0xa0, 0xa3, 0xe0, 0x48, 0xa6,
-	0x6f, 0x74, 0xfb, 0xbb, 0x52, 0x46, 0x4b, 0xe0, 0xb6, 0x4c, 0x97, 0xd3, 0x10, 0x15, 0xac, 0x2c,
-	0x63, 0x5b, 0xc4, 0x62, 0x2e, 0xbc, 0x5a, 0x56, 0x3c, 0x8b, 0xa9, 0xd6, 0x34, 0xd6, 0x44, 0x60,
-	0x1a, 0x16, 0xfc, 0xa7, 0x32, 0x3a, 0x0e, 0x8e, 0x65, 0xbb, 0x47, 0x45, 0xa3, 0x00, 0xfe, 0x59,
-	0x9d, 0xa3, 0xde, 0x10, 0xf7, 0x1c, 0xea, 0x37, 0x8d, 0x3a, 0xa1, 0x16, 0x66, 0x04, 0xfe, 0x8b,
-	0x3a, 0x47, 0xca, 0x08, 0x11, 0xff, 0x5a, 0x46, 0x77, 0x80, 0x5b, 0x33, 0xdd, 0x63, 0xd5, 0xb9,
-	0x40, 0xfe, 0x4d, 0x9d, 0x25, 0x39, 0xda, 0xb0, 0xe3, 0x98, 0x1b, 0x92, 0xf9, 0xf7, 0xb2, 0x1a,
-	0x8a, 0x31, 0x63, 0x62, 0x8f, 0xef, 0xde, 0x58, 0xd4, 0x7f, 0x94, 0xd1, 0x2d, 0xe0, 0xa6, 0x31,
-	0xa7, 0x08, 0x9f, 0x88, 0xce, 0xff, 0x2c, 0x2b, 0x4b, 0xc1, 0xa3, 0x72, 0x8d, 0x07, 0x3b, 0xcf,
-	0xdb, 0xd8, 0x34, 0xe1, 0x7f, 0x95, 0x95, 0xad, 0x36, 0x46, 0xb8, 0x8c, 0x12, 0xdc, 0x82, 0xff,
-	0x5d, 0x56, 0xf6, 0x84, 0xbb, 0xe1, 0x9a, 0x76, 0xa3, 0x91, 0xe8, 0xf0, 0x3f, 0xaa, 0xc5, 0xeb,
-	0xe2, 0x94, 0xd6, 0xc8, 0xc8, 0xf1, 0xff, 0xab, 0x3a, 0x5e, 0x88, 0x27, 0x96, 0x9e, 0x00, 0xaf,
-	0x9d, 0x29, 0x00, 0xb2, 0x6e, 0x7d, 0xdd, 0x8c, 0x62, 0xa8, 0x7c, 0x2a, 0x10, 0x37, 0x60, 0xf8,
-	0xc3, 0x19, 0x25, 0xdc, 0xe2, 0x4e, 0x21, 0x00, 0x3e, 0x3f, 0xa3, 0x66, 0x6d, 0xa3, 0xee, 0x9c,
-	0x5b, 0xc7, 0x66, 0xaa, 0xa3, 0x66, 0x5b, 0x16, 0xdf, 0xdd, 0x3f, 0x9a, 0x4a, 0xc6, 0x7f, 0xc0,
-	0x1f, 0xab, 0xfa, 0xba, 0xae, 0xe9, 0xdb, 0x0e, 0xb1, 0x78, 0x21, 0xbc, 0x46, 0x28, 0xfc, 0xc9,
-	0x8c, 0x92, 0x2a, 0xc6, 0x9c, 0x22, 0xda, 0x5d, 0x86, 0x29, 0x83, 0x3f, 0x9d, 0x51, 0x96, 0x20,
-	0xe3, 0x1a, 0xd1, 0xba, 0x8e, 0x4d, 0xf8, 0xb3, 0x19, 0x65, 0x37, 0x64, 0x21, 0x71, 0x11, 0xd7,
-	0x31, 0xc3, 0xf0, 0xe7, 0xaa, 0x56, 0x0d, 0xd7, 0x1d, 0xd3, 0xea, 0x85, 0x19, 0x65, 0xa9, 0xea,
-	0x8d, 0xb8, 0x94, 0x72, 0x9b, 0x1e, 0xd3, 0xed, 0x75, 0x0b, 0x7e, 0xa6, 0xa2, 0x6c, 0x9a, 0x11,
-	0xc2, 0xf5, 0xf5, 0x1c, 0xf8, 0xd9, 0x8a, 0x1a, 0xbf, 0xe2, 0xde, 0x27, 0x52, 0xdb, 0xe7, 0x2a,
-	0xea, 0xee, 0xe7, 0x55, 0x15, 0xaf, 0xe6, 0x1d, 0xdf, 0x73, 0x74, 0x1e, 0x3f, 0x9f, 0xaf, 0x28,
-	0xdb, 0x89, 0x9c, 0x27, 0x9a, 0xc7, 0x88, 0xdf, 0xc0, 0xac, 0x49, 0x28, 0xfc, 0x42, 0x45, 0xb1,
-	0x55, 0x9c, 0x66, 0x75, 0xcc, 0xb4, 0x66, 0x5a, 0x79, 0x5b, 0x0d, 0xf8, 0x6c, 0x45, 0xf1, 0x5b,
-	0x06, 0x23, 0x26, 0xd1, 0x04, 0xf4, 0xc5, 0x8a, 0x12, 0x69, 0x19, 0xc8, 0xb4, 0xb1, 0xce, 0x99,
-	0x2f, 0x15, 0xcf, 0xe7, 0x19, 0xa6, 0x9e, 0x9d, 0xef, 0xcb, 0xc5, 0xf3, 0x09, 0x2c, 0x9d, 0xef,
-	0x2b, 0x15, 0x65, 0x03, 0x65, 0x20, 0xfe, 0x27, 0xbf, 0x41, 0x1a, 0x96, 0x45, 0x28, 0xfc, 0xea,
-	0x35, 0x90, 0xb6, 0xc7, 0x08, 0x85, 0x5f, 0xab, 0x28, 0x47, 0xb8, 0x20, 0x1b, 0xd4, 0x5e, 0x97,
-	0x86, 0x10, 0x37, 0xab, 0xe6, 0x73, 0x15, 0xe5, 0x5c, 0xc8, 0xd3, 0x3a, 0xd1, 0x0c, 0x61, 0xf9,
-	0xd7, 0xa7, 0xb3, 0xa9, 0x65, 0xdf, 0xa8, 0x28, 0x67, 0x72, 0x9e, 0x95, 0xf7, 0x4d, 0x0e, 0x7f,
-	0xb3, 0xa2, 0x54, 0x35, 0x79, 0x98, 0x12, 0x07, 0x53, 0x66, 0xf0, 0xf3, 0x89, 0x8f, 0xf8, 0xd6,
-	0x3e, 0x46, 0x7a, 0xda, 0x2a, 0x61, 0x63, 0x46, 0x7e, 0x7b, 0x1f, 0xc5, 0x63, 0x3a, 0x55, 0xfc,
-	0x3b, 0x15, 0xa5, 0x84, 0xce, 0xb3, 0x94, 0xc8, 0x1a, 0x96, 0xe3, 0xdf, 0x55, 0x37, 0x70, 0x92,
-	0x77, 0x45, 0xfd, 0x2c, 0xa2, 0xec, 0x7b, 0x95, 0xdc, 0x71, 0x9a, 0x41, 0xe4, 0xa3, 0x8a, 0xd6,
-	0xc4, 0x56, 0x83, 0xc0, 0xef, 0x57, 0x94, 0xac, 0xd5, 0x3a, 0xe7, 0x8b, 0x83, 0xc0, 0xc2, 0x26,
-	0xfc, 0x5b, 0x35, 0x10, 0x5a, 0xe7, 0x7c, 0xc7, 0x63, 0x7e, 0x8b, 0xb8, 0x2e, 0x8f, 0xa5, 0xbf,
-	0x53, 0xe3, 0xac, 0x75, 0x2e, 0xcd, 0x3f, 0x7f, 0x5f, 0x41, 0x37, 0x8f, 0xbd, 0x63, 0xb6, 0xce,
-	0x89, 0x7c, 0x00, 0xff, 0xa1, 0xa2, 0x14, 0xeb, 0x69, 0x95, 0x53, 0x37, 0x18, 0xbf, 0x8f, 0xb9,
-	0x1a, 0xb6, 0xe0, 0x3f, 0xaa, 0x0e, 0x4c, 0xa9, 0xf8, 0x99, 0x47, 0xbe, 0x78, 0x0a, 0xf6, 0x07,
-	0x15, 0x25, 0xa9, 0xa4, 0xac, 0x5c, 0x70, 0xf8, 0xc3, 0x8a, 0x52, 0xeb, 0xf2, 0x5a, 0x59, 0xbe,
-	0x61, 0x8e, 0x05, 0xfe, 0xf3, 0xaa, 0xce, 0x0e, 0xb5, 0x5b, 0x36, 0x23, 0xf0, 0x47, 0x15, 0x25,
-	0x57, 0x16, 0x5c, 0x56, 0x75, 0x6a, 0x3b, 0xf0, 0xc7, 0x6a, 0xa8, 0xe6, 0x0a, 0x7a, 0x81, 0xfd,
-	0xa4, 0xa2, 0x9c, 0xd0, 0x2e, 0x5e, 0x21, 0xe9, 0xd5, 0x14, 0xfe, 0xb4, 0x82, 0x6a, 0xe0, 0x86,
-	0xb1, 0xf3, 0x4c, 0x3e, 0x4b, 0xc0, 0x9f, 0xa9, 0xa6, 0x66, 0x5e, 0x26, 0x75, 0xdb, 0x22, 0xf0,
-	0xe7, 0x6a, 0x72, 0xcc, 0x00, 0x32, 0x9d, 0xbf, 0xa0, 0xfa, 0xbf, 0x8e, 0x5d, 0x22, 0xee, 0xb9,
-	0x9e, 0xe3, 0xb3, 0x26, 0xb5, 0x19, 0x33, 0x09, 0x7c, 0xea, 0xa0, 0xa2, 0x02, 0xaf, 0x65, 0x4c,
-	0x42, 0x1c, 0xf8, 0xf4, 0x41, 0x65, 0x7c, 0x7a, 0x22, 0xcb, 0xe2, 0x40, 0x27, 0x26, 0xde, 0x80,
-	0xcf, 0x1c, 0x54, 0x0e, 0x3c, 0x5e, 0x42, 0x19, 0x26, 0x91, 0xc7, 0xe1, 0xeb, 0xab, 0x6a, 0x85,
-	0x12, 0xf7, 0xca, 0xf3, 0xf0, 0xd1, 0xaa, 0x9a, 0xa3, 0xb3, 0x0f, 0xb5, 0x42, 0xc2, 0x1b, 0xf6,
-	0x45, 0xb8, 0xbf, 0xe0, 0x1b, 0xab, 0x4a, 0x02, 0xcb, 0x21, 0xc9, 0xba, 0xbf, 0xa9, 0xaa, 0x24,
-	0xe1, 0x31, 0x52, 0xea, 0xf4, 0xe6, 0xaa, 0x12, 0x53, 0x79, 0x26, 0x11, 0xf7, 0x96, 0xaa, 0x12,
-	0x36, 0x9a, 0xed, 0x6c, 0x64, 0x74, 0x7f, 0x6b, 0x55, 0x5d, 0xc4, 0xb4, 0x5f, 0xce, 0xf5, 0xb6,
-	0xaa, 0xb2, 0x88, 0x3c, 0xaa, 0x25, 0x10, 0x97, 0xef, 0x6f, 0x57, 0x45, 0x8c, 0x88, 0x15, 0xd3,
-	0x73, 0x9b, 0xf0, 0x31, 0xd5, 0xf8, 0x11, 0x60, 0xb4, 0x5a, 0x44, 0x37, 0x78, 0x0c, 0x09, 0x37,
-	0xbd, 0x43, 0x35, 0x7e, 0x44, 0x3a, 0x94, 0xac, 0x10, 0xa6, 0x35, 0xe1, 0x15, 0xd5, 0xa2, 0x11,
-	0x23, 0x2c, 0xba, 0x3a, 0xb9, 0x5f, 0xcc, 0xf1, 0xf8, 0xe4, 0x39, 0xe2, 0xf7, 0x0f, 0x02, 0x9f,
-	0x98, 0x6c, 0x92, 0xf4, 0xca, 0x3b, 0xab, 0xca, 0xf9, 0xa6, 0xbb, 0x2d, 0xde, 0x6f, 0xfa, 0xaf,
-	0x24, 0xd4, 0x8e, 0xa1, 0x77, 0x55, 0xd5, 0x9b, 0x19, 0xbf, 0x9a, 0x0a, 0x29, 0x58, 0xd7, 0x99,
-	0xcd, 0x85, 0xea, 0x06, 0x95, 0x6a, 0xbf, 0xfb, 0x1a, 0x61, 0x61, 0xc3, 0x7b, 0xaa, 0xea, 0x45,
-	0xb5, 0x18, 0x96, 0x7a, 0xbc, 0xb7, 0x9a, 0xab, 0x8e, 0x13, 0x3a, 0x4e, 0x60, 0x42, 0x83, 0xf7,
-	0x4d, 0xc5, 0xc4, 0xdc, 0x4f, 0x56, 0xd5, 0xcb, 0xba, 0x8a, 0xc9, 0x59, 0xdf, 0x5f, 0x55, 0x5f,
-	0x63, 0x52, 0x8e, 0x12, 0x91, 0x09, 0xc6, 0xec, 0xff, 0x40, 0x55, 0x7d, 0xe7, 0x48, 0x5f, 0xb0,
-	0xe4, 0x86, 0xce, 0x26, 0x0f, 0xae, 0xc7, 0x07, 0xf3, 0x3e, 0x18, 0x1f, 0x90, 0x3c, 0x42, 0x0b,
-	0xfa, 0x43, 0x79, 0x6d, 0x8a, 0x69, 0xa9, 0xfc, 0x87, 0xab, 0xea, 0x2b, 0x8e, 0x82, 0x0b, 0xa1,
-	0x4f, 0xa9, 0x1b, 0x5b, 0xa5, 0xd2, 0x0d, 0xf5, 0x74, 0x75, 0xc2, 0x05, 0x25, 0x21, 0xe5, 0xb4,
-	0xcf, 0xa8, 0x99, 0x24, 0xfb, 0xa2, 0x2e, 0xfd, 0xf4, 0x91, 0x7d, 0x11, 0xa1, 0xd6, 0x47, 0xd5,
-	0x1d, 0x3e, 0x86, 0xc8, 0x99, 0x3e, 0x56, 0xcd, 0xdd, 0x65, 0x6c, 0xaa, 0xa7, 0xaf, 0x63, 0x72,
-	0xae, 0x8f, 0x57, 0x73, 0xe9, 0x75, 0x0c, 0x92, 0xa2, 0x3e, 0xa1, 0x2e, 0x44, 0x42, 0x25, 0x36,
-	0x26, 0xae, 0x15, 0x32, 0x3f, 0x59, 0x9d, 0x76, 0x2a, 0x09, 0xec, 0x53, 0xea, 0x7a, 0x15, 0x60,
-	0xe2, 0x49, 0x43, 0x9a, 0xfc, 0x3b, 0x53, 0xa5, 0x0a, 0xec, 0x77, 0xd5, 0xbd, 0x9b, 0xc3, 0xa4,
-	0x49, 0x9f, 0x56, 0xe3, 0xdf, 0x35, 0xa9, 0x27, 0xb3, 0x99, 0x14, 0xf4, 0x7b, 0x55, 0xe5, 0xe6,
-	0x2d, 0x00, 0xa1, 0xf9, 0xef, 0x17, 0x76, 0x89, 0x51, 0x7f, 0x50, 0x55, 0x6a, 0x14, 0xd1, 0x25,
-	0xa7, 0xfc, 0x43, 0x35, 0x6d, 0xf1, 0x13, 0x58, 0x56, 0xb8, 0x42, 0xec, 0x1f, 0x4d, 0xee, 0x17,
-	0xb2, 0xff, 0x38, 0xa7, 0x72, 0xda, 0x2f, 0x27, 0xf8, 0x93, 0xaa, 0x52, 0xc5, 0x30, 0xa3, 0x45,
-	0x4c, 0xc3, 0x22, 0x7e, 0xd3, 0xe0, 0x9e, 0xdc, 0xc8, 0xe4, 0xc8, 0x3f, 0x55, 0x93, 0x51, 0x31,
-	0x2b, 0x05, 0xff, 0x99, 0xea, 0xfb, 0x1c, 0x2c, 0x0c, 0xf8, 0xf3, 0xa9, 0x98, 0x98, 0xfa, 0x2f,
-	0xd4, 0x25, 0xca, 0x61, 0x72, 0xd6, 0xbf, 0x54, 0x37, 0x39, 0x5b, 0xb7, 0xe5, 0x0f, 0x75, 0xa3,
-	0xa3, 0xe0, 0xaf, 0xf6, 0x67, 0xc4, 0x7c, 0x7f, 0xad, 0x06, 0xc2, 0x38, 0x23, 0x27, 0xfb, 0x1b,
-	0x35, 0x39, 0xad, 0x63, 0x33, 0xbe, 0x50, 0x16, 0x1b, 0xfb, 0x19, 0x75, 0x66, 0xf1, 0x83, 0xb1,
-	0x6d, 0x33, 0x97, 0xd1, 0x24, 0x4c, 0x3f, 0x5b, 0x2d, 0xb8, 0xcb, 0x8e, 0x18, 0x39, 0xf3, 0xe7,
-	0xd4, 0xea, 0x84, 0x43, 0xe2, 0x8c, 0x16, 0xf3, 0x7c, 0x7e, 0x62, 0xb7, 0x98, 0xe2, 0x0b, 0xea,
-	0xa6, 0x49, 0xbb, 0xa5, 0xf4, 0x67, 0x8b, 0x86, 0x8b, 0xdf, 0x18, 0xc5, 0xf0, 0x2f, 0x16, 0x0d,
-	0x17, 0xdd, 0x72, 0xf8, 0x97, 0xaa, 0x4a, 0x61, 0xb6, 0x1e, 0xff, 0xca, 0x0e, 0xbf, 0x5c, 0xd4,
-	0x23, 0x64, 0x7e, 0x45, 0x5d, 0xdf, 0xa4, 0xc7, 0x6f, 0x11, 0xd6, 0xb4, 0x75, 0x1f, 0xbb, 0xae,
-	0xd1, 0xb0, 0xe0, 0x57, 0xd5, 0x30, 0x4a, 0xdf, 0x38, 0xe0, 0xd7, 0x54, 0xc7, 0x89, 0xef, 0x00,
-	0xf8, 0x28, 0xee, 0x40, 0x4c, 0xa9, 0x41, 0x28, 0x7c, 0xae, 0xaa, 0x14, 0x7d, 0x86, 0x2d, 0x7f,
-	0xa0, 0x11, 0x5a, 0x5c, 0xb1, 0x94, 0xf5, 0xc1, 0x1e, 0xb5, 0x29, 0x16, 0xca, 0x27, 0x8f, 0x28,
-	0x57, 0x2d, 0x65, 0x9a, 0x84, 0xf1, 0xac, 0xf8, 0xf7, 0x1a, 0xc3, 0x82, 0x8f, 0x5b, 0x6a, 0xed,
-	0x67, 0x30, 0xcf, 0x8d, 0x5f, 0x89, 0xf9, 0xed, 0xc6, 0x85, 0x4f, 0x58, 0x4b, 0x33, 0xb3, 0x6d,
-	0xd8, 0x5e, 0x7a, 0xf6, 0x00, 0x38, 0x3a, 0xf1, 0xb3, 0x31, 0x74, 0x37, 0x58, 0x8c, 0x3f, 0x0d,
-	0x53, 0xbe, 0xd4, 0x3a, 0x2c, 0x9b, 0x8d, 0xe4, 0x7b, 0xad, 0xec, 0xb7, 0x57, 0x07, 0xc6, 0xbf,
-	0xbd, 0x52, 0xbf, 0xa8, 0x2a, 0xe7, 0xbf, 0xa8, 0xba, 0x03, 0xcc, 0x0f, 0xc2, 0x6d, 0xf9, 0xb9,
-	0xd3, 0xe8, 0xeb, 0xad, 0xb9, 0xa4, 0x8d, 0x23, 0xa7, 0x01, 0x4c, 0x3e, 0x9c, 0x49, 0x55, 0xa9,
-	0x08, 0x55, 0x16, 0xe3, 0xf6, 0x54, 0x97, 0x07, 0x01, 0x10, 0x1f, 0x14, 0x85, 0x6d, 0x3f, 0x88,
-	0xc4, 0x07, 0x5c, 0x53, 0xbe, 0x48, 0x8a, 0x69, 0x1c, 0xa1, 0xdb, 0x01, 0x08, 0x76, 0xa3, 0x9e,
-	0x34, 0x2e, 0xfe, 0xae, 0x2b, 0xd3, 0x82, 0x8e, 0x80, 0x4a, 0xd4, 0x0b, 0x86, 0x91, 0xf8, 0xa4,
-	0x6b, 0x96, 0xca, 0x7f, 0x96, 0x9e, 0x9c, 0x01, 0x37, 0x4f, 0xf8, 0x14, 0xee, 0xda, 0x3d, 0x68,
-	0x81, 0x4a, 0x7f, 0x2b, 0x18, 0x86, 0xc2, 0x7d, 0x87, 0xcf, 0x3e, 0xf0, 0xff, 0xf9, 0xe0, 0x2e,
-	0x69, 0xe7, 0xe3, 0xa9, 0x14, 0x83, 0x4e, 0x82, 0xc5, 0xad, 0x30, 0xe8, 0xfb, 0x17, 0xb6, 0x1f,
-	0x1e, 0xfa, 0x51, 0x2f, 0x0a, 0xb6, 0x85, 0xe7, 0xcb, 0x74, 0x81, 0x37, 0xd7, 0xb7, 0x1f, 0x1e,
-	0x32, 0xde, 0x88, 0xee, 0x01, 0xd7, 0x8f, 0xb8, 0xe1, 0x66, 0xd0, 0xed, 0x86, 0x6d, 0xb1, 0x00,
-	0x65, 0xba, 0x98, 0x90, 0xae, 0x6c, 0x46, 0x67, 0x00, 0x1a, 0xb1, 0x52, 0xff, 0xb0, 0x2d, 0x96,
-	0xa1, 0x4c, 0x61, 0x02, 0xaf, 0xc5, 0xed, 0x9c, 0xee, 0x74, 0xdb, 0xe1, 0x5e, 0x4c, 0xfa, 0x9b,
-	0xbd, 0xdd, 0xae, 0x5c, 0x8f, 0x32, 0x85, 0xa2, 0x47, 0xa2, 0x1a, 0x6f, 0xe7, 0xfa, 0xee, 0x04,
-	0x7b, 0x7e, 0x3b, 0x0c, 0xda, 0x7e, 0xb4, 0xdb, 0xdf, 0x0e, 0x87, 0xc2, 0xff, 0x65, 0xba, 0xb0,
-	0x13, 0xec, 0xe9, 0x61, 0xd0, 0x66, 0xa2, 0x91, 0x73, 0xdd, 0xdd, 0x9d, 0x31, 0x6e, 0x56, 0x72,
-	0xdd, 0xdd, 0x9d, 0x11, 0xb7, 0xf4, 0x68, 0x09, 0xcc, 0x65, 0xdc, 0x82, 0x20, 0x98, 0xe7, 0x59,
-	0x42, 0xfc, 0x48, 0x6e, 0x58, 0x0d, 0x78, 0x1d, 0x5a, 0x00, 0x87, 0xc4, 0xd7, 0x03, 0x4d, 0x82,
-	0x1d, 0x58, 0xe2, 0x40, 0xfc, 0x90, 0x2c, 0xae, 0xce, 0xf0, 0x00, 0x5a, 0x04, 0x73, 0x71, 0x8b,
-	0x40, 0xca, 0xe8, 0x7a, 0xb0, 0x20, 0xaf, 0xd5, 0x9a, 0x49, 0xb0, 0xe5, 0x39, 0x70, 0x06, 0xcd,
-	0x83, 0xd9, 0xb4, 0xa0, 0xaa, 0x70, 0x60, 0xc5, 0xe0, 0x11, 0x9f, 0x00, 0x07, 0x2f, 0x1c, 0x14,
-	0x3b, 0xee, 0x65, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x45, 0xcf, 0x3c, 0x3b, 0x2b, 0x00,
-	0x00,
+	// 3936 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x9a, 0xf9, 0x77, 0xdb, 0xc6,
+	0x9d, 0xc0, 0x43, 0x53, 0x34, 0xa5, 0x91, 0x64, 0x8d, 0x27, 0x8e, 0x4d, 0x3b, 0x87, 0x6d, 0xc5,
+	0xf1, 0x15, 0x57, 0x69, 0xdd, 0xbc, 0x6d, 0xf2, 0x76, 0xf7, 0xed, 0x1b, 0x02, 0x23, 0x12, 0x15,
+	0x08, 0xc0, 0x03, 0x40, 0xb2, 0xfa, 0x0b, 0x1e, 0x4c, 0x22, 0x16, 0x6b, 0x89, 0x64, 0x48, 0x48,
+	0x91, 0xbb, 0x57, 0xba, 0xdb, 0xf4, 0x3e, 0xe2, 0xa4, 0x67, 0x7a, 0x25, 0xe9, 0xbd, 0xdb, 0xbd,
+	0x8f, 0xee, 0xd1, 0xb4, 0x4d, 0xcf, 0xf4, 0x6e, 0xf7, 0xea, 0x7d, 0xfc, 0x11, 0x7b, 0xdf, 0x6f,
+	0x66, 0x00, 0x10, 0x1c, 0x80, 0xa2, 0xfb, 0x8b, 0x9e, 0x30, 0xf3, 0x99, 0xef, 0x7c, 0xe7, 0x7b,
+	0xcc, 0xc5, 0x01, 0xc7, 0x9b, 0xdd, 0xad, 0x9e, 0xdf, 0x0c, 0x3d, 0xbf, 0x19, 0xb6, 0x77, 0xda,
+	0xe1, 0x75, 0x6f, 0xd0, 0xf1, 0x7b, 0x83, 0x8d, 0x6e, 0xb8, 0xd4, 0xeb, 0x77, 0xc3, 0x2e, 0xba,
+	0xb5, 0x77, 0xd5, 0xef, 0xf8, 0x9b, 0xd7, 0x5f, 0x15, 0x2c, 0x35, 0xbb, 0x9b, 0x9b, 0x41, 0x33,
+	0xec, 0xf6, 0x8f, 0x1d, 0xbf, 0xda, 0xed, 0x5e, 0xdd, 0x0c, 0xee, 0xe3, 0xc8, 0x95, 0xed, 0x87,
+	0xee, 0x0b, 0xdb, 0x5b, 0xc1, 0x20, 0xf4, 0xb7, 0x7a, 0xa2, 0xd5, 0xb1, 0xb9, 0xc1, 0x86, 0xdf,
+	0x0f, 0x5a, 0xe2, 0x6b, 0xf1, 0xd1, 0x12, 0x38, 0xa2, 0x88, 0x7e, 0x70, 0xd4, 0x8d, 0x1d, 0xf5,
+	0x82, 0x4c, 0x00, 0x7b, 0xdd, 0x41, 0x78, 0xb5, 0x1f, 0x0c, 0xbc, 0x9d, 0xa0, 0x3f, 0x68, 0x77,
+	0x3b, 0x95, 0xc2, 0x89, 0xc2, 0xd9, 0xd9, 0x8b, 0xa7, 0x96, 0x72, 0xba, 0x5e, 0xb2, 0x22, 0x78,
+	0x55, 0xb0, 0x74, 0xa1, 0x37, 0x5a, 0x80, 0x1e, 0x00, 0xd3, 0x57, 0xfc, 0xe6, 0xb5, 0xa0, 0xd3,
+	0x1a, 0x54, 0xf6, 0x9d, 0x28, 0x9e, 0x9d, 0xbd, 0x78, 0x47, 0xae, 0xa0, 0xaa, 0x80, 0x68, 0x42,
+	0x23, 0x17, 0x1c, 0xed, 0xf5, 0x83, 0x9d, 0xac, 0x29, 0x3c, 0x3f, 0xac, 0x14, 0xb9, 0x4e, 0xc7,
+	0x96, 0xc4, 0xc8, 0x97, 0xe2, 0x91, 0x2f, 0x39, 0xf1, 0xc8, 0xe9, 0x61, 0xd6, 0x58, 0x1e, 0x1f,
+	0x0e, 0x51, 0x0f, 0xdc, 0xb1, 0xe3, 0x37, 0xb7, 0xb7, 0xb7, 0xbc, 0x5e, 0xbf, 0xcb, 0x34, 0x1d,
+	0x78, 0xed, 0xce, 0x43, 0xdd, 0xfe, 0x96, 0x1f, 0xb6, 0xbb, 0x9d, 0x41, 0x05, 0x70, 0x25, 0x97,
+	0x72, 0x95, 0x5c, 0xe5, 0x0d, 0xad, 0xa8, 0x9d, 0x36, 0x6c, 0x46, 0x8f, 0xed, 0x8c, 0xab, 0x1a,
+	0xa0, 0x57, 0x82, 0x63, 0x72, 0x8f, 0x83, 0xd0, 0x0f, 0xdb, 0x83, 0xb0, 0xdd, 0x1c, 0x54, 0x66,
+	0x79, 0x7f, 0x17, 0x6e, 0xa2, 0x3f, 0x3b, 0x6e, 0x44, 0x2b, 0x3b, 0xf9, 0x15, 0x03, 0x54, 0x05,
+	0xd3, 0x71, 0x27, 0x95, 0x39, 0x2e, 0xf9, 0x74, 0xae, 0x64, 0xb3, 0x17, 0xf4, 0xb9, 0x7a, 0xb1,
+	0x0c, 0x9a, 0xb4, 0x43, 0x3e, 0x38, 0x12, 0xec, 0x36, 0x37, 0xb7, 0x5b, 0x41, 0xcb, 0x8b, 0xbc,
+	0xe1, 0x35, 0xbb, 0xdb, 0x9d, 0x70, 0x50, 0x99, 0xe7, 0x22, 0xcf, 0xe5, 0x8a, 0x24, 0x51, 0x9b,
+	0xc8, 0x93, 0x0a, 0x6b, 0x41, 0x6f, 0x0b, 0x72, 0x4a, 0x07, 0x8b, 0x26, 0x38, 0x94, 0x87, 0xa3,
+	0x93, 0x60, 0x2e, 0xee, 0x31, 0xbc, 0xde, 0x0b, 0x78, 0xe8, 0xcd, 0xd0, 0xd9, 0xa8, 0xcc, 0xb9,
+	0xde, 0x0b, 0xd0, 0x21, 0x50, 0xe2, 0xca, 0x54, 0xf6, 0x9d, 0x28, 0x9c, 0x2d, 0x51, 0xf1, 0xb1,
+	0xf8, 0xa9, 0x15, 0x50, 0x8e, 0x24, 0xa1, 0x63, 0x60, 0xba, 0xdd, 0x0a, 0x3a, 0x61, 0x3b, 0xbc,
+	0xce, 0x05, 0x4c, 0xd1, 0xe4, 0x1b, 0x41, 0x50, 0xec, 0xb5, 0x5b, 0x51, 0x5b, 0xf6, 0x2f, 0x3a,
+	0x01, 0xe6, 0x36, 0xfc, 0x81, 0xd7, 0xef, 0x6e, 0x06, 0x5e, 0xbb, 0xb5, 0xcb, 0x23, 0x6b, 0x9a,
+	0x82, 0x0d, 0x7f, 0x40, 0xbb, 0x9b, 0x81, 0xd6, 0xda, 0x45, 0x47, 0xc1, 0x74, 0x52, 0x3b, 0xc5,
+	0x1b, 0x96, 0xfb, 0x51, 0xd5, 0x59, 0x00, 0x59, 0xe3, 0x96, 0x1f, 0xfa, 0x57, 0xfc, 0x81, 0x40,
+	0x4a, 0x5c, 0xc0, 0x81, 0x0d, 0x7f, 0xa0, 0x46, 0xc5, 0x8c, 0x3c, 0x09, 0xe6, 0x46, 0xa8, 0xfd,
+	0x5c, 0xd0, 0x6c, 0x2b, 0x85, 0x2c, 0x82, 0x79, 0x26, 0xec, 0xe1, 0xed, 0xa0, 0x7f, 0x9d, 0x33,
+	0x65, 0x2e, 0x69, 0x76, 0xc3, 0x1f, 0x5c, 0x62, 0x65, 0x8c, 0xb9, 0x1d, 0xcc, 0x0c, 0xeb, 0xa7,
+	0xb9, 0x8c, 0xe9, 0x87, 0xe3, 0xca, 0x3b, 0x01, 0x10, 0x95, 0x61, 0xb0, 0x1b, 0x56, 0x66, 0xb8,
+	0xed, 0x04, 0xee, 0x04, 0xbb, 0x21, 0x3a, 0x07, 0xa0, 0xdf, 0xeb, 0x6d, 0xb6, 0x9b, 0xdc, 0xf1,
+	0x5e, 0xc7, 0xdf, 0x0a, 0x2a, 0x80, 0x43, 0x0b, 0xa9, 0x72, 0xc3, 0xdf, 0x0a, 0xd0, 0x71, 0x30,
+	0xdb, 0xdc, 0x6c, 0x07, 0x9d, 0xd0, 0xf3, 0x5b, 0xad, 0x7e, 0x65, 0x96, 0x53, 0x40, 0x14, 0xe1,
+	0x56, 0xab, 0x9f, 0x02, 0x7a, 0xdd, 0x7e, 0x58, 0x99, 0xe3, 0x9a, 0x44, 0x80, 0xd5, 0xed, 0x87,
+	0xe8, 0xd7, 0xc0, 0x7c, 0xec, 0xc9, 0x41, 0xe8, 0xf7, 0xc3, 0xca, 0xfc, 0xc4, 0x8c, 0x8d, 0x5d,
+	0x6f, 0x33, 0x1e, 0x3d, 0x08, 0xc0, 0x2e, 0x9b, 0x09, 0x45, 0xeb, 0x03, 0x13, 0x5b, 0xcf, 0x30,
+	0x5a, 0x34, 0xfd, 0x65, 0x30, 0x2b, 0xec, 0x20, 0xda, 0x2e, 0x4c, 0x6c, 0x2b, 0xcc, 0x26, 0x1a,
+	0xff, 0x2a, 0x98, 0x63, 0xd9, 0x19, 0x78, 0xcd, 0x0d, 0xbf, 0x73, 0x35, 0xa8, 0xc0, 0x89, 0xad,
+	0x67, 0x39, 0xaf, 0x70, 0x1c, 0x55, 0x40, 0xf9, 0x11, 0xbf, 0x1d, 0xb6, 0x3b, 0x57, 0x2b, 0x07,
+	0xb9, 0xfb, 0xe2, 0x4f, 0x16, 0xb8, 0x1c, 0xac, 0x20, 0x6e, 0x4d, 0xf1, 0x81, 0x4e, 0x83, 0x05,
+	0x06, 0x78, 0xc1, 0x0e, 0x33, 0x26, 0x0f, 0xfa, 0x5b, 0x79, 0xfd, 0x3c, 0x2b, 0x26, 0xac, 0x94,
+	0x87, 0xfd, 0x9d, 0x00, 0x0c, 0xb9, 0xca, 0x21, 0xe1, 0xdb, 0x04, 0xc9, 0x24, 0xce, 0x6d, 0xd9,
+	0xc4, 0x39, 0x03, 0x16, 0xa2, 0xe8, 0xe8, 0x6f, 0x77, 0x9a, 0x7e, 0x18, 0xb4, 0x2a, 0x87, 0x45,
+	0xa8, 0xf2, 0x62, 0x27, 0x2e, 0x5d, 0xbc, 0xb1, 0x0f, 0xcc, 0xaf, 0x8d, 0x74, 0x7e, 0x1b, 0x38,
+	0x68, 0xd5, 0xbc, 0x35, 0xac, 0x39, 0x9e, 0x6b, 0xa8, 0x64, 0x59, 0x33, 0x88, 0x0a, 0x6f, 0x41,
+	0x15, 0x70, 0x28, 0x2e, 0xd6, 0xd7, 0x74, 0x53, 0x59, 0xf1, 0x0c, 0xdc, 0x20, 0x2a, 0x2c, 0xa0,
+	0x63, 0xe0, 0xb0, 0x54, 0xe3, 0x50, 0x6c, 0x28, 0x75, 0x02, 0xf7, 0x21, 0x08, 0xe6, 0x92, 0x3a,
+	0x53, 0x59, 0x81, 0x45, 0x74, 0x18, 0xa0, 0xb8, 0xa4, 0xea, 0x2e, 0x2f, 0x13, 0xea, 0x59, 0x9a,
+	0x01, 0xa7, 0x10, 0x02, 0x07, 0x46, 0xa5, 0xc0, 0x12, 0x3a, 0x04, 0x60, 0x5c, 0x86, 0x15, 0x47,
+	0x5b, 0xd5, 0x9c, 0x75, 0xb8, 0x3f, 0x4d, 0x2a, 0xba, 0x46, 0x0c, 0x07, 0x96, 0xd3, 0x4a, 0x93,
+	0xcb, 0x0e, 0x31, 0x6c, 0xcd, 0x34, 0xe0, 0x34, 0x5a, 0x00, 0xb3, 0x71, 0xb1, 0x66, 0x29, 0x70,
+	0x06, 0xdd, 0x0a, 0x16, 0xe2, 0x02, 0x47, 0x6b, 0x10, 0xd3, 0x75, 0x20, 0x40, 0x07, 0x00, 0x48,
+	0x28, 0x13, 0xce, 0x2e, 0x7e, 0xaf, 0x0a, 0x66, 0x12, 0x9b, 0x30, 0x85, 0x85, 0xdc, 0x55, 0x62,
+	0x30, 0x93, 0xac, 0x18, 0xe6, 0x9a, 0x01, 0x6f, 0x41, 0xa7, 0xc1, 0x62, 0xaa, 0x3c, 0x1a, 0xb9,
+	0x5d, 0x6f, 0x90, 0x86, 0xa7, 0x19, 0x2a, 0xb9, 0x2c, 0x06, 0x1c, 0xa0, 0x45, 0x70, 0x57, 0x96,
+	0x33, 0x35, 0xd5, 0xab, 0x11, 0x43, 0x30, 0x0f, 0xe5, 0x33, 0x97, 0xd3, 0xcc, 0x55, 0x74, 0x0f,
+	0x38, 0x99, 0x65, 0x2c, 0x6a, 0x2a, 0x1e, 0xa6, 0x14, 0xaf, 0x0b, 0x6c, 0x03, 0x9d, 0x01, 0x77,
+	0xe7, 0xa8, 0xe5, 0x69, 0xc6, 0x2a, 0xd6, 0x3d, 0x4a, 0xb0, 0x2a, 0xc0, 0x36, 0x3a, 0x0b, 0x4e,
+	0x8d, 0x07, 0xd7, 0xa8, 0xe6, 0x10, 0x41, 0xbe, 0x12, 0x9d, 0x07, 0xa7, 0xb3, 0xe4, 0x1a, 0xd6,
+	0x99, 0x03, 0xbd, 0x06, 0xb6, 0x2c, 0xcd, 0xa8, 0x09, 0xf6, 0x1a, 0x3a, 0x05, 0x4e, 0xe4, 0xb3,
+	0x29, 0x89, 0x9b, 0xf9, 0x4a, 0x2a, 0xa6, 0xe1, 0x50, 0x53, 0xf7, 0x96, 0x35, 0x3d, 0x02, 0xb7,
+	0xf2, 0x07, 0xad, 0xd4, 0x89, 0xb2, 0x62, 0x99, 0x9a, 0x11, 0x05, 0x55, 0x27, 0x7f, 0x2c, 0x8a,
+	0xa7, 0x9b, 0xb5, 0x44, 0x2a, 0x27, 0xbb, 0xe8, 0x5e, 0x70, 0x26, 0x67, 0xd4, 0x6e, 0x95, 0x85,
+	0xac, 0x3d, 0x0a, 0xf7, 0xd0, 0x39, 0x70, 0x4f, 0x16, 0x6e, 0xb8, 0xba, 0xa3, 0x79, 0x97, 0xb1,
+	0xe2, 0x0c, 0xbd, 0xf3, 0x30, 0xba, 0x1f, 0xbc, 0x78, 0x4f, 0xd4, 0x5c, 0x5e, 0xb6, 0x89, 0x33,
+	0xda, 0x41, 0x7f, 0x62, 0xab, 0x06, 0x69, 0x54, 0x09, 0x1d, 0x6d, 0x35, 0xc8, 0x57, 0x8b, 0x12,
+	0xdd, 0x53, 0xb0, 0x52, 0x27, 0x9e, 0x66, 0xc4, 0xd9, 0x16, 0xa2, 0x0b, 0xe0, 0xec, 0x5e, 0xf6,
+	0xe3, 0xb2, 0x1b, 0x0d, 0x41, 0x6f, 0xe7, 0x3b, 0xda, 0x59, 0x33, 0x3d, 0xab, 0x8e, 0x6d, 0xe2,
+	0xd9, 0x0e, 0x8e, 0x5d, 0xb8, 0x93, 0x2f, 0xd9, 0xc1, 0x55, 0x9d, 0xd8, 0x16, 0x56, 0x88, 0xa7,
+	0x50, 0x92, 0xd0, 0x8f, 0xe4, 0x3b, 0xbc, 0xea, 0x50, 0x42, 0xbc, 0x55, 0xac, 0xb8, 0x6e, 0xa4,
+	0xc2, 0x6e, 0xbe, 0x7f, 0xb0, 0xaa, 0x6a, 0x46, 0x92, 0x5b, 0xf1, 0xe8, 0xae, 0xe7, 0x47, 0x07,
+	0x76, 0x1d, 0x33, 0x2d, 0xf3, 0x55, 0x68, 0x09, 0x9c, 0xdf, 0x13, 0xb3, 0x95, 0x3a, 0x51, 0xdd,
+	0x38, 0xe8, 0x7e, 0x3d, 0x3f, 0x86, 0xed, 0x75, 0x43, 0xf1, 0x6c, 0x05, 0x47, 0x1e, 0xff, 0x8d,
+	0x7c, 0x4d, 0x29, 0xd1, 0xb1, 0xa3, 0x99, 0xc6, 0x68, 0x5a, 0xfc, 0x66, 0xbe, 0x48, 0xcc, 0x65,
+	0x2a, 0x4e, 0xe4, 0xd8, 0xdf, 0xca, 0x9f, 0x52, 0x04, 0x75, 0xc9, 0x25, 0x6e, 0xa4, 0xe0, 0x6f,
+	0xa3, 0x8b, 0xe0, 0x45, 0x39, 0x0a, 0x12, 0xaa, 0x61, 0x5d, 0x7b, 0x05, 0x73, 0x81, 0x88, 0x9e,
+	0x3a, 0xb6, 0xeb, 0xa2, 0xc9, 0xa3, 0x05, 0xf4, 0x4b, 0xe0, 0x25, 0x13, 0xda, 0x2c, 0x6b, 0x86,
+	0x66, 0xd7, 0x89, 0xea, 0xe9, 0x9a, 0x1d, 0x99, 0xf8, 0xd5, 0x05, 0xf4, 0x2b, 0xe0, 0x65, 0x13,
+	0xda, 0x59, 0x94, 0xa8, 0x9a, 0x12, 0x3b, 0x3b, 0xd5, 0xfa, 0x77, 0x0a, 0xe8, 0x4c, 0xde, 0x88,
+	0x4c, 0x5d, 0x65, 0x12, 0xf8, 0x04, 0xc7, 0xc1, 0xdf, 0x2d, 0xa0, 0x53, 0xe0, 0xf8, 0x18, 0x9b,
+	0x53, 0x62, 0x09, 0xea, 0x35, 0x05, 0xf4, 0xa2, 0xbc, 0xa0, 0xab, 0x62, 0x65, 0xa5, 0x46, 0x4d,
+	0xd7, 0x50, 0xbd, 0x35, 0x93, 0xae, 0x10, 0x2a, 0xf0, 0xc7, 0x0a, 0xe8, 0x01, 0xf0, 0xd2, 0x2c,
+	0xae, 0xae, 0x1b, 0xb8, 0xa1, 0x29, 0x9e, 0x5d, 0xc7, 0x54, 0x65, 0x19, 0x66, 0xd2, 0xf5, 0xd1,
+	0x0c, 0x7b, 0x6d, 0x01, 0xdd, 0x93, 0xeb, 0x2f, 0xd7, 0x31, 0x53, 0xb3, 0xd3, 0xeb, 0x0a, 0xe8,
+	0x65, 0xe0, 0x62, 0x5e, 0x0c, 0x58, 0xba, 0xa6, 0x88, 0x30, 0xb0, 0x75, 0xd3, 0xf1, 0xb0, 0xae,
+	0x9b, 0xd1, 0x37, 0x6f, 0xf8, 0xfa, 0x02, 0xba, 0x1f, 0xdc, 0x77, 0x13, 0x0d, 0x47, 0xb4, 0x7a,
+	0xc3, 0x98, 0xe1, 0xb3, 0x04, 0xd6, 0x1c, 0xcf, 0x91, 0x66, 0xaf, 0x37, 0x8e, 0x19, 0xc4, 0x10,
+	0xe7, 0xd8, 0x9b, 0x0a, 0x68, 0x09, 0x9c, 0xdb, 0x5b, 0x17, 0x93, 0x6a, 0x35, 0x2d, 0xd2, 0xfd,
+	0xcd, 0x05, 0xf4, 0x12, 0x70, 0x61, 0xcf, 0x49, 0xcb, 0xa1, 0xae, 0x91, 0x1e, 0xee, 0x5b, 0xc6,
+	0x34, 0xe1, 0x61, 0x60, 0x60, 0xcb, 0xae, 0x9b, 0x62, 0x35, 0x66, 0x49, 0x23, 0x9a, 0xbc, 0xb5,
+	0x80, 0xce, 0x83, 0x7b, 0xf2, 0x5d, 0x4d, 0x0c, 0xd5, 0xa3, 0xd8, 0x50, 0xcd, 0x28, 0xbf, 0xdf,
+	0x36, 0x66, 0x04, 0xba, 0x59, 0xd3, 0x14, 0xbe, 0xe6, 0x59, 0x23, 0x71, 0xf1, 0x78, 0x01, 0xdd,
+	0x9b, 0x37, 0xcf, 0x29, 0x6c, 0xb5, 0x90, 0x75, 0xbf, 0x51, 0x40, 0xa7, 0xa5, 0x49, 0x26, 0xda,
+	0xdc, 0x08, 0x5e, 0x6c, 0x61, 0x6c, 0xf8, 0x44, 0x56, 0xe1, 0x84, 0xe3, 0x06, 0x77, 0xec, 0x84,
+	0x7d, 0x72, 0x3c, 0x9b, 0x2c, 0x44, 0x31, 0xfb, 0xf6, 0xac, 0xd3, 0x63, 0xb6, 0xc1, 0x8c, 0x1d,
+	0x2d, 0x2b, 0x31, 0xfe, 0x8e, 0x09, 0x78, 0xb4, 0x9e, 0xc4, 0xf8, 0x3b, 0xb3, 0x09, 0x1a, 0xe3,
+	0x62, 0xd6, 0x89, 0xc1, 0x77, 0x65, 0x6d, 0x16, 0x83, 0xa6, 0xae, 0xda, 0x84, 0xb2, 0x54, 0x8e,
+	0xe1, 0x77, 0x67, 0xb3, 0x39, 0x86, 0xd9, 0x46, 0x40, 0x33, 0x6c, 0x42, 0x1d, 0xf8, 0x9e, 0x02,
+	0x3a, 0x0b, 0xee, 0xce, 0xa5, 0x84, 0x20, 0x1e, 0xce, 0x6c, 0x77, 0xf7, 0x54, 0x01, 0xdd, 0x07,
+	0xce, 0xef, 0x45, 0x6a, 0xa6, 0xa7, 0x19, 0x6c, 0x2f, 0x54, 0xa3, 0xc4, 0xb6, 0xe1, 0x7b, 0x0b,
+	0xe8, 0x02, 0x38, 0x93, 0xdb, 0x20, 0x1b, 0xd6, 0xf0, 0x7d, 0x05, 0xf4, 0x20, 0xb8, 0x7f, 0x22,
+	0xcd, 0x13, 0x52, 0xea, 0xe8, 0xfd, 0x05, 0x74, 0x17, 0x38, 0x9a, 0xdb, 0x94, 0x6d, 0xcc, 0xe0,
+	0x07, 0x26, 0x8e, 0x31, 0x5a, 0x26, 0xe0, 0x07, 0xc7, 0xc7, 0x99, 0x48, 0x2f, 0x6c, 0xe0, 0x1a,
+	0xa1, 0xf0, 0xe9, 0x02, 0x7a, 0x31, 0xb8, 0x77, 0x4c, 0x8f, 0x23, 0xd3, 0x70, 0xdc, 0xe2, 0x99,
+	0xf1, 0xc6, 0xb0, 0x30, 0xc5, 0xba, 0x4e, 0x74, 0xb1, 0x50, 0xbc, 0xdc, 0xd4, 0x0c, 0xf8, 0xec,
+	0x4d, 0xd0, 0x97, 0x5c, 0x42, 0xd7, 0x3d, 0xd5, 0xc6, 0xf0, 0x43, 0xd9, 0x39, 0x26, 0x89, 0x64,
+	0x62, 0xb3, 0x3d, 0x38, 0xc7, 0x3e, 0x9c, 0xcd, 0x50, 0x19, 0xa3, 0x44, 0x31, 0xa9, 0x2a, 0xf6,
+	0x0f, 0xf0, 0x23, 0x93, 0x79, 0x67, 0xdd, 0x6a, 0x98, 0x31, 0xff, 0xd1, 0xf1, 0xd1, 0xc9, 0x26,
+	0x79, 0xa2, 0x7a, 0x8e, 0x6b, 0xe9, 0xc4, 0x76, 0x4c, 0x4a, 0xe0, 0xc7, 0x0a, 0xe8, 0x4e, 0x50,
+	0xc9, 0x85, 0x9d, 0x6a, 0x03, 0x7e, 0xbc, 0x80, 0xce, 0x81, 0x53, 0xb9, 0xd5, 0x89, 0x01, 0xb0,
+	0x65, 0x11, 0x43, 0x85, 0x9f, 0x28, 0xa0, 0x13, 0xe0, 0xf6, 0x34, 0x6a, 0x2a, 0x2b, 0x0e, 0xae,
+	0x25, 0x9b, 0x00, 0xf8, 0x42, 0x26, 0xbf, 0x24, 0x42, 0x1c, 0x56, 0x54, 0xf8, 0xd5, 0x02, 0xba,
+	0x03, 0x1c, 0xc9, 0x01, 0x2d, 0x5c, 0x23, 0xf0, 0x6b, 0x19, 0x95, 0xa3, 0x5a, 0x3e, 0x2c, 0xf8,
+	0xf5, 0x02, 0xba, 0x1b, 0xdc, 0x95, 0x57, 0xcd, 0xa6, 0x12, 0xac, 0x70, 0x55, 0xbe, 0x91, 0x99,
+	0x74, 0x22, 0x68, 0x55, 0xa3, 0x8e, 0x8b, 0xf5, 0x34, 0xfb, 0xcd, 0x8c, 0x0d, 0x22, 0xd6, 0xb6,
+	0x88, 0xe2, 0x32, 0xcd, 0x57, 0x89, 0xe7, 0x98, 0x2b, 0xc4, 0x80, 0xdf, 0xca, 0x64, 0x40, 0x84,
+	0x9a, 0xd5, 0x97, 0x13, 0xc5, 0x81, 0xdf, 0x1e, 0x67, 0x23, 0xd7, 0x26, 0x94, 0xfd, 0x0f, 0xbf,
+	0x33, 0x8e, 0xc0, 0xea, 0xaa, 0x66, 0x9b, 0x74, 0x1d, 0x7e, 0x97, 0x1d, 0x31, 0x6f, 0x4b, 0x11,
+	0xa9, 0x73, 0xe3, 0x27, 0xf7, 0xa1, 0xa3, 0xe0, 0x50, 0xaa, 0x6e, 0x78, 0xfa, 0x7b, 0xbc, 0x88,
+	0x16, 0xc1, 0x9d, 0xa9, 0x2a, 0xab, 0xc6, 0x77, 0xb0, 0xfc, 0x0f, 0x69, 0x10, 0xc3, 0xb1, 0xe1,
+	0x8d, 0xa2, 0x64, 0x59, 0x4c, 0x95, 0xba, 0xb6, 0xca, 0x13, 0x53, 0x33, 0xe0, 0x3f, 0x17, 0xd1,
+	0x71, 0x70, 0x2c, 0x5d, 0x3d, 0xdc, 0x34, 0x72, 0xe0, 0x5f, 0xe4, 0x3e, 0xaa, 0x35, 0x7e, 0xce,
+	0xa1, 0x5e, 0x5d, 0xab, 0x12, 0x6a, 0x60, 0x87, 0xc0, 0x7f, 0x95, 0xfb, 0x48, 0x18, 0x2e, 0xe2,
+	0xdf, 0x8a, 0xe8, 0x24, 0xb8, 0x23, 0x55, 0x3d, 0xb2, 0x3b, 0xe7, 0xc8, 0xbf, 0xcb, 0xbd, 0xc4,
+	0x4b, 0x1b, 0xb6, 0x2c, 0x7d, 0x5d, 0x30, 0xff, 0x51, 0x94, 0x53, 0x31, 0x62, 0x74, 0xec, 0xb2,
+	0xe8, 0x8d, 0x44, 0xfd, 0x67, 0x11, 0xdd, 0x0e, 0x0e, 0x8f, 0x18, 0x85, 0xdb, 0x84, 0x57, 0xfe,
+	0x57, 0x51, 0x72, 0x05, 0xcb, 0xca, 0x55, 0x96, 0xec, 0x6c, 0xde, 0xc6, 0xba, 0x0e, 0xff, 0xbb,
+	0x28, 0x85, 0xda, 0x08, 0x61, 0x3b, 0x94, 0xe0, 0x06, 0xfc, 0x9f, 0xa2, 0x14, 0x13, 0xf6, 0xba,
+	0xad, 0x9b, 0xb5, 0x5a, 0xac, 0xc3, 0xff, 0xca, 0x23, 0x5e, 0xe3, 0xab, 0xb4, 0x42, 0x86, 0x86,
+	0xff, 0x3f, 0xd9, 0xf0, 0x5c, 0x3c, 0x31, 0xd4, 0x18, 0x78, 0x74, 0x2a, 0x07, 0x48, 0x9b, 0xf5,
+	0xd5, 0x53, 0xd2, 0x40, 0xc5, 0x55, 0x01, 0x3f, 0x01, 0xc3, 0xef, 0x4f, 0x49, 0xe9, 0x16, 0x55,
+	0x72, 0x01, 0xf0, 0x07, 0x53, 0xf2, 0xac, 0xad, 0x55, 0xad, 0x4b, 0x6b, 0x58, 0x4f, 0x74, 0x54,
+	0x4c, 0xc3, 0x60, 0xd1, 0xfd, 0xc3, 0x89, 0x64, 0xf4, 0x0f, 0xfc, 0x91, 0xac, 0xaf, 0x6d, 0xeb,
+	0x9e, 0x69, 0x11, 0x83, 0x6d, 0x84, 0x57, 0x09, 0x85, 0x3f, 0x9e, 0x92, 0xa6, 0x8a, 0x11, 0xa3,
+	0xf0, 0x72, 0xdb, 0xc1, 0xd4, 0x81, 0x3f, 0x99, 0x92, 0x5c, 0x90, 0x32, 0x0d, 0x2f, 0x5d, 0xc3,
+	0x3a, 0xfc, 0xe9, 0x94, 0x14, 0x0d, 0x69, 0x88, 0x0d, 0xd2, 0x53, 0xb1, 0x83, 0xe1, 0xcf, 0x64,
+	0xad, 0x6a, 0xb6, 0x3d, 0xa2, 0xd5, 0xcf, 0xa7, 0x24, 0x57, 0x55, 0x6b, 0xd1, 0x56, 0xca, 0xae,
+	0xbb, 0x8e, 0xca, 0x2e, 0x42, 0x3e, 0x5d, 0x92, 0x82, 0x66, 0x88, 0x30, 0x7d, 0x5d, 0x0b, 0x3e,
+	0x57, 0x92, 0xf3, 0x97, 0x9f, 0xfb, 0xf8, 0xd4, 0xf6, 0x99, 0x92, 0x1c, 0xfd, 0x6c, 0x57, 0xc5,
+	0x76, 0xf3, 0x96, 0xe7, 0x5a, 0x2a, 0xcb, 0x9f, 0xcf, 0x96, 0xa4, 0x70, 0x22, 0x97, 0x89, 0xe2,
+	0x3a, 0xc4, 0xab, 0x61, 0xa7, 0x4e, 0x28, 0xfc, 0x5c, 0x49, 0x1a, 0x2b, 0x5f, 0xcd, 0xaa, 0xd8,
+	0x51, 0xea, 0xc9, 0xce, 0xdb, 0xa8, 0xc1, 0xe7, 0x4b, 0x92, 0xdd, 0x52, 0x18, 0xd1, 0x89, 0xc2,
+	0xa1, 0xcf, 0x97, 0xa4, 0x4c, 0x4b, 0x41, 0xba, 0x89, 0x55, 0xc6, 0x7c, 0x21, 0xbf, 0x3f, 0x57,
+	0xd3, 0xd5, 0x74, 0x7f, 0x5f, 0xcc, 0xef, 0x8f, 0x63, 0x49, 0x7f, 0x5f, 0x2a, 0x49, 0x01, 0x94,
+	0x82, 0xd8, 0xbf, 0xec, 0x04, 0xa9, 0x19, 0x06, 0xa1, 0xf0, 0xcb, 0x37, 0x41, 0x9a, 0xae, 0x43,
+	0x28, 0xfc, 0x4a, 0x49, 0x5a, 0xc2, 0x39, 0x59, 0xa3, 0xe6, 0x9a, 0x18, 0x08, 0xb1, 0xd3, 0x6a,
+	0xbe, 0x50, 0x92, 0xd6, 0x85, 0x2c, 0xad, 0x12, 0x45, 0xe3, 0x23, 0xff, 0xea, 0x64, 0x36, 0x19,
+	0xd9, 0xd7, 0x4a, 0xd2, 0x9a, 0x9c, 0x65, 0xc5, 0x79, 0x93, 0xc1, 0x5f, 0x2f, 0x49, 0xbb, 0x9a,
+	0x2c, 0x4c, 0x89, 0x85, 0xa9, 0xa3, 0xb1, 0xf5, 0x89, 0xb5, 0xf8, 0xc6, 0x1e, 0x83, 0x74, 0x95,
+	0x15, 0xe2, 0x8c, 0x0c, 0xf2, 0x9b, 0x7b, 0x28, 0x1e, 0xd1, 0x89, 0xe2, 0xdf, 0x2a, 0x49, 0x5b,
+	0xe8, 0x2c, 0x4b, 0x89, 0xd8, 0xc3, 0x32, 0xfc, 0xdb, 0x72, 0x00, 0xc7, 0xf3, 0x2e, 0xdf, 0x3f,
+	0xf3, 0x2c, 0xfb, 0x4e, 0x29, 0xb3, 0x9c, 0xa6, 0x10, 0x71, 0xa9, 0xa2, 0xd4, 0xb1, 0x51, 0x23,
+	0xf0, 0xbb, 0x25, 0x69, 0xd6, 0x6a, 0x5c, 0xf2, 0xf8, 0x42, 0x60, 0x60, 0x1d, 0xfe, 0x9d, 0x9c,
+	0x08, 0x8d, 0x4b, 0x9e, 0xe5, 0xb2, 0x4b, 0x22, 0xdb, 0x66, 0xb9, 0xf4, 0xf7, 0x72, 0x9e, 0x35,
+	0x2e, 0x25, 0xf3, 0xcf, 0x3f, 0x94, 0xd0, 0x91, 0x91, 0x7b, 0xcc, 0xc6, 0x25, 0x3e, 0x1f, 0xc0,
+	0x7f, 0x2c, 0x49, 0x9b, 0xf5, 0x64, 0x97, 0x53, 0xd5, 0x1c, 0x76, 0x1e, 0x63, 0x57, 0x1e, 0xf0,
+	0x9f, 0x64, 0x03, 0x26, 0x54, 0x74, 0xcd, 0x23, 0x6e, 0x3c, 0x39, 0xfb, 0xbd, 0x92, 0x34, 0xa9,
+	0x24, 0xac, 0x70, 0x38, 0xfc, 0x7e, 0x49, 0xda, 0xeb, 0xb2, 0xbd, 0xb2, 0xb8, 0xc3, 0x1c, 0x49,
+	0xfc, 0x1f, 0xc8, 0x3a, 0x5b, 0xd4, 0x6c, 0x98, 0x0e, 0x81, 0x3f, 0x2c, 0x49, 0x73, 0x65, 0xce,
+	0x61, 0x55, 0xa5, 0xa6, 0x05, 0x7f, 0x24, 0xa7, 0x6a, 0x66, 0x43, 0xcf, 0xb1, 0x1f, 0x97, 0xa4,
+	0x15, 0xda, 0xc6, 0xcb, 0x24, 0x39, 0x9a, 0xc2, 0x9f, 0x94, 0x50, 0x05, 0xdc, 0x3a, 0xb2, 0x9e,
+	0x89, 0x6b, 0x09, 0xf8, 0x53, 0x79, 0xa8, 0xa9, 0x9b, 0x49, 0xd5, 0x34, 0x08, 0xfc, 0x99, 0x3c,
+	0x39, 0xa6, 0x00, 0x31, 0x9d, 0xff, 0x5c, 0xb6, 0x7f, 0x15, 0xdb, 0x84, 0x9f, 0x73, 0x5d, 0xcb,
+	0x73, 0xea, 0xd4, 0x74, 0x1c, 0x9d, 0xc0, 0xa7, 0xf7, 0x4b, 0x2a, 0xb0, 0xbd, 0x8c, 0x4e, 0x88,
+	0x05, 0x9f, 0xd9, 0x2f, 0xb5, 0x4f, 0x56, 0x64, 0xb1, 0x39, 0x50, 0x89, 0x8e, 0xd7, 0xe1, 0xb3,
+	0xfb, 0xa5, 0x05, 0x8f, 0x6d, 0xa1, 0x34, 0x9d, 0x88, 0xe5, 0xf0, 0x35, 0x65, 0x79, 0x87, 0x12,
+	0xd5, 0x8a, 0xf5, 0xf0, 0xb1, 0xb2, 0x3c, 0x47, 0xa7, 0x2f, 0x6a, 0xb9, 0x84, 0xd7, 0xee, 0x89,
+	0x30, 0x7b, 0xc1, 0xd7, 0x95, 0xa5, 0x09, 0x2c, 0x83, 0xc4, 0x7e, 0x7f, 0x7d, 0x59, 0x9a, 0x84,
+	0x47, 0x48, 0xa1, 0xd3, 0x1b, 0xca, 0x52, 0x4e, 0x65, 0x99, 0x58, 0xdc, 0x1b, 0xcb, 0x52, 0xda,
+	0x28, 0xa6, 0xb5, 0x9e, 0xd2, 0xfd, 0x4d, 0x65, 0xd9, 0x89, 0x49, 0xbd, 0xe8, 0xeb, 0xcd, 0x65,
+	0xc9, 0x89, 0x2c, 0xab, 0x05, 0x10, 0x6d, 0xdf, 0xdf, 0x22, 0x8b, 0x18, 0x12, 0xcb, 0xba, 0x6b,
+	0xd7, 0xe1, 0x5b, 0xe5, 0xc1, 0x0f, 0x01, 0xad, 0xd1, 0x20, 0xaa, 0x86, 0x1d, 0x61, 0x03, 0xf8,
+	0x36, 0x79, 0xf0, 0x43, 0xd2, 0xa2, 0x64, 0x99, 0x38, 0x4a, 0x1d, 0x3e, 0x2e, 0x8f, 0x68, 0xc8,
+	0xf0, 0x11, 0xdd, 0x18, 0x5f, 0xcf, 0xfb, 0x78, 0x62, 0x7c, 0x1f, 0xd1, 0xfd, 0x07, 0x81, 0x4f,
+	0x8e, 0x1f, 0x92, 0xb0, 0xca, 0xdb, 0xcb, 0xd2, 0xfa, 0xa6, 0xda, 0x0d, 0x56, 0xaf, 0x7b, 0xaf,
+	0x20, 0xd4, 0x8c, 0xa0, 0x77, 0x94, 0xe5, 0x93, 0x19, 0x3b, 0x9a, 0x72, 0x29, 0x58, 0x55, 0x1d,
+	0x93, 0x09, 0x55, 0x35, 0x2a, 0xd4, 0x7e, 0xe7, 0x4d, 0xc2, 0x7c, 0x0c, 0xef, 0x2a, 0xcb, 0x07,
+	0xd5, 0x7c, 0x58, 0xe8, 0xf1, 0xee, 0x72, 0x66, 0x77, 0x1c, 0xd3, 0xd1, 0x04, 0xc6, 0x35, 0x78,
+	0xcf, 0x44, 0x8c, 0xf7, 0xfd, 0x54, 0x59, 0x3e, 0xac, 0xcb, 0x98, 0xe8, 0xf5, 0xbd, 0x65, 0xf9,
+	0x36, 0x26, 0xe1, 0x28, 0xe1, 0x33, 0xc1, 0xc8, 0xf8, 0xdf, 0x57, 0x96, 0xef, 0x39, 0x92, 0x1b,
+	0x2c, 0x11, 0xd0, 0xe9, 0xc9, 0x83, 0xe9, 0xf1, 0xfe, 0xac, 0x0d, 0x46, 0x1b, 0xc4, 0x97, 0xd0,
+	0x9c, 0xfe, 0x40, 0x56, 0x9b, 0x7c, 0x5a, 0x28, 0xff, 0xc1, 0xb2, 0x7c, 0x8b, 0x23, 0xe1, 0x5c,
+	0xe8, 0xd3, 0x72, 0x60, 0xcb, 0x54, 0x12, 0x50, 0xcf, 0x94, 0xc7, 0x1c, 0x50, 0x62, 0x52, 0x74,
+	0xfb, 0xac, 0x3c, 0x93, 0xa4, 0x6f, 0xd4, 0x85, 0x9d, 0x3e, 0xb4, 0x27, 0xc2, 0xd5, 0xfa, 0xb0,
+	0x1c, 0xe1, 0x23, 0x88, 0xe8, 0xe9, 0x23, 0xe5, 0xcc, 0x59, 0xc6, 0xa4, 0x6a, 0x72, 0x3b, 0x26,
+	0xfa, 0xfa, 0x68, 0x39, 0x33, 0xbd, 0x8e, 0x40, 0x42, 0xd4, 0xc7, 0x64, 0x47, 0xc4, 0x54, 0x3c,
+	0xc6, 0xd8, 0xb4, 0x5c, 0xe6, 0xc7, 0xcb, 0x93, 0x56, 0x25, 0x8e, 0x7d, 0x42, 0xf6, 0x57, 0x0e,
+	0xc6, 0xaf, 0x34, 0xc4, 0x90, 0x7f, 0x6f, 0xa2, 0x54, 0x8e, 0xfd, 0xbe, 0x1c, 0xbb, 0x19, 0x4c,
+	0x0c, 0xe9, 0x93, 0x72, 0xfe, 0xdb, 0x3a, 0x75, 0xc5, 0x6c, 0x26, 0x04, 0xfd, 0x41, 0x59, 0x3a,
+	0x79, 0x73, 0x80, 0x6b, 0xfe, 0x87, 0xb9, 0x55, 0xbc, 0xd5, 0x1f, 0x95, 0xa5, 0x3d, 0x0a, 0xaf,
+	0x12, 0x5d, 0xfe, 0xb1, 0x3c, 0x6d, 0xb1, 0x15, 0x58, 0xec, 0x70, 0xb9, 0xd8, 0x3f, 0x19, 0x5f,
+	0xcf, 0x65, 0xff, 0x69, 0x46, 0xe5, 0xa4, 0x5e, 0x74, 0xf0, 0x67, 0x65, 0x69, 0x17, 0xc3, 0x2e,
+	0x9d, 0x75, 0xcd, 0x20, 0x5e, 0x5d, 0x63, 0x96, 0x5c, 0x4f, 0xcd, 0x91, 0x7f, 0x2e, 0x4f, 0x46,
+	0xf9, 0xac, 0x10, 0xfc, 0x17, 0xb2, 0xed, 0x33, 0x30, 0x1f, 0xc0, 0x5f, 0x4e, 0xc4, 0x78, 0xd7,
+	0x9f, 0x92, 0x5d, 0x94, 0xc1, 0x44, 0xaf, 0x7f, 0x25, 0x07, 0xb9, 0xb3, 0x66, 0x8a, 0x1f, 0xea,
+	0x86, 0x4b, 0xc1, 0x5f, 0xef, 0xcd, 0xf0, 0xfe, 0xfe, 0x46, 0x4e, 0x84, 0x51, 0x46, 0x74, 0xf6,
+	0xb7, 0xf2, 0xe4, 0xb4, 0x86, 0xf5, 0xe8, 0x40, 0x99, 0x3f, 0xd8, 0x4f, 0xcb, 0x3d, 0xf3, 0x1f,
+	0x8c, 0x4d, 0xd3, 0xb1, 0x1d, 0x1a, 0xa7, 0xe9, 0x73, 0xe5, 0x9c, 0xb3, 0xec, 0x90, 0x11, 0x3d,
+	0x7f, 0x46, 0xde, 0x9d, 0x30, 0x88, 0xaf, 0xd1, 0xbc, 0x9f, 0xcf, 0x8e, 0xad, 0xe6, 0x5d, 0x7c,
+	0x4e, 0x0e, 0x9a, 0xa4, 0x5a, 0x48, 0x7f, 0x3e, 0xaf, 0x39, 0xff, 0x8d, 0x91, 0x37, 0xff, 0x7c,
+	0x5e, 0x73, 0x5e, 0x2d, 0x9a, 0x7f, 0xa1, 0x2c, 0x6d, 0xcc, 0xd6, 0xa2, 0x5f, 0xd9, 0xe1, 0x17,
+	0xf3, 0x6a, 0xb8, 0xcc, 0x2f, 0xc9, 0xfe, 0x8d, 0x6b, 0xbc, 0x06, 0x71, 0xea, 0xa6, 0xea, 0x61,
+	0xdb, 0xd6, 0x6a, 0x06, 0xfc, 0xb2, 0x9c, 0x46, 0xc9, 0x1d, 0x07, 0xfc, 0x8a, 0x6c, 0x38, 0xfe,
+	0x0e, 0x80, 0xb5, 0x62, 0x06, 0xc4, 0x94, 0x6a, 0x84, 0xc2, 0x17, 0xca, 0xd2, 0xa6, 0x4f, 0x33,
+	0xc5, 0x0f, 0x34, 0x5c, 0x8b, 0xc7, 0x0d, 0xc9, 0x3f, 0xd8, 0xa5, 0x26, 0xc5, 0x5c, 0xf9, 0xf8,
+	0x12, 0xe5, 0x86, 0x21, 0x75, 0x13, 0x33, 0xae, 0x11, 0xfd, 0x5e, 0xa3, 0x19, 0xf0, 0x09, 0x43,
+	0xde, 0xfb, 0x69, 0x8e, 0x6b, 0x47, 0xb7, 0xc4, 0xec, 0x74, 0x63, 0xc3, 0x27, 0x8d, 0xc5, 0xa9,
+	0xe9, 0x16, 0x6c, 0x2d, 0x3e, 0x57, 0x04, 0x07, 0x33, 0xaf, 0xd1, 0xd8, 0x5b, 0xa5, 0xf8, 0x3d,
+	0x8b, 0xf4, 0x96, 0x6b, 0x21, 0x2a, 0xd7, 0xa2, 0x62, 0xf6, 0xe2, 0xa6, 0xd9, 0xdd, 0xda, 0xf2,
+	0x3b, 0xe2, 0x59, 0xd7, 0x0c, 0x8d, 0x3f, 0xd9, 0x8b, 0x9b, 0xde, 0x86, 0x3f, 0x08, 0xf8, 0x9b,
+	0xae, 0x19, 0x2a, 0x3e, 0xf6, 0x7a, 0xce, 0x25, 0x3f, 0xd2, 0x2a, 0x65, 0x1f, 0x69, 0x45, 0x2f,
+	0xbe, 0xfa, 0xc1, 0x26, 0x57, 0x38, 0x79, 0xcb, 0x25, 0x5e, 0x7c, 0xd1, 0xa8, 0x38, 0x12, 0x36,
+	0x42, 0x95, 0x85, 0xb0, 0x7e, 0x0a, 0x79, 0x10, 0x00, 0xfe, 0x44, 0x29, 0x68, 0xb1, 0x37, 0x8d,
+	0xd3, 0x93, 0xdf, 0x38, 0x45, 0x34, 0x0e, 0xd9, 0x43, 0xb0, 0x47, 0xba, 0xfd, 0x6b, 0x5e, 0xab,
+	0xdb, 0x09, 0xf8, 0x53, 0xaf, 0x22, 0x9d, 0x66, 0x05, 0x6a, 0xb7, 0x23, 0x1e, 0x0b, 0xb1, 0xca,
+	0xb0, 0x1b, 0xfa, 0x9b, 0xfc, 0x8d, 0x57, 0x91, 0x72, 0xdc, 0x61, 0x05, 0xf1, 0x18, 0x7a, 0x41,
+	0xbf, 0xc9, 0x1e, 0x1d, 0x71, 0x11, 0xb3, 0xc9, 0x18, 0x2c, 0x51, 0xcc, 0x05, 0x9d, 0x04, 0x73,
+	0x23, 0x14, 0x7b, 0xe7, 0x55, 0xa0, 0xb3, 0xbd, 0x21, 0xb2, 0xf8, 0xfc, 0x3e, 0x70, 0x74, 0xec,
+	0xbb, 0x48, 0xf6, 0xe8, 0x28, 0x7a, 0xfb, 0x28, 0xb9, 0xf1, 0x80, 0x28, 0x4e, 0xbc, 0x98, 0xf6,
+	0xca, 0xbe, 0xbd, 0xbd, 0x52, 0xcc, 0x7a, 0x45, 0xb6, 0xf5, 0x54, 0xd6, 0xd6, 0x79, 0x11, 0x55,
+	0xca, 0x8f, 0xa8, 0x51, 0xb7, 0xec, 0xff, 0x45, 0xdc, 0x72, 0x17, 0x00, 0xfe, 0x76, 0xd8, 0x15,
+	0x83, 0x8b, 0x1e, 0xf0, 0xa5, 0x4a, 0x58, 0x48, 0x86, 0x5d, 0x7f, 0x20, 0x9c, 0x3d, 0x4d, 0xc5,
+	0xc7, 0xe2, 0x53, 0x53, 0xe0, 0xc8, 0x98, 0xb7, 0x9e, 0x37, 0x6f, 0x41, 0x23, 0x8e, 0x76, 0x66,
+	0xbe, 0x03, 0x17, 0x1f, 0xf8, 0x45, 0x5e, 0x94, 0xc6, 0xe5, 0xac, 0x7d, 0x9c, 0x27, 0xa7, 0xc1,
+	0xc2, 0x46, 0xe0, 0xf7, 0xbc, 0x2b, 0x9b, 0xd7, 0x06, 0x51, 0x24, 0x15, 0x79, 0x24, 0xcd, 0xb3,
+	0xe2, 0xea, 0xe6, 0xb5, 0x81, 0x88, 0xa6, 0xf3, 0xe0, 0xe0, 0x90, 0x1b, 0x34, 0xfd, 0x4e, 0x27,
+	0x68, 0x71, 0x07, 0x14, 0xe9, 0x42, 0x4c, 0xda, 0xa2, 0x18, 0x5d, 0x00, 0x68, 0xc8, 0x0a, 0xfd,
+	0x83, 0x16, 0x77, 0x43, 0x91, 0xc2, 0x18, 0x5e, 0x8d, 0xca, 0x19, 0xdd, 0xee, 0xb4, 0x82, 0xdd,
+	0x88, 0x14, 0x8f, 0x50, 0xb9, 0x3f, 0x8a, 0x14, 0xf2, 0x1a, 0x81, 0x8a, 0xb7, 0xa3, 0xa7, 0xc1,
+	0xc2, 0x96, 0xbf, 0xeb, 0xb5, 0x02, 0xbf, 0xe5, 0x85, 0xdb, 0xbd, 0xcd, 0x60, 0xc0, 0xed, 0x5f,
+	0xa4, 0xf3, 0x5b, 0xfe, 0xae, 0x1a, 0xf8, 0x2d, 0x87, 0x17, 0x32, 0xae, 0xb3, 0xbd, 0x35, 0xc2,
+	0x4d, 0x0b, 0xae, 0xb3, 0xbd, 0x35, 0xe4, 0x16, 0x1f, 0x2b, 0x80, 0xd9, 0x94, 0x59, 0xd8, 0xbb,
+	0x35, 0x36, 0xcb, 0xf3, 0x47, 0x0e, 0xec, 0x9a, 0xe7, 0x16, 0x34, 0x0f, 0x66, 0xf8, 0xeb, 0x8f,
+	0x3a, 0xc1, 0x16, 0x2c, 0x30, 0x20, 0xfa, 0x21, 0x80, 0x5f, 0x7d, 0xc0, 0x7d, 0xec, 0xad, 0x59,
+	0x54, 0xc2, 0x91, 0x22, 0x3a, 0x08, 0xe6, 0x79, 0x9d, 0xa7, 0xe8, 0x04, 0x1b, 0xae, 0x05, 0xa7,
+	0xd0, 0x1c, 0x98, 0x4e, 0x36, 0xc4, 0x25, 0x06, 0x2c, 0x6b, 0x6c, 0xc6, 0x8e, 0x81, 0xfd, 0x57,
+	0xf6, 0xf3, 0x88, 0x7b, 0xe9, 0xff, 0x0f, 0x00, 0xc4, 0xf8, 0x5e, 0x22, 0x1c, 0x2e, 0x00, 0x00,
 }
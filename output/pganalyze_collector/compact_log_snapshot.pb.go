@@ -21,6 +21,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type LockEvent_Kind int32
+
+const (
+	LockEvent_LOCK_WAITING      LockEvent_Kind = 0
+	LockEvent_LOCK_ACQUIRED     LockEvent_Kind = 1
+	LockEvent_LOCK_TIMEOUT      LockEvent_Kind = 2
+	LockEvent_STATEMENT_TIMEOUT LockEvent_Kind = 3
+)
+
+var LockEvent_Kind_name = map[int32]string{
+	0: "LOCK_WAITING",
+	1: "LOCK_ACQUIRED",
+	2: "LOCK_TIMEOUT",
+	3: "STATEMENT_TIMEOUT",
+}
+
+var LockEvent_Kind_value = map[string]int32{
+	"LOCK_WAITING":      0,
+	"LOCK_ACQUIRED":     1,
+	"LOCK_TIMEOUT":      2,
+	"STATEMENT_TIMEOUT": 3,
+}
+
+func (x LockEvent_Kind) String() string {
+	return proto.EnumName(LockEvent_Kind_name, int32(x))
+}
+
+func (LockEvent_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1b302a0d569b4233, []int{1, 0}
+}
+
 type LogFileReference_LogSecretKind int32
 
 const (
@@ -58,7 +89,7 @@ func (x LogFileReference_LogSecretKind) String() string {
 }
 
 func (LogFileReference_LogSecretKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b302a0d569b4233, []int{1, 0}
+	return fileDescriptor_1b302a0d569b4233, []int{2, 0}
 }
 
 type LogLineInformation_LogLevel int32
@@ -121,7 +152,7 @@ func (x LogLineInformation_LogLevel) String() string {
 }
 
 func (LogLineInformation_LogLevel) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b302a0d569b4233, []int{2, 0}
+	return fileDescriptor_1b302a0d569b4233, []int{3, 0}
 }
 
 type LogLineInformation_LogClassification int32
@@ -445,7 +476,7 @@ func (x LogLineInformation_LogClassification) String() string {
 }
 
 func (LogLineInformation_LogClassification) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b302a0d569b4233, []int{2, 1}
+	return fileDescriptor_1b302a0d569b4233, []int{3, 1}
 }
 
 type QuerySample_ExplainFormat int32
@@ -470,7 +501,7 @@ func (x QuerySample_ExplainFormat) String() string {
 }
 
 func (QuerySample_ExplainFormat) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b302a0d569b4233, []int{3, 0}
+	return fileDescriptor_1b302a0d569b4233, []int{4, 0}
 }
 
 type QuerySample_ExplainSource int32
@@ -501,16 +532,18 @@ func (x QuerySample_ExplainSource) String() string {
 }
 
 func (QuerySample_ExplainSource) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b302a0d569b4233, []int{3, 1}
+	return fileDescriptor_1b302a0d569b4233, []int{4, 1}
 }
 
 type CompactLogSnapshot struct {
-	LogFileReferences    []*LogFileReference   `protobuf:"bytes,1,rep,name=log_file_references,json=logFileReferences,proto3" json:"log_file_references,omitempty"`
-	LogLineInformations  []*LogLineInformation `protobuf:"bytes,2,rep,name=log_line_informations,json=logLineInformations,proto3" json:"log_line_informations,omitempty"`
-	QuerySamples         []*QuerySample        `protobuf:"bytes,3,rep,name=query_samples,json=querySamples,proto3" json:"query_samples,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	LogFileReferences   []*LogFileReference   `protobuf:"bytes,1,rep,name=log_file_references,json=logFileReferences,proto3" json:"log_file_references,omitempty"`
+	LogLineInformations []*LogLineInformation `protobuf:"bytes,2,rep,name=log_line_informations,json=logLineInformations,proto3" json:"log_line_informations,omitempty"`
+	QuerySamples        []*QuerySample        `protobuf:"bytes,3,rep,name=query_samples,json=querySamples,proto3" json:"query_samples,omitempty"`
+	// Lock waits and timeouts, extracted from log_line_informations
+	LockEvents           []*LockEvent `protobuf:"bytes,4,rep,name=lock_events,json=lockEvents,proto3" json:"lock_events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *CompactLogSnapshot) Reset()         { *m = CompactLogSnapshot{} }
@@ -559,6 +592,174 @@ func (m *CompactLogSnapshot) GetQuerySamples() []*QuerySample {
 	return nil
 }
 
+func (m *CompactLogSnapshot) GetLockEvents() []*LockEvent {
+	if m != nil {
+		return m.LockEvents
+	}
+	return nil
+}
+
+type LockEvent struct {
+	Kind           LockEvent_Kind       `protobuf:"varint,1,opt,name=kind,proto3,enum=pganalyze.collector.LockEvent_Kind" json:"kind,omitempty"`
+	OccurredAt     *timestamp.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	LogLineUuid    string               `protobuf:"bytes,3,opt,name=log_line_uuid,json=logLineUuid,proto3" json:"log_line_uuid,omitempty"`
+	HasRoleIdx     bool                 `protobuf:"varint,4,opt,name=has_role_idx,json=hasRoleIdx,proto3" json:"has_role_idx,omitempty"`
+	RoleIdx        int32                `protobuf:"varint,5,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`
+	HasDatabaseIdx bool                 `protobuf:"varint,6,opt,name=has_database_idx,json=hasDatabaseIdx,proto3" json:"has_database_idx,omitempty"`
+	DatabaseIdx    int32                `protobuf:"varint,7,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	// Statement that waited or got canceled (only when the log line includes it)
+	HasQueryIdx bool   `protobuf:"varint,8,opt,name=has_query_idx,json=hasQueryIdx,proto3" json:"has_query_idx,omitempty"`
+	QueryIdx    int32  `protobuf:"varint,9,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
+	BackendPid  int32  `protobuf:"varint,10,opt,name=backend_pid,json=backendPid,proto3" json:"backend_pid,omitempty"`
+	LockMode    string `protobuf:"bytes,11,opt,name=lock_mode,json=lockMode,proto3" json:"lock_mode,omitempty"`
+	LockType    string `protobuf:"bytes,12,opt,name=lock_type,json=lockType,proto3" json:"lock_type,omitempty"`
+	// Relation the lock is on, if any (the database may differ from the one the backend is connected to)
+	RelationOid          *NullInt64  `protobuf:"bytes,13,opt,name=relation_oid,json=relationOid,proto3" json:"relation_oid,omitempty"`
+	LockDatabaseOid      *NullInt64  `protobuf:"bytes,14,opt,name=lock_database_oid,json=lockDatabaseOid,proto3" json:"lock_database_oid,omitempty"`
+	WaitedMs             *NullDouble `protobuf:"bytes,15,opt,name=waited_ms,json=waitedMs,proto3" json:"waited_ms,omitempty"`
+	BlockingPids         []int32     `protobuf:"varint,16,rep,packed,name=blocking_pids,json=blockingPids,proto3" json:"blocking_pids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *LockEvent) Reset()         { *m = LockEvent{} }
+func (m *LockEvent) String() string { return proto.CompactTextString(m) }
+func (*LockEvent) ProtoMessage()    {}
+func (*LockEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b302a0d569b4233, []int{1}
+}
+
+func (m *LockEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockEvent.Unmarshal(m, b)
+}
+func (m *LockEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockEvent.Marshal(b, m, deterministic)
+}
+func (m *LockEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockEvent.Merge(m, src)
+}
+func (m *LockEvent) XXX_Size() int {
+	return xxx_messageInfo_LockEvent.Size(m)
+}
+func (m *LockEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockEvent proto.InternalMessageInfo
+
+func (m *LockEvent) GetKind() LockEvent_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return LockEvent_LOCK_WAITING
+}
+
+func (m *LockEvent) GetOccurredAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.OccurredAt
+	}
+	return nil
+}
+
+func (m *LockEvent) GetLogLineUuid() string {
+	if m != nil {
+		return m.LogLineUuid
+	}
+	return ""
+}
+
+func (m *LockEvent) GetHasRoleIdx() bool {
+	if m != nil {
+		return m.HasRoleIdx
+	}
+	return false
+}
+
+func (m *LockEvent) GetRoleIdx() int32 {
+	if m != nil {
+		return m.RoleIdx
+	}
+	return 0
+}
+
+func (m *LockEvent) GetHasDatabaseIdx() bool {
+	if m != nil {
+		return m.HasDatabaseIdx
+	}
+	return false
+}
+
+func (m *LockEvent) GetDatabaseIdx() int32 {
+	if m != nil {
+		return m.DatabaseIdx
+	}
+	return 0
+}
+
+func (m *LockEvent) GetHasQueryIdx() bool {
+	if m != nil {
+		return m.HasQueryIdx
+	}
+	return false
+}
+
+func (m *LockEvent) GetQueryIdx() int32 {
+	if m != nil {
+		return m.QueryIdx
+	}
+	return 0
+}
+
+func (m *LockEvent) GetBackendPid() int32 {
+	if m != nil {
+		return m.BackendPid
+	}
+	return 0
+}
+
+func (m *LockEvent) GetLockMode() string {
+	if m != nil {
+		return m.LockMode
+	}
+	return ""
+}
+
+func (m *LockEvent) GetLockType() string {
+	if m != nil {
+		return m.LockType
+	}
+	return ""
+}
+
+func (m *LockEvent) GetRelationOid() *NullInt64 {
+	if m != nil {
+		return m.RelationOid
+	}
+	return nil
+}
+
+func (m *LockEvent) GetLockDatabaseOid() *NullInt64 {
+	if m != nil {
+		return m.LockDatabaseOid
+	}
+	return nil
+}
+
+func (m *LockEvent) GetWaitedMs() *NullDouble {
+	if m != nil {
+		return m.WaitedMs
+	}
+	return nil
+}
+
+func (m *LockEvent) GetBlockingPids() []int32 {
+	if m != nil {
+		return m.BlockingPids
+	}
+	return nil
+}
+
 type LogFileReference struct {
 	Uuid                 string                           `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	S3Location           string                           `protobuf:"bytes,2,opt,name=s3_location,json=s3Location,proto3" json:"s3_location,omitempty"`
@@ -576,7 +777,7 @@ func (m *LogFileReference) Reset()         { *m = LogFileReference{} }
 func (m *LogFileReference) String() string { return proto.CompactTextString(m) }
 func (*LogFileReference) ProtoMessage()    {}
 func (*LogFileReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b302a0d569b4233, []int{1}
+	return fileDescriptor_1b302a0d569b4233, []int{2}
 }
 
 func (m *LogFileReference) XXX_Unmarshal(b []byte) error {
@@ -676,7 +877,7 @@ func (m *LogLineInformation) Reset()         { *m = LogLineInformation{} }
 func (m *LogLineInformation) String() string { return proto.CompactTextString(m) }
 func (*LogLineInformation) ProtoMessage()    {}
 func (*LogLineInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b302a0d569b4233, []int{2}
+	return fileDescriptor_1b302a0d569b4233, []int{3}
 }
 
 func (m *LogLineInformation) XXX_Unmarshal(b []byte) error {
@@ -845,21 +1046,23 @@ type QuerySample struct {
 	Parameters  []string             `protobuf:"bytes,5,rep,name=parameters,proto3" json:"parameters,omitempty"`
 	LogLineUuid string               `protobuf:"bytes,10,opt,name=log_line_uuid,json=logLineUuid,proto3" json:"log_line_uuid,omitempty"`
 	// Note: For historic reasons this contains an inline version of QueryExplainInformation
-	HasExplain           bool                      `protobuf:"varint,20,opt,name=has_explain,json=hasExplain,proto3" json:"has_explain,omitempty"`
-	ExplainOutput        string                    `protobuf:"bytes,21,opt,name=explain_output,json=explainOutput,proto3" json:"explain_output,omitempty"`
-	ExplainError         string                    `protobuf:"bytes,22,opt,name=explain_error,json=explainError,proto3" json:"explain_error,omitempty"`
-	ExplainFormat        QuerySample_ExplainFormat `protobuf:"varint,23,opt,name=explain_format,json=explainFormat,proto3,enum=pganalyze.collector.QuerySample_ExplainFormat" json:"explain_format,omitempty"`
-	ExplainSource        QuerySample_ExplainSource `protobuf:"varint,24,opt,name=explain_source,json=explainSource,proto3,enum=pganalyze.collector.QuerySample_ExplainSource" json:"explain_source,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	HasExplain    bool                      `protobuf:"varint,20,opt,name=has_explain,json=hasExplain,proto3" json:"has_explain,omitempty"`
+	ExplainOutput string                    `protobuf:"bytes,21,opt,name=explain_output,json=explainOutput,proto3" json:"explain_output,omitempty"`
+	ExplainError  string                    `protobuf:"bytes,22,opt,name=explain_error,json=explainError,proto3" json:"explain_error,omitempty"`
+	ExplainFormat QuerySample_ExplainFormat `protobuf:"varint,23,opt,name=explain_format,json=explainFormat,proto3,enum=pganalyze.collector.QuerySample_ExplainFormat" json:"explain_format,omitempty"`
+	ExplainSource QuerySample_ExplainSource `protobuf:"varint,24,opt,name=explain_source,json=explainSource,proto3,enum=pganalyze.collector.QuerySample_ExplainSource" json:"explain_source,omitempty"`
+	// Top-level fields extracted from JSON format plans (if available)
+	ExplainSummary       *QueryExplainSummary `protobuf:"bytes,25,opt,name=explain_summary,json=explainSummary,proto3" json:"explain_summary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *QuerySample) Reset()         { *m = QuerySample{} }
 func (m *QuerySample) String() string { return proto.CompactTextString(m) }
 func (*QuerySample) ProtoMessage()    {}
 func (*QuerySample) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b302a0d569b4233, []int{3}
+	return fileDescriptor_1b302a0d569b4233, []int{4}
 }
 
 func (m *QuerySample) XXX_Unmarshal(b []byte) error {
@@ -957,183 +1160,306 @@ func (m *QuerySample) GetExplainSource() QuerySample_ExplainSource {
 	return QuerySample_STATEMENT_LOG_EXPLAIN_SOURCE
 }
 
+func (m *QuerySample) GetExplainSummary() *QueryExplainSummary {
+	if m != nil {
+		return m.ExplainSummary
+	}
+	return nil
+}
+
+type QueryExplainSummary struct {
+	NodeType    string  `protobuf:"bytes,1,opt,name=node_type,json=nodeType,proto3" json:"node_type,omitempty"`
+	StartupCost float64 `protobuf:"fixed64,2,opt,name=startup_cost,json=startupCost,proto3" json:"startup_cost,omitempty"`
+	TotalCost   float64 `protobuf:"fixed64,3,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	PlanRows    float64 `protobuf:"fixed64,4,opt,name=plan_rows,json=planRows,proto3" json:"plan_rows,omitempty"`
+	// Only set when the plan includes actual row counts (EXPLAIN ANALYZE)
+	HasActualRows        bool     `protobuf:"varint,5,opt,name=has_actual_rows,json=hasActualRows,proto3" json:"has_actual_rows,omitempty"`
+	ActualRows           float64  `protobuf:"fixed64,6,opt,name=actual_rows,json=actualRows,proto3" json:"actual_rows,omitempty"`
+	Misestimated         bool     `protobuf:"varint,7,opt,name=misestimated,proto3" json:"misestimated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryExplainSummary) Reset()         { *m = QueryExplainSummary{} }
+func (m *QueryExplainSummary) String() string { return proto.CompactTextString(m) }
+func (*QueryExplainSummary) ProtoMessage()    {}
+func (*QueryExplainSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b302a0d569b4233, []int{5}
+}
+
+func (m *QueryExplainSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryExplainSummary.Unmarshal(m, b)
+}
+func (m *QueryExplainSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryExplainSummary.Marshal(b, m, deterministic)
+}
+func (m *QueryExplainSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExplainSummary.Merge(m, src)
+}
+func (m *QueryExplainSummary) XXX_Size() int {
+	return xxx_messageInfo_QueryExplainSummary.Size(m)
+}
+func (m *QueryExplainSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExplainSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExplainSummary proto.InternalMessageInfo
+
+func (m *QueryExplainSummary) GetNodeType() string {
+	if m != nil {
+		return m.NodeType
+	}
+	return ""
+}
+
+func (m *QueryExplainSummary) GetStartupCost() float64 {
+	if m != nil {
+		return m.StartupCost
+	}
+	return 0
+}
+
+func (m *QueryExplainSummary) GetTotalCost() float64 {
+	if m != nil {
+		return m.TotalCost
+	}
+	return 0
+}
+
+func (m *QueryExplainSummary) GetPlanRows() float64 {
+	if m != nil {
+		return m.PlanRows
+	}
+	return 0
+}
+
+func (m *QueryExplainSummary) GetHasActualRows() bool {
+	if m != nil {
+		return m.HasActualRows
+	}
+	return false
+}
+
+func (m *QueryExplainSummary) GetActualRows() float64 {
+	if m != nil {
+		return m.ActualRows
+	}
+	return 0
+}
+
+func (m *QueryExplainSummary) GetMisestimated() bool {
+	if m != nil {
+		return m.Misestimated
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("pganalyze.collector.LockEvent_Kind", LockEvent_Kind_name, LockEvent_Kind_value)
 	proto.RegisterEnum("pganalyze.collector.LogFileReference_LogSecretKind", LogFileReference_LogSecretKind_name, LogFileReference_LogSecretKind_value)
 	proto.RegisterEnum("pganalyze.collector.LogLineInformation_LogLevel", LogLineInformation_LogLevel_name, LogLineInformation_LogLevel_value)
 	proto.RegisterEnum("pganalyze.collector.LogLineInformation_LogClassification", LogLineInformation_LogClassification_name, LogLineInformation_LogClassification_value)
 	proto.RegisterEnum("pganalyze.collector.QuerySample_ExplainFormat", QuerySample_ExplainFormat_name, QuerySample_ExplainFormat_value)
 	proto.RegisterEnum("pganalyze.collector.QuerySample_ExplainSource", QuerySample_ExplainSource_name, QuerySample_ExplainSource_value)
 	proto.RegisterType((*CompactLogSnapshot)(nil), "pganalyze.collector.CompactLogSnapshot")
+	proto.RegisterType((*LockEvent)(nil), "pganalyze.collector.LockEvent")
 	proto.RegisterType((*LogFileReference)(nil), "pganalyze.collector.LogFileReference")
 	proto.RegisterType((*LogLineInformation)(nil), "pganalyze.collector.LogLineInformation")
 	proto.RegisterType((*QuerySample)(nil), "pganalyze.collector.QuerySample")
+	proto.RegisterType((*QueryExplainSummary)(nil), "pganalyze.collector.QueryExplainSummary")
 }
 
 func init() { proto.RegisterFile("compact_log_snapshot.proto", fileDescriptor_1b302a0d569b4233) }
 
 var fileDescriptor_1b302a0d569b4233 = []byte{
-	// 2595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xdb, 0x76, 0x1b, 0xb9,
-	0xb1, 0x35, 0x45, 0xcb, 0x92, 0xa0, 0x8b, 0x61, 0xd8, 0x92, 0x68, 0xc9, 0x96, 0x69, 0xf9, 0xf8,
-	0x8c, 0xce, 0xc9, 0x44, 0x93, 0x65, 0x27, 0x0f, 0x59, 0xb9, 0x42, 0xdd, 0x45, 0x0a, 0x56, 0x13,
-	0x68, 0xa1, 0xd1, 0xba, 0x78, 0x92, 0x20, 0x34, 0xd9, 0x92, 0x19, 0x53, 0xa4, 0xcc, 0xa6, 0x26,
-	0xb6, 0x73, 0x9d, 0xdc, 0x26, 0xc9, 0x3c, 0xe4, 0x29, 0x7f, 0x91, 0xdf, 0xc9, 0xca, 0x2f, 0xe4,
-	0x33, 0xb2, 0x0a, 0xdd, 0xbc, 0x88, 0x56, 0x92, 0x99, 0x37, 0xb2, 0xf6, 0xc6, 0x06, 0x50, 0x55,
-	0xa8, 0x02, 0x9a, 0xac, 0x35, 0xba, 0x67, 0xe7, 0xf5, 0x46, 0xdf, 0xb6, 0xbb, 0xa7, 0x36, 0xed,
-	0xd4, 0xcf, 0xd3, 0x97, 0xdd, 0xfe, 0xf6, 0x79, 0xaf, 0xdb, 0xef, 0xb2, 0xdb, 0xe7, 0xa7, 0xf5,
-	0x4e, 0xbd, 0xfd, 0xf6, 0x5d, 0xb2, 0xdd, 0xe8, 0xb6, 0xdb, 0x49, 0xa3, 0xdf, 0xed, 0xad, 0x3d,
-	0x38, 0xed, 0x76, 0x4f, 0xdb, 0xc9, 0x47, 0x8e, 0xf2, 0xe2, 0xe2, 0xe4, 0xa3, 0x7e, 0xeb, 0x2c,
-	0x49, 0xfb, 0xf5, 0xb3, 0xf3, 0x6c, 0xd4, 0xe6, 0x5f, 0xa6, 0x08, 0xf3, 0x32, 0xd1, 0xa0, 0x7b,
-	0x1a, 0xe5, 0x92, 0x2c, 0x26, 0xb7, 0x71, 0x8a, 0x93, 0x56, 0x3b, 0xb1, 0xbd, 0xe4, 0x24, 0xe9,
-	0x25, 0x9d, 0x46, 0x92, 0x96, 0x0a, 0xe5, 0xe2, 0xd6, 0xfc, 0x93, 0xc7, 0xdb, 0x57, 0x4c, 0xb5,
-	0x1d, 0x74, 0x4f, 0x2b, 0xad, 0x76, 0xa2, 0x07, 0x6c, 0x7d, 0xab, 0x3d, 0x61, 0x49, 0xd9, 0xc7,
-	0x64, 0x19, 0x65, 0xdb, 0xad, 0x4e, 0x62, 0x5b, 0x9d, 0x93, 0x6e, 0xef, 0xac, 0xde, 0x6f, 0x75,
-	0x3b, 0x69, 0x69, 0xca, 0x09, 0x7f, 0xf0, 0xef, 0x84, 0x83, 0x56, 0x27, 0x11, 0x23, 0xbe, 0xc6,
-	0xc5, 0x4d, 0xd8, 0x52, 0x06, 0x64, 0xf1, 0xf5, 0x45, 0xd2, 0x7b, 0x6b, 0xd3, 0xfa, 0xd9, 0x79,
-	0x3b, 0x49, 0x4b, 0x45, 0x27, 0x5a, 0xbe, 0x52, 0x74, 0x1f, 0x99, 0x91, 0x23, 0xea, 0x85, 0xd7,
-	0xa3, 0x3f, 0xe9, 0xe6, 0x67, 0xd7, 0x09, 0x9d, 0xdc, 0x0b, 0x63, 0xe4, 0xfa, 0xc5, 0x45, 0xab,
-	0x59, 0x2a, 0x94, 0x0b, 0x5b, 0x73, 0xda, 0xfd, 0x66, 0x0f, 0xc8, 0x7c, 0xfa, 0xd4, 0xb6, 0xbb,
-	0x0d, 0x37, 0x7f, 0x69, 0xca, 0x41, 0x24, 0x7d, 0x1a, 0xe4, 0x16, 0xb6, 0xe1, 0x08, 0x8d, 0xe4,
-	0x95, 0xad, 0xb7, 0x4f, 0xbb, 0xa5, 0xa2, 0x23, 0xcc, 0xa5, 0x4f, 0xbd, 0xe4, 0x15, 0x6f, 0x9f,
-	0x76, 0xd9, 0x43, 0xb2, 0x88, 0xf8, 0xd9, 0x2b, 0xfb, 0x2a, 0x79, 0x6b, 0x5b, 0xcd, 0xd2, 0xf5,
-	0x81, 0x84, 0x77, 0xf6, 0x6a, 0x2f, 0x79, 0x2b, 0x9a, 0x6c, 0x9d, 0xcc, 0xbd, 0x78, 0xdb, 0x4f,
-	0x6c, 0xda, 0x7a, 0x97, 0x94, 0xa6, 0xcb, 0x85, 0xad, 0xa2, 0x9e, 0x45, 0x43, 0xd4, 0x7a, 0x97,
-	0xb0, 0x47, 0x64, 0xb1, 0xdb, 0x6b, 0x9d, 0xb6, 0x3a, 0xf5, 0xb6, 0xed, 0xd4, 0xcf, 0x92, 0xd2,
-	0x0d, 0x37, 0x7e, 0x61, 0x60, 0x94, 0xf5, 0xb3, 0x84, 0x59, 0x72, 0xeb, 0xa4, 0xd5, 0xee, 0x27,
-	0xbd, 0x2c, 0x67, 0x92, 0x46, 0x2f, 0xe9, 0x97, 0x48, 0xb9, 0xb8, 0xb5, 0xf4, 0xe4, 0xe9, 0x17,
-	0x8a, 0x23, 0x1a, 0x22, 0x37, 0x6c, 0xaf, 0xd5, 0x69, 0xea, 0x9b, 0x99, 0xda, 0xd0, 0xb8, 0xf9,
-	0x8f, 0x02, 0x59, 0xbc, 0x44, 0x61, 0x77, 0xc9, 0xb2, 0xa7, 0xc1, 0x07, 0x69, 0x04, 0x0f, 0x6c,
-	0xa0, 0xaa, 0x36, 0x02, 0x4f, 0x83, 0xa1, 0xd7, 0xd8, 0x3d, 0x52, 0x0a, 0xb9, 0x8e, 0x84, 0xac,
-	0x5a, 0xd0, 0x5a, 0xe9, 0x71, 0xb4, 0xc0, 0xee, 0x93, 0xbb, 0x91, 0xe1, 0x06, 0x6a, 0x20, 0x8d,
-	0x35, 0x70, 0x64, 0xc6, 0xe1, 0x29, 0xb6, 0x49, 0x36, 0x46, 0x70, 0xc8, 0x35, 0xaf, 0x81, 0x81,
-	0x4b, 0x12, 0x45, 0x9c, 0xdb, 0xf0, 0x9d, 0x00, 0xac, 0xcf, 0x0d, 0x1f, 0x87, 0xae, 0x33, 0x46,
-	0x96, 0x54, 0x18, 0x8d, 0xdb, 0xa6, 0xd9, 0x3a, 0x59, 0x8d, 0xa5, 0x70, 0x4b, 0xad, 0x08, 0xf0,
-	0xc7, 0xc1, 0x1b, 0x9b, 0x9f, 0x6e, 0x10, 0xf6, 0x7e, 0xf2, 0xb1, 0x32, 0x59, 0x18, 0x9e, 0x8d,
-	0x56, 0xf3, 0x8d, 0xcb, 0x89, 0x69, 0x4d, 0xf2, 0x6c, 0x17, 0xcd, 0x37, 0xc3, 0x6c, 0x99, 0xba,
-	0x9c, 0x2d, 0xe7, 0xf5, 0x5e, 0xd2, 0xe9, 0x5b, 0x07, 0x65, 0xc9, 0x40, 0x32, 0x53, 0x8c, 0x84,
-	0xfb, 0x84, 0x64, 0xa1, 0xee, 0xd7, 0x7b, 0x7d, 0x97, 0x0a, 0x45, 0xed, 0x82, 0x1f, 0xa1, 0x81,
-	0x7d, 0x48, 0x98, 0x83, 0x1b, 0xdd, 0x4e, 0x1f, 0x55, 0x32, 0x5a, 0x96, 0x12, 0x14, 0x11, 0x2f,
-	0x03, 0x32, 0xf6, 0x5d, 0xe2, 0xd2, 0xc4, 0x26, 0x9d, 0xa6, 0xcb, 0x8a, 0xa2, 0x9e, 0xc1, 0xff,
-	0xd0, 0x69, 0xe2, 0xf2, 0x5f, 0xd6, 0x53, 0xdb, 0xeb, 0xe6, 0xcb, 0x9f, 0x29, 0x17, 0xb6, 0x66,
-	0x35, 0x79, 0x59, 0x4f, 0x75, 0x37, 0x5b, 0xfe, 0x5d, 0x32, 0x3b, 0x44, 0x67, 0xdd, 0xe6, 0x66,
-	0x7a, 0x39, 0xb4, 0x45, 0x28, 0x0e, 0x6e, 0xd6, 0xfb, 0xf5, 0x17, 0xf5, 0x34, 0xa3, 0xcc, 0x39,
-	0x81, 0xa5, 0x97, 0xf5, 0xd4, 0xcf, 0xcd, 0xc8, 0x7c, 0x48, 0x16, 0x2e, 0xb1, 0x88, 0x13, 0x9a,
-	0x6f, 0x8e, 0x51, 0x36, 0xc9, 0x22, 0x8a, 0x65, 0x87, 0x16, 0x39, 0xf3, 0x4e, 0x69, 0xfe, 0x65,
-	0x3d, 0x75, 0xc7, 0x13, 0x39, 0xeb, 0x64, 0x6e, 0x84, 0x2f, 0x38, 0x8d, 0xd9, 0xd7, 0x03, 0xf0,
-	0x5b, 0x64, 0xbe, 0xdb, 0x68, 0x5c, 0xf4, 0x7a, 0x49, 0xd3, 0xd6, 0xfb, 0xa5, 0xc5, 0x72, 0x61,
-	0x6b, 0xfe, 0xc9, 0xda, 0x76, 0x56, 0xf3, 0xb6, 0x07, 0x35, 0x6f, 0xdb, 0x0c, 0x6a, 0x9e, 0x26,
-	0x03, 0x3a, 0xef, 0x63, 0x40, 0x5e, 0xd4, 0x1b, 0xaf, 0x92, 0x4e, 0xd3, 0x9e, 0xb7, 0x9a, 0xa5,
-	0xa5, 0x2c, 0x8a, 0xb9, 0x29, 0x6c, 0x35, 0x59, 0x85, 0x4c, 0xb7, 0x93, 0x4f, 0x92, 0x76, 0xe9,
-	0x66, 0xb9, 0xb0, 0xb5, 0xf4, 0xe4, 0x6b, 0x5f, 0xb0, 0x38, 0x39, 0x13, 0x8e, 0xd3, 0xd9, 0x70,
-	0x56, 0x27, 0x4b, 0x8d, 0x76, 0x3d, 0x4d, 0x5b, 0x27, 0xad, 0xbc, 0x54, 0x50, 0x27, 0xf8, 0xcd,
-	0x2f, 0x21, 0xe8, 0x5d, 0x12, 0xd0, 0x13, 0x82, 0xce, 0xd9, 0x49, 0xbf, 0xde, 0x6a, 0xa7, 0xf6,
-	0x27, 0x69, 0xb7, 0x53, 0xba, 0xe5, 0xb2, 0x6b, 0x3e, 0xb7, 0x3d, 0x4b, 0xbb, 0x9d, 0x41, 0xe4,
-	0x7a, 0x49, 0xdb, 0x0d, 0x71, 0xfe, 0x64, 0xc3, 0xc8, 0xe9, 0xdc, 0x9c, 0x47, 0xee, 0x12, 0xeb,
-	0x76, 0x16, 0xb9, 0xde, 0x15, 0x94, 0xc4, 0xf9, 0x2e, 0x2d, 0xdd, 0x29, 0x17, 0x87, 0x94, 0x04,
-	0x9d, 0x97, 0x6e, 0xfe, 0xad, 0x40, 0x66, 0x07, 0x9e, 0x60, 0xf3, 0x64, 0x26, 0x96, 0x7b, 0x52,
-	0x1d, 0x4a, 0x7a, 0x8d, 0xcd, 0x91, 0x69, 0x1f, 0x76, 0xe2, 0x2a, 0x2d, 0xb0, 0x59, 0x72, 0x5d,
-	0xc8, 0x8a, 0xa2, 0x53, 0x8c, 0x90, 0x1b, 0x52, 0x19, 0xe1, 0x01, 0x2d, 0x22, 0xfb, 0x90, 0x6b,
-	0x29, 0x64, 0x95, 0x5e, 0x47, 0xb6, 0xab, 0x14, 0x74, 0x9a, 0xcd, 0x90, 0x62, 0xa0, 0xaa, 0xf4,
-	0x06, 0xda, 0x2a, 0xdc, 0xf0, 0x80, 0xce, 0xe0, 0xcf, 0x90, 0x4b, 0xe1, 0xd1, 0x59, 0x94, 0xf0,
-	0xc1, 0x70, 0x11, 0xd0, 0x39, 0x14, 0xde, 0x15, 0xd2, 0x50, 0x82, 0x62, 0x9e, 0x92, 0x58, 0x4c,
-	0xe8, 0x3c, 0x5b, 0x24, 0x73, 0xc3, 0x0a, 0x42, 0x17, 0x70, 0xf0, 0x7e, 0x0c, 0xfa, 0x98, 0x2e,
-	0x6e, 0xfe, 0x75, 0x85, 0xdc, 0x7a, 0xcf, 0xcf, 0x6c, 0x83, 0xac, 0xe5, 0xeb, 0x76, 0x95, 0xc1,
-	0x0b, 0x78, 0x14, 0x89, 0x8a, 0xf0, 0xb8, 0x11, 0x0a, 0xb7, 0xc2, 0xc8, 0x52, 0x04, 0xfa, 0x00,
-	0xb4, 0xf5, 0x34, 0x8f, 0x76, 0xc1, 0xa7, 0x05, 0x46, 0xc9, 0x42, 0x6e, 0x8b, 0x0c, 0xd7, 0x58,
-	0xb7, 0xd6, 0xc9, 0xea, 0xb8, 0xc5, 0x6a, 0xf0, 0xd4, 0x01, 0x68, 0xdc, 0x5f, 0x91, 0xdd, 0x26,
-	0x37, 0x07, 0xe0, 0x6e, 0x6c, 0x7c, 0x74, 0xd1, 0x75, 0x56, 0x22, 0x77, 0x72, 0xa3, 0x8a, 0x8d,
-	0x55, 0x15, 0x5b, 0x83, 0x9a, 0xd2, 0xc7, 0x59, 0xc1, 0xca, 0x11, 0x21, 0x0f, 0x78, 0x20, 0x7c,
-	0xeb, 0xed, 0x82, 0xb7, 0x17, 0xc5, 0x35, 0x7a, 0x03, 0xab, 0x6b, 0x0e, 0x1a, 0xa8, 0x85, 0xb6,
-	0x22, 0x02, 0xb0, 0x9e, 0x06, 0x6e, 0xc0, 0xa7, 0x33, 0xec, 0x26, 0x99, 0xcf, 0xd1, 0x9a, 0x88,
-	0xd0, 0x61, 0xb7, 0xc8, 0x62, 0x6e, 0xd0, 0x10, 0x28, 0xee, 0xd3, 0x39, 0x2c, 0x9f, 0xb9, 0x29,
-	0xd4, 0xca, 0x83, 0x28, 0xb2, 0x70, 0x24, 0x70, 0x38, 0x71, 0xd5, 0x77, 0xb8, 0x0b, 0x13, 0x59,
-	0x4f, 0x05, 0x01, 0x78, 0x46, 0x69, 0x6b, 0x44, 0x0d, 0x54, 0x8c, 0xfe, 0x5d, 0x25, 0xb7, 0x3d,
-	0x25, 0x25, 0x78, 0xe8, 0x1f, 0xdc, 0x27, 0x88, 0x03, 0xf0, 0xe9, 0x1d, 0xd7, 0x12, 0x46, 0x00,
-	0x8f, 0xcd, 0xae, 0xd2, 0xe2, 0x39, 0xf8, 0x74, 0xf9, 0xbd, 0x31, 0xcf, 0xc0, 0xc3, 0x09, 0x57,
-	0x70, 0xab, 0x63, 0x80, 0x2f, 0xa2, 0xfc, 0x1f, 0xf8, 0x74, 0x95, 0x7d, 0x40, 0x1e, 0x8d, 0x81,
-	0x5e, 0x20, 0xb0, 0x27, 0x54, 0xb8, 0x08, 0xc0, 0xb7, 0x46, 0xd9, 0x1c, 0xa3, 0x25, 0xf4, 0xef,
-	0x18, 0x31, 0x50, 0x91, 0xa1, 0x77, 0x27, 0xa4, 0xd1, 0x68, 0x55, 0x08, 0xd2, 0x9a, 0x23, 0xba,
-	0x36, 0xb1, 0x56, 0x03, 0xba, 0x26, 0xa4, 0x73, 0xe1, 0x3a, 0x5b, 0x21, 0x2c, 0x0f, 0xc8, 0x88,
-	0x11, 0xd1, 0x7b, 0xd8, 0xb8, 0x8c, 0x52, 0xb6, 0xc6, 0xe5, 0xf1, 0x38, 0x62, 0xb5, 0x0a, 0x80,
-	0xde, 0x67, 0x8f, 0xc8, 0x03, 0x4f, 0xc5, 0x81, 0x6f, 0xa5, 0x32, 0x96, 0x7b, 0x1e, 0x84, 0xc6,
-	0x46, 0x51, 0x30, 0x46, 0xa5, 0x1b, 0xec, 0x7f, 0xc9, 0x66, 0xa8, 0x95, 0x51, 0x9e, 0x0a, 0xf2,
-	0xde, 0x18, 0xcb, 0x28, 0x0e, 0x43, 0xa5, 0x0d, 0xf8, 0xf6, 0x00, 0x74, 0x84, 0xbc, 0x07, 0xec,
-	0x31, 0x79, 0x38, 0xc1, 0x13, 0xd2, 0x53, 0xb5, 0x30, 0x00, 0x03, 0xb6, 0x06, 0x51, 0xc4, 0xab,
-	0x40, 0xcb, 0xec, 0x21, 0xb9, 0x7f, 0xe5, 0x92, 0xb0, 0x2f, 0xee, 0xf0, 0x08, 0xe8, 0x43, 0xe7,
-	0x79, 0x4c, 0x9e, 0x50, 0x09, 0x69, 0xb2, 0xdc, 0xc4, 0x9c, 0xdc, 0x9a, 0x00, 0x06, 0xe2, 0xf4,
-	0xff, 0x9c, 0xdf, 0x46, 0x00, 0xea, 0x57, 0x34, 0xec, 0xc7, 0x78, 0x9a, 0xfe, 0x1f, 0xfd, 0xa6,
-	0xc1, 0xa9, 0x4c, 0x08, 0x7e, 0xe5, 0x3d, 0x68, 0x28, 0xf9, 0x21, 0xc6, 0xe7, 0x12, 0xc4, 0x0d,
-	0xfd, 0x2a, 0xfa, 0xf3, 0x90, 0x07, 0xc3, 0x14, 0xc7, 0x03, 0xa3, 0x7d, 0x1b, 0x80, 0xac, 0x9a,
-	0x5d, 0xfa, 0x84, 0x2d, 0x90, 0x59, 0x84, 0x35, 0xf8, 0x8a, 0x3e, 0xc5, 0x43, 0x8a, 0xff, 0xb8,
-	0xf6, 0x76, 0xc5, 0x01, 0xa0, 0x76, 0x8d, 0x4b, 0x3f, 0x4f, 0x06, 0xfa, 0x75, 0x3c, 0x15, 0x88,
-	0xe3, 0xa6, 0xed, 0x0e, 0xf7, 0xf6, 0xe2, 0x70, 0x34, 0xff, 0x37, 0xd8, 0x32, 0xb9, 0xc5, 0x63,
-	0xa3, 0x0e, 0xb8, 0x17, 0xc7, 0x35, 0xeb, 0x71, 0xe9, 0x41, 0x40, 0xbf, 0x8d, 0x3b, 0x35, 0x47,
-	0xc2, 0xb7, 0x87, 0x9a, 0x87, 0x5c, 0xab, 0x58, 0xfa, 0x76, 0x50, 0x93, 0xbe, 0xe3, 0x2e, 0x19,
-	0x13, 0x60, 0x56, 0xa3, 0xbe, 0xcb, 0x1e, 0x90, 0xf5, 0x31, 0xb9, 0x80, 0xc7, 0xd2, 0xdb, 0x1d,
-	0x1c, 0x7c, 0xf0, 0xe9, 0xf7, 0x30, 0x7c, 0x57, 0x12, 0x76, 0x63, 0x83, 0xce, 0xb2, 0xae, 0x02,
-	0x7c, 0x1f, 0x2b, 0xc0, 0xf8, 0xb2, 0xf2, 0xf5, 0xfa, 0x94, 0xe3, 0xe4, 0x88, 0x70, 0xc9, 0x83,
-	0xe3, 0xe7, 0x30, 0x06, 0xed, 0x60, 0x0a, 0x45, 0x7b, 0x22, 0x0c, 0x51, 0x67, 0x30, 0x81, 0xf2,
-	0xf6, 0xb2, 0xb4, 0x3b, 0xe0, 0x22, 0xc0, 0x9b, 0x11, 0xf5, 0xf0, 0xf0, 0x0c, 0x79, 0x03, 0x9d,
-	0x2b, 0x88, 0x3e, 0x56, 0x08, 0x67, 0xe7, 0xde, 0x7e, 0x2c, 0x34, 0xf8, 0xb4, 0x82, 0xe5, 0xcd,
-	0x99, 0x0e, 0xb9, 0x70, 0xc1, 0xad, 0x0e, 0x2d, 0x83, 0x32, 0xb0, 0xcb, 0xd6, 0xc8, 0x8a, 0xb3,
-	0xf8, 0xc0, 0xfd, 0xfc, 0x87, 0xc9, 0x0e, 0xae, 0xc0, 0xe5, 0x5f, 0xc6, 0xf8, 0x81, 0x12, 0x3e,
-	0xf8, 0xf4, 0x19, 0x9e, 0xae, 0xd1, 0xfd, 0xce, 0x8f, 0x75, 0x56, 0x65, 0x43, 0x0c, 0xf0, 0xc8,
-	0x9e, 0x45, 0x08, 0x4f, 0x79, 0x3e, 0xdd, 0xbe, 0xab, 0x89, 0xef, 0xe3, 0x71, 0x04, 0x9a, 0x6a,
-	0x57, 0xe4, 0x86, 0x20, 0xb6, 0x8f, 0x08, 0x97, 0x37, 0x32, 0xa1, 0x2f, 0x2d, 0x1c, 0x85, 0x01,
-	0x17, 0x92, 0x1a, 0x0c, 0x4f, 0x64, 0xb8, 0xf4, 0x77, 0x8e, 0x2d, 0xa6, 0xa5, 0xd2, 0x80, 0x81,
-	0x0f, 0x6c, 0x45, 0xab, 0xda, 0x20, 0xc5, 0xe8, 0xf3, 0xfc, 0xa6, 0xea, 0x68, 0x79, 0x68, 0x6d,
-	0x64, 0x34, 0xf0, 0x1a, 0xba, 0xe4, 0x63, 0x3c, 0x7c, 0x23, 0x38, 0x37, 0x5b, 0x21, 0x0d, 0x68,
-	0x1d, 0x87, 0xe8, 0x87, 0x1f, 0x5c, 0x56, 0x50, 0x61, 0x78, 0x49, 0xe1, 0x87, 0xe3, 0xeb, 0xf0,
-	0x94, 0x8c, 0x44, 0x64, 0x70, 0xb1, 0x79, 0xe7, 0x70, 0x93, 0x1a, 0xa0, 0x3f, 0xca, 0x5d, 0x33,
-	0x58, 0xc7, 0x84, 0x0b, 0xa8, 0x75, 0x1d, 0x21, 0xc7, 0x07, 0x87, 0x09, 0xfd, 0x16, 0x08, 0x09,
-	0xf4, 0xc7, 0x98, 0xac, 0xb1, 0x14, 0xfb, 0x31, 0xb8, 0x39, 0x8c, 0xe6, 0x78, 0x00, 0x0f, 0x84,
-	0x0a, 0x32, 0xcf, 0x37, 0xd9, 0xff, 0x90, 0x72, 0x45, 0x69, 0x10, 0x55, 0x69, 0xf7, 0xe0, 0xf8,
-	0x6a, 0x56, 0x82, 0xbb, 0xc5, 0xc4, 0x91, 0x71, 0x10, 0x5c, 0x4d, 0x39, 0xc1, 0x75, 0xba, 0xc2,
-	0x71, 0x35, 0x7e, 0x8a, 0xcd, 0x05, 0x8e, 0xbc, 0x20, 0x8e, 0x5c, 0x35, 0xbf, 0x8a, 0xf3, 0xd2,
-	0x35, 0xd6, 0x63, 0x69, 0xf8, 0x51, 0x7e, 0xd8, 0x3a, 0x78, 0x48, 0x06, 0xbb, 0x12, 0x32, 0x8c,
-	0x8d, 0xcd, 0x70, 0xda, 0xc5, 0x94, 0x38, 0xe0, 0x41, 0x0c, 0xae, 0x46, 0x05, 0x4a, 0x56, 0x6d,
-	0x05, 0x1b, 0xd5, 0x71, 0x08, 0xf4, 0x1c, 0x53, 0x62, 0x30, 0xcc, 0x91, 0xe8, 0x6b, 0xe4, 0xd7,
-	0x78, 0x50, 0x51, 0xba, 0x06, 0xbe, 0xe5, 0x5a, 0xf3, 0x63, 0x1b, 0x08, 0x03, 0x9a, 0x07, 0xb4,
-	0xe7, 0xf2, 0x25, 0xde, 0x71, 0x37, 0x05, 0x6c, 0x9d, 0xee, 0xf5, 0xc2, 0x03, 0xc1, 0x23, 0x9a,
-	0xe2, 0xde, 0x85, 0x8c, 0x40, 0x1b, 0x6b, 0xb8, 0xae, 0x02, 0x96, 0xb6, 0x20, 0xae, 0x49, 0xe4,
-	0xd5, 0xb8, 0xf1, 0x76, 0x69, 0x1f, 0x87, 0x63, 0x11, 0xe6, 0x01, 0x56, 0x2c, 0x77, 0x8e, 0xa2,
-	0x6c, 0x0a, 0x7a, 0xc1, 0xca, 0xe4, 0xde, 0x68, 0x80, 0x13, 0x76, 0x89, 0x56, 0xd5, 0x2a, 0x0e,
-	0xed, 0xce, 0x31, 0xfd, 0x04, 0x57, 0xa6, 0x21, 0xf3, 0x81, 0xf5, 0x15, 0x44, 0xee, 0x8c, 0xc2,
-	0x91, 0x88, 0x0c, 0xfd, 0x69, 0xd6, 0xaa, 0xdc, 0xf0, 0x09, 0x08, 0x2f, 0xce, 0xab, 0x2a, 0x04,
-	0xcd, 0xb1, 0x41, 0x4f, 0x80, 0x6f, 0x5d, 0x38, 0xb2, 0x71, 0x1a, 0x2a, 0xa0, 0x41, 0x7a, 0x60,
-	0x79, 0x6d, 0x47, 0x54, 0x63, 0x15, 0x47, 0xf4, 0x1d, 0x16, 0xc5, 0x10, 0xfb, 0x5e, 0xe4, 0xe2,
-	0xe1, 0x83, 0x14, 0xe0, 0xd3, 0x9f, 0xe1, 0x4e, 0x8c, 0xe6, 0x32, 0xe2, 0x59, 0x6b, 0x14, 0x91,
-	0xe5, 0x3b, 0xae, 0x3d, 0xd1, 0x9f, 0x63, 0x8f, 0xcb, 0x42, 0x57, 0x09, 0x84, 0x67, 0xac, 0x54,
-	0xe3, 0x61, 0xcc, 0x5c, 0xf1, 0x0b, 0x0c, 0xf3, 0x38, 0x49, 0xab, 0x43, 0xcb, 0x2b, 0x15, 0x57,
-	0x1a, 0xac, 0x39, 0xc4, 0xdb, 0xdf, 0x2f, 0xc7, 0xf6, 0xe4, 0x71, 0x89, 0x8b, 0xde, 0x01, 0xeb,
-	0xf1, 0xc8, 0xd0, 0x5f, 0xb1, 0x65, 0x42, 0x7d, 0x71, 0x20, 0xdc, 0xa2, 0x76, 0x8e, 0xed, 0x73,
-	0xd0, 0x8a, 0xfe, 0x1a, 0x6f, 0x5c, 0xf3, 0x39, 0xd5, 0xd7, 0x2a, 0xa4, 0x9f, 0x16, 0xd8, 0x5d,
-	0x4c, 0x0c, 0x03, 0xd5, 0xd1, 0x05, 0x4a, 0x73, 0x59, 0x05, 0xfa, 0x9b, 0x02, 0xbb, 0x4d, 0x96,
-	0x46, 0x6d, 0xa5, 0x0a, 0x47, 0x21, 0xfd, 0x6d, 0x81, 0x31, 0xb2, 0xe8, 0xde, 0x93, 0x83, 0x28,
-	0xd0, 0xdf, 0x15, 0xd8, 0x3d, 0xb2, 0x5a, 0x89, 0xa5, 0x77, 0x95, 0xe3, 0x7f, 0x5f, 0x60, 0x2b,
-	0xe4, 0x96, 0x54, 0x36, 0x8a, 0xbd, 0x5d, 0x1b, 0xf1, 0x03, 0x70, 0xbd, 0x8b, 0xfe, 0xa1, 0xc0,
-	0x1e, 0xe0, 0x8d, 0x71, 0x74, 0x67, 0xb0, 0xfb, 0xb1, 0xca, 0x8b, 0x03, 0xca, 0x7e, 0x56, 0x60,
-	0x8f, 0xc8, 0xc6, 0x55, 0x84, 0xe1, 0x1b, 0x54, 0xd3, 0x3f, 0x16, 0xd8, 0x1a, 0x59, 0x1e, 0x2c,
-	0x72, 0xe7, 0xd8, 0x80, 0x8d, 0x5c, 0x93, 0xf5, 0x80, 0xfe, 0xa9, 0xc0, 0xb6, 0xf0, 0xe6, 0x33,
-	0xb8, 0x4c, 0x44, 0xa0, 0x05, 0x0f, 0xc4, 0x73, 0xb0, 0x1a, 0x42, 0xe0, 0xd9, 0xd3, 0x57, 0x03,
-	0xf7, 0xe9, 0x9f, 0x0b, 0xec, 0x31, 0x29, 0x5f, 0xc5, 0x1c, 0xfc, 0x72, 0x35, 0xfe, 0xf3, 0x02,
-	0x5b, 0x27, 0x2b, 0x61, 0x95, 0x8f, 0xdd, 0xe7, 0xf2, 0xb5, 0x1c, 0xd3, 0x7f, 0xce, 0x6c, 0xfe,
-	0x7d, 0x9a, 0xcc, 0x8f, 0x7d, 0x2b, 0xb9, 0xfc, 0x1e, 0x2b, 0xfc, 0xe7, 0xf7, 0xd8, 0xd4, 0x97,
-	0x7a, 0x8f, 0xdd, 0x27, 0xa4, 0x77, 0xd1, 0xe9, 0xb7, 0xce, 0x12, 0x7b, 0x96, 0xba, 0xf7, 0x71,
-	0x41, 0xcf, 0xe5, 0x96, 0x5a, 0x8a, 0x70, 0x36, 0x71, 0x3f, 0x79, 0xd3, 0xcf, 0xbf, 0x94, 0x64,
-	0x4b, 0x31, 0xc9, 0x9b, 0x3e, 0xdb, 0x20, 0xf8, 0x96, 0xae, 0x9f, 0x25, 0xfd, 0xa4, 0x97, 0x96,
-	0xa6, 0xcb, 0xc5, 0xfc, 0x75, 0x9d, 0x5b, 0xf0, 0xad, 0x39, 0xfc, 0xf2, 0xe4, 0x1e, 0xe0, 0x24,
-	0x7b, 0x22, 0xe5, 0x1f, 0x92, 0xe2, 0xfc, 0x89, 0x8e, 0x4f, 0xa4, 0xe4, 0xcd, 0x79, 0xbb, 0xde,
-	0xea, 0x94, 0xee, 0x0c, 0x1f, 0xc6, 0x90, 0x59, 0xd8, 0x63, 0xb2, 0x94, 0x83, 0xb6, 0x7b, 0xd1,
-	0x3f, 0xbf, 0xe8, 0x97, 0x96, 0x9d, 0xca, 0x62, 0x6e, 0x55, 0xce, 0xc8, 0x1e, 0x91, 0x81, 0xc1,
-	0x26, 0xbd, 0x5e, 0xb7, 0x57, 0x5a, 0xc9, 0xbe, 0xcb, 0xe4, 0x46, 0x40, 0x1b, 0x8b, 0x47, 0x5a,
-	0xd9, 0x4b, 0xaf, 0xb4, 0xea, 0x5e, 0x85, 0xdb, 0xff, 0xed, 0x73, 0xd5, 0x76, 0xbe, 0x9a, 0x8a,
-	0x1b, 0x35, 0x9c, 0x3b, 0xfb, 0x3b, 0x2e, 0x9b, 0x76, 0x2f, 0x7a, 0x8d, 0xa4, 0x54, 0xfa, 0x72,
-	0xb2, 0x91, 0x1b, 0x35, 0x94, 0xcd, 0xfe, 0x6e, 0x72, 0xb2, 0x78, 0x69, 0x5a, 0xbc, 0x22, 0xba,
-	0x0f, 0x34, 0x79, 0xe7, 0xc4, 0xf2, 0x5a, 0xe3, 0x86, 0x5e, 0x43, 0xe0, 0x59, 0xa4, 0xe4, 0x24,
-	0x50, 0xd8, 0xfc, 0xbc, 0x30, 0xd4, 0xc8, 0x44, 0xb1, 0xf8, 0x5d, 0x6a, 0xcd, 0xc3, 0x31, 0x91,
-	0x8a, 0xb5, 0x07, 0xf4, 0xda, 0xe0, 0x36, 0x35, 0x04, 0x26, 0x08, 0x98, 0xbb, 0xab, 0x70, 0x64,
-	0x40, 0x4b, 0x1e, 0x4c, 0x82, 0x53, 0x58, 0xae, 0xaa, 0x20, 0x41, 0x0b, 0x6f, 0x12, 0x2b, 0xbe,
-	0xb8, 0xe1, 0xb2, 0xf1, 0xe9, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa9, 0x89, 0x58, 0xa8, 0x52,
-	0x15, 0x00, 0x00,
+	// 3006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xdb, 0x76, 0xdb, 0xb8,
+	0xb9, 0x0e, 0x2d, 0x1f, 0x21, 0xdb, 0x81, 0xe1, 0x24, 0x56, 0x9c, 0xc4, 0x56, 0x94, 0x9d, 0x19,
+	0xef, 0xbd, 0xa7, 0x9e, 0xae, 0x64, 0xda, 0xae, 0xae, 0x4e, 0x3b, 0x85, 0x49, 0x48, 0x66, 0x4c,
+	0x91, 0x34, 0x08, 0x3a, 0x76, 0xa6, 0x2d, 0xca, 0x48, 0x8c, 0xa3, 0x9a, 0x12, 0x1d, 0x91, 0x9a,
+	0xc4, 0xe9, 0x71, 0x7a, 0x9a, 0xb6, 0x73, 0xd9, 0xd5, 0x77, 0xe8, 0x45, 0x1f, 0xa6, 0x77, 0x7d,
+	0x85, 0x3e, 0x45, 0x57, 0xd7, 0x0f, 0x92, 0x92, 0x2c, 0x3b, 0x33, 0xc9, 0x1d, 0xf9, 0x7f, 0x3f,
+	0x3e, 0x00, 0xff, 0x11, 0x00, 0x5a, 0x6f, 0xc5, 0xdd, 0xd3, 0xa0, 0x95, 0xca, 0x28, 0x3e, 0x96,
+	0x49, 0x2f, 0x38, 0x4d, 0x9e, 0xc7, 0xe9, 0xf6, 0x69, 0x3f, 0x4e, 0x63, 0xb2, 0x7a, 0x7a, 0x1c,
+	0xf4, 0x82, 0xe8, 0xec, 0x75, 0xb8, 0xdd, 0x8a, 0xa3, 0x28, 0x6c, 0xa5, 0x71, 0x7f, 0x7d, 0xf3,
+	0x38, 0x8e, 0x8f, 0xa3, 0xf0, 0x43, 0xa5, 0xf2, 0x74, 0xf0, 0xec, 0xc3, 0xb4, 0xd3, 0x0d, 0x93,
+	0x34, 0xe8, 0x9e, 0x66, 0xa3, 0xd6, 0x17, 0x93, 0xe7, 0x41, 0x3f, 0x6c, 0x67, 0x7f, 0xb5, 0x7f,
+	0x4e, 0x21, 0xa2, 0x67, 0x53, 0x58, 0xf1, 0xb1, 0x97, 0x4f, 0x40, 0x7c, 0xb4, 0x0a, 0x13, 0x3e,
+	0xeb, 0x44, 0xa1, 0xec, 0x87, 0xcf, 0xc2, 0x7e, 0xd8, 0x6b, 0x85, 0x49, 0x45, 0xab, 0x96, 0xb6,
+	0xca, 0x0f, 0xee, 0x6f, 0x5f, 0x32, 0xf1, 0xb6, 0x15, 0x1f, 0xd7, 0x3b, 0x51, 0xc8, 0x0b, 0x6d,
+	0xbe, 0x12, 0x4d, 0x48, 0x12, 0xf2, 0x29, 0xba, 0x0e, 0xb4, 0x51, 0xa7, 0x17, 0xca, 0x4e, 0xef,
+	0x59, 0xdc, 0xef, 0x06, 0x69, 0x27, 0xee, 0x25, 0x95, 0x29, 0x45, 0xfc, 0xfe, 0x9b, 0x88, 0xad,
+	0x4e, 0x2f, 0x34, 0x47, 0xfa, 0x7c, 0x35, 0xba, 0x20, 0x4b, 0x08, 0x43, 0x4b, 0x2f, 0x06, 0x61,
+	0xff, 0x4c, 0x26, 0x41, 0xf7, 0x34, 0x0a, 0x93, 0x4a, 0x49, 0x91, 0x56, 0x2f, 0x25, 0xdd, 0x07,
+	0x4d, 0x4f, 0x29, 0xf2, 0xc5, 0x17, 0xa3, 0x9f, 0x84, 0x7c, 0x82, 0xca, 0x51, 0xdc, 0x3a, 0x91,
+	0xe1, 0x67, 0x61, 0x2f, 0x4d, 0x2a, 0xd3, 0x8a, 0x64, 0xe3, 0x0d, 0x2b, 0x6b, 0x9d, 0x30, 0x50,
+	0xe3, 0x28, 0x2a, 0x3e, 0x93, 0xda, 0x5f, 0x67, 0xd1, 0xc2, 0x10, 0x21, 0xdf, 0x41, 0xd3, 0x27,
+	0x9d, 0x5e, 0xbb, 0xa2, 0x55, 0xb5, 0xad, 0xe5, 0x07, 0xf7, 0xbe, 0x9a, 0x67, 0x7b, 0xaf, 0xd3,
+	0x6b, 0x73, 0x35, 0x80, 0x7c, 0x0f, 0x95, 0xe3, 0x56, 0x6b, 0xd0, 0xef, 0x87, 0x6d, 0x19, 0xa4,
+	0x95, 0xa9, 0xaa, 0xb6, 0x55, 0x7e, 0xb0, 0xbe, 0x9d, 0xb9, 0x77, 0xbb, 0x70, 0xef, 0xb6, 0x28,
+	0xdc, 0xcb, 0x51, 0xa1, 0x4e, 0x53, 0x52, 0x43, 0x4b, 0x43, 0x43, 0x0f, 0x06, 0x9d, 0x76, 0xa5,
+	0x54, 0xd5, 0xb6, 0x16, 0x78, 0x39, 0xb7, 0x9b, 0x3f, 0xe8, 0xb4, 0x49, 0x15, 0x2d, 0x3e, 0x0f,
+	0x12, 0xd9, 0x8f, 0xa3, 0x50, 0x76, 0xda, 0xaf, 0x2a, 0xd3, 0x55, 0x6d, 0x6b, 0x9e, 0xa3, 0xe7,
+	0x41, 0xc2, 0xe3, 0x28, 0x34, 0xdb, 0xaf, 0xc8, 0x4d, 0x34, 0x3f, 0x44, 0x67, 0xaa, 0xda, 0xd6,
+	0x0c, 0x9f, 0xeb, 0xe7, 0xd0, 0x16, 0xc2, 0x30, 0xb8, 0x1d, 0xa4, 0xc1, 0xd3, 0x20, 0xc9, 0x54,
+	0x66, 0x15, 0xc1, 0xf2, 0xf3, 0x20, 0x31, 0x72, 0x31, 0x68, 0xde, 0x45, 0x8b, 0xe7, 0xb4, 0xe6,
+	0x14, 0x51, 0xb9, 0x3d, 0xa6, 0x52, 0x43, 0x4b, 0x40, 0x96, 0x79, 0x0f, 0x74, 0xe6, 0x15, 0x53,
+	0xf9, 0x79, 0x90, 0x28, 0x3f, 0x81, 0xce, 0x2d, 0xb4, 0x30, 0xc2, 0x17, 0x14, 0xc7, 0xfc, 0x8b,
+	0x02, 0xdc, 0x44, 0xe5, 0xa7, 0x41, 0xeb, 0x24, 0xec, 0xb5, 0xe5, 0x69, 0xa7, 0x5d, 0x41, 0x0a,
+	0x46, 0xb9, 0xc8, 0xed, 0xb4, 0x61, 0xb4, 0x72, 0x6a, 0x37, 0x6e, 0x87, 0x95, 0xb2, 0xb2, 0xc5,
+	0x3c, 0x08, 0x9a, 0x71, 0x3b, 0x1c, 0x82, 0xe9, 0xd9, 0x69, 0x58, 0x59, 0x1c, 0x81, 0xe2, 0xec,
+	0x34, 0x24, 0x14, 0x2d, 0xf6, 0xc3, 0x48, 0x85, 0x98, 0x8c, 0x3b, 0xed, 0xca, 0x52, 0x55, 0x7b,
+	0x63, 0x3c, 0xd8, 0x83, 0x28, 0x32, 0x7b, 0xe9, 0xb7, 0x3f, 0xe2, 0xe5, 0x62, 0x8c, 0xd3, 0x69,
+	0x93, 0x47, 0x68, 0x45, 0xf1, 0x0f, 0xcd, 0x00, 0x3c, 0xcb, 0x6f, 0xc5, 0x73, 0x15, 0x06, 0x16,
+	0xd6, 0x04, 0xae, 0x8f, 0xd1, 0xc2, 0xcb, 0xa0, 0x93, 0x86, 0x6d, 0xd9, 0x4d, 0x2a, 0x57, 0x15,
+	0xc7, 0xe6, 0x1b, 0x39, 0x8c, 0x78, 0xf0, 0x34, 0x0a, 0xf9, 0x7c, 0x36, 0xa2, 0x99, 0x90, 0x7b,
+	0x68, 0xe9, 0x29, 0x30, 0x76, 0x7a, 0xc7, 0x60, 0xa8, 0xa4, 0x82, 0xab, 0xa5, 0xad, 0x19, 0xbe,
+	0x58, 0x08, 0xdd, 0x4e, 0x3b, 0xa9, 0x09, 0x34, 0x0d, 0x61, 0x48, 0x30, 0x5a, 0xb4, 0x1c, 0x7d,
+	0x4f, 0x3e, 0xa6, 0xa6, 0x30, 0xed, 0x06, 0xbe, 0x42, 0x56, 0xd0, 0x92, 0x92, 0x50, 0x7d, 0xdf,
+	0x37, 0x39, 0x33, 0xb0, 0x36, 0x54, 0x12, 0x66, 0x93, 0x39, 0xbe, 0xc0, 0x53, 0xe4, 0x3a, 0x5a,
+	0xf1, 0x04, 0x15, 0xac, 0xc9, 0x6c, 0x31, 0x14, 0x97, 0x6a, 0x5f, 0x4c, 0x23, 0x3c, 0x59, 0x22,
+	0x08, 0x41, 0xd3, 0x2a, 0x3a, 0x35, 0x65, 0x74, 0xf5, 0x0d, 0xbe, 0x4c, 0x1e, 0xca, 0x28, 0x6e,
+	0x29, 0xfb, 0xa9, 0xb8, 0x5f, 0xe0, 0x28, 0x79, 0x68, 0xe5, 0x12, 0xb2, 0xa1, 0x14, 0x5a, 0xe1,
+	0x89, 0x0c, 0xa2, 0xe3, 0x38, 0x8f, 0xec, 0x85, 0xe4, 0xa1, 0x1e, 0x9e, 0xd0, 0xe8, 0x38, 0x26,
+	0x77, 0xd1, 0x12, 0xe0, 0xdd, 0x13, 0x79, 0x12, 0x42, 0xb8, 0x54, 0xa6, 0x0b, 0x0a, 0xbd, 0x7b,
+	0xb2, 0x17, 0x9e, 0x99, 0x2a, 0x1c, 0x9e, 0x9e, 0xa5, 0xa1, 0x4c, 0x3a, 0xaf, 0x43, 0x15, 0xd9,
+	0x25, 0x3e, 0x0f, 0x02, 0xaf, 0xf3, 0x3a, 0x04, 0x23, 0xc5, 0xfd, 0xce, 0x71, 0xa7, 0x17, 0x44,
+	0xb2, 0x17, 0x74, 0x43, 0x15, 0xd7, 0x0b, 0x7c, 0xb1, 0x10, 0xda, 0x41, 0x37, 0x24, 0x12, 0xad,
+	0x3c, 0xeb, 0x44, 0x69, 0xd8, 0xcf, 0x0a, 0x73, 0xd8, 0xea, 0x87, 0x69, 0x05, 0x55, 0x4b, 0x5b,
+	0xcb, 0x0f, 0x1e, 0xbe, 0x55, 0x79, 0x04, 0x81, 0xa7, 0x86, 0xa9, 0x9c, 0xbf, 0x9a, 0xb1, 0x0d,
+	0x85, 0xb5, 0x7f, 0x69, 0x68, 0xe9, 0x9c, 0x0a, 0xb9, 0x89, 0xae, 0xeb, 0x9c, 0x19, 0xcc, 0x16,
+	0x26, 0xb5, 0xa4, 0xe5, 0x34, 0xa4, 0xc7, 0x74, 0xce, 0x04, 0xbe, 0x42, 0x6e, 0xa3, 0x8a, 0x4b,
+	0xb9, 0x67, 0xda, 0x0d, 0xc9, 0x38, 0x77, 0xf8, 0x38, 0xaa, 0x91, 0x3b, 0xe8, 0xe6, 0x98, 0x47,
+	0xd8, 0xa1, 0x18, 0x87, 0xa7, 0x48, 0x0d, 0x6d, 0x8c, 0x60, 0x97, 0x72, 0xda, 0x64, 0x82, 0x9d,
+	0xa3, 0x28, 0xc1, 0xdc, 0x82, 0xee, 0x58, 0x4c, 0x1a, 0x54, 0xd0, 0x71, 0x68, 0x9a, 0x10, 0xb4,
+	0xec, 0xb8, 0xde, 0xb8, 0x6c, 0x86, 0xdc, 0x42, 0x6b, 0xbe, 0x6d, 0xaa, 0xa5, 0xd6, 0x4d, 0x66,
+	0x8c, 0x83, 0xb3, 0xb5, 0xcf, 0x37, 0x10, 0xb9, 0x58, 0xd3, 0xa1, 0x1c, 0x0d, 0x5b, 0x0e, 0xe4,
+	0xb8, 0x96, 0x25, 0x71, 0xde, 0x44, 0x20, 0xcb, 0x8b, 0x68, 0x99, 0x3a, 0x1f, 0x2d, 0xa7, 0x41,
+	0x3f, 0xec, 0xa5, 0xe3, 0x65, 0x0e, 0x65, 0x22, 0x55, 0xe5, 0xee, 0x20, 0x94, 0xb9, 0x3a, 0x0d,
+	0xfa, 0xa9, 0x0a, 0x85, 0x12, 0x57, 0xce, 0xf7, 0x40, 0x40, 0x3e, 0x40, 0x44, 0xc1, 0xad, 0xb8,
+	0x97, 0x02, 0x4b, 0xa6, 0x96, 0x85, 0x04, 0x06, 0x44, 0xcf, 0x80, 0x4c, 0xfb, 0x26, 0x52, 0x61,
+	0x22, 0xc3, 0x5e, 0x5b, 0x45, 0x45, 0x89, 0xcf, 0xc1, 0x3f, 0xeb, 0x5d, 0xac, 0xa6, 0x73, 0x5f,
+	0x59, 0x4d, 0xe7, 0xbf, 0xbe, 0x9a, 0x2e, 0xbc, 0x55, 0x35, 0x45, 0x6f, 0x51, 0x4d, 0xcb, 0x5f,
+	0x53, 0x4d, 0x17, 0x27, 0xaa, 0xe9, 0x44, 0xe7, 0x59, 0x7a, 0xa7, 0xce, 0x33, 0x51, 0x8a, 0x97,
+	0x2f, 0x94, 0xe2, 0x3a, 0x9a, 0x89, 0xc2, 0xcf, 0xc2, 0x48, 0x55, 0xaf, 0xe5, 0x07, 0xdf, 0x7c,
+	0xcb, 0x9e, 0xaf, 0x44, 0x30, 0x8e, 0x67, 0xc3, 0x49, 0x80, 0x96, 0x5b, 0x51, 0x90, 0x24, 0x9d,
+	0x67, 0x9d, 0xbc, 0x54, 0x60, 0x45, 0xf8, 0xdd, 0x77, 0x20, 0xd4, 0xcf, 0x11, 0xf0, 0x09, 0x42,
+	0x65, 0xec, 0x30, 0x0d, 0x3a, 0x51, 0x22, 0x7f, 0x96, 0xc4, 0xbd, 0xca, 0x4a, 0xd6, 0x44, 0x73,
+	0xd9, 0xa3, 0x24, 0xee, 0x15, 0x9e, 0x1b, 0xb6, 0x08, 0xb0, 0x27, 0x19, 0x7a, 0x8e, 0xe7, 0xe2,
+	0xdc, 0x73, 0xe7, 0xb4, 0x56, 0x33, 0xcf, 0xf5, 0x2f, 0x51, 0x09, 0xdb, 0x59, 0x75, 0xbe, 0x56,
+	0x2d, 0x0d, 0x55, 0xc2, 0xb6, 0x2a, 0xce, 0xff, 0xd0, 0xd0, 0x7c, 0x61, 0x09, 0x52, 0x46, 0x73,
+	0xbe, 0xbd, 0x67, 0x3b, 0x8f, 0x6d, 0x7c, 0x85, 0x2c, 0xa0, 0x19, 0x83, 0xed, 0xf8, 0x0d, 0xac,
+	0x91, 0x79, 0x34, 0x6d, 0xda, 0x75, 0x07, 0x4f, 0x11, 0x84, 0x66, 0x6d, 0x47, 0x98, 0x3a, 0xc3,
+	0x25, 0xd0, 0x7e, 0x4c, 0xb9, 0x0d, 0xa5, 0x7c, 0x1a, 0xb4, 0x55, 0xa5, 0xc0, 0x33, 0x64, 0x0e,
+	0x95, 0x2c, 0xa7, 0x81, 0x67, 0x41, 0x56, 0xa7, 0x82, 0x5a, 0x78, 0x0e, 0x3e, 0x5d, 0x6a, 0x9b,
+	0x3a, 0x9e, 0x07, 0x0a, 0x83, 0x09, 0x6a, 0x5a, 0x78, 0x01, 0x88, 0x77, 0x4d, 0x5b, 0x60, 0x04,
+	0x64, 0xba, 0x63, 0x43, 0x31, 0xc1, 0x65, 0xb2, 0x84, 0x16, 0x86, 0x15, 0x04, 0x2f, 0xc2, 0xe0,
+	0x7d, 0x9f, 0xf1, 0x23, 0xbc, 0x54, 0xfb, 0xdb, 0x0d, 0xb4, 0x72, 0xc1, 0xce, 0x64, 0x03, 0xad,
+	0xe7, 0xeb, 0x56, 0x95, 0x41, 0xb7, 0xa8, 0xe7, 0x99, 0x75, 0x53, 0xa7, 0xc2, 0x74, 0x60, 0x2b,
+	0x04, 0x2d, 0x7b, 0x8c, 0x1f, 0x30, 0x2e, 0x75, 0x4e, 0xbd, 0xdd, 0xa2, 0xd1, 0xe4, 0x32, 0x4f,
+	0x50, 0x0e, 0x75, 0xeb, 0x16, 0x5a, 0x1b, 0x97, 0x48, 0xce, 0x74, 0xe7, 0x80, 0x71, 0xd8, 0x5f,
+	0x89, 0xac, 0xa2, 0xab, 0x05, 0xb8, 0xeb, 0x0b, 0x03, 0x4c, 0x34, 0x4d, 0x2a, 0xe8, 0x5a, 0x2e,
+	0x74, 0x7c, 0x21, 0x9d, 0xba, 0x6c, 0xb2, 0xa6, 0xc3, 0x8f, 0xb2, 0x82, 0x95, 0x23, 0xa6, 0x7d,
+	0x40, 0x2d, 0xd3, 0x90, 0xfa, 0x2e, 0xd3, 0xf7, 0x3c, 0xbf, 0x89, 0x67, 0xa1, 0xba, 0xe6, 0xa0,
+	0x60, 0x4d, 0x57, 0xd6, 0x4d, 0x8b, 0x49, 0x9d, 0x33, 0x2a, 0x98, 0x81, 0xe7, 0xc8, 0x55, 0x54,
+	0xce, 0xd1, 0xa6, 0xe9, 0x81, 0xc1, 0x56, 0xd0, 0x52, 0x2e, 0xe0, 0xcc, 0x72, 0xa8, 0x81, 0x17,
+	0xa0, 0x7c, 0xe6, 0x22, 0x97, 0x3b, 0x3a, 0xf3, 0x3c, 0xc9, 0x0e, 0x4d, 0x18, 0x8e, 0x54, 0xf5,
+	0x1d, 0xee, 0x42, 0x78, 0x52, 0x77, 0x2c, 0x8b, 0xe9, 0xc2, 0xe1, 0xc3, 0xde, 0x59, 0x26, 0x6b,
+	0x68, 0x55, 0x77, 0x6c, 0x9b, 0xe9, 0x60, 0x1f, 0xd8, 0x27, 0x33, 0x0f, 0x98, 0x81, 0xaf, 0xa9,
+	0x96, 0x30, 0x02, 0xa8, 0x2f, 0x76, 0x1d, 0x6e, 0x3e, 0x61, 0x06, 0xbe, 0x7e, 0x61, 0xcc, 0x23,
+	0xa6, 0xc3, 0x84, 0x37, 0x60, 0xab, 0x63, 0x80, 0x61, 0x7a, 0xf9, 0x1f, 0x33, 0xf0, 0x1a, 0x79,
+	0x1f, 0xdd, 0x1b, 0x03, 0x75, 0xcb, 0x84, 0x9e, 0x50, 0xa7, 0xa6, 0xc5, 0x0c, 0x29, 0x1c, 0x99,
+	0x63, 0xb8, 0x02, 0xf6, 0x1d, 0x53, 0xb4, 0x1c, 0x4f, 0xe0, 0x9b, 0x13, 0xd4, 0x20, 0x94, 0x8e,
+	0xcb, 0x6c, 0x29, 0x0e, 0xf1, 0xfa, 0xc4, 0x5a, 0x05, 0xe3, 0x4d, 0xd3, 0x56, 0x26, 0xbc, 0x45,
+	0x6e, 0x20, 0x92, 0x3b, 0x64, 0xa4, 0xe1, 0xe1, 0xdb, 0xd0, 0xb8, 0x84, 0xe3, 0xc8, 0x26, 0xb5,
+	0x8f, 0xc6, 0x11, 0xc9, 0x1d, 0x8b, 0xe1, 0x3b, 0xe4, 0x1e, 0xda, 0xd4, 0x1d, 0xdf, 0x32, 0xa4,
+	0xed, 0x08, 0x49, 0x75, 0x9d, 0xb9, 0x42, 0x7a, 0x9e, 0x35, 0xa6, 0x8a, 0x37, 0xc8, 0x7b, 0xa8,
+	0xe6, 0x72, 0x47, 0x38, 0xba, 0x63, 0xe5, 0xbd, 0xd1, 0xb7, 0x3d, 0xdf, 0x75, 0x1d, 0x2e, 0x98,
+	0x21, 0x0f, 0x18, 0xf7, 0x40, 0x6f, 0x93, 0xdc, 0x47, 0x77, 0x27, 0xf4, 0x4c, 0x5b, 0x77, 0x9a,
+	0xae, 0xc5, 0x04, 0x93, 0x4d, 0xe6, 0x79, 0xb4, 0xc1, 0x70, 0x95, 0xdc, 0x45, 0x77, 0x2e, 0x5d,
+	0x12, 0xf4, 0xc5, 0x1d, 0xea, 0x31, 0x7c, 0x57, 0x59, 0x1e, 0x82, 0xc7, 0x75, 0x4c, 0x5b, 0x64,
+	0xb1, 0x09, 0x31, 0xb9, 0x35, 0x01, 0x14, 0xe4, 0xf8, 0x7f, 0x95, 0xdd, 0x46, 0x00, 0xf0, 0xd7,
+	0x39, 0xdb, 0xf7, 0x21, 0x9b, 0xfe, 0x0f, 0xec, 0xc6, 0x99, 0x62, 0x99, 0x20, 0xfc, 0xff, 0x0b,
+	0xd0, 0x90, 0xf2, 0x03, 0xf0, 0xcf, 0x39, 0x88, 0x0a, 0xfc, 0x0d, 0xb0, 0xe7, 0x63, 0x6a, 0x0d,
+	0x43, 0x1c, 0x12, 0x86, 0x1b, 0xd2, 0x62, 0x76, 0x43, 0xec, 0xe2, 0x07, 0x64, 0x11, 0xcd, 0x03,
+	0xcc, 0x99, 0xe1, 0xe0, 0x87, 0x90, 0xa4, 0xf0, 0x47, 0xb9, 0xbe, 0x6b, 0x1e, 0x30, 0xe0, 0x6e,
+	0x52, 0xdb, 0xc8, 0x83, 0x01, 0x7f, 0x04, 0x59, 0x01, 0x38, 0x6c, 0x5a, 0xee, 0x50, 0x7d, 0xcf,
+	0x77, 0x47, 0xf3, 0x7f, 0x0b, 0x4e, 0x81, 0xd4, 0x17, 0xce, 0x01, 0xd5, 0x7d, 0xbf, 0x29, 0x75,
+	0x6a, 0xeb, 0xcc, 0xc2, 0x1f, 0xc3, 0x4e, 0xc5, 0xa1, 0x69, 0xc8, 0xc7, 0x9c, 0xba, 0x94, 0x3b,
+	0xbe, 0x6d, 0xc8, 0xa2, 0x26, 0x7d, 0x5f, 0x1d, 0x32, 0x26, 0xc0, 0xac, 0x46, 0xfd, 0x80, 0x6c,
+	0xa2, 0x5b, 0x63, 0x74, 0x16, 0xf5, 0x6d, 0x7d, 0xb7, 0x48, 0x7c, 0x66, 0xe0, 0x4f, 0xc0, 0x7d,
+	0x97, 0x2a, 0xec, 0xfa, 0x02, 0x8c, 0x25, 0x55, 0x05, 0xf8, 0x21, 0x54, 0x80, 0xf1, 0x65, 0xe5,
+	0xeb, 0x35, 0x30, 0x85, 0xc9, 0x01, 0xa1, 0x36, 0xb5, 0x8e, 0x9e, 0xb0, 0x31, 0x68, 0x07, 0x42,
+	0xc8, 0xdb, 0x33, 0x5d, 0x17, 0x78, 0x8a, 0x09, 0xe0, 0xcc, 0xab, 0xc2, 0xee, 0x80, 0x9a, 0x16,
+	0x9c, 0x8c, 0xb0, 0x0e, 0xc9, 0x33, 0xd4, 0x2b, 0x78, 0x2e, 0x51, 0x34, 0x2e, 0x9e, 0xa3, 0xeb,
+	0x17, 0x0e, 0xdb, 0x8d, 0x0b, 0x27, 0xeb, 0x5d, 0xb2, 0x8e, 0x6e, 0x28, 0x89, 0xc1, 0xa8, 0x91,
+	0x7f, 0x88, 0x2c, 0x71, 0x4d, 0x58, 0xfe, 0x79, 0x8c, 0x1e, 0x38, 0xa6, 0xc1, 0x0c, 0xfc, 0x08,
+	0xb2, 0x6b, 0x74, 0xbe, 0x33, 0x7c, 0x9e, 0x55, 0x59, 0x17, 0x1c, 0x3c, 0x92, 0x67, 0x1e, 0x62,
+	0xc6, 0x70, 0xba, 0x7d, 0x55, 0x13, 0x2f, 0xe2, 0xbe, 0xc7, 0x38, 0xe6, 0xaa, 0xc8, 0x0d, 0x41,
+	0x68, 0x1f, 0x1e, 0x2c, 0x6f, 0x24, 0x02, 0x5b, 0x4a, 0x76, 0xe8, 0x5a, 0xd4, 0xb4, 0xb1, 0x00,
+	0xf7, 0x78, 0x82, 0xda, 0xc6, 0xce, 0x91, 0x84, 0xb0, 0x74, 0x38, 0x03, 0xc7, 0x5b, 0xb2, 0xce,
+	0x9d, 0x66, 0x11, 0x62, 0xf8, 0x49, 0x7e, 0x52, 0x55, 0x6a, 0xb9, 0x6b, 0xa5, 0x27, 0x38, 0xa3,
+	0x4d, 0x30, 0xc9, 0xa7, 0x90, 0x7c, 0x23, 0x38, 0x17, 0x4b, 0xd3, 0x16, 0x8c, 0x73, 0xdf, 0x05,
+	0x3b, 0xfc, 0xe8, 0x3c, 0x83, 0xe3, 0xba, 0xe7, 0x18, 0x7e, 0x3c, 0xbe, 0x0e, 0xdd, 0xb1, 0x3d,
+	0xd3, 0x13, 0xb0, 0xd8, 0xbc, 0x73, 0xa8, 0x49, 0x05, 0xc3, 0x3f, 0xc9, 0x4d, 0x53, 0xac, 0x63,
+	0xc2, 0x04, 0x58, 0xaa, 0x8e, 0x90, 0xe3, 0x45, 0x32, 0x81, 0xdd, 0x2c, 0xd3, 0x66, 0xf8, 0xa7,
+	0x10, 0xac, 0xbe, 0x6d, 0xee, 0xfb, 0x4c, 0xcd, 0x21, 0x38, 0x85, 0x04, 0x3c, 0x30, 0x1d, 0x2b,
+	0xb3, 0x7c, 0x9b, 0xfc, 0x0f, 0xaa, 0xd6, 0x1d, 0xce, 0xcc, 0x86, 0x2d, 0xf7, 0xd8, 0xd1, 0xe5,
+	0x5a, 0x21, 0xec, 0x16, 0x02, 0xc7, 0xf6, 0x2d, 0xeb, 0x72, 0x95, 0x67, 0xb0, 0x4e, 0x55, 0x38,
+	0x2e, 0xc7, 0x8f, 0xa1, 0xb9, 0xb0, 0x43, 0xdd, 0xf2, 0x3d, 0x55, 0xcd, 0x2f, 0xd3, 0x79, 0xae,
+	0x1a, 0xeb, 0x91, 0x2d, 0xe8, 0x61, 0x9e, 0x6c, 0x3d, 0x48, 0x92, 0x62, 0x57, 0xa6, 0xed, 0xfa,
+	0x42, 0x66, 0x38, 0x8e, 0x21, 0x24, 0x0e, 0xa8, 0xe5, 0x33, 0x55, 0xa3, 0x2c, 0xc7, 0x6e, 0xc8,
+	0x3a, 0x34, 0xaa, 0x23, 0x97, 0xe1, 0x53, 0x08, 0x89, 0x62, 0x98, 0x52, 0xc2, 0x2f, 0x40, 0xbf,
+	0x49, 0xad, 0xba, 0xc3, 0x9b, 0xcc, 0x90, 0x94, 0x73, 0x7a, 0x24, 0x2d, 0x53, 0x30, 0x4e, 0x2d,
+	0xdc, 0x57, 0xf1, 0xe2, 0xef, 0xa8, 0x93, 0x02, 0xb4, 0x4e, 0x75, 0x7b, 0xa1, 0x96, 0x49, 0x3d,
+	0x9c, 0xc0, 0xde, 0x4d, 0xdb, 0x63, 0x5c, 0x48, 0x41, 0x79, 0x83, 0x41, 0x69, 0xb3, 0xfc, 0xa6,
+	0x0d, 0x7a, 0x4d, 0x2a, 0xf4, 0x5d, 0x9c, 0xc2, 0x70, 0x28, 0xc2, 0xd4, 0x82, 0x8a, 0xa5, 0xf2,
+	0xc8, 0xcb, 0xa6, 0xc0, 0x03, 0x52, 0x45, 0xb7, 0x47, 0x03, 0x14, 0xb1, 0x0a, 0xb4, 0x06, 0x77,
+	0x7c, 0x57, 0xee, 0x1c, 0xe1, 0xcf, 0x60, 0x65, 0x9c, 0x65, 0x36, 0x90, 0x86, 0xc3, 0x3c, 0x95,
+	0xa3, 0xec, 0xd0, 0xf4, 0x04, 0x7e, 0x99, 0xb5, 0x2a, 0x35, 0x7c, 0x02, 0x82, 0x83, 0xf3, 0x9a,
+	0xe3, 0x32, 0x4e, 0xa1, 0x41, 0x4f, 0x80, 0x67, 0xca, 0x1d, 0xd9, 0x38, 0xce, 0xea, 0x8c, 0x33,
+	0x5b, 0x67, 0x92, 0x36, 0x77, 0xcc, 0x86, 0xef, 0xf8, 0x1e, 0x7e, 0x0d, 0x45, 0xd1, 0x85, 0xbe,
+	0xe7, 0x29, 0x7f, 0x18, 0xcc, 0x36, 0x99, 0x81, 0x7f, 0x0e, 0x3b, 0x11, 0x9c, 0xda, 0x1e, 0xcd,
+	0x5a, 0xa3, 0xe9, 0x49, 0xba, 0xa3, 0xda, 0x13, 0xfe, 0x05, 0xf4, 0xb8, 0xcc, 0x75, 0x75, 0xcb,
+	0xd4, 0x85, 0xb4, 0x9d, 0x71, 0x37, 0x66, 0xa6, 0xf8, 0x25, 0xb8, 0x79, 0x5c, 0x89, 0x3b, 0x8f,
+	0x25, 0xad, 0xd7, 0x55, 0x69, 0x90, 0xe2, 0x31, 0x9c, 0xfe, 0x7e, 0x35, 0xb6, 0x27, 0x9d, 0xda,
+	0xb0, 0xe8, 0x1d, 0x26, 0x75, 0xea, 0x09, 0xfc, 0x6b, 0x72, 0x1d, 0x61, 0xc3, 0x3c, 0x30, 0xd5,
+	0xa2, 0x76, 0x8e, 0xe4, 0x13, 0xc6, 0x1d, 0xfc, 0x1b, 0x38, 0x71, 0x95, 0x73, 0x55, 0x83, 0x3b,
+	0x2e, 0xfe, 0x5c, 0x23, 0x37, 0x21, 0x30, 0x04, 0x6b, 0x8c, 0x0e, 0x50, 0x9c, 0xda, 0x0d, 0x86,
+	0x7f, 0xab, 0x91, 0x55, 0xb4, 0x3c, 0x6a, 0x2b, 0x0d, 0x76, 0xe8, 0xe2, 0xdf, 0x69, 0x84, 0xa0,
+	0x25, 0x75, 0x9f, 0x2c, 0xbc, 0x80, 0x7f, 0xaf, 0x91, 0xdb, 0x68, 0xad, 0xee, 0xdb, 0xfa, 0x65,
+	0x86, 0xff, 0x83, 0x46, 0x6e, 0xa0, 0x15, 0xdb, 0x91, 0x9e, 0xaf, 0xef, 0x4a, 0x8f, 0x1e, 0x30,
+	0xd5, 0xbb, 0xf0, 0x1f, 0x35, 0xb2, 0x09, 0x27, 0xc6, 0xd1, 0x99, 0x41, 0xee, 0xfb, 0x4e, 0x5e,
+	0x1c, 0x80, 0xf6, 0x0b, 0x8d, 0xdc, 0x43, 0x1b, 0x97, 0x29, 0x0c, 0xef, 0xa0, 0x1c, 0xff, 0x49,
+	0x23, 0xeb, 0xe8, 0x7a, 0xb1, 0xc8, 0x9d, 0x23, 0xc1, 0xa4, 0xa7, 0x9a, 0xac, 0xce, 0xf0, 0x9f,
+	0x35, 0xb2, 0x85, 0xee, 0x8d, 0x0e, 0x13, 0x1e, 0xe3, 0x26, 0xb5, 0xcc, 0x27, 0x4c, 0x72, 0xe6,
+	0x32, 0x9a, 0x5d, 0x7d, 0x39, 0xa3, 0x06, 0xfe, 0x8b, 0x46, 0xee, 0xa3, 0xea, 0x65, 0x9a, 0xc5,
+	0x97, 0xaa, 0xf1, 0x5f, 0x6a, 0xe4, 0x16, 0xba, 0xe1, 0x36, 0xe8, 0xd8, 0x79, 0x2e, 0x5f, 0xcb,
+	0x11, 0xfe, 0xf7, 0x5c, 0xed, 0xef, 0xb3, 0xa8, 0x3c, 0xf6, 0x04, 0x79, 0xfe, 0x3e, 0xa6, 0x7d,
+	0xf5, 0x7d, 0xec, 0xdd, 0x5e, 0x02, 0xef, 0x20, 0xd4, 0x1f, 0xf4, 0xe0, 0x11, 0x18, 0x5e, 0x8c,
+	0xe0, 0x7e, 0xac, 0xf1, 0x85, 0x5c, 0xd2, 0x4c, 0x00, 0xce, 0x26, 0x4e, 0xc3, 0x57, 0x69, 0xfe,
+	0x52, 0x92, 0x2d, 0x45, 0x84, 0xaf, 0x52, 0xb2, 0x81, 0xe0, 0x2e, 0x1d, 0x74, 0xc3, 0x34, 0xec,
+	0x27, 0x95, 0x99, 0x6a, 0x29, 0xbf, 0x5d, 0xe7, 0x92, 0x8b, 0xef, 0x8c, 0xe8, 0xe2, 0x3b, 0xe3,
+	0x26, 0x82, 0xab, 0xa7, 0x0c, 0x5f, 0x9d, 0x46, 0x41, 0xa7, 0x57, 0xb9, 0x36, 0xbc, 0x18, 0xb3,
+	0x4c, 0x42, 0xee, 0xa3, 0xe5, 0x1c, 0x94, 0xf1, 0x20, 0x3d, 0x1d, 0xa4, 0x95, 0xeb, 0x8a, 0x65,
+	0x29, 0x97, 0x3a, 0x4a, 0x08, 0xef, 0x32, 0x85, 0x5a, 0xd8, 0xef, 0xc7, 0xfd, 0xca, 0x8d, 0xec,
+	0x5d, 0x26, 0x17, 0x32, 0x90, 0x11, 0x7f, 0xc4, 0x95, 0xdd, 0xf4, 0x2a, 0x6b, 0xea, 0x56, 0xb8,
+	0xfd, 0x75, 0xaf, 0xc0, 0xdb, 0xf9, 0x6a, 0xea, 0x6a, 0xd4, 0x70, 0xee, 0xec, 0x77, 0x9c, 0x36,
+	0x89, 0x07, 0xfd, 0x56, 0x58, 0xa9, 0xbc, 0x1b, 0xad, 0xa7, 0x46, 0x0d, 0x69, 0xb3, 0x5f, 0xb2,
+	0x8f, 0xae, 0x0e, 0x69, 0x07, 0xdd, 0x6e, 0xd0, 0x3f, 0xab, 0xdc, 0x54, 0xde, 0xdd, 0x7a, 0x33,
+	0x6f, 0x41, 0x98, 0xe9, 0xf3, 0xe5, 0xf0, 0xdc, 0x7f, 0x8d, 0xa2, 0xa5, 0x73, 0x3b, 0x81, 0x53,
+	0xa7, 0x7a, 0xf3, 0xc9, 0x9b, 0x31, 0x54, 0xec, 0x26, 0x85, 0x47, 0xa3, 0x35, 0xb4, 0xfa, 0xc8,
+	0x73, 0xec, 0x49, 0x40, 0xab, 0x7d, 0xa9, 0x0d, 0x39, 0xf2, 0x75, 0x56, 0xd1, 0xed, 0x73, 0xdd,
+	0x7e, 0x38, 0xc6, 0x73, 0x7c, 0xae, 0x33, 0x7c, 0xa5, 0x38, 0xa0, 0x0d, 0x81, 0x09, 0x05, 0x48,
+	0x87, 0x35, 0x76, 0x28, 0x18, 0xb7, 0xa9, 0x35, 0x09, 0x4e, 0x41, 0x05, 0x6c, 0x30, 0x9b, 0x71,
+	0x53, 0x9f, 0xc4, 0x4a, 0xb5, 0xff, 0x68, 0x68, 0xf5, 0x92, 0x8d, 0x43, 0xca, 0xf4, 0xe2, 0x76,
+	0x98, 0xbd, 0xda, 0x66, 0x0f, 0x88, 0xf3, 0x20, 0x50, 0xaf, 0xb6, 0x77, 0xd1, 0xa2, 0x7a, 0xc9,
+	0x19, 0x9c, 0xca, 0x56, 0x9c, 0x64, 0x39, 0xa3, 0xf1, 0x72, 0x2e, 0xd3, 0xe3, 0x44, 0x25, 0x46,
+	0x1a, 0xa7, 0x41, 0x94, 0x29, 0xe4, 0x89, 0xa1, 0x24, 0x0a, 0xbe, 0x85, 0x16, 0x4e, 0xa3, 0xa0,
+	0x27, 0xfb, 0xf1, 0xcb, 0x44, 0xe5, 0x85, 0xc6, 0xe7, 0x41, 0xc0, 0xe3, 0x97, 0x09, 0x79, 0x0f,
+	0x5d, 0x85, 0x90, 0x0e, 0x5a, 0xe9, 0x20, 0x88, 0x32, 0x95, 0x19, 0x15, 0xd6, 0xf0, 0xf2, 0x42,
+	0x95, 0x54, 0xe9, 0x6d, 0xa2, 0xf2, 0xb8, 0xce, 0xac, 0xa2, 0x41, 0xc1, 0x48, 0xa1, 0x86, 0x16,
+	0xbb, 0x9d, 0x24, 0x4c, 0xd2, 0x4e, 0x17, 0xae, 0xf8, 0xf9, 0xab, 0xd1, 0x39, 0xd9, 0xd3, 0x59,
+	0x95, 0xe1, 0x0f, 0xff, 0x3b, 0x00, 0x30, 0x32, 0x5c, 0xb7, 0x0b, 0x1a, 0x00, 0x00,
 }
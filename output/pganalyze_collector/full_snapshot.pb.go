@@ -59,7 +59,7 @@ func (x BackendCountStatistic_BackendState) String() string {
 }
 
 func (BackendCountStatistic_BackendState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{9, 0}
+	return fileDescriptor_b6b4141022f7abf6, []int{10, 0}
 }
 
 // ! When changing this, also update mappings/backend_type.json
@@ -112,7 +112,7 @@ func (x BackendCountStatistic_BackendType) String() string {
 }
 
 func (BackendCountStatistic_BackendType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{9, 1}
+	return fileDescriptor_b6b4141022f7abf6, []int{10, 1}
 }
 
 type RelationEvent_EventType int32
@@ -143,7 +143,7 @@ func (x RelationEvent_EventType) String() string {
 }
 
 func (RelationEvent_EventType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{16, 0}
+	return fileDescriptor_b6b4141022f7abf6, []int{34, 0}
 }
 
 type FullSnapshot struct {
@@ -155,8 +155,18 @@ type FullSnapshot struct {
 	SnapshotUuid          string               `protobuf:"bytes,10,opt,name=snapshot_uuid,json=snapshotUuid,proto3" json:"snapshot_uuid,omitempty"`
 	CollectedAt           *timestamp.Timestamp `protobuf:"bytes,11,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
 	CollectedIntervalSecs uint32               `protobuf:"varint,12,opt,name=collected_interval_secs,json=collectedIntervalSecs,proto3" json:"collected_interval_secs,omitempty"`
-	CollectorStatistic    *CollectorStatistic  `protobuf:"bytes,20,opt,name=collector_statistic,json=collectorStatistic,proto3" json:"collector_statistic,omitempty"`
-	CollectorErrors       []string             `protobuf:"bytes,21,rep,name=collector_errors,json=collectorErrors,proto3" json:"collector_errors,omitempty"`
+	QuerySource           string               `protobuf:"bytes,13,opt,name=query_source,json=querySource,proto3" json:"query_source,omitempty"`
+	Labels                map[string]string    `protobuf:"bytes,14,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Set when relation, index and function information was left out because the
+	// schema (identified by schema_hash) didn't change since the last snapshot
+	SchemaUnchanged bool   `protobuf:"varint,15,opt,name=schema_unchanged,json=schemaUnchanged,proto3" json:"schema_unchanged,omitempty"`
+	SchemaHash      string `protobuf:"bytes,16,opt,name=schema_hash,json=schemaHash,proto3" json:"schema_hash,omitempty"`
+	// Set when this snapshot was sent without incremental optimizations (complete
+	// schema and query statistics), so the server can re-baseline from it
+	ForcedFullSnapshot        bool                       `protobuf:"varint,17,opt,name=forced_full_snapshot,json=forcedFullSnapshot,proto3" json:"forced_full_snapshot,omitempty"`
+	CollectorStatistic        *CollectorStatistic        `protobuf:"bytes,20,opt,name=collector_statistic,json=collectorStatistic,proto3" json:"collector_statistic,omitempty"`
+	CollectorErrors           []string                   `protobuf:"bytes,21,rep,name=collector_errors,json=collectorErrors,proto3" json:"collector_errors,omitempty"`
+	CollectionSectionStatuses []*CollectionSectionStatus `protobuf:"bytes,22,rep,name=collection_section_statuses,json=collectionSectionStatuses,proto3" json:"collection_section_statuses,omitempty"`
 	// Per server (and hence snapshot)
 	System                 *System                  `protobuf:"bytes,100,opt,name=system,proto3" json:"system,omitempty"`
 	PostgresVersion        *PostgresVersion         `protobuf:"bytes,101,opt,name=postgres_version,json=postgresVersion,proto3" json:"postgres_version,omitempty"`
@@ -167,27 +177,57 @@ type FullSnapshot struct {
 	Settings               []*Setting               `protobuf:"bytes,122,rep,name=settings,proto3" json:"settings,omitempty"`
 	Replication            *Replication             `protobuf:"bytes,123,opt,name=replication,proto3" json:"replication,omitempty"`
 	BackendCountStatistics []*BackendCountStatistic `protobuf:"bytes,124,rep,name=backend_count_statistics,json=backendCountStatistics,proto3" json:"backend_count_statistics,omitempty"`
+	// Only set for standbys (diffed since the last snapshot)
+	RecoveryConflicts []*RecoveryConflictStatistic `protobuf:"bytes,125,rep,name=recovery_conflicts,json=recoveryConflicts,proto3" json:"recovery_conflicts,omitempty"`
+	// Oldest xmin that holds back VACUUM cluster-wide (not set if there is none)
+	XminHorizon *XminHorizon `protobuf:"bytes,126,opt,name=xmin_horizon,json=xminHorizon,proto3" json:"xmin_horizon,omitempty"`
+	Wraparound  *Wraparound  `protobuf:"bytes,127,opt,name=wraparound,proto3" json:"wraparound,omitempty"`
+	// Effective autovacuum settings, and when autovacuum will next process each table
+	AutovacuumForecast *AutovacuumForecast `protobuf:"bytes,133,opt,name=autovacuum_forecast,json=autovacuumForecast,proto3" json:"autovacuum_forecast,omitempty"`
+	// Publications (per database) and subscriptions, Postgres 10+
+	LogicalReplication *LogicalReplication `protobuf:"bytes,128,opt,name=logical_replication,json=logicalReplication,proto3" json:"logical_replication,omitempty"`
+	// SLRU cache activity (diffed since the last snapshot), Postgres 13+
+	SlruStatistics         []*SlruStatistic         `protobuf:"bytes,129,rep,name=slru_statistics,json=slruStatistics,proto3" json:"slru_statistics,omitempty"`
 	TablespaceReferences   []*TablespaceReference   `protobuf:"bytes,130,rep,name=tablespace_references,json=tablespaceReferences,proto3" json:"tablespace_references,omitempty"`
 	TablespaceInformations []*TablespaceInformation `protobuf:"bytes,131,rep,name=tablespace_informations,json=tablespaceInformations,proto3" json:"tablespace_informations,omitempty"`
+	// pg_stat_statements eviction statistics, Postgres 14+
+	StatementStatsInfo *StatementStatsInfo `protobuf:"bytes,132,opt,name=statement_stats_info,json=statementStatsInfo,proto3" json:"statement_stats_info,omitempty"`
+	// Background writer and checkpointer activity (diffed since the last snapshot)
+	BgwriterStatistic *BgwriterStatistic `protobuf:"bytes,134,opt,name=bgwriter_statistic,json=bgwriterStatistic,proto3" json:"bgwriter_statistic,omitempty"`
+	// pg_hba.conf authentication rules (PG10+, only when pg_hba_file_rules is readable)
+	HbaRules []*HbaRule `protobuf:"bytes,135,rep,name=hba_rules,json=hbaRules,proto3" json:"hba_rules,omitempty"`
+	// What the collector's role is allowed to see
+	CollectorPrivileges *CollectorPrivileges `protobuf:"bytes,136,opt,name=collector_privileges,json=collectorPrivileges,proto3" json:"collector_privileges,omitempty"`
+	// Client connections compared to max_connections, including the peak since the last snapshot
+	ConnectionSaturation *ConnectionSaturation `protobuf:"bytes,137,opt,name=connection_saturation,json=connectionSaturation,proto3" json:"connection_saturation,omitempty"`
 	// Per database
-	QueryReferences         []*QueryReference          `protobuf:"bytes,200,rep,name=query_references,json=queryReferences,proto3" json:"query_references,omitempty"`
-	RelationReferences      []*RelationReference       `protobuf:"bytes,201,rep,name=relation_references,json=relationReferences,proto3" json:"relation_references,omitempty"`
-	IndexReferences         []*IndexReference          `protobuf:"bytes,202,rep,name=index_references,json=indexReferences,proto3" json:"index_references,omitempty"`
-	FunctionReferences      []*FunctionReference       `protobuf:"bytes,203,rep,name=function_references,json=functionReferences,proto3" json:"function_references,omitempty"`
-	QueryInformations       []*QueryInformation        `protobuf:"bytes,210,rep,name=query_informations,json=queryInformations,proto3" json:"query_informations,omitempty"`
-	QueryStatistics         []*QueryStatistic          `protobuf:"bytes,211,rep,name=query_statistics,json=queryStatistics,proto3" json:"query_statistics,omitempty"`
-	HistoricQueryStatistics []*HistoricQueryStatistics `protobuf:"bytes,213,rep,name=historic_query_statistics,json=historicQueryStatistics,proto3" json:"historic_query_statistics,omitempty"`
-	QueryExplains           []*QueryExplainInformation `protobuf:"bytes,214,rep,name=query_explains,json=queryExplains,proto3" json:"query_explains,omitempty"`
-	RelationInformations    []*RelationInformation     `protobuf:"bytes,220,rep,name=relation_informations,json=relationInformations,proto3" json:"relation_informations,omitempty"`
-	RelationStatistics      []*RelationStatistic       `protobuf:"bytes,221,rep,name=relation_statistics,json=relationStatistics,proto3" json:"relation_statistics,omitempty"`
-	RelationEvents          []*RelationEvent           `protobuf:"bytes,223,rep,name=relation_events,json=relationEvents,proto3" json:"relation_events,omitempty"`
-	IndexInformations       []*IndexInformation        `protobuf:"bytes,224,rep,name=index_informations,json=indexInformations,proto3" json:"index_informations,omitempty"`
-	IndexStatistics         []*IndexStatistic          `protobuf:"bytes,225,rep,name=index_statistics,json=indexStatistics,proto3" json:"index_statistics,omitempty"`
-	FunctionInformations    []*FunctionInformation     `protobuf:"bytes,227,rep,name=function_informations,json=functionInformations,proto3" json:"function_informations,omitempty"`
-	FunctionStatistics      []*FunctionStatistic       `protobuf:"bytes,228,rep,name=function_statistics,json=functionStatistics,proto3" json:"function_statistics,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}                   `json:"-"`
-	XXX_unrecognized        []byte                     `json:"-"`
-	XXX_sizecache           int32                      `json:"-"`
+	QueryReferences              []*QueryReference              `protobuf:"bytes,200,rep,name=query_references,json=queryReferences,proto3" json:"query_references,omitempty"`
+	RelationReferences           []*RelationReference           `protobuf:"bytes,201,rep,name=relation_references,json=relationReferences,proto3" json:"relation_references,omitempty"`
+	IndexReferences              []*IndexReference              `protobuf:"bytes,202,rep,name=index_references,json=indexReferences,proto3" json:"index_references,omitempty"`
+	FunctionReferences           []*FunctionReference           `protobuf:"bytes,203,rep,name=function_references,json=functionReferences,proto3" json:"function_references,omitempty"`
+	QueryInformations            []*QueryInformation            `protobuf:"bytes,210,rep,name=query_informations,json=queryInformations,proto3" json:"query_informations,omitempty"`
+	QueryStatistics              []*QueryStatistic              `protobuf:"bytes,211,rep,name=query_statistics,json=queryStatistics,proto3" json:"query_statistics,omitempty"`
+	HistoricQueryStatistics      []*HistoricQueryStatistics     `protobuf:"bytes,213,rep,name=historic_query_statistics,json=historicQueryStatistics,proto3" json:"historic_query_statistics,omitempty"`
+	QueryExplains                []*QueryExplainInformation     `protobuf:"bytes,214,rep,name=query_explains,json=queryExplains,proto3" json:"query_explains,omitempty"`
+	RelationInformations         []*RelationInformation         `protobuf:"bytes,220,rep,name=relation_informations,json=relationInformations,proto3" json:"relation_informations,omitempty"`
+	RelationStatistics           []*RelationStatistic           `protobuf:"bytes,221,rep,name=relation_statistics,json=relationStatistics,proto3" json:"relation_statistics,omitempty"`
+	RelationEvents               []*RelationEvent               `protobuf:"bytes,223,rep,name=relation_events,json=relationEvents,proto3" json:"relation_events,omitempty"`
+	IndexInformations            []*IndexInformation            `protobuf:"bytes,224,rep,name=index_informations,json=indexInformations,proto3" json:"index_informations,omitempty"`
+	IndexStatistics              []*IndexStatistic              `protobuf:"bytes,225,rep,name=index_statistics,json=indexStatistics,proto3" json:"index_statistics,omitempty"`
+	FunctionInformations         []*FunctionInformation         `protobuf:"bytes,227,rep,name=function_informations,json=functionInformations,proto3" json:"function_informations,omitempty"`
+	FunctionStatistics           []*FunctionStatistic           `protobuf:"bytes,228,rep,name=function_statistics,json=functionStatistics,proto3" json:"function_statistics,omitempty"`
+	MaterializedViewInformations []*MaterializedViewInformation `protobuf:"bytes,230,rep,name=materialized_view_informations,json=materializedViewInformations,proto3" json:"materialized_view_informations,omitempty"`
+	// Indices made redundant by another index on the same table
+	DuplicateIndices []*DuplicateIndex `protobuf:"bytes,231,rep,name=duplicate_indices,json=duplicateIndices,proto3" json:"duplicate_indices,omitempty"`
+	// Foreign keys without an index on the referencing columns
+	MissingForeignKeyIndices []*MissingForeignKeyIndex `protobuf:"bytes,232,rep,name=missing_foreign_key_indices,json=missingForeignKeyIndices,proto3" json:"missing_foreign_key_indices,omitempty"`
+	// Labels returned by the labels_query for individual tables
+	RelationLabels []*RelationLabels `protobuf:"bytes,233,rep,name=relation_labels,json=relationLabels,proto3" json:"relation_labels,omitempty"`
+	// Shared buffer usage (only set when enabled and pg_buffercache is available)
+	BufferCache          *BufferCacheStatistic `protobuf:"bytes,229,opt,name=buffer_cache,json=bufferCache,proto3" json:"buffer_cache,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *FullSnapshot) Reset()         { *m = FullSnapshot{} }
@@ -264,6 +304,41 @@ func (m *FullSnapshot) GetCollectedIntervalSecs() uint32 {
 	return 0
 }
 
+func (m *FullSnapshot) GetQuerySource() string {
+	if m != nil {
+		return m.QuerySource
+	}
+	return ""
+}
+
+func (m *FullSnapshot) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetSchemaUnchanged() bool {
+	if m != nil {
+		return m.SchemaUnchanged
+	}
+	return false
+}
+
+func (m *FullSnapshot) GetSchemaHash() string {
+	if m != nil {
+		return m.SchemaHash
+	}
+	return ""
+}
+
+func (m *FullSnapshot) GetForcedFullSnapshot() bool {
+	if m != nil {
+		return m.ForcedFullSnapshot
+	}
+	return false
+}
+
 func (m *FullSnapshot) GetCollectorStatistic() *CollectorStatistic {
 	if m != nil {
 		return m.CollectorStatistic
@@ -278,6 +353,13 @@ func (m *FullSnapshot) GetCollectorErrors() []string {
 	return nil
 }
 
+func (m *FullSnapshot) GetCollectionSectionStatuses() []*CollectionSectionStatus {
+	if m != nil {
+		return m.CollectionSectionStatuses
+	}
+	return nil
+}
+
 func (m *FullSnapshot) GetSystem() *System {
 	if m != nil {
 		return m.System
@@ -341,6 +423,48 @@ func (m *FullSnapshot) GetBackendCountStatistics() []*BackendCountStatistic {
 	return nil
 }
 
+func (m *FullSnapshot) GetRecoveryConflicts() []*RecoveryConflictStatistic {
+	if m != nil {
+		return m.RecoveryConflicts
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetXminHorizon() *XminHorizon {
+	if m != nil {
+		return m.XminHorizon
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetWraparound() *Wraparound {
+	if m != nil {
+		return m.Wraparound
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetAutovacuumForecast() *AutovacuumForecast {
+	if m != nil {
+		return m.AutovacuumForecast
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetLogicalReplication() *LogicalReplication {
+	if m != nil {
+		return m.LogicalReplication
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetSlruStatistics() []*SlruStatistic {
+	if m != nil {
+		return m.SlruStatistics
+	}
+	return nil
+}
+
 func (m *FullSnapshot) GetTablespaceReferences() []*TablespaceReference {
 	if m != nil {
 		return m.TablespaceReferences
@@ -355,6 +479,41 @@ func (m *FullSnapshot) GetTablespaceInformations() []*TablespaceInformation {
 	return nil
 }
 
+func (m *FullSnapshot) GetStatementStatsInfo() *StatementStatsInfo {
+	if m != nil {
+		return m.StatementStatsInfo
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetBgwriterStatistic() *BgwriterStatistic {
+	if m != nil {
+		return m.BgwriterStatistic
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetHbaRules() []*HbaRule {
+	if m != nil {
+		return m.HbaRules
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetCollectorPrivileges() *CollectorPrivileges {
+	if m != nil {
+		return m.CollectorPrivileges
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetConnectionSaturation() *ConnectionSaturation {
+	if m != nil {
+		return m.ConnectionSaturation
+	}
+	return nil
+}
+
 func (m *FullSnapshot) GetQueryReferences() []*QueryReference {
 	if m != nil {
 		return m.QueryReferences
@@ -460,6 +619,112 @@ func (m *FullSnapshot) GetFunctionStatistics() []*FunctionStatistic {
 	return nil
 }
 
+func (m *FullSnapshot) GetMaterializedViewInformations() []*MaterializedViewInformation {
+	if m != nil {
+		return m.MaterializedViewInformations
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetDuplicateIndices() []*DuplicateIndex {
+	if m != nil {
+		return m.DuplicateIndices
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetMissingForeignKeyIndices() []*MissingForeignKeyIndex {
+	if m != nil {
+		return m.MissingForeignKeyIndices
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetRelationLabels() []*RelationLabels {
+	if m != nil {
+		return m.RelationLabels
+	}
+	return nil
+}
+
+func (m *FullSnapshot) GetBufferCache() *BufferCacheStatistic {
+	if m != nil {
+		return m.BufferCache
+	}
+	return nil
+}
+
+type CollectionSectionStatus struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ok                   bool     `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs           float64  `protobuf:"fixed64,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	TimedOut             bool     `protobuf:"varint,5,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectionSectionStatus) Reset()         { *m = CollectionSectionStatus{} }
+func (m *CollectionSectionStatus) String() string { return proto.CompactTextString(m) }
+func (*CollectionSectionStatus) ProtoMessage()    {}
+func (*CollectionSectionStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{1}
+}
+
+func (m *CollectionSectionStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionSectionStatus.Unmarshal(m, b)
+}
+func (m *CollectionSectionStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionSectionStatus.Marshal(b, m, deterministic)
+}
+func (m *CollectionSectionStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionSectionStatus.Merge(m, src)
+}
+func (m *CollectionSectionStatus) XXX_Size() int {
+	return xxx_messageInfo_CollectionSectionStatus.Size(m)
+}
+func (m *CollectionSectionStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionSectionStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionSectionStatus proto.InternalMessageInfo
+
+func (m *CollectionSectionStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CollectionSectionStatus) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *CollectionSectionStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CollectionSectionStatus) GetDurationMs() float64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+func (m *CollectionSectionStatus) GetTimedOut() bool {
+	if m != nil {
+		return m.TimedOut
+	}
+	return false
+}
+
 type CollectorStatistic struct {
 	GoVersion string `protobuf:"bytes,10,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
 	// Statistics from after the collection input step
@@ -479,7 +744,7 @@ func (m *CollectorStatistic) Reset()         { *m = CollectorStatistic{} }
 func (m *CollectorStatistic) String() string { return proto.CompactTextString(m) }
 func (*CollectorStatistic) ProtoMessage()    {}
 func (*CollectorStatistic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{1}
+	return fileDescriptor_b6b4141022f7abf6, []int{2}
 }
 
 func (m *CollectorStatistic) XXX_Unmarshal(b []byte) error {
@@ -571,7 +836,7 @@ func (m *RoleInformation) Reset()         { *m = RoleInformation{} }
 func (m *RoleInformation) String() string { return proto.CompactTextString(m) }
 func (*RoleInformation) ProtoMessage()    {}
 func (*RoleInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{2}
+	return fileDescriptor_b6b4141022f7abf6, []int{3}
 }
 
 func (m *RoleInformation) XXX_Unmarshal(b []byte) error {
@@ -704,7 +969,7 @@ func (m *DatabaseInformation) Reset()         { *m = DatabaseInformation{} }
 func (m *DatabaseInformation) String() string { return proto.CompactTextString(m) }
 func (*DatabaseInformation) ProtoMessage()    {}
 func (*DatabaseInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{3}
+	return fileDescriptor_b6b4141022f7abf6, []int{4}
 }
 
 func (m *DatabaseInformation) XXX_Unmarshal(b []byte) error {
@@ -820,7 +1085,7 @@ func (m *Setting) Reset()         { *m = Setting{} }
 func (m *Setting) String() string { return proto.CompactTextString(m) }
 func (*Setting) ProtoMessage()    {}
 func (*Setting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{4}
+	return fileDescriptor_b6b4141022f7abf6, []int{5}
 }
 
 func (m *Setting) XXX_Unmarshal(b []byte) error {
@@ -921,7 +1186,7 @@ func (m *Replication) Reset()         { *m = Replication{} }
 func (m *Replication) String() string { return proto.CompactTextString(m) }
 func (*Replication) ProtoMessage()    {}
 func (*Replication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{5}
+	return fileDescriptor_b6b4141022f7abf6, []int{6}
 }
 
 func (m *Replication) XXX_Unmarshal(b []byte) error {
@@ -1030,7 +1295,7 @@ func (m *StandbyReference) Reset()         { *m = StandbyReference{} }
 func (m *StandbyReference) String() string { return proto.CompactTextString(m) }
 func (*StandbyReference) ProtoMessage()    {}
 func (*StandbyReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{6}
+	return fileDescriptor_b6b4141022f7abf6, []int{7}
 }
 
 func (m *StandbyReference) XXX_Unmarshal(b []byte) error {
@@ -1077,7 +1342,7 @@ func (m *StandbyInformation) Reset()         { *m = StandbyInformation{} }
 func (m *StandbyInformation) String() string { return proto.CompactTextString(m) }
 func (*StandbyInformation) ProtoMessage()    {}
 func (*StandbyInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{7}
+	return fileDescriptor_b6b4141022f7abf6, []int{8}
 }
 
 func (m *StandbyInformation) XXX_Unmarshal(b []byte) error {
@@ -1178,7 +1443,7 @@ func (m *StandbyStatistic) Reset()         { *m = StandbyStatistic{} }
 func (m *StandbyStatistic) String() string { return proto.CompactTextString(m) }
 func (*StandbyStatistic) ProtoMessage()    {}
 func (*StandbyStatistic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{8}
+	return fileDescriptor_b6b4141022f7abf6, []int{9}
 }
 
 func (m *StandbyStatistic) XXX_Unmarshal(b []byte) error {
@@ -1266,7 +1531,7 @@ func (m *BackendCountStatistic) Reset()         { *m = BackendCountStatistic{} }
 func (m *BackendCountStatistic) String() string { return proto.CompactTextString(m) }
 func (*BackendCountStatistic) ProtoMessage()    {}
 func (*BackendCountStatistic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{9}
+	return fileDescriptor_b6b4141022f7abf6, []int{10}
 }
 
 func (m *BackendCountStatistic) XXX_Unmarshal(b []byte) error {
@@ -1343,1305 +1608,3493 @@ func (m *BackendCountStatistic) GetCount() int32 {
 	return 0
 }
 
-type TablespaceReference struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+type RecoveryConflictStatistic struct {
+	DatabaseIdx          int32    `protobuf:"varint,1,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	ConflTablespace      int64    `protobuf:"varint,2,opt,name=confl_tablespace,json=conflTablespace,proto3" json:"confl_tablespace,omitempty"`
+	ConflLock            int64    `protobuf:"varint,3,opt,name=confl_lock,json=conflLock,proto3" json:"confl_lock,omitempty"`
+	ConflSnapshot        int64    `protobuf:"varint,4,opt,name=confl_snapshot,json=conflSnapshot,proto3" json:"confl_snapshot,omitempty"`
+	ConflBufferpin       int64    `protobuf:"varint,5,opt,name=confl_bufferpin,json=conflBufferpin,proto3" json:"confl_bufferpin,omitempty"`
+	ConflDeadlock        int64    `protobuf:"varint,6,opt,name=confl_deadlock,json=conflDeadlock,proto3" json:"confl_deadlock,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TablespaceReference) Reset()         { *m = TablespaceReference{} }
-func (m *TablespaceReference) String() string { return proto.CompactTextString(m) }
-func (*TablespaceReference) ProtoMessage()    {}
-func (*TablespaceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{10}
+func (m *RecoveryConflictStatistic) Reset()         { *m = RecoveryConflictStatistic{} }
+func (m *RecoveryConflictStatistic) String() string { return proto.CompactTextString(m) }
+func (*RecoveryConflictStatistic) ProtoMessage()    {}
+func (*RecoveryConflictStatistic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{11}
 }
 
-func (m *TablespaceReference) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TablespaceReference.Unmarshal(m, b)
+func (m *RecoveryConflictStatistic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecoveryConflictStatistic.Unmarshal(m, b)
 }
-func (m *TablespaceReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TablespaceReference.Marshal(b, m, deterministic)
+func (m *RecoveryConflictStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecoveryConflictStatistic.Marshal(b, m, deterministic)
 }
-func (m *TablespaceReference) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TablespaceReference.Merge(m, src)
+func (m *RecoveryConflictStatistic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryConflictStatistic.Merge(m, src)
 }
-func (m *TablespaceReference) XXX_Size() int {
-	return xxx_messageInfo_TablespaceReference.Size(m)
+func (m *RecoveryConflictStatistic) XXX_Size() int {
+	return xxx_messageInfo_RecoveryConflictStatistic.Size(m)
 }
-func (m *TablespaceReference) XXX_DiscardUnknown() {
-	xxx_messageInfo_TablespaceReference.DiscardUnknown(m)
+func (m *RecoveryConflictStatistic) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryConflictStatistic.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TablespaceReference proto.InternalMessageInfo
+var xxx_messageInfo_RecoveryConflictStatistic proto.InternalMessageInfo
 
-func (m *TablespaceReference) GetName() string {
+func (m *RecoveryConflictStatistic) GetDatabaseIdx() int32 {
 	if m != nil {
-		return m.Name
+		return m.DatabaseIdx
 	}
-	return ""
-}
-
-type TablespaceInformation struct {
-	TablespaceIdx        int32    `protobuf:"varint,1,opt,name=tablespace_idx,json=tablespaceIdx,proto3" json:"tablespace_idx,omitempty"`
-	DiskPartitionIdx     int32    `protobuf:"varint,2,opt,name=disk_partition_idx,json=diskPartitionIdx,proto3" json:"disk_partition_idx,omitempty"`
-	RoleIdx              int32    `protobuf:"varint,3,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`
-	Config               []string `protobuf:"bytes,4,rep,name=config,proto3" json:"config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *TablespaceInformation) Reset()         { *m = TablespaceInformation{} }
-func (m *TablespaceInformation) String() string { return proto.CompactTextString(m) }
-func (*TablespaceInformation) ProtoMessage()    {}
-func (*TablespaceInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{11}
+	return 0
 }
 
-func (m *TablespaceInformation) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TablespaceInformation.Unmarshal(m, b)
-}
-func (m *TablespaceInformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TablespaceInformation.Marshal(b, m, deterministic)
-}
-func (m *TablespaceInformation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TablespaceInformation.Merge(m, src)
-}
-func (m *TablespaceInformation) XXX_Size() int {
-	return xxx_messageInfo_TablespaceInformation.Size(m)
-}
-func (m *TablespaceInformation) XXX_DiscardUnknown() {
-	xxx_messageInfo_TablespaceInformation.DiscardUnknown(m)
+func (m *RecoveryConflictStatistic) GetConflTablespace() int64 {
+	if m != nil {
+		return m.ConflTablespace
+	}
+	return 0
 }
 
-var xxx_messageInfo_TablespaceInformation proto.InternalMessageInfo
-
-func (m *TablespaceInformation) GetTablespaceIdx() int32 {
+func (m *RecoveryConflictStatistic) GetConflLock() int64 {
 	if m != nil {
-		return m.TablespaceIdx
+		return m.ConflLock
 	}
 	return 0
 }
 
-func (m *TablespaceInformation) GetDiskPartitionIdx() int32 {
+func (m *RecoveryConflictStatistic) GetConflSnapshot() int64 {
 	if m != nil {
-		return m.DiskPartitionIdx
+		return m.ConflSnapshot
 	}
 	return 0
 }
 
-func (m *TablespaceInformation) GetRoleIdx() int32 {
+func (m *RecoveryConflictStatistic) GetConflBufferpin() int64 {
 	if m != nil {
-		return m.RoleIdx
+		return m.ConflBufferpin
 	}
 	return 0
 }
 
-func (m *TablespaceInformation) GetConfig() []string {
+func (m *RecoveryConflictStatistic) GetConflDeadlock() int64 {
 	if m != nil {
-		return m.Config
+		return m.ConflDeadlock
 	}
-	return nil
+	return 0
 }
 
-type QueryStatistic struct {
-	QueryIdx             int32    `protobuf:"varint,1,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
-	Calls                int64    `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
-	TotalTime            float64  `protobuf:"fixed64,3,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
-	Rows                 int64    `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
-	SharedBlksHit        int64    `protobuf:"varint,5,opt,name=shared_blks_hit,json=sharedBlksHit,proto3" json:"shared_blks_hit,omitempty"`
-	SharedBlksRead       int64    `protobuf:"varint,6,opt,name=shared_blks_read,json=sharedBlksRead,proto3" json:"shared_blks_read,omitempty"`
-	SharedBlksDirtied    int64    `protobuf:"varint,7,opt,name=shared_blks_dirtied,json=sharedBlksDirtied,proto3" json:"shared_blks_dirtied,omitempty"`
-	SharedBlksWritten    int64    `protobuf:"varint,8,opt,name=shared_blks_written,json=sharedBlksWritten,proto3" json:"shared_blks_written,omitempty"`
-	LocalBlksHit         int64    `protobuf:"varint,9,opt,name=local_blks_hit,json=localBlksHit,proto3" json:"local_blks_hit,omitempty"`
-	LocalBlksRead        int64    `protobuf:"varint,10,opt,name=local_blks_read,json=localBlksRead,proto3" json:"local_blks_read,omitempty"`
-	LocalBlksDirtied     int64    `protobuf:"varint,11,opt,name=local_blks_dirtied,json=localBlksDirtied,proto3" json:"local_blks_dirtied,omitempty"`
-	LocalBlksWritten     int64    `protobuf:"varint,12,opt,name=local_blks_written,json=localBlksWritten,proto3" json:"local_blks_written,omitempty"`
-	TempBlksRead         int64    `protobuf:"varint,13,opt,name=temp_blks_read,json=tempBlksRead,proto3" json:"temp_blks_read,omitempty"`
-	TempBlksWritten      int64    `protobuf:"varint,14,opt,name=temp_blks_written,json=tempBlksWritten,proto3" json:"temp_blks_written,omitempty"`
-	BlkReadTime          float64  `protobuf:"fixed64,15,opt,name=blk_read_time,json=blkReadTime,proto3" json:"blk_read_time,omitempty"`
-	BlkWriteTime         float64  `protobuf:"fixed64,16,opt,name=blk_write_time,json=blkWriteTime,proto3" json:"blk_write_time,omitempty"`
+type SlruStatistic struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BlksZeroed           int64    `protobuf:"varint,2,opt,name=blks_zeroed,json=blksZeroed,proto3" json:"blks_zeroed,omitempty"`
+	BlksHit              int64    `protobuf:"varint,3,opt,name=blks_hit,json=blksHit,proto3" json:"blks_hit,omitempty"`
+	BlksRead             int64    `protobuf:"varint,4,opt,name=blks_read,json=blksRead,proto3" json:"blks_read,omitempty"`
+	BlksWritten          int64    `protobuf:"varint,5,opt,name=blks_written,json=blksWritten,proto3" json:"blks_written,omitempty"`
+	Truncates            int64    `protobuf:"varint,6,opt,name=truncates,proto3" json:"truncates,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *QueryStatistic) Reset()         { *m = QueryStatistic{} }
-func (m *QueryStatistic) String() string { return proto.CompactTextString(m) }
-func (*QueryStatistic) ProtoMessage()    {}
-func (*QueryStatistic) Descriptor() ([]byte, []int) {
+func (m *SlruStatistic) Reset()         { *m = SlruStatistic{} }
+func (m *SlruStatistic) String() string { return proto.CompactTextString(m) }
+func (*SlruStatistic) ProtoMessage()    {}
+func (*SlruStatistic) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b6b4141022f7abf6, []int{12}
 }
 
-func (m *QueryStatistic) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryStatistic.Unmarshal(m, b)
+func (m *SlruStatistic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SlruStatistic.Unmarshal(m, b)
 }
-func (m *QueryStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryStatistic.Marshal(b, m, deterministic)
+func (m *SlruStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SlruStatistic.Marshal(b, m, deterministic)
 }
-func (m *QueryStatistic) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStatistic.Merge(m, src)
+func (m *SlruStatistic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlruStatistic.Merge(m, src)
 }
-func (m *QueryStatistic) XXX_Size() int {
-	return xxx_messageInfo_QueryStatistic.Size(m)
+func (m *SlruStatistic) XXX_Size() int {
+	return xxx_messageInfo_SlruStatistic.Size(m)
 }
-func (m *QueryStatistic) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStatistic.DiscardUnknown(m)
+func (m *SlruStatistic) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlruStatistic.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStatistic proto.InternalMessageInfo
+var xxx_messageInfo_SlruStatistic proto.InternalMessageInfo
 
-func (m *QueryStatistic) GetQueryIdx() int32 {
+func (m *SlruStatistic) GetName() string {
 	if m != nil {
-		return m.QueryIdx
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *QueryStatistic) GetCalls() int64 {
+func (m *SlruStatistic) GetBlksZeroed() int64 {
 	if m != nil {
-		return m.Calls
+		return m.BlksZeroed
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetTotalTime() float64 {
+func (m *SlruStatistic) GetBlksHit() int64 {
 	if m != nil {
-		return m.TotalTime
+		return m.BlksHit
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetRows() int64 {
+func (m *SlruStatistic) GetBlksRead() int64 {
 	if m != nil {
-		return m.Rows
+		return m.BlksRead
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetSharedBlksHit() int64 {
+func (m *SlruStatistic) GetBlksWritten() int64 {
 	if m != nil {
-		return m.SharedBlksHit
+		return m.BlksWritten
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetSharedBlksRead() int64 {
+func (m *SlruStatistic) GetTruncates() int64 {
 	if m != nil {
-		return m.SharedBlksRead
+		return m.Truncates
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetSharedBlksDirtied() int64 {
+type BgwriterStatistic struct {
+	CheckpointsTimed    int64   `protobuf:"varint,1,opt,name=checkpoints_timed,json=checkpointsTimed,proto3" json:"checkpoints_timed,omitempty"`
+	CheckpointsReq      int64   `protobuf:"varint,2,opt,name=checkpoints_req,json=checkpointsReq,proto3" json:"checkpoints_req,omitempty"`
+	CheckpointWriteTime float64 `protobuf:"fixed64,3,opt,name=checkpoint_write_time,json=checkpointWriteTime,proto3" json:"checkpoint_write_time,omitempty"`
+	CheckpointSyncTime  float64 `protobuf:"fixed64,4,opt,name=checkpoint_sync_time,json=checkpointSyncTime,proto3" json:"checkpoint_sync_time,omitempty"`
+	BuffersCheckpoint   int64   `protobuf:"varint,5,opt,name=buffers_checkpoint,json=buffersCheckpoint,proto3" json:"buffers_checkpoint,omitempty"`
+	BuffersClean        int64   `protobuf:"varint,6,opt,name=buffers_clean,json=buffersClean,proto3" json:"buffers_clean,omitempty"`
+	MaxwrittenClean     int64   `protobuf:"varint,7,opt,name=maxwritten_clean,json=maxwrittenClean,proto3" json:"maxwritten_clean,omitempty"`
+	BuffersBackend      int64   `protobuf:"varint,8,opt,name=buffers_backend,json=buffersBackend,proto3" json:"buffers_backend,omitempty"`
+	BuffersBackendFsync int64   `protobuf:"varint,9,opt,name=buffers_backend_fsync,json=buffersBackendFsync,proto3" json:"buffers_backend_fsync,omitempty"`
+	BuffersAlloc        int64   `protobuf:"varint,10,opt,name=buffers_alloc,json=buffersAlloc,proto3" json:"buffers_alloc,omitempty"`
+	// Share of checkpoints that were requested (not started by checkpoint_timeout)
+	RequestedCheckpointsRatio *NullDouble `protobuf:"bytes,11,opt,name=requested_checkpoints_ratio,json=requestedCheckpointsRatio,proto3" json:"requested_checkpoints_ratio,omitempty"`
+	AvgCheckpointWriteTime    *NullDouble `protobuf:"bytes,12,opt,name=avg_checkpoint_write_time,json=avgCheckpointWriteTime,proto3" json:"avg_checkpoint_write_time,omitempty"`
+	// Set when requested checkpoints outnumber timed ones, i.e. max_wal_size is likely too small
+	FrequentRequestedCheckpoints bool     `protobuf:"varint,13,opt,name=frequent_requested_checkpoints,json=frequentRequestedCheckpoints,proto3" json:"frequent_requested_checkpoints,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *BgwriterStatistic) Reset()         { *m = BgwriterStatistic{} }
+func (m *BgwriterStatistic) String() string { return proto.CompactTextString(m) }
+func (*BgwriterStatistic) ProtoMessage()    {}
+func (*BgwriterStatistic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{13}
+}
+
+func (m *BgwriterStatistic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BgwriterStatistic.Unmarshal(m, b)
+}
+func (m *BgwriterStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BgwriterStatistic.Marshal(b, m, deterministic)
+}
+func (m *BgwriterStatistic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BgwriterStatistic.Merge(m, src)
+}
+func (m *BgwriterStatistic) XXX_Size() int {
+	return xxx_messageInfo_BgwriterStatistic.Size(m)
+}
+func (m *BgwriterStatistic) XXX_DiscardUnknown() {
+	xxx_messageInfo_BgwriterStatistic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BgwriterStatistic proto.InternalMessageInfo
+
+func (m *BgwriterStatistic) GetCheckpointsTimed() int64 {
 	if m != nil {
-		return m.SharedBlksDirtied
+		return m.CheckpointsTimed
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetSharedBlksWritten() int64 {
+func (m *BgwriterStatistic) GetCheckpointsReq() int64 {
 	if m != nil {
-		return m.SharedBlksWritten
+		return m.CheckpointsReq
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetLocalBlksHit() int64 {
+func (m *BgwriterStatistic) GetCheckpointWriteTime() float64 {
 	if m != nil {
-		return m.LocalBlksHit
+		return m.CheckpointWriteTime
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetLocalBlksRead() int64 {
+func (m *BgwriterStatistic) GetCheckpointSyncTime() float64 {
 	if m != nil {
-		return m.LocalBlksRead
+		return m.CheckpointSyncTime
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetLocalBlksDirtied() int64 {
+func (m *BgwriterStatistic) GetBuffersCheckpoint() int64 {
 	if m != nil {
-		return m.LocalBlksDirtied
+		return m.BuffersCheckpoint
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetLocalBlksWritten() int64 {
+func (m *BgwriterStatistic) GetBuffersClean() int64 {
 	if m != nil {
-		return m.LocalBlksWritten
+		return m.BuffersClean
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetTempBlksRead() int64 {
+func (m *BgwriterStatistic) GetMaxwrittenClean() int64 {
 	if m != nil {
-		return m.TempBlksRead
+		return m.MaxwrittenClean
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetTempBlksWritten() int64 {
+func (m *BgwriterStatistic) GetBuffersBackend() int64 {
 	if m != nil {
-		return m.TempBlksWritten
+		return m.BuffersBackend
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetBlkReadTime() float64 {
+func (m *BgwriterStatistic) GetBuffersBackendFsync() int64 {
 	if m != nil {
-		return m.BlkReadTime
+		return m.BuffersBackendFsync
 	}
 	return 0
 }
 
-func (m *QueryStatistic) GetBlkWriteTime() float64 {
+func (m *BgwriterStatistic) GetBuffersAlloc() int64 {
 	if m != nil {
-		return m.BlkWriteTime
+		return m.BuffersAlloc
 	}
 	return 0
 }
 
-type HistoricQueryStatistics struct {
-	CollectedAt           *timestamp.Timestamp `protobuf:"bytes,1,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
-	CollectedIntervalSecs uint32               `protobuf:"varint,2,opt,name=collected_interval_secs,json=collectedIntervalSecs,proto3" json:"collected_interval_secs,omitempty"`
-	Statistics            []*QueryStatistic    `protobuf:"bytes,3,rep,name=statistics,proto3" json:"statistics,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}             `json:"-"`
-	XXX_unrecognized      []byte               `json:"-"`
-	XXX_sizecache         int32                `json:"-"`
-}
-
-func (m *HistoricQueryStatistics) Reset()         { *m = HistoricQueryStatistics{} }
-func (m *HistoricQueryStatistics) String() string { return proto.CompactTextString(m) }
-func (*HistoricQueryStatistics) ProtoMessage()    {}
-func (*HistoricQueryStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{13}
-}
-
-func (m *HistoricQueryStatistics) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HistoricQueryStatistics.Unmarshal(m, b)
-}
-func (m *HistoricQueryStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HistoricQueryStatistics.Marshal(b, m, deterministic)
-}
-func (m *HistoricQueryStatistics) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HistoricQueryStatistics.Merge(m, src)
-}
-func (m *HistoricQueryStatistics) XXX_Size() int {
-	return xxx_messageInfo_HistoricQueryStatistics.Size(m)
-}
-func (m *HistoricQueryStatistics) XXX_DiscardUnknown() {
-	xxx_messageInfo_HistoricQueryStatistics.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_HistoricQueryStatistics proto.InternalMessageInfo
-
-func (m *HistoricQueryStatistics) GetCollectedAt() *timestamp.Timestamp {
+func (m *BgwriterStatistic) GetRequestedCheckpointsRatio() *NullDouble {
 	if m != nil {
-		return m.CollectedAt
+		return m.RequestedCheckpointsRatio
 	}
 	return nil
 }
 
-func (m *HistoricQueryStatistics) GetCollectedIntervalSecs() uint32 {
+func (m *BgwriterStatistic) GetAvgCheckpointWriteTime() *NullDouble {
 	if m != nil {
-		return m.CollectedIntervalSecs
+		return m.AvgCheckpointWriteTime
 	}
-	return 0
+	return nil
 }
 
-func (m *HistoricQueryStatistics) GetStatistics() []*QueryStatistic {
+func (m *BgwriterStatistic) GetFrequentRequestedCheckpoints() bool {
 	if m != nil {
-		return m.Statistics
+		return m.FrequentRequestedCheckpoints
 	}
-	return nil
+	return false
 }
 
-type RelationInformation struct {
-	RelationIdx            int32                             `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
-	RelationType           string                            `protobuf:"bytes,2,opt,name=relation_type,json=relationType,proto3" json:"relation_type,omitempty"`
-	ViewDefinition         *NullString                       `protobuf:"bytes,3,opt,name=view_definition,json=viewDefinition,proto3" json:"view_definition,omitempty"`
-	Columns                []*RelationInformation_Column     `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
-	Constraints            []*RelationInformation_Constraint `protobuf:"bytes,5,rep,name=constraints,proto3" json:"constraints,omitempty"`
-	PersistenceType        string                            `protobuf:"bytes,6,opt,name=persistence_type,json=persistenceType,proto3" json:"persistence_type,omitempty"`
-	Fillfactor             int32                             `protobuf:"varint,7,opt,name=fillfactor,proto3" json:"fillfactor,omitempty"`
-	HasOids                bool                              `protobuf:"varint,8,opt,name=has_oids,json=hasOids,proto3" json:"has_oids,omitempty"`
-	HasInheritanceChildren bool                              `protobuf:"varint,9,opt,name=has_inheritance_children,json=hasInheritanceChildren,proto3" json:"has_inheritance_children,omitempty"`
-	HasToast               bool                              `protobuf:"varint,10,opt,name=has_toast,json=hasToast,proto3" json:"has_toast,omitempty"`
-	FrozenXid              uint32                            `protobuf:"varint,11,opt,name=frozen_xid,json=frozenXid,proto3" json:"frozen_xid,omitempty"`
-	MinimumMultixactXid    uint32                            `protobuf:"varint,12,opt,name=minimum_multixact_xid,json=minimumMultixactXid,proto3" json:"minimum_multixact_xid,omitempty"`
-	// True if another process is currently holding an AccessExclusiveLock on this
-	// relation, this also means we won't have columns/index/constraints information
-	ExclusivelyLocked    bool              `protobuf:"varint,13,opt,name=exclusively_locked,json=exclusivelyLocked,proto3" json:"exclusively_locked,omitempty"`
-	Options              map[string]string `protobuf:"bytes,14,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type ConnectionSaturation struct {
+	// max_connections minus superuser_reserved_connections
+	MaxConnections int32                                 `protobuf:"varint,1,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	Current        *ConnectionSaturation_ConnectionUsage `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	// Highest usage since the last snapshot (including samples taken by activity snapshots)
+	Peak      *ConnectionSaturation_ConnectionUsage `protobuf:"bytes,3,opt,name=peak,proto3" json:"peak,omitempty"`
+	PeakRatio float64                               `protobuf:"fixed64,4,opt,name=peak_ratio,json=peakRatio,proto3" json:"peak_ratio,omitempty"`
+	// Peaks of recent snapshot intervals, oldest first (including this one)
+	PeakHistory []*ConnectionSaturation_ConnectionPeak `protobuf:"bytes,5,rep,name=peak_history,json=peakHistory,proto3" json:"peak_history,omitempty"`
+	// Set when the peak reached connection_saturation_warn_percent of max_connections
+	ExceedsWarnPercent   bool     `protobuf:"varint,6,opt,name=exceeds_warn_percent,json=exceedsWarnPercent,proto3" json:"exceeds_warn_percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RelationInformation) Reset()         { *m = RelationInformation{} }
-func (m *RelationInformation) String() string { return proto.CompactTextString(m) }
-func (*RelationInformation) ProtoMessage()    {}
-func (*RelationInformation) Descriptor() ([]byte, []int) {
+func (m *ConnectionSaturation) Reset()         { *m = ConnectionSaturation{} }
+func (m *ConnectionSaturation) String() string { return proto.CompactTextString(m) }
+func (*ConnectionSaturation) ProtoMessage()    {}
+func (*ConnectionSaturation) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b6b4141022f7abf6, []int{14}
 }
 
-func (m *RelationInformation) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RelationInformation.Unmarshal(m, b)
+func (m *ConnectionSaturation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectionSaturation.Unmarshal(m, b)
 }
-func (m *RelationInformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RelationInformation.Marshal(b, m, deterministic)
+func (m *ConnectionSaturation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectionSaturation.Marshal(b, m, deterministic)
 }
-func (m *RelationInformation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RelationInformation.Merge(m, src)
+func (m *ConnectionSaturation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionSaturation.Merge(m, src)
 }
-func (m *RelationInformation) XXX_Size() int {
-	return xxx_messageInfo_RelationInformation.Size(m)
+func (m *ConnectionSaturation) XXX_Size() int {
+	return xxx_messageInfo_ConnectionSaturation.Size(m)
 }
-func (m *RelationInformation) XXX_DiscardUnknown() {
-	xxx_messageInfo_RelationInformation.DiscardUnknown(m)
+func (m *ConnectionSaturation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionSaturation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RelationInformation proto.InternalMessageInfo
+var xxx_messageInfo_ConnectionSaturation proto.InternalMessageInfo
 
-func (m *RelationInformation) GetRelationIdx() int32 {
+func (m *ConnectionSaturation) GetMaxConnections() int32 {
 	if m != nil {
-		return m.RelationIdx
+		return m.MaxConnections
 	}
 	return 0
 }
 
-func (m *RelationInformation) GetRelationType() string {
+func (m *ConnectionSaturation) GetCurrent() *ConnectionSaturation_ConnectionUsage {
 	if m != nil {
-		return m.RelationType
+		return m.Current
 	}
-	return ""
+	return nil
 }
 
-func (m *RelationInformation) GetViewDefinition() *NullString {
+func (m *ConnectionSaturation) GetPeak() *ConnectionSaturation_ConnectionUsage {
 	if m != nil {
-		return m.ViewDefinition
+		return m.Peak
 	}
 	return nil
 }
 
-func (m *RelationInformation) GetColumns() []*RelationInformation_Column {
+func (m *ConnectionSaturation) GetPeakRatio() float64 {
 	if m != nil {
-		return m.Columns
+		return m.PeakRatio
 	}
-	return nil
+	return 0
 }
 
-func (m *RelationInformation) GetConstraints() []*RelationInformation_Constraint {
+func (m *ConnectionSaturation) GetPeakHistory() []*ConnectionSaturation_ConnectionPeak {
 	if m != nil {
-		return m.Constraints
+		return m.PeakHistory
 	}
 	return nil
 }
 
-func (m *RelationInformation) GetPersistenceType() string {
+func (m *ConnectionSaturation) GetExceedsWarnPercent() bool {
 	if m != nil {
-		return m.PersistenceType
+		return m.ExceedsWarnPercent
 	}
-	return ""
+	return false
 }
 
-func (m *RelationInformation) GetFillfactor() int32 {
-	if m != nil {
-		return m.Fillfactor
-	}
-	return 0
+type ConnectionSaturation_ConnectionUsage struct {
+	SampledAt   *timestamp.Timestamp `protobuf:"bytes,1,opt,name=sampled_at,json=sampledAt,proto3" json:"sampled_at,omitempty"`
+	Connections int32                `protobuf:"varint,2,opt,name=connections,proto3" json:"connections,omitempty"`
+	// Applications and users holding the most connections, highest first
+	TopClients           []*ConnectionSaturation_ConnectionCount `protobuf:"bytes,3,rep,name=top_clients,json=topClients,proto3" json:"top_clients,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
 }
 
-func (m *RelationInformation) GetHasOids() bool {
-	if m != nil {
-		return m.HasOids
-	}
-	return false
+func (m *ConnectionSaturation_ConnectionUsage) Reset()         { *m = ConnectionSaturation_ConnectionUsage{} }
+func (m *ConnectionSaturation_ConnectionUsage) String() string { return proto.CompactTextString(m) }
+func (*ConnectionSaturation_ConnectionUsage) ProtoMessage()    {}
+func (*ConnectionSaturation_ConnectionUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{14, 0}
 }
 
-func (m *RelationInformation) GetHasInheritanceChildren() bool {
-	if m != nil {
-		return m.HasInheritanceChildren
-	}
-	return false
+func (m *ConnectionSaturation_ConnectionUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectionSaturation_ConnectionUsage.Unmarshal(m, b)
 }
-
-func (m *RelationInformation) GetHasToast() bool {
-	if m != nil {
-		return m.HasToast
-	}
-	return false
+func (m *ConnectionSaturation_ConnectionUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectionSaturation_ConnectionUsage.Marshal(b, m, deterministic)
 }
-
-func (m *RelationInformation) GetFrozenXid() uint32 {
-	if m != nil {
-		return m.FrozenXid
-	}
-	return 0
+func (m *ConnectionSaturation_ConnectionUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionSaturation_ConnectionUsage.Merge(m, src)
+}
+func (m *ConnectionSaturation_ConnectionUsage) XXX_Size() int {
+	return xxx_messageInfo_ConnectionSaturation_ConnectionUsage.Size(m)
+}
+func (m *ConnectionSaturation_ConnectionUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionSaturation_ConnectionUsage.DiscardUnknown(m)
 }
 
-func (m *RelationInformation) GetMinimumMultixactXid() uint32 {
+var xxx_messageInfo_ConnectionSaturation_ConnectionUsage proto.InternalMessageInfo
+
+func (m *ConnectionSaturation_ConnectionUsage) GetSampledAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.MinimumMultixactXid
+		return m.SampledAt
 	}
-	return 0
+	return nil
 }
 
-func (m *RelationInformation) GetExclusivelyLocked() bool {
+func (m *ConnectionSaturation_ConnectionUsage) GetConnections() int32 {
 	if m != nil {
-		return m.ExclusivelyLocked
+		return m.Connections
 	}
-	return false
+	return 0
 }
 
-func (m *RelationInformation) GetOptions() map[string]string {
+func (m *ConnectionSaturation_ConnectionUsage) GetTopClients() []*ConnectionSaturation_ConnectionCount {
 	if m != nil {
-		return m.Options
+		return m.TopClients
 	}
 	return nil
 }
 
-type RelationInformation_Column struct {
-	Name                 string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	DataType             string      `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	DefaultValue         *NullString `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
-	NotNull              bool        `protobuf:"varint,5,opt,name=not_null,json=notNull,proto3" json:"not_null,omitempty"`
-	Position             int32       `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type ConnectionSaturation_ConnectionCount struct {
+	ApplicationName      string   `protobuf:"bytes,1,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	RoleName             string   `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RelationInformation_Column) Reset()         { *m = RelationInformation_Column{} }
-func (m *RelationInformation_Column) String() string { return proto.CompactTextString(m) }
-func (*RelationInformation_Column) ProtoMessage()    {}
-func (*RelationInformation_Column) Descriptor() ([]byte, []int) {
+func (m *ConnectionSaturation_ConnectionCount) Reset()         { *m = ConnectionSaturation_ConnectionCount{} }
+func (m *ConnectionSaturation_ConnectionCount) String() string { return proto.CompactTextString(m) }
+func (*ConnectionSaturation_ConnectionCount) ProtoMessage()    {}
+func (*ConnectionSaturation_ConnectionCount) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b6b4141022f7abf6, []int{14, 1}
 }
 
-func (m *RelationInformation_Column) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RelationInformation_Column.Unmarshal(m, b)
+func (m *ConnectionSaturation_ConnectionCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectionSaturation_ConnectionCount.Unmarshal(m, b)
 }
-func (m *RelationInformation_Column) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RelationInformation_Column.Marshal(b, m, deterministic)
+func (m *ConnectionSaturation_ConnectionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectionSaturation_ConnectionCount.Marshal(b, m, deterministic)
 }
-func (m *RelationInformation_Column) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RelationInformation_Column.Merge(m, src)
+func (m *ConnectionSaturation_ConnectionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionSaturation_ConnectionCount.Merge(m, src)
 }
-func (m *RelationInformation_Column) XXX_Size() int {
-	return xxx_messageInfo_RelationInformation_Column.Size(m)
+func (m *ConnectionSaturation_ConnectionCount) XXX_Size() int {
+	return xxx_messageInfo_ConnectionSaturation_ConnectionCount.Size(m)
 }
-func (m *RelationInformation_Column) XXX_DiscardUnknown() {
-	xxx_messageInfo_RelationInformation_Column.DiscardUnknown(m)
+func (m *ConnectionSaturation_ConnectionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionSaturation_ConnectionCount.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RelationInformation_Column proto.InternalMessageInfo
+var xxx_messageInfo_ConnectionSaturation_ConnectionCount proto.InternalMessageInfo
 
-func (m *RelationInformation_Column) GetName() string {
+func (m *ConnectionSaturation_ConnectionCount) GetApplicationName() string {
 	if m != nil {
-		return m.Name
+		return m.ApplicationName
 	}
 	return ""
 }
 
-func (m *RelationInformation_Column) GetDataType() string {
+func (m *ConnectionSaturation_ConnectionCount) GetRoleName() string {
 	if m != nil {
-		return m.DataType
+		return m.RoleName
 	}
 	return ""
 }
 
-func (m *RelationInformation_Column) GetDefaultValue() *NullString {
+func (m *ConnectionSaturation_ConnectionCount) GetCount() int32 {
 	if m != nil {
-		return m.DefaultValue
+		return m.Count
 	}
-	return nil
+	return 0
 }
 
-func (m *RelationInformation_Column) GetNotNull() bool {
+type ConnectionSaturation_ConnectionPeak struct {
+	CollectedAt          *timestamp.Timestamp `protobuf:"bytes,1,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	Connections          int32                `protobuf:"varint,2,opt,name=connections,proto3" json:"connections,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ConnectionSaturation_ConnectionPeak) Reset()         { *m = ConnectionSaturation_ConnectionPeak{} }
+func (m *ConnectionSaturation_ConnectionPeak) String() string { return proto.CompactTextString(m) }
+func (*ConnectionSaturation_ConnectionPeak) ProtoMessage()    {}
+func (*ConnectionSaturation_ConnectionPeak) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{14, 2}
+}
+
+func (m *ConnectionSaturation_ConnectionPeak) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectionSaturation_ConnectionPeak.Unmarshal(m, b)
+}
+func (m *ConnectionSaturation_ConnectionPeak) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectionSaturation_ConnectionPeak.Marshal(b, m, deterministic)
+}
+func (m *ConnectionSaturation_ConnectionPeak) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionSaturation_ConnectionPeak.Merge(m, src)
+}
+func (m *ConnectionSaturation_ConnectionPeak) XXX_Size() int {
+	return xxx_messageInfo_ConnectionSaturation_ConnectionPeak.Size(m)
+}
+func (m *ConnectionSaturation_ConnectionPeak) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionSaturation_ConnectionPeak.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionSaturation_ConnectionPeak proto.InternalMessageInfo
+
+func (m *ConnectionSaturation_ConnectionPeak) GetCollectedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.NotNull
+		return m.CollectedAt
 	}
-	return false
+	return nil
 }
 
-func (m *RelationInformation_Column) GetPosition() int32 {
+func (m *ConnectionSaturation_ConnectionPeak) GetConnections() int32 {
 	if m != nil {
-		return m.Position
+		return m.Connections
 	}
 	return 0
 }
 
-type RelationInformation_Constraint struct {
-	ForeignRelationIdx   int32    `protobuf:"varint,1,opt,name=foreign_relation_idx,json=foreignRelationIdx,proto3" json:"foreign_relation_idx,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	ConstraintDef        string   `protobuf:"bytes,4,opt,name=constraint_def,json=constraintDef,proto3" json:"constraint_def,omitempty"`
-	Columns              []int32  `protobuf:"varint,5,rep,packed,name=columns,proto3" json:"columns,omitempty"`
-	ForeignColumns       []int32  `protobuf:"varint,6,rep,packed,name=foreign_columns,json=foreignColumns,proto3" json:"foreign_columns,omitempty"`
-	ForeignUpdateType    string   `protobuf:"bytes,7,opt,name=foreign_update_type,json=foreignUpdateType,proto3" json:"foreign_update_type,omitempty"`
-	ForeignDeleteType    string   `protobuf:"bytes,8,opt,name=foreign_delete_type,json=foreignDeleteType,proto3" json:"foreign_delete_type,omitempty"`
-	ForeignMatchType     string   `protobuf:"bytes,9,opt,name=foreign_match_type,json=foreignMatchType,proto3" json:"foreign_match_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CollectorPrivileges struct {
+	Superuser      bool `protobuf:"varint,1,opt,name=superuser,proto3" json:"superuser,omitempty"`
+	PgMonitor      bool `protobuf:"varint,2,opt,name=pg_monitor,json=pgMonitor,proto3" json:"pg_monitor,omitempty"`
+	PgReadAllStats bool `protobuf:"varint,3,opt,name=pg_read_all_stats,json=pgReadAllStats,proto3" json:"pg_read_all_stats,omitempty"`
+	// Sections missing other roles' data due to insufficient privileges (e.g. "statements")
+	PermissionLimitedSections []string `protobuf:"bytes,4,rep,name=permission_limited_sections,json=permissionLimitedSections,proto3" json:"permission_limited_sections,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
 }
 
-func (m *RelationInformation_Constraint) Reset()         { *m = RelationInformation_Constraint{} }
-func (m *RelationInformation_Constraint) String() string { return proto.CompactTextString(m) }
-func (*RelationInformation_Constraint) ProtoMessage()    {}
-func (*RelationInformation_Constraint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{14, 2}
+func (m *CollectorPrivileges) Reset()         { *m = CollectorPrivileges{} }
+func (m *CollectorPrivileges) String() string { return proto.CompactTextString(m) }
+func (*CollectorPrivileges) ProtoMessage()    {}
+func (*CollectorPrivileges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{15}
 }
 
-func (m *RelationInformation_Constraint) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RelationInformation_Constraint.Unmarshal(m, b)
+func (m *CollectorPrivileges) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectorPrivileges.Unmarshal(m, b)
 }
-func (m *RelationInformation_Constraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RelationInformation_Constraint.Marshal(b, m, deterministic)
+func (m *CollectorPrivileges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectorPrivileges.Marshal(b, m, deterministic)
 }
-func (m *RelationInformation_Constraint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RelationInformation_Constraint.Merge(m, src)
+func (m *CollectorPrivileges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectorPrivileges.Merge(m, src)
 }
-func (m *RelationInformation_Constraint) XXX_Size() int {
-	return xxx_messageInfo_RelationInformation_Constraint.Size(m)
+func (m *CollectorPrivileges) XXX_Size() int {
+	return xxx_messageInfo_CollectorPrivileges.Size(m)
 }
-func (m *RelationInformation_Constraint) XXX_DiscardUnknown() {
-	xxx_messageInfo_RelationInformation_Constraint.DiscardUnknown(m)
+func (m *CollectorPrivileges) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectorPrivileges.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RelationInformation_Constraint proto.InternalMessageInfo
+var xxx_messageInfo_CollectorPrivileges proto.InternalMessageInfo
 
-func (m *RelationInformation_Constraint) GetForeignRelationIdx() int32 {
+func (m *CollectorPrivileges) GetSuperuser() bool {
 	if m != nil {
-		return m.ForeignRelationIdx
+		return m.Superuser
 	}
-	return 0
+	return false
 }
 
-func (m *RelationInformation_Constraint) GetName() string {
+func (m *CollectorPrivileges) GetPgMonitor() bool {
 	if m != nil {
-		return m.Name
+		return m.PgMonitor
 	}
-	return ""
+	return false
 }
 
-func (m *RelationInformation_Constraint) GetType() string {
+func (m *CollectorPrivileges) GetPgReadAllStats() bool {
 	if m != nil {
-		return m.Type
+		return m.PgReadAllStats
 	}
-	return ""
+	return false
 }
 
-func (m *RelationInformation_Constraint) GetConstraintDef() string {
+func (m *CollectorPrivileges) GetPermissionLimitedSections() []string {
 	if m != nil {
-		return m.ConstraintDef
+		return m.PermissionLimitedSections
+	}
+	return nil
+}
+
+type HbaRule struct {
+	LineNumber int32    `protobuf:"varint,1,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
+	Type       string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Databases  []string `protobuf:"bytes,3,rep,name=databases,proto3" json:"databases,omitempty"`
+	UserNames  []string `protobuf:"bytes,4,rep,name=user_names,json=userNames,proto3" json:"user_names,omitempty"`
+	Address    string   `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Netmask    string   `protobuf:"bytes,6,opt,name=netmask,proto3" json:"netmask,omitempty"`
+	AuthMethod string   `protobuf:"bytes,7,opt,name=auth_method,json=authMethod,proto3" json:"auth_method,omitempty"`
+	Options    []string `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty"`
+	Error      string   `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// Why this rule is considered overly permissive (empty if it isn't)
+	PermissiveReasons    []string `protobuf:"bytes,10,rep,name=permissive_reasons,json=permissiveReasons,proto3" json:"permissive_reasons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HbaRule) Reset()         { *m = HbaRule{} }
+func (m *HbaRule) String() string { return proto.CompactTextString(m) }
+func (*HbaRule) ProtoMessage()    {}
+func (*HbaRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{16}
+}
+
+func (m *HbaRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HbaRule.Unmarshal(m, b)
+}
+func (m *HbaRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HbaRule.Marshal(b, m, deterministic)
+}
+func (m *HbaRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HbaRule.Merge(m, src)
+}
+func (m *HbaRule) XXX_Size() int {
+	return xxx_messageInfo_HbaRule.Size(m)
+}
+func (m *HbaRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_HbaRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HbaRule proto.InternalMessageInfo
+
+func (m *HbaRule) GetLineNumber() int32 {
+	if m != nil {
+		return m.LineNumber
+	}
+	return 0
+}
+
+func (m *HbaRule) GetType() string {
+	if m != nil {
+		return m.Type
 	}
 	return ""
 }
 
-func (m *RelationInformation_Constraint) GetColumns() []int32 {
+func (m *HbaRule) GetDatabases() []string {
 	if m != nil {
-		return m.Columns
+		return m.Databases
 	}
 	return nil
 }
 
-func (m *RelationInformation_Constraint) GetForeignColumns() []int32 {
+func (m *HbaRule) GetUserNames() []string {
 	if m != nil {
-		return m.ForeignColumns
+		return m.UserNames
 	}
 	return nil
 }
 
-func (m *RelationInformation_Constraint) GetForeignUpdateType() string {
+func (m *HbaRule) GetAddress() string {
 	if m != nil {
-		return m.ForeignUpdateType
+		return m.Address
 	}
 	return ""
 }
 
-func (m *RelationInformation_Constraint) GetForeignDeleteType() string {
+func (m *HbaRule) GetNetmask() string {
 	if m != nil {
-		return m.ForeignDeleteType
+		return m.Netmask
 	}
 	return ""
 }
 
-func (m *RelationInformation_Constraint) GetForeignMatchType() string {
+func (m *HbaRule) GetAuthMethod() string {
 	if m != nil {
-		return m.ForeignMatchType
+		return m.AuthMethod
 	}
 	return ""
 }
 
-type RelationStatistic struct {
-	RelationIdx          int32    `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
-	SizeBytes            int64    `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	SeqScan              int64    `protobuf:"varint,3,opt,name=seq_scan,json=seqScan,proto3" json:"seq_scan,omitempty"`
-	SeqTupRead           int64    `protobuf:"varint,4,opt,name=seq_tup_read,json=seqTupRead,proto3" json:"seq_tup_read,omitempty"`
-	IdxScan              int64    `protobuf:"varint,5,opt,name=idx_scan,json=idxScan,proto3" json:"idx_scan,omitempty"`
-	IdxTupFetch          int64    `protobuf:"varint,6,opt,name=idx_tup_fetch,json=idxTupFetch,proto3" json:"idx_tup_fetch,omitempty"`
-	NTupIns              int64    `protobuf:"varint,7,opt,name=n_tup_ins,json=nTupIns,proto3" json:"n_tup_ins,omitempty"`
-	NTupUpd              int64    `protobuf:"varint,8,opt,name=n_tup_upd,json=nTupUpd,proto3" json:"n_tup_upd,omitempty"`
-	NTupDel              int64    `protobuf:"varint,9,opt,name=n_tup_del,json=nTupDel,proto3" json:"n_tup_del,omitempty"`
-	NTupHotUpd           int64    `protobuf:"varint,10,opt,name=n_tup_hot_upd,json=nTupHotUpd,proto3" json:"n_tup_hot_upd,omitempty"`
-	NLiveTup             int64    `protobuf:"varint,11,opt,name=n_live_tup,json=nLiveTup,proto3" json:"n_live_tup,omitempty"`
-	NDeadTup             int64    `protobuf:"varint,12,opt,name=n_dead_tup,json=nDeadTup,proto3" json:"n_dead_tup,omitempty"`
-	NModSinceAnalyze     int64    `protobuf:"varint,13,opt,name=n_mod_since_analyze,json=nModSinceAnalyze,proto3" json:"n_mod_since_analyze,omitempty"`
-	HeapBlksRead         int64    `protobuf:"varint,18,opt,name=heap_blks_read,json=heapBlksRead,proto3" json:"heap_blks_read,omitempty"`
-	HeapBlksHit          int64    `protobuf:"varint,19,opt,name=heap_blks_hit,json=heapBlksHit,proto3" json:"heap_blks_hit,omitempty"`
-	IdxBlksRead          int64    `protobuf:"varint,20,opt,name=idx_blks_read,json=idxBlksRead,proto3" json:"idx_blks_read,omitempty"`
-	IdxBlksHit           int64    `protobuf:"varint,21,opt,name=idx_blks_hit,json=idxBlksHit,proto3" json:"idx_blks_hit,omitempty"`
-	ToastBlksRead        int64    `protobuf:"varint,22,opt,name=toast_blks_read,json=toastBlksRead,proto3" json:"toast_blks_read,omitempty"`
-	ToastBlksHit         int64    `protobuf:"varint,23,opt,name=toast_blks_hit,json=toastBlksHit,proto3" json:"toast_blks_hit,omitempty"`
-	TidxBlksRead         int64    `protobuf:"varint,24,opt,name=tidx_blks_read,json=tidxBlksRead,proto3" json:"tidx_blks_read,omitempty"`
-	TidxBlksHit          int64    `protobuf:"varint,25,opt,name=tidx_blks_hit,json=tidxBlksHit,proto3" json:"tidx_blks_hit,omitempty"`
-	ToastSizeBytes       int64    `protobuf:"varint,26,opt,name=toast_size_bytes,json=toastSizeBytes,proto3" json:"toast_size_bytes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *HbaRule) GetOptions() []string {
+	if m != nil {
+		return m.Options
+	}
+	return nil
 }
 
-func (m *RelationStatistic) Reset()         { *m = RelationStatistic{} }
-func (m *RelationStatistic) String() string { return proto.CompactTextString(m) }
-func (*RelationStatistic) ProtoMessage()    {}
-func (*RelationStatistic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{15}
+func (m *HbaRule) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *RelationStatistic) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RelationStatistic.Unmarshal(m, b)
+func (m *HbaRule) GetPermissiveReasons() []string {
+	if m != nil {
+		return m.PermissiveReasons
+	}
+	return nil
 }
-func (m *RelationStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RelationStatistic.Marshal(b, m, deterministic)
+
+type RelationLabels struct {
+	RelationIdx          int32             `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *RelationStatistic) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RelationStatistic.Merge(m, src)
+
+func (m *RelationLabels) Reset()         { *m = RelationLabels{} }
+func (m *RelationLabels) String() string { return proto.CompactTextString(m) }
+func (*RelationLabels) ProtoMessage()    {}
+func (*RelationLabels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{17}
 }
-func (m *RelationStatistic) XXX_Size() int {
-	return xxx_messageInfo_RelationStatistic.Size(m)
+
+func (m *RelationLabels) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelationLabels.Unmarshal(m, b)
 }
-func (m *RelationStatistic) XXX_DiscardUnknown() {
-	xxx_messageInfo_RelationStatistic.DiscardUnknown(m)
+func (m *RelationLabels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelationLabels.Marshal(b, m, deterministic)
+}
+func (m *RelationLabels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationLabels.Merge(m, src)
+}
+func (m *RelationLabels) XXX_Size() int {
+	return xxx_messageInfo_RelationLabels.Size(m)
+}
+func (m *RelationLabels) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationLabels.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RelationStatistic proto.InternalMessageInfo
+var xxx_messageInfo_RelationLabels proto.InternalMessageInfo
 
-func (m *RelationStatistic) GetRelationIdx() int32 {
+func (m *RelationLabels) GetRelationIdx() int32 {
 	if m != nil {
 		return m.RelationIdx
 	}
 	return 0
 }
 
-func (m *RelationStatistic) GetSizeBytes() int64 {
+func (m *RelationLabels) GetLabels() map[string]string {
 	if m != nil {
-		return m.SizeBytes
+		return m.Labels
 	}
-	return 0
+	return nil
 }
 
-func (m *RelationStatistic) GetSeqScan() int64 {
-	if m != nil {
-		return m.SeqScan
-	}
-	return 0
+type DuplicateIndex struct {
+	IndexIdx             int32    `protobuf:"varint,1,opt,name=index_idx,json=indexIdx,proto3" json:"index_idx,omitempty"`
+	CoveringIndexIdx     int32    `protobuf:"varint,2,opt,name=covering_index_idx,json=coveringIndexIdx,proto3" json:"covering_index_idx,omitempty"`
+	Exact                bool     `protobuf:"varint,3,opt,name=exact,proto3" json:"exact,omitempty"`
+	ReclaimableBytes     int64    `protobuf:"varint,4,opt,name=reclaimable_bytes,json=reclaimableBytes,proto3" json:"reclaimable_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RelationStatistic) GetSeqTupRead() int64 {
-	if m != nil {
-		return m.SeqTupRead
-	}
-	return 0
+func (m *DuplicateIndex) Reset()         { *m = DuplicateIndex{} }
+func (m *DuplicateIndex) String() string { return proto.CompactTextString(m) }
+func (*DuplicateIndex) ProtoMessage()    {}
+func (*DuplicateIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{18}
 }
 
-func (m *RelationStatistic) GetIdxScan() int64 {
-	if m != nil {
-		return m.IdxScan
-	}
-	return 0
+func (m *DuplicateIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DuplicateIndex.Unmarshal(m, b)
+}
+func (m *DuplicateIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DuplicateIndex.Marshal(b, m, deterministic)
+}
+func (m *DuplicateIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateIndex.Merge(m, src)
+}
+func (m *DuplicateIndex) XXX_Size() int {
+	return xxx_messageInfo_DuplicateIndex.Size(m)
+}
+func (m *DuplicateIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateIndex.DiscardUnknown(m)
 }
 
-func (m *RelationStatistic) GetIdxTupFetch() int64 {
+var xxx_messageInfo_DuplicateIndex proto.InternalMessageInfo
+
+func (m *DuplicateIndex) GetIndexIdx() int32 {
 	if m != nil {
-		return m.IdxTupFetch
+		return m.IndexIdx
 	}
 	return 0
 }
 
-func (m *RelationStatistic) GetNTupIns() int64 {
+func (m *DuplicateIndex) GetCoveringIndexIdx() int32 {
 	if m != nil {
-		return m.NTupIns
+		return m.CoveringIndexIdx
 	}
 	return 0
 }
 
-func (m *RelationStatistic) GetNTupUpd() int64 {
+func (m *DuplicateIndex) GetExact() bool {
 	if m != nil {
-		return m.NTupUpd
+		return m.Exact
 	}
-	return 0
+	return false
 }
 
-func (m *RelationStatistic) GetNTupDel() int64 {
+func (m *DuplicateIndex) GetReclaimableBytes() int64 {
 	if m != nil {
-		return m.NTupDel
+		return m.ReclaimableBytes
 	}
 	return 0
 }
 
-func (m *RelationStatistic) GetNTupHotUpd() int64 {
-	if m != nil {
-		return m.NTupHotUpd
-	}
-	return 0
+type MissingForeignKeyIndex struct {
+	RelationIdx          int32    `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	ConstraintName       string   `protobuf:"bytes,2,opt,name=constraint_name,json=constraintName,proto3" json:"constraint_name,omitempty"`
+	ColumnNames          []string `protobuf:"bytes,3,rep,name=column_names,json=columnNames,proto3" json:"column_names,omitempty"`
+	SuggestedIndex       string   `protobuf:"bytes,4,opt,name=suggested_index,json=suggestedIndex,proto3" json:"suggested_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RelationStatistic) GetNLiveTup() int64 {
-	if m != nil {
-		return m.NLiveTup
-	}
-	return 0
+func (m *MissingForeignKeyIndex) Reset()         { *m = MissingForeignKeyIndex{} }
+func (m *MissingForeignKeyIndex) String() string { return proto.CompactTextString(m) }
+func (*MissingForeignKeyIndex) ProtoMessage()    {}
+func (*MissingForeignKeyIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{19}
 }
 
-func (m *RelationStatistic) GetNDeadTup() int64 {
-	if m != nil {
-		return m.NDeadTup
-	}
-	return 0
+func (m *MissingForeignKeyIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MissingForeignKeyIndex.Unmarshal(m, b)
+}
+func (m *MissingForeignKeyIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MissingForeignKeyIndex.Marshal(b, m, deterministic)
+}
+func (m *MissingForeignKeyIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissingForeignKeyIndex.Merge(m, src)
+}
+func (m *MissingForeignKeyIndex) XXX_Size() int {
+	return xxx_messageInfo_MissingForeignKeyIndex.Size(m)
+}
+func (m *MissingForeignKeyIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissingForeignKeyIndex.DiscardUnknown(m)
 }
 
-func (m *RelationStatistic) GetNModSinceAnalyze() int64 {
+var xxx_messageInfo_MissingForeignKeyIndex proto.InternalMessageInfo
+
+func (m *MissingForeignKeyIndex) GetRelationIdx() int32 {
 	if m != nil {
-		return m.NModSinceAnalyze
+		return m.RelationIdx
 	}
 	return 0
 }
 
-func (m *RelationStatistic) GetHeapBlksRead() int64 {
+func (m *MissingForeignKeyIndex) GetConstraintName() string {
 	if m != nil {
-		return m.HeapBlksRead
+		return m.ConstraintName
 	}
-	return 0
+	return ""
 }
 
-func (m *RelationStatistic) GetHeapBlksHit() int64 {
+func (m *MissingForeignKeyIndex) GetColumnNames() []string {
 	if m != nil {
-		return m.HeapBlksHit
+		return m.ColumnNames
 	}
-	return 0
+	return nil
 }
 
-func (m *RelationStatistic) GetIdxBlksRead() int64 {
+func (m *MissingForeignKeyIndex) GetSuggestedIndex() string {
 	if m != nil {
-		return m.IdxBlksRead
+		return m.SuggestedIndex
 	}
-	return 0
+	return ""
 }
 
-func (m *RelationStatistic) GetIdxBlksHit() int64 {
+type XminHorizon struct {
+	Source               string     `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Identifier           string     `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Xmin                 uint32     `protobuf:"varint,3,opt,name=xmin,proto3" json:"xmin,omitempty"`
+	XminAge              int64      `protobuf:"varint,4,opt,name=xmin_age,json=xminAge,proto3" json:"xmin_age,omitempty"`
+	AgeSecs              *NullInt64 `protobuf:"bytes,5,opt,name=age_secs,json=ageSecs,proto3" json:"age_secs,omitempty"`
+	ExceedsWarnAge       bool       `protobuf:"varint,6,opt,name=exceeds_warn_age,json=exceedsWarnAge,proto3" json:"exceeds_warn_age,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *XminHorizon) Reset()         { *m = XminHorizon{} }
+func (m *XminHorizon) String() string { return proto.CompactTextString(m) }
+func (*XminHorizon) ProtoMessage()    {}
+func (*XminHorizon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{20}
+}
+
+func (m *XminHorizon) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_XminHorizon.Unmarshal(m, b)
+}
+func (m *XminHorizon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_XminHorizon.Marshal(b, m, deterministic)
+}
+func (m *XminHorizon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XminHorizon.Merge(m, src)
+}
+func (m *XminHorizon) XXX_Size() int {
+	return xxx_messageInfo_XminHorizon.Size(m)
+}
+func (m *XminHorizon) XXX_DiscardUnknown() {
+	xxx_messageInfo_XminHorizon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XminHorizon proto.InternalMessageInfo
+
+func (m *XminHorizon) GetSource() string {
 	if m != nil {
-		return m.IdxBlksHit
+		return m.Source
 	}
-	return 0
+	return ""
 }
 
-func (m *RelationStatistic) GetToastBlksRead() int64 {
+func (m *XminHorizon) GetIdentifier() string {
 	if m != nil {
-		return m.ToastBlksRead
+		return m.Identifier
 	}
-	return 0
+	return ""
 }
 
-func (m *RelationStatistic) GetToastBlksHit() int64 {
+func (m *XminHorizon) GetXmin() uint32 {
 	if m != nil {
-		return m.ToastBlksHit
+		return m.Xmin
 	}
 	return 0
 }
 
-func (m *RelationStatistic) GetTidxBlksRead() int64 {
+func (m *XminHorizon) GetXminAge() int64 {
 	if m != nil {
-		return m.TidxBlksRead
+		return m.XminAge
 	}
 	return 0
 }
 
-func (m *RelationStatistic) GetTidxBlksHit() int64 {
+func (m *XminHorizon) GetAgeSecs() *NullInt64 {
 	if m != nil {
-		return m.TidxBlksHit
+		return m.AgeSecs
 	}
-	return 0
+	return nil
 }
 
-func (m *RelationStatistic) GetToastSizeBytes() int64 {
+func (m *XminHorizon) GetExceedsWarnAge() bool {
 	if m != nil {
-		return m.ToastSizeBytes
+		return m.ExceedsWarnAge
 	}
-	return 0
+	return false
 }
 
-type RelationEvent struct {
-	RelationIdx           int32                   `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
-	Type                  RelationEvent_EventType `protobuf:"varint,2,opt,name=type,proto3,enum=pganalyze.collector.RelationEvent_EventType" json:"type,omitempty"`
-	OccurredAt            *timestamp.Timestamp    `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
-	ApproximateOccurredAt bool                    `protobuf:"varint,4,opt,name=approximate_occurred_at,json=approximateOccurredAt,proto3" json:"approximate_occurred_at,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}                `json:"-"`
-	XXX_unrecognized      []byte                  `json:"-"`
-	XXX_sizecache         int32                   `json:"-"`
+type StatementStatsInfo struct {
+	Dealloc              int64                `protobuf:"varint,1,opt,name=dealloc,proto3" json:"dealloc,omitempty"`
+	StatsReset           *timestamp.Timestamp `protobuf:"bytes,2,opt,name=stats_reset,json=statsReset,proto3" json:"stats_reset,omitempty"`
+	DeallocSinceLast     *NullInt64           `protobuf:"bytes,3,opt,name=dealloc_since_last,json=deallocSinceLast,proto3" json:"dealloc_since_last,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *RelationEvent) Reset()         { *m = RelationEvent{} }
-func (m *RelationEvent) String() string { return proto.CompactTextString(m) }
-func (*RelationEvent) ProtoMessage()    {}
-func (*RelationEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{16}
+func (m *StatementStatsInfo) Reset()         { *m = StatementStatsInfo{} }
+func (m *StatementStatsInfo) String() string { return proto.CompactTextString(m) }
+func (*StatementStatsInfo) ProtoMessage()    {}
+func (*StatementStatsInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{21}
 }
 
-func (m *RelationEvent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RelationEvent.Unmarshal(m, b)
+func (m *StatementStatsInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatementStatsInfo.Unmarshal(m, b)
 }
-func (m *RelationEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RelationEvent.Marshal(b, m, deterministic)
+func (m *StatementStatsInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatementStatsInfo.Marshal(b, m, deterministic)
 }
-func (m *RelationEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RelationEvent.Merge(m, src)
+func (m *StatementStatsInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatementStatsInfo.Merge(m, src)
 }
-func (m *RelationEvent) XXX_Size() int {
-	return xxx_messageInfo_RelationEvent.Size(m)
+func (m *StatementStatsInfo) XXX_Size() int {
+	return xxx_messageInfo_StatementStatsInfo.Size(m)
 }
-func (m *RelationEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_RelationEvent.DiscardUnknown(m)
+func (m *StatementStatsInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatementStatsInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RelationEvent proto.InternalMessageInfo
+var xxx_messageInfo_StatementStatsInfo proto.InternalMessageInfo
 
-func (m *RelationEvent) GetRelationIdx() int32 {
+func (m *StatementStatsInfo) GetDealloc() int64 {
 	if m != nil {
-		return m.RelationIdx
+		return m.Dealloc
 	}
 	return 0
 }
 
-func (m *RelationEvent) GetType() RelationEvent_EventType {
+func (m *StatementStatsInfo) GetStatsReset() *timestamp.Timestamp {
 	if m != nil {
-		return m.Type
+		return m.StatsReset
+	}
+	return nil
+}
+
+func (m *StatementStatsInfo) GetDeallocSinceLast() *NullInt64 {
+	if m != nil {
+		return m.DeallocSinceLast
+	}
+	return nil
+}
+
+type Wraparound struct {
+	AutovacuumFreezeMaxAge int64                 `protobuf:"varint,1,opt,name=autovacuum_freeze_max_age,json=autovacuumFreezeMaxAge,proto3" json:"autovacuum_freeze_max_age,omitempty"`
+	Databases              []*WraparoundDatabase `protobuf:"bytes,2,rep,name=databases,proto3" json:"databases,omitempty"`
+	// Tables closest to a forced anti-wraparound VACUUM
+	Relations            []*WraparoundRelation `protobuf:"bytes,3,rep,name=relations,proto3" json:"relations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Wraparound) Reset()         { *m = Wraparound{} }
+func (m *Wraparound) String() string { return proto.CompactTextString(m) }
+func (*Wraparound) ProtoMessage()    {}
+func (*Wraparound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{22}
+}
+
+func (m *Wraparound) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Wraparound.Unmarshal(m, b)
+}
+func (m *Wraparound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Wraparound.Marshal(b, m, deterministic)
+}
+func (m *Wraparound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Wraparound.Merge(m, src)
+}
+func (m *Wraparound) XXX_Size() int {
+	return xxx_messageInfo_Wraparound.Size(m)
+}
+func (m *Wraparound) XXX_DiscardUnknown() {
+	xxx_messageInfo_Wraparound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Wraparound proto.InternalMessageInfo
+
+func (m *Wraparound) GetAutovacuumFreezeMaxAge() int64 {
+	if m != nil {
+		return m.AutovacuumFreezeMaxAge
+	}
+	return 0
+}
+
+func (m *Wraparound) GetDatabases() []*WraparoundDatabase {
+	if m != nil {
+		return m.Databases
+	}
+	return nil
+}
+
+func (m *Wraparound) GetRelations() []*WraparoundRelation {
+	if m != nil {
+		return m.Relations
+	}
+	return nil
+}
+
+type WraparoundDatabase struct {
+	DatabaseIdx          int32    `protobuf:"varint,1,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	XidAge               int64    `protobuf:"varint,2,opt,name=xid_age,json=xidAge,proto3" json:"xid_age,omitempty"`
+	ForcedVacuumPct      float64  `protobuf:"fixed64,3,opt,name=forced_vacuum_pct,json=forcedVacuumPct,proto3" json:"forced_vacuum_pct,omitempty"`
+	WraparoundPct        float64  `protobuf:"fixed64,4,opt,name=wraparound_pct,json=wraparoundPct,proto3" json:"wraparound_pct,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WraparoundDatabase) Reset()         { *m = WraparoundDatabase{} }
+func (m *WraparoundDatabase) String() string { return proto.CompactTextString(m) }
+func (*WraparoundDatabase) ProtoMessage()    {}
+func (*WraparoundDatabase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{23}
+}
+
+func (m *WraparoundDatabase) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WraparoundDatabase.Unmarshal(m, b)
+}
+func (m *WraparoundDatabase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WraparoundDatabase.Marshal(b, m, deterministic)
+}
+func (m *WraparoundDatabase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WraparoundDatabase.Merge(m, src)
+}
+func (m *WraparoundDatabase) XXX_Size() int {
+	return xxx_messageInfo_WraparoundDatabase.Size(m)
+}
+func (m *WraparoundDatabase) XXX_DiscardUnknown() {
+	xxx_messageInfo_WraparoundDatabase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WraparoundDatabase proto.InternalMessageInfo
+
+func (m *WraparoundDatabase) GetDatabaseIdx() int32 {
+	if m != nil {
+		return m.DatabaseIdx
+	}
+	return 0
+}
+
+func (m *WraparoundDatabase) GetXidAge() int64 {
+	if m != nil {
+		return m.XidAge
+	}
+	return 0
+}
+
+func (m *WraparoundDatabase) GetForcedVacuumPct() float64 {
+	if m != nil {
+		return m.ForcedVacuumPct
+	}
+	return 0
+}
+
+func (m *WraparoundDatabase) GetWraparoundPct() float64 {
+	if m != nil {
+		return m.WraparoundPct
+	}
+	return 0
+}
+
+type WraparoundRelation struct {
+	RelationIdx          int32    `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	XidAge               int64    `protobuf:"varint,2,opt,name=xid_age,json=xidAge,proto3" json:"xid_age,omitempty"`
+	FreezeMaxAge         int64    `protobuf:"varint,3,opt,name=freeze_max_age,json=freezeMaxAge,proto3" json:"freeze_max_age,omitempty"`
+	ForcedVacuumPct      float64  `protobuf:"fixed64,4,opt,name=forced_vacuum_pct,json=forcedVacuumPct,proto3" json:"forced_vacuum_pct,omitempty"`
+	WraparoundPct        float64  `protobuf:"fixed64,5,opt,name=wraparound_pct,json=wraparoundPct,proto3" json:"wraparound_pct,omitempty"`
+	Flagged              bool     `protobuf:"varint,6,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WraparoundRelation) Reset()         { *m = WraparoundRelation{} }
+func (m *WraparoundRelation) String() string { return proto.CompactTextString(m) }
+func (*WraparoundRelation) ProtoMessage()    {}
+func (*WraparoundRelation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{24}
+}
+
+func (m *WraparoundRelation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WraparoundRelation.Unmarshal(m, b)
+}
+func (m *WraparoundRelation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WraparoundRelation.Marshal(b, m, deterministic)
+}
+func (m *WraparoundRelation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WraparoundRelation.Merge(m, src)
+}
+func (m *WraparoundRelation) XXX_Size() int {
+	return xxx_messageInfo_WraparoundRelation.Size(m)
+}
+func (m *WraparoundRelation) XXX_DiscardUnknown() {
+	xxx_messageInfo_WraparoundRelation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WraparoundRelation proto.InternalMessageInfo
+
+func (m *WraparoundRelation) GetRelationIdx() int32 {
+	if m != nil {
+		return m.RelationIdx
+	}
+	return 0
+}
+
+func (m *WraparoundRelation) GetXidAge() int64 {
+	if m != nil {
+		return m.XidAge
+	}
+	return 0
+}
+
+func (m *WraparoundRelation) GetFreezeMaxAge() int64 {
+	if m != nil {
+		return m.FreezeMaxAge
+	}
+	return 0
+}
+
+func (m *WraparoundRelation) GetForcedVacuumPct() float64 {
+	if m != nil {
+		return m.ForcedVacuumPct
+	}
+	return 0
+}
+
+func (m *WraparoundRelation) GetWraparoundPct() float64 {
+	if m != nil {
+		return m.WraparoundPct
+	}
+	return 0
+}
+
+func (m *WraparoundRelation) GetFlagged() bool {
+	if m != nil {
+		return m.Flagged
+	}
+	return false
+}
+
+type AutovacuumForecast struct {
+	Settings             *AutovacuumSettings           `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	Relations            []*AutovacuumRelationForecast `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *AutovacuumForecast) Reset()         { *m = AutovacuumForecast{} }
+func (m *AutovacuumForecast) String() string { return proto.CompactTextString(m) }
+func (*AutovacuumForecast) ProtoMessage()    {}
+func (*AutovacuumForecast) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{25}
+}
+
+func (m *AutovacuumForecast) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutovacuumForecast.Unmarshal(m, b)
+}
+func (m *AutovacuumForecast) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutovacuumForecast.Marshal(b, m, deterministic)
+}
+func (m *AutovacuumForecast) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutovacuumForecast.Merge(m, src)
+}
+func (m *AutovacuumForecast) XXX_Size() int {
+	return xxx_messageInfo_AutovacuumForecast.Size(m)
+}
+func (m *AutovacuumForecast) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutovacuumForecast.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutovacuumForecast proto.InternalMessageInfo
+
+func (m *AutovacuumForecast) GetSettings() *AutovacuumSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *AutovacuumForecast) GetRelations() []*AutovacuumRelationForecast {
+	if m != nil {
+		return m.Relations
+	}
+	return nil
+}
+
+type AutovacuumSettings struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	VacuumThreshold      int64    `protobuf:"varint,2,opt,name=vacuum_threshold,json=vacuumThreshold,proto3" json:"vacuum_threshold,omitempty"`
+	VacuumScaleFactor    float64  `protobuf:"fixed64,3,opt,name=vacuum_scale_factor,json=vacuumScaleFactor,proto3" json:"vacuum_scale_factor,omitempty"`
+	AnalyzeThreshold     int64    `protobuf:"varint,4,opt,name=analyze_threshold,json=analyzeThreshold,proto3" json:"analyze_threshold,omitempty"`
+	AnalyzeScaleFactor   float64  `protobuf:"fixed64,5,opt,name=analyze_scale_factor,json=analyzeScaleFactor,proto3" json:"analyze_scale_factor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AutovacuumSettings) Reset()         { *m = AutovacuumSettings{} }
+func (m *AutovacuumSettings) String() string { return proto.CompactTextString(m) }
+func (*AutovacuumSettings) ProtoMessage()    {}
+func (*AutovacuumSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{26}
+}
+
+func (m *AutovacuumSettings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutovacuumSettings.Unmarshal(m, b)
+}
+func (m *AutovacuumSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutovacuumSettings.Marshal(b, m, deterministic)
+}
+func (m *AutovacuumSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutovacuumSettings.Merge(m, src)
+}
+func (m *AutovacuumSettings) XXX_Size() int {
+	return xxx_messageInfo_AutovacuumSettings.Size(m)
+}
+func (m *AutovacuumSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutovacuumSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutovacuumSettings proto.InternalMessageInfo
+
+func (m *AutovacuumSettings) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *AutovacuumSettings) GetVacuumThreshold() int64 {
+	if m != nil {
+		return m.VacuumThreshold
+	}
+	return 0
+}
+
+func (m *AutovacuumSettings) GetVacuumScaleFactor() float64 {
+	if m != nil {
+		return m.VacuumScaleFactor
+	}
+	return 0
+}
+
+func (m *AutovacuumSettings) GetAnalyzeThreshold() int64 {
+	if m != nil {
+		return m.AnalyzeThreshold
+	}
+	return 0
+}
+
+func (m *AutovacuumSettings) GetAnalyzeScaleFactor() float64 {
+	if m != nil {
+		return m.AnalyzeScaleFactor
+	}
+	return 0
+}
+
+type AutovacuumRelationForecast struct {
+	RelationIdx             int32               `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	Settings                *AutovacuumSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	Overridden              bool                `protobuf:"varint,3,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	DeadTuples              int64               `protobuf:"varint,4,opt,name=dead_tuples,json=deadTuples,proto3" json:"dead_tuples,omitempty"`
+	VacuumTriggerDeadTuples int64               `protobuf:"varint,5,opt,name=vacuum_trigger_dead_tuples,json=vacuumTriggerDeadTuples,proto3" json:"vacuum_trigger_dead_tuples,omitempty"`
+	ModsSinceAnalyze        *NullInt64          `protobuf:"bytes,6,opt,name=mods_since_analyze,json=modsSinceAnalyze,proto3" json:"mods_since_analyze,omitempty"`
+	AnalyzeTriggerMods      int64               `protobuf:"varint,7,opt,name=analyze_trigger_mods,json=analyzeTriggerMods,proto3" json:"analyze_trigger_mods,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}            `json:"-"`
+	XXX_unrecognized        []byte              `json:"-"`
+	XXX_sizecache           int32               `json:"-"`
+}
+
+func (m *AutovacuumRelationForecast) Reset()         { *m = AutovacuumRelationForecast{} }
+func (m *AutovacuumRelationForecast) String() string { return proto.CompactTextString(m) }
+func (*AutovacuumRelationForecast) ProtoMessage()    {}
+func (*AutovacuumRelationForecast) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{27}
+}
+
+func (m *AutovacuumRelationForecast) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutovacuumRelationForecast.Unmarshal(m, b)
+}
+func (m *AutovacuumRelationForecast) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutovacuumRelationForecast.Marshal(b, m, deterministic)
+}
+func (m *AutovacuumRelationForecast) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutovacuumRelationForecast.Merge(m, src)
+}
+func (m *AutovacuumRelationForecast) XXX_Size() int {
+	return xxx_messageInfo_AutovacuumRelationForecast.Size(m)
+}
+func (m *AutovacuumRelationForecast) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutovacuumRelationForecast.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutovacuumRelationForecast proto.InternalMessageInfo
+
+func (m *AutovacuumRelationForecast) GetRelationIdx() int32 {
+	if m != nil {
+		return m.RelationIdx
+	}
+	return 0
+}
+
+func (m *AutovacuumRelationForecast) GetSettings() *AutovacuumSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *AutovacuumRelationForecast) GetOverridden() bool {
+	if m != nil {
+		return m.Overridden
+	}
+	return false
+}
+
+func (m *AutovacuumRelationForecast) GetDeadTuples() int64 {
+	if m != nil {
+		return m.DeadTuples
+	}
+	return 0
+}
+
+func (m *AutovacuumRelationForecast) GetVacuumTriggerDeadTuples() int64 {
+	if m != nil {
+		return m.VacuumTriggerDeadTuples
+	}
+	return 0
+}
+
+func (m *AutovacuumRelationForecast) GetModsSinceAnalyze() *NullInt64 {
+	if m != nil {
+		return m.ModsSinceAnalyze
+	}
+	return nil
+}
+
+func (m *AutovacuumRelationForecast) GetAnalyzeTriggerMods() int64 {
+	if m != nil {
+		return m.AnalyzeTriggerMods
+	}
+	return 0
+}
+
+type TablespaceReference struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TablespaceReference) Reset()         { *m = TablespaceReference{} }
+func (m *TablespaceReference) String() string { return proto.CompactTextString(m) }
+func (*TablespaceReference) ProtoMessage()    {}
+func (*TablespaceReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{28}
+}
+
+func (m *TablespaceReference) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TablespaceReference.Unmarshal(m, b)
+}
+func (m *TablespaceReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TablespaceReference.Marshal(b, m, deterministic)
+}
+func (m *TablespaceReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TablespaceReference.Merge(m, src)
+}
+func (m *TablespaceReference) XXX_Size() int {
+	return xxx_messageInfo_TablespaceReference.Size(m)
+}
+func (m *TablespaceReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_TablespaceReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TablespaceReference proto.InternalMessageInfo
+
+func (m *TablespaceReference) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type TablespaceInformation struct {
+	TablespaceIdx        int32      `protobuf:"varint,1,opt,name=tablespace_idx,json=tablespaceIdx,proto3" json:"tablespace_idx,omitempty"`
+	DiskPartitionIdx     int32      `protobuf:"varint,2,opt,name=disk_partition_idx,json=diskPartitionIdx,proto3" json:"disk_partition_idx,omitempty"`
+	RoleIdx              int32      `protobuf:"varint,3,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`
+	Config               []string   `protobuf:"bytes,4,rep,name=config,proto3" json:"config,omitempty"`
+	Location             string     `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	SizeBytes            *NullInt64 `protobuf:"bytes,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	HasDiskPartition     bool       `protobuf:"varint,7,opt,name=has_disk_partition,json=hasDiskPartition,proto3" json:"has_disk_partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *TablespaceInformation) Reset()         { *m = TablespaceInformation{} }
+func (m *TablespaceInformation) String() string { return proto.CompactTextString(m) }
+func (*TablespaceInformation) ProtoMessage()    {}
+func (*TablespaceInformation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{29}
+}
+
+func (m *TablespaceInformation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TablespaceInformation.Unmarshal(m, b)
+}
+func (m *TablespaceInformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TablespaceInformation.Marshal(b, m, deterministic)
+}
+func (m *TablespaceInformation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TablespaceInformation.Merge(m, src)
+}
+func (m *TablespaceInformation) XXX_Size() int {
+	return xxx_messageInfo_TablespaceInformation.Size(m)
+}
+func (m *TablespaceInformation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TablespaceInformation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TablespaceInformation proto.InternalMessageInfo
+
+func (m *TablespaceInformation) GetTablespaceIdx() int32 {
+	if m != nil {
+		return m.TablespaceIdx
+	}
+	return 0
+}
+
+func (m *TablespaceInformation) GetDiskPartitionIdx() int32 {
+	if m != nil {
+		return m.DiskPartitionIdx
+	}
+	return 0
+}
+
+func (m *TablespaceInformation) GetRoleIdx() int32 {
+	if m != nil {
+		return m.RoleIdx
+	}
+	return 0
+}
+
+func (m *TablespaceInformation) GetConfig() []string {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *TablespaceInformation) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+func (m *TablespaceInformation) GetSizeBytes() *NullInt64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return nil
+}
+
+func (m *TablespaceInformation) GetHasDiskPartition() bool {
+	if m != nil {
+		return m.HasDiskPartition
+	}
+	return false
+}
+
+type QueryStatistic struct {
+	QueryIdx          int32   `protobuf:"varint,1,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
+	Calls             int64   `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	TotalTime         float64 `protobuf:"fixed64,3,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
+	Rows              int64   `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
+	SharedBlksHit     int64   `protobuf:"varint,5,opt,name=shared_blks_hit,json=sharedBlksHit,proto3" json:"shared_blks_hit,omitempty"`
+	SharedBlksRead    int64   `protobuf:"varint,6,opt,name=shared_blks_read,json=sharedBlksRead,proto3" json:"shared_blks_read,omitempty"`
+	SharedBlksDirtied int64   `protobuf:"varint,7,opt,name=shared_blks_dirtied,json=sharedBlksDirtied,proto3" json:"shared_blks_dirtied,omitempty"`
+	SharedBlksWritten int64   `protobuf:"varint,8,opt,name=shared_blks_written,json=sharedBlksWritten,proto3" json:"shared_blks_written,omitempty"`
+	LocalBlksHit      int64   `protobuf:"varint,9,opt,name=local_blks_hit,json=localBlksHit,proto3" json:"local_blks_hit,omitempty"`
+	LocalBlksRead     int64   `protobuf:"varint,10,opt,name=local_blks_read,json=localBlksRead,proto3" json:"local_blks_read,omitempty"`
+	LocalBlksDirtied  int64   `protobuf:"varint,11,opt,name=local_blks_dirtied,json=localBlksDirtied,proto3" json:"local_blks_dirtied,omitempty"`
+	LocalBlksWritten  int64   `protobuf:"varint,12,opt,name=local_blks_written,json=localBlksWritten,proto3" json:"local_blks_written,omitempty"`
+	TempBlksRead      int64   `protobuf:"varint,13,opt,name=temp_blks_read,json=tempBlksRead,proto3" json:"temp_blks_read,omitempty"`
+	TempBlksWritten   int64   `protobuf:"varint,14,opt,name=temp_blks_written,json=tempBlksWritten,proto3" json:"temp_blks_written,omitempty"`
+	BlkReadTime       float64 `protobuf:"fixed64,15,opt,name=blk_read_time,json=blkReadTime,proto3" json:"blk_read_time,omitempty"`
+	BlkWriteTime      float64 `protobuf:"fixed64,16,opt,name=blk_write_time,json=blkWriteTime,proto3" json:"blk_write_time,omitempty"`
+	// From pg_stat_kcache (only set if installed)
+	HasKcache            bool     `protobuf:"varint,17,opt,name=has_kcache,json=hasKcache,proto3" json:"has_kcache,omitempty"`
+	UserCpuTime          float64  `protobuf:"fixed64,18,opt,name=user_cpu_time,json=userCpuTime,proto3" json:"user_cpu_time,omitempty"`
+	SysCpuTime           float64  `protobuf:"fixed64,19,opt,name=sys_cpu_time,json=sysCpuTime,proto3" json:"sys_cpu_time,omitempty"`
+	FsReadBytes          int64    `protobuf:"varint,20,opt,name=fs_read_bytes,json=fsReadBytes,proto3" json:"fs_read_bytes,omitempty"`
+	FsWriteBytes         int64    `protobuf:"varint,21,opt,name=fs_write_bytes,json=fsWriteBytes,proto3" json:"fs_write_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryStatistic) Reset()         { *m = QueryStatistic{} }
+func (m *QueryStatistic) String() string { return proto.CompactTextString(m) }
+func (*QueryStatistic) ProtoMessage()    {}
+func (*QueryStatistic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{30}
+}
+
+func (m *QueryStatistic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryStatistic.Unmarshal(m, b)
+}
+func (m *QueryStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryStatistic.Marshal(b, m, deterministic)
+}
+func (m *QueryStatistic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatistic.Merge(m, src)
+}
+func (m *QueryStatistic) XXX_Size() int {
+	return xxx_messageInfo_QueryStatistic.Size(m)
+}
+func (m *QueryStatistic) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatistic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStatistic proto.InternalMessageInfo
+
+func (m *QueryStatistic) GetQueryIdx() int32 {
+	if m != nil {
+		return m.QueryIdx
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetCalls() int64 {
+	if m != nil {
+		return m.Calls
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetTotalTime() float64 {
+	if m != nil {
+		return m.TotalTime
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetRows() int64 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetSharedBlksHit() int64 {
+	if m != nil {
+		return m.SharedBlksHit
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetSharedBlksRead() int64 {
+	if m != nil {
+		return m.SharedBlksRead
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetSharedBlksDirtied() int64 {
+	if m != nil {
+		return m.SharedBlksDirtied
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetSharedBlksWritten() int64 {
+	if m != nil {
+		return m.SharedBlksWritten
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetLocalBlksHit() int64 {
+	if m != nil {
+		return m.LocalBlksHit
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetLocalBlksRead() int64 {
+	if m != nil {
+		return m.LocalBlksRead
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetLocalBlksDirtied() int64 {
+	if m != nil {
+		return m.LocalBlksDirtied
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetLocalBlksWritten() int64 {
+	if m != nil {
+		return m.LocalBlksWritten
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetTempBlksRead() int64 {
+	if m != nil {
+		return m.TempBlksRead
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetTempBlksWritten() int64 {
+	if m != nil {
+		return m.TempBlksWritten
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetBlkReadTime() float64 {
+	if m != nil {
+		return m.BlkReadTime
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetBlkWriteTime() float64 {
+	if m != nil {
+		return m.BlkWriteTime
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetHasKcache() bool {
+	if m != nil {
+		return m.HasKcache
+	}
+	return false
+}
+
+func (m *QueryStatistic) GetUserCpuTime() float64 {
+	if m != nil {
+		return m.UserCpuTime
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetSysCpuTime() float64 {
+	if m != nil {
+		return m.SysCpuTime
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetFsReadBytes() int64 {
+	if m != nil {
+		return m.FsReadBytes
+	}
+	return 0
+}
+
+func (m *QueryStatistic) GetFsWriteBytes() int64 {
+	if m != nil {
+		return m.FsWriteBytes
+	}
+	return 0
+}
+
+type HistoricQueryStatistics struct {
+	CollectedAt           *timestamp.Timestamp `protobuf:"bytes,1,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	CollectedIntervalSecs uint32               `protobuf:"varint,2,opt,name=collected_interval_secs,json=collectedIntervalSecs,proto3" json:"collected_interval_secs,omitempty"`
+	Statistics            []*QueryStatistic    `protobuf:"bytes,3,rep,name=statistics,proto3" json:"statistics,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}             `json:"-"`
+	XXX_unrecognized      []byte               `json:"-"`
+	XXX_sizecache         int32                `json:"-"`
+}
+
+func (m *HistoricQueryStatistics) Reset()         { *m = HistoricQueryStatistics{} }
+func (m *HistoricQueryStatistics) String() string { return proto.CompactTextString(m) }
+func (*HistoricQueryStatistics) ProtoMessage()    {}
+func (*HistoricQueryStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{31}
+}
+
+func (m *HistoricQueryStatistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoricQueryStatistics.Unmarshal(m, b)
+}
+func (m *HistoricQueryStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoricQueryStatistics.Marshal(b, m, deterministic)
+}
+func (m *HistoricQueryStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricQueryStatistics.Merge(m, src)
+}
+func (m *HistoricQueryStatistics) XXX_Size() int {
+	return xxx_messageInfo_HistoricQueryStatistics.Size(m)
+}
+func (m *HistoricQueryStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricQueryStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricQueryStatistics proto.InternalMessageInfo
+
+func (m *HistoricQueryStatistics) GetCollectedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CollectedAt
+	}
+	return nil
+}
+
+func (m *HistoricQueryStatistics) GetCollectedIntervalSecs() uint32 {
+	if m != nil {
+		return m.CollectedIntervalSecs
+	}
+	return 0
+}
+
+func (m *HistoricQueryStatistics) GetStatistics() []*QueryStatistic {
+	if m != nil {
+		return m.Statistics
+	}
+	return nil
+}
+
+type RelationInformation struct {
+	RelationIdx            int32                             `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	RelationType           string                            `protobuf:"bytes,2,opt,name=relation_type,json=relationType,proto3" json:"relation_type,omitempty"`
+	ViewDefinition         *NullString                       `protobuf:"bytes,3,opt,name=view_definition,json=viewDefinition,proto3" json:"view_definition,omitempty"`
+	Columns                []*RelationInformation_Column     `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	Constraints            []*RelationInformation_Constraint `protobuf:"bytes,5,rep,name=constraints,proto3" json:"constraints,omitempty"`
+	PersistenceType        string                            `protobuf:"bytes,6,opt,name=persistence_type,json=persistenceType,proto3" json:"persistence_type,omitempty"`
+	Fillfactor             int32                             `protobuf:"varint,7,opt,name=fillfactor,proto3" json:"fillfactor,omitempty"`
+	HasOids                bool                              `protobuf:"varint,8,opt,name=has_oids,json=hasOids,proto3" json:"has_oids,omitempty"`
+	HasInheritanceChildren bool                              `protobuf:"varint,9,opt,name=has_inheritance_children,json=hasInheritanceChildren,proto3" json:"has_inheritance_children,omitempty"`
+	HasToast               bool                              `protobuf:"varint,10,opt,name=has_toast,json=hasToast,proto3" json:"has_toast,omitempty"`
+	FrozenXid              uint32                            `protobuf:"varint,11,opt,name=frozen_xid,json=frozenXid,proto3" json:"frozen_xid,omitempty"`
+	MinimumMultixactXid    uint32                            `protobuf:"varint,12,opt,name=minimum_multixact_xid,json=minimumMultixactXid,proto3" json:"minimum_multixact_xid,omitempty"`
+	// True if another process is currently holding an AccessExclusiveLock on this
+	// relation, this also means we won't have columns/index/constraints information
+	ExclusivelyLocked    bool              `protobuf:"varint,13,opt,name=exclusively_locked,json=exclusivelyLocked,proto3" json:"exclusively_locked,omitempty"`
+	Options              map[string]string `protobuf:"bytes,14,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RelationInformation) Reset()         { *m = RelationInformation{} }
+func (m *RelationInformation) String() string { return proto.CompactTextString(m) }
+func (*RelationInformation) ProtoMessage()    {}
+func (*RelationInformation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{32}
+}
+
+func (m *RelationInformation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelationInformation.Unmarshal(m, b)
+}
+func (m *RelationInformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelationInformation.Marshal(b, m, deterministic)
+}
+func (m *RelationInformation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationInformation.Merge(m, src)
+}
+func (m *RelationInformation) XXX_Size() int {
+	return xxx_messageInfo_RelationInformation.Size(m)
+}
+func (m *RelationInformation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationInformation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelationInformation proto.InternalMessageInfo
+
+func (m *RelationInformation) GetRelationIdx() int32 {
+	if m != nil {
+		return m.RelationIdx
+	}
+	return 0
+}
+
+func (m *RelationInformation) GetRelationType() string {
+	if m != nil {
+		return m.RelationType
+	}
+	return ""
+}
+
+func (m *RelationInformation) GetViewDefinition() *NullString {
+	if m != nil {
+		return m.ViewDefinition
+	}
+	return nil
+}
+
+func (m *RelationInformation) GetColumns() []*RelationInformation_Column {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func (m *RelationInformation) GetConstraints() []*RelationInformation_Constraint {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
+func (m *RelationInformation) GetPersistenceType() string {
+	if m != nil {
+		return m.PersistenceType
+	}
+	return ""
+}
+
+func (m *RelationInformation) GetFillfactor() int32 {
+	if m != nil {
+		return m.Fillfactor
+	}
+	return 0
+}
+
+func (m *RelationInformation) GetHasOids() bool {
+	if m != nil {
+		return m.HasOids
+	}
+	return false
+}
+
+func (m *RelationInformation) GetHasInheritanceChildren() bool {
+	if m != nil {
+		return m.HasInheritanceChildren
+	}
+	return false
+}
+
+func (m *RelationInformation) GetHasToast() bool {
+	if m != nil {
+		return m.HasToast
+	}
+	return false
+}
+
+func (m *RelationInformation) GetFrozenXid() uint32 {
+	if m != nil {
+		return m.FrozenXid
+	}
+	return 0
+}
+
+func (m *RelationInformation) GetMinimumMultixactXid() uint32 {
+	if m != nil {
+		return m.MinimumMultixactXid
+	}
+	return 0
+}
+
+func (m *RelationInformation) GetExclusivelyLocked() bool {
+	if m != nil {
+		return m.ExclusivelyLocked
+	}
+	return false
+}
+
+func (m *RelationInformation) GetOptions() map[string]string {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type RelationInformation_Column struct {
+	Name                 string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DataType             string      `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	DefaultValue         *NullString `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	NotNull              bool        `protobuf:"varint,5,opt,name=not_null,json=notNull,proto3" json:"not_null,omitempty"`
+	Position             int32       `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RelationInformation_Column) Reset()         { *m = RelationInformation_Column{} }
+func (m *RelationInformation_Column) String() string { return proto.CompactTextString(m) }
+func (*RelationInformation_Column) ProtoMessage()    {}
+func (*RelationInformation_Column) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{32, 1}
+}
+
+func (m *RelationInformation_Column) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelationInformation_Column.Unmarshal(m, b)
+}
+func (m *RelationInformation_Column) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelationInformation_Column.Marshal(b, m, deterministic)
+}
+func (m *RelationInformation_Column) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationInformation_Column.Merge(m, src)
+}
+func (m *RelationInformation_Column) XXX_Size() int {
+	return xxx_messageInfo_RelationInformation_Column.Size(m)
+}
+func (m *RelationInformation_Column) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationInformation_Column.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelationInformation_Column proto.InternalMessageInfo
+
+func (m *RelationInformation_Column) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RelationInformation_Column) GetDataType() string {
+	if m != nil {
+		return m.DataType
+	}
+	return ""
+}
+
+func (m *RelationInformation_Column) GetDefaultValue() *NullString {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return nil
+}
+
+func (m *RelationInformation_Column) GetNotNull() bool {
+	if m != nil {
+		return m.NotNull
+	}
+	return false
+}
+
+func (m *RelationInformation_Column) GetPosition() int32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+type RelationInformation_Constraint struct {
+	ForeignRelationIdx   int32    `protobuf:"varint,1,opt,name=foreign_relation_idx,json=foreignRelationIdx,proto3" json:"foreign_relation_idx,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ConstraintDef        string   `protobuf:"bytes,4,opt,name=constraint_def,json=constraintDef,proto3" json:"constraint_def,omitempty"`
+	Columns              []int32  `protobuf:"varint,5,rep,packed,name=columns,proto3" json:"columns,omitempty"`
+	ForeignColumns       []int32  `protobuf:"varint,6,rep,packed,name=foreign_columns,json=foreignColumns,proto3" json:"foreign_columns,omitempty"`
+	ForeignUpdateType    string   `protobuf:"bytes,7,opt,name=foreign_update_type,json=foreignUpdateType,proto3" json:"foreign_update_type,omitempty"`
+	ForeignDeleteType    string   `protobuf:"bytes,8,opt,name=foreign_delete_type,json=foreignDeleteType,proto3" json:"foreign_delete_type,omitempty"`
+	ForeignMatchType     string   `protobuf:"bytes,9,opt,name=foreign_match_type,json=foreignMatchType,proto3" json:"foreign_match_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelationInformation_Constraint) Reset()         { *m = RelationInformation_Constraint{} }
+func (m *RelationInformation_Constraint) String() string { return proto.CompactTextString(m) }
+func (*RelationInformation_Constraint) ProtoMessage()    {}
+func (*RelationInformation_Constraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{32, 2}
+}
+
+func (m *RelationInformation_Constraint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelationInformation_Constraint.Unmarshal(m, b)
+}
+func (m *RelationInformation_Constraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelationInformation_Constraint.Marshal(b, m, deterministic)
+}
+func (m *RelationInformation_Constraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationInformation_Constraint.Merge(m, src)
+}
+func (m *RelationInformation_Constraint) XXX_Size() int {
+	return xxx_messageInfo_RelationInformation_Constraint.Size(m)
+}
+func (m *RelationInformation_Constraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationInformation_Constraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelationInformation_Constraint proto.InternalMessageInfo
+
+func (m *RelationInformation_Constraint) GetForeignRelationIdx() int32 {
+	if m != nil {
+		return m.ForeignRelationIdx
+	}
+	return 0
+}
+
+func (m *RelationInformation_Constraint) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RelationInformation_Constraint) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *RelationInformation_Constraint) GetConstraintDef() string {
+	if m != nil {
+		return m.ConstraintDef
+	}
+	return ""
+}
+
+func (m *RelationInformation_Constraint) GetColumns() []int32 {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func (m *RelationInformation_Constraint) GetForeignColumns() []int32 {
+	if m != nil {
+		return m.ForeignColumns
+	}
+	return nil
+}
+
+func (m *RelationInformation_Constraint) GetForeignUpdateType() string {
+	if m != nil {
+		return m.ForeignUpdateType
+	}
+	return ""
+}
+
+func (m *RelationInformation_Constraint) GetForeignDeleteType() string {
+	if m != nil {
+		return m.ForeignDeleteType
+	}
+	return ""
+}
+
+func (m *RelationInformation_Constraint) GetForeignMatchType() string {
+	if m != nil {
+		return m.ForeignMatchType
+	}
+	return ""
+}
+
+type RelationStatistic struct {
+	RelationIdx      int32       `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	SizeBytes        int64       `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	SeqScan          int64       `protobuf:"varint,3,opt,name=seq_scan,json=seqScan,proto3" json:"seq_scan,omitempty"`
+	SeqTupRead       int64       `protobuf:"varint,4,opt,name=seq_tup_read,json=seqTupRead,proto3" json:"seq_tup_read,omitempty"`
+	IdxScan          int64       `protobuf:"varint,5,opt,name=idx_scan,json=idxScan,proto3" json:"idx_scan,omitempty"`
+	IdxTupFetch      int64       `protobuf:"varint,6,opt,name=idx_tup_fetch,json=idxTupFetch,proto3" json:"idx_tup_fetch,omitempty"`
+	NTupIns          int64       `protobuf:"varint,7,opt,name=n_tup_ins,json=nTupIns,proto3" json:"n_tup_ins,omitempty"`
+	NTupUpd          int64       `protobuf:"varint,8,opt,name=n_tup_upd,json=nTupUpd,proto3" json:"n_tup_upd,omitempty"`
+	NTupDel          int64       `protobuf:"varint,9,opt,name=n_tup_del,json=nTupDel,proto3" json:"n_tup_del,omitempty"`
+	NTupHotUpd       int64       `protobuf:"varint,10,opt,name=n_tup_hot_upd,json=nTupHotUpd,proto3" json:"n_tup_hot_upd,omitempty"`
+	NLiveTup         int64       `protobuf:"varint,11,opt,name=n_live_tup,json=nLiveTup,proto3" json:"n_live_tup,omitempty"`
+	NDeadTup         int64       `protobuf:"varint,12,opt,name=n_dead_tup,json=nDeadTup,proto3" json:"n_dead_tup,omitempty"`
+	NModSinceAnalyze int64       `protobuf:"varint,13,opt,name=n_mod_since_analyze,json=nModSinceAnalyze,proto3" json:"n_mod_since_analyze,omitempty"`
+	HeapBlksRead     int64       `protobuf:"varint,18,opt,name=heap_blks_read,json=heapBlksRead,proto3" json:"heap_blks_read,omitempty"`
+	HeapBlksHit      int64       `protobuf:"varint,19,opt,name=heap_blks_hit,json=heapBlksHit,proto3" json:"heap_blks_hit,omitempty"`
+	IdxBlksRead      int64       `protobuf:"varint,20,opt,name=idx_blks_read,json=idxBlksRead,proto3" json:"idx_blks_read,omitempty"`
+	IdxBlksHit       int64       `protobuf:"varint,21,opt,name=idx_blks_hit,json=idxBlksHit,proto3" json:"idx_blks_hit,omitempty"`
+	ToastBlksRead    int64       `protobuf:"varint,22,opt,name=toast_blks_read,json=toastBlksRead,proto3" json:"toast_blks_read,omitempty"`
+	ToastBlksHit     int64       `protobuf:"varint,23,opt,name=toast_blks_hit,json=toastBlksHit,proto3" json:"toast_blks_hit,omitempty"`
+	TidxBlksRead     int64       `protobuf:"varint,24,opt,name=tidx_blks_read,json=tidxBlksRead,proto3" json:"tidx_blks_read,omitempty"`
+	TidxBlksHit      int64       `protobuf:"varint,25,opt,name=tidx_blks_hit,json=tidxBlksHit,proto3" json:"tidx_blks_hit,omitempty"`
+	ToastSizeBytes   int64       `protobuf:"varint,26,opt,name=toast_size_bytes,json=toastSizeBytes,proto3" json:"toast_size_bytes,omitempty"`
+	SizeBytesGrowth  *NullInt64  `protobuf:"bytes,27,opt,name=size_bytes_growth,json=sizeBytesGrowth,proto3" json:"size_bytes_growth,omitempty"`
+	HotUpdateRatio   *NullDouble `protobuf:"bytes,28,opt,name=hot_update_ratio,json=hotUpdateRatio,proto3" json:"hot_update_ratio,omitempty"`
+	SeqScanRatio     *NullDouble `protobuf:"bytes,29,opt,name=seq_scan_ratio,json=seqScanRatio,proto3" json:"seq_scan_ratio,omitempty"`
+	// Breakdown of the table's size by fork (excluding child partitions), and the size of its indexes
+	MainSizeBytes        int64    `protobuf:"varint,30,opt,name=main_size_bytes,json=mainSizeBytes,proto3" json:"main_size_bytes,omitempty"`
+	FsmSizeBytes         int64    `protobuf:"varint,31,opt,name=fsm_size_bytes,json=fsmSizeBytes,proto3" json:"fsm_size_bytes,omitempty"`
+	VmSizeBytes          int64    `protobuf:"varint,32,opt,name=vm_size_bytes,json=vmSizeBytes,proto3" json:"vm_size_bytes,omitempty"`
+	IndexesSizeBytes     int64    `protobuf:"varint,33,opt,name=indexes_size_bytes,json=indexesSizeBytes,proto3" json:"indexes_size_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelationStatistic) Reset()         { *m = RelationStatistic{} }
+func (m *RelationStatistic) String() string { return proto.CompactTextString(m) }
+func (*RelationStatistic) ProtoMessage()    {}
+func (*RelationStatistic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{33}
+}
+
+func (m *RelationStatistic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelationStatistic.Unmarshal(m, b)
+}
+func (m *RelationStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelationStatistic.Marshal(b, m, deterministic)
+}
+func (m *RelationStatistic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationStatistic.Merge(m, src)
+}
+func (m *RelationStatistic) XXX_Size() int {
+	return xxx_messageInfo_RelationStatistic.Size(m)
+}
+func (m *RelationStatistic) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationStatistic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelationStatistic proto.InternalMessageInfo
+
+func (m *RelationStatistic) GetRelationIdx() int32 {
+	if m != nil {
+		return m.RelationIdx
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetSeqScan() int64 {
+	if m != nil {
+		return m.SeqScan
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetSeqTupRead() int64 {
+	if m != nil {
+		return m.SeqTupRead
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetIdxScan() int64 {
+	if m != nil {
+		return m.IdxScan
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetIdxTupFetch() int64 {
+	if m != nil {
+		return m.IdxTupFetch
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetNTupIns() int64 {
+	if m != nil {
+		return m.NTupIns
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetNTupUpd() int64 {
+	if m != nil {
+		return m.NTupUpd
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetNTupDel() int64 {
+	if m != nil {
+		return m.NTupDel
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetNTupHotUpd() int64 {
+	if m != nil {
+		return m.NTupHotUpd
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetNLiveTup() int64 {
+	if m != nil {
+		return m.NLiveTup
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetNDeadTup() int64 {
+	if m != nil {
+		return m.NDeadTup
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetNModSinceAnalyze() int64 {
+	if m != nil {
+		return m.NModSinceAnalyze
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetHeapBlksRead() int64 {
+	if m != nil {
+		return m.HeapBlksRead
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetHeapBlksHit() int64 {
+	if m != nil {
+		return m.HeapBlksHit
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetIdxBlksRead() int64 {
+	if m != nil {
+		return m.IdxBlksRead
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetIdxBlksHit() int64 {
+	if m != nil {
+		return m.IdxBlksHit
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetToastBlksRead() int64 {
+	if m != nil {
+		return m.ToastBlksRead
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetToastBlksHit() int64 {
+	if m != nil {
+		return m.ToastBlksHit
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetTidxBlksRead() int64 {
+	if m != nil {
+		return m.TidxBlksRead
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetTidxBlksHit() int64 {
+	if m != nil {
+		return m.TidxBlksHit
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetToastSizeBytes() int64 {
+	if m != nil {
+		return m.ToastSizeBytes
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetSizeBytesGrowth() *NullInt64 {
+	if m != nil {
+		return m.SizeBytesGrowth
+	}
+	return nil
+}
+
+func (m *RelationStatistic) GetHotUpdateRatio() *NullDouble {
+	if m != nil {
+		return m.HotUpdateRatio
+	}
+	return nil
+}
+
+func (m *RelationStatistic) GetSeqScanRatio() *NullDouble {
+	if m != nil {
+		return m.SeqScanRatio
+	}
+	return nil
+}
+
+func (m *RelationStatistic) GetMainSizeBytes() int64 {
+	if m != nil {
+		return m.MainSizeBytes
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetFsmSizeBytes() int64 {
+	if m != nil {
+		return m.FsmSizeBytes
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetVmSizeBytes() int64 {
+	if m != nil {
+		return m.VmSizeBytes
+	}
+	return 0
+}
+
+func (m *RelationStatistic) GetIndexesSizeBytes() int64 {
+	if m != nil {
+		return m.IndexesSizeBytes
+	}
+	return 0
+}
+
+type RelationEvent struct {
+	RelationIdx           int32                   `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	Type                  RelationEvent_EventType `protobuf:"varint,2,opt,name=type,proto3,enum=pganalyze.collector.RelationEvent_EventType" json:"type,omitempty"`
+	OccurredAt            *timestamp.Timestamp    `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ApproximateOccurredAt bool                    `protobuf:"varint,4,opt,name=approximate_occurred_at,json=approximateOccurredAt,proto3" json:"approximate_occurred_at,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                `json:"-"`
+	XXX_unrecognized      []byte                  `json:"-"`
+	XXX_sizecache         int32                   `json:"-"`
+}
+
+func (m *RelationEvent) Reset()         { *m = RelationEvent{} }
+func (m *RelationEvent) String() string { return proto.CompactTextString(m) }
+func (*RelationEvent) ProtoMessage()    {}
+func (*RelationEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{34}
+}
+
+func (m *RelationEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelationEvent.Unmarshal(m, b)
+}
+func (m *RelationEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelationEvent.Marshal(b, m, deterministic)
+}
+func (m *RelationEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationEvent.Merge(m, src)
+}
+func (m *RelationEvent) XXX_Size() int {
+	return xxx_messageInfo_RelationEvent.Size(m)
+}
+func (m *RelationEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelationEvent proto.InternalMessageInfo
+
+func (m *RelationEvent) GetRelationIdx() int32 {
+	if m != nil {
+		return m.RelationIdx
+	}
+	return 0
+}
+
+func (m *RelationEvent) GetType() RelationEvent_EventType {
+	if m != nil {
+		return m.Type
 	}
 	return RelationEvent_MANUAL_VACUUM
 }
 
-func (m *RelationEvent) GetOccurredAt() *timestamp.Timestamp {
+func (m *RelationEvent) GetOccurredAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.OccurredAt
+	}
+	return nil
+}
+
+func (m *RelationEvent) GetApproximateOccurredAt() bool {
+	if m != nil {
+		return m.ApproximateOccurredAt
+	}
+	return false
+}
+
+type IndexInformation struct {
+	IndexIdx             int32       `protobuf:"varint,1,opt,name=index_idx,json=indexIdx,proto3" json:"index_idx,omitempty"`
+	RelationIdx          int32       `protobuf:"varint,2,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	Columns              []int32     `protobuf:"varint,3,rep,packed,name=columns,proto3" json:"columns,omitempty"`
+	IndexDef             string      `protobuf:"bytes,4,opt,name=index_def,json=indexDef,proto3" json:"index_def,omitempty"`
+	ConstraintDef        *NullString `protobuf:"bytes,5,opt,name=constraint_def,json=constraintDef,proto3" json:"constraint_def,omitempty"`
+	IsPrimary            bool        `protobuf:"varint,6,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	IsUnique             bool        `protobuf:"varint,7,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`
+	IsValid              bool        `protobuf:"varint,8,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	Fillfactor           int32       `protobuf:"varint,9,opt,name=fillfactor,proto3" json:"fillfactor,omitempty"`
+	IndexType            string      `protobuf:"bytes,10,opt,name=index_type,json=indexType,proto3" json:"index_type,omitempty"`
+	IsReady              bool        `protobuf:"varint,11,opt,name=is_ready,json=isReady,proto3" json:"is_ready,omitempty"`
+	BeingBuilt           bool        `protobuf:"varint,12,opt,name=being_built,json=beingBuilt,proto3" json:"being_built,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *IndexInformation) Reset()         { *m = IndexInformation{} }
+func (m *IndexInformation) String() string { return proto.CompactTextString(m) }
+func (*IndexInformation) ProtoMessage()    {}
+func (*IndexInformation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{35}
+}
+
+func (m *IndexInformation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexInformation.Unmarshal(m, b)
+}
+func (m *IndexInformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexInformation.Marshal(b, m, deterministic)
+}
+func (m *IndexInformation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexInformation.Merge(m, src)
+}
+func (m *IndexInformation) XXX_Size() int {
+	return xxx_messageInfo_IndexInformation.Size(m)
+}
+func (m *IndexInformation) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexInformation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexInformation proto.InternalMessageInfo
+
+func (m *IndexInformation) GetIndexIdx() int32 {
+	if m != nil {
+		return m.IndexIdx
+	}
+	return 0
+}
+
+func (m *IndexInformation) GetRelationIdx() int32 {
+	if m != nil {
+		return m.RelationIdx
+	}
+	return 0
+}
+
+func (m *IndexInformation) GetColumns() []int32 {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func (m *IndexInformation) GetIndexDef() string {
+	if m != nil {
+		return m.IndexDef
+	}
+	return ""
+}
+
+func (m *IndexInformation) GetConstraintDef() *NullString {
+	if m != nil {
+		return m.ConstraintDef
+	}
+	return nil
+}
+
+func (m *IndexInformation) GetIsPrimary() bool {
+	if m != nil {
+		return m.IsPrimary
+	}
+	return false
+}
+
+func (m *IndexInformation) GetIsUnique() bool {
+	if m != nil {
+		return m.IsUnique
+	}
+	return false
+}
+
+func (m *IndexInformation) GetIsValid() bool {
+	if m != nil {
+		return m.IsValid
+	}
+	return false
+}
+
+func (m *IndexInformation) GetFillfactor() int32 {
+	if m != nil {
+		return m.Fillfactor
+	}
+	return 0
+}
+
+func (m *IndexInformation) GetIndexType() string {
+	if m != nil {
+		return m.IndexType
+	}
+	return ""
+}
+
+func (m *IndexInformation) GetIsReady() bool {
+	if m != nil {
+		return m.IsReady
+	}
+	return false
+}
+
+func (m *IndexInformation) GetBeingBuilt() bool {
+	if m != nil {
+		return m.BeingBuilt
+	}
+	return false
+}
+
+type IndexStatistic struct {
+	IndexIdx             int32      `protobuf:"varint,1,opt,name=index_idx,json=indexIdx,proto3" json:"index_idx,omitempty"`
+	SizeBytes            int64      `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	IdxScan              int64      `protobuf:"varint,3,opt,name=idx_scan,json=idxScan,proto3" json:"idx_scan,omitempty"`
+	IdxTupRead           int64      `protobuf:"varint,4,opt,name=idx_tup_read,json=idxTupRead,proto3" json:"idx_tup_read,omitempty"`
+	IdxTupFetch          int64      `protobuf:"varint,6,opt,name=idx_tup_fetch,json=idxTupFetch,proto3" json:"idx_tup_fetch,omitempty"`
+	IdxBlksRead          int64      `protobuf:"varint,7,opt,name=idx_blks_read,json=idxBlksRead,proto3" json:"idx_blks_read,omitempty"`
+	IdxBlksHit           int64      `protobuf:"varint,8,opt,name=idx_blks_hit,json=idxBlksHit,proto3" json:"idx_blks_hit,omitempty"`
+	SizeBytesGrowth      *NullInt64 `protobuf:"bytes,9,opt,name=size_bytes_growth,json=sizeBytesGrowth,proto3" json:"size_bytes_growth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *IndexStatistic) Reset()         { *m = IndexStatistic{} }
+func (m *IndexStatistic) String() string { return proto.CompactTextString(m) }
+func (*IndexStatistic) ProtoMessage()    {}
+func (*IndexStatistic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{36}
+}
+
+func (m *IndexStatistic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexStatistic.Unmarshal(m, b)
+}
+func (m *IndexStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexStatistic.Marshal(b, m, deterministic)
+}
+func (m *IndexStatistic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexStatistic.Merge(m, src)
+}
+func (m *IndexStatistic) XXX_Size() int {
+	return xxx_messageInfo_IndexStatistic.Size(m)
+}
+func (m *IndexStatistic) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexStatistic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexStatistic proto.InternalMessageInfo
+
+func (m *IndexStatistic) GetIndexIdx() int32 {
+	if m != nil {
+		return m.IndexIdx
+	}
+	return 0
+}
+
+func (m *IndexStatistic) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *IndexStatistic) GetIdxScan() int64 {
+	if m != nil {
+		return m.IdxScan
+	}
+	return 0
+}
+
+func (m *IndexStatistic) GetIdxTupRead() int64 {
+	if m != nil {
+		return m.IdxTupRead
+	}
+	return 0
+}
+
+func (m *IndexStatistic) GetIdxTupFetch() int64 {
+	if m != nil {
+		return m.IdxTupFetch
+	}
+	return 0
+}
+
+func (m *IndexStatistic) GetIdxBlksRead() int64 {
+	if m != nil {
+		return m.IdxBlksRead
+	}
+	return 0
+}
+
+func (m *IndexStatistic) GetIdxBlksHit() int64 {
+	if m != nil {
+		return m.IdxBlksHit
+	}
+	return 0
+}
+
+func (m *IndexStatistic) GetSizeBytesGrowth() *NullInt64 {
+	if m != nil {
+		return m.SizeBytesGrowth
+	}
+	return nil
+}
+
+type FunctionInformation struct {
+	FunctionIdx          int32    `protobuf:"varint,1,opt,name=function_idx,json=functionIdx,proto3" json:"function_idx,omitempty"`
+	Language             string   `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Source               string   `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	SourceBin            string   `protobuf:"bytes,5,opt,name=source_bin,json=sourceBin,proto3" json:"source_bin,omitempty"`
+	Config               []string `protobuf:"bytes,6,rep,name=config,proto3" json:"config,omitempty"`
+	Result               string   `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
+	Aggregate            bool     `protobuf:"varint,9,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	Window               bool     `protobuf:"varint,10,opt,name=window,proto3" json:"window,omitempty"`
+	SecurityDefiner      bool     `protobuf:"varint,11,opt,name=security_definer,json=securityDefiner,proto3" json:"security_definer,omitempty"`
+	Leakproof            bool     `protobuf:"varint,12,opt,name=leakproof,proto3" json:"leakproof,omitempty"`
+	Strict               bool     `protobuf:"varint,13,opt,name=strict,proto3" json:"strict,omitempty"`
+	ReturnsSet           bool     `protobuf:"varint,14,opt,name=returns_set,json=returnsSet,proto3" json:"returns_set,omitempty"`
+	Volatile             string   `protobuf:"bytes,15,opt,name=volatile,proto3" json:"volatile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FunctionInformation) Reset()         { *m = FunctionInformation{} }
+func (m *FunctionInformation) String() string { return proto.CompactTextString(m) }
+func (*FunctionInformation) ProtoMessage()    {}
+func (*FunctionInformation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{37}
+}
+
+func (m *FunctionInformation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FunctionInformation.Unmarshal(m, b)
+}
+func (m *FunctionInformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FunctionInformation.Marshal(b, m, deterministic)
+}
+func (m *FunctionInformation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionInformation.Merge(m, src)
+}
+func (m *FunctionInformation) XXX_Size() int {
+	return xxx_messageInfo_FunctionInformation.Size(m)
+}
+func (m *FunctionInformation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionInformation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunctionInformation proto.InternalMessageInfo
+
+func (m *FunctionInformation) GetFunctionIdx() int32 {
+	if m != nil {
+		return m.FunctionIdx
+	}
+	return 0
+}
+
+func (m *FunctionInformation) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *FunctionInformation) GetSource() string {
 	if m != nil {
-		return m.OccurredAt
+		return m.Source
+	}
+	return ""
+}
+
+func (m *FunctionInformation) GetSourceBin() string {
+	if m != nil {
+		return m.SourceBin
+	}
+	return ""
+}
+
+func (m *FunctionInformation) GetConfig() []string {
+	if m != nil {
+		return m.Config
 	}
 	return nil
 }
 
-func (m *RelationEvent) GetApproximateOccurredAt() bool {
+func (m *FunctionInformation) GetResult() string {
 	if m != nil {
-		return m.ApproximateOccurredAt
+		return m.Result
+	}
+	return ""
+}
+
+func (m *FunctionInformation) GetAggregate() bool {
+	if m != nil {
+		return m.Aggregate
 	}
 	return false
 }
 
-type IndexInformation struct {
-	IndexIdx             int32       `protobuf:"varint,1,opt,name=index_idx,json=indexIdx,proto3" json:"index_idx,omitempty"`
-	RelationIdx          int32       `protobuf:"varint,2,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
-	Columns              []int32     `protobuf:"varint,3,rep,packed,name=columns,proto3" json:"columns,omitempty"`
-	IndexDef             string      `protobuf:"bytes,4,opt,name=index_def,json=indexDef,proto3" json:"index_def,omitempty"`
-	ConstraintDef        *NullString `protobuf:"bytes,5,opt,name=constraint_def,json=constraintDef,proto3" json:"constraint_def,omitempty"`
-	IsPrimary            bool        `protobuf:"varint,6,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
-	IsUnique             bool        `protobuf:"varint,7,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`
-	IsValid              bool        `protobuf:"varint,8,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
-	Fillfactor           int32       `protobuf:"varint,9,opt,name=fillfactor,proto3" json:"fillfactor,omitempty"`
-	IndexType            string      `protobuf:"bytes,10,opt,name=index_type,json=indexType,proto3" json:"index_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *FunctionInformation) GetWindow() bool {
+	if m != nil {
+		return m.Window
+	}
+	return false
 }
 
-func (m *IndexInformation) Reset()         { *m = IndexInformation{} }
-func (m *IndexInformation) String() string { return proto.CompactTextString(m) }
-func (*IndexInformation) ProtoMessage()    {}
-func (*IndexInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{17}
+func (m *FunctionInformation) GetSecurityDefiner() bool {
+	if m != nil {
+		return m.SecurityDefiner
+	}
+	return false
+}
+
+func (m *FunctionInformation) GetLeakproof() bool {
+	if m != nil {
+		return m.Leakproof
+	}
+	return false
+}
+
+func (m *FunctionInformation) GetStrict() bool {
+	if m != nil {
+		return m.Strict
+	}
+	return false
+}
+
+func (m *FunctionInformation) GetReturnsSet() bool {
+	if m != nil {
+		return m.ReturnsSet
+	}
+	return false
+}
+
+func (m *FunctionInformation) GetVolatile() string {
+	if m != nil {
+		return m.Volatile
+	}
+	return ""
+}
+
+type FunctionStatistic struct {
+	FunctionIdx          int32    `protobuf:"varint,1,opt,name=function_idx,json=functionIdx,proto3" json:"function_idx,omitempty"`
+	Calls                int64    `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	TotalTime            float64  `protobuf:"fixed64,3,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
+	SelfTime             float64  `protobuf:"fixed64,4,opt,name=self_time,json=selfTime,proto3" json:"self_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FunctionStatistic) Reset()         { *m = FunctionStatistic{} }
+func (m *FunctionStatistic) String() string { return proto.CompactTextString(m) }
+func (*FunctionStatistic) ProtoMessage()    {}
+func (*FunctionStatistic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{38}
+}
+
+func (m *FunctionStatistic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FunctionStatistic.Unmarshal(m, b)
+}
+func (m *FunctionStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FunctionStatistic.Marshal(b, m, deterministic)
+}
+func (m *FunctionStatistic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionStatistic.Merge(m, src)
+}
+func (m *FunctionStatistic) XXX_Size() int {
+	return xxx_messageInfo_FunctionStatistic.Size(m)
+}
+func (m *FunctionStatistic) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionStatistic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunctionStatistic proto.InternalMessageInfo
+
+func (m *FunctionStatistic) GetFunctionIdx() int32 {
+	if m != nil {
+		return m.FunctionIdx
+	}
+	return 0
+}
+
+func (m *FunctionStatistic) GetCalls() int64 {
+	if m != nil {
+		return m.Calls
+	}
+	return 0
+}
+
+func (m *FunctionStatistic) GetTotalTime() float64 {
+	if m != nil {
+		return m.TotalTime
+	}
+	return 0
+}
+
+func (m *FunctionStatistic) GetSelfTime() float64 {
+	if m != nil {
+		return m.SelfTime
+	}
+	return 0
+}
+
+type BufferCacheStatistic struct {
+	TotalBuffers int64 `protobuf:"varint,1,opt,name=total_buffers,json=totalBuffers,proto3" json:"total_buffers,omitempty"`
+	UsedBuffers  int64 `protobuf:"varint,2,opt,name=used_buffers,json=usedBuffers,proto3" json:"used_buffers,omitempty"`
+	// Number of used buffers for each usage count (the index is the usage count)
+	UsageCountBuffers    []int64                         `protobuf:"varint,3,rep,packed,name=usage_count_buffers,json=usageCountBuffers,proto3" json:"usage_count_buffers,omitempty"`
+	RelationStatistics   []*BufferCacheRelationStatistic `protobuf:"bytes,4,rep,name=relation_statistics,json=relationStatistics,proto3" json:"relation_statistics,omitempty"`
+	IndexStatistics      []*BufferCacheIndexStatistic    `protobuf:"bytes,5,rep,name=index_statistics,json=indexStatistics,proto3" json:"index_statistics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *BufferCacheStatistic) Reset()         { *m = BufferCacheStatistic{} }
+func (m *BufferCacheStatistic) String() string { return proto.CompactTextString(m) }
+func (*BufferCacheStatistic) ProtoMessage()    {}
+func (*BufferCacheStatistic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{39}
+}
+
+func (m *BufferCacheStatistic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BufferCacheStatistic.Unmarshal(m, b)
+}
+func (m *BufferCacheStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BufferCacheStatistic.Marshal(b, m, deterministic)
+}
+func (m *BufferCacheStatistic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BufferCacheStatistic.Merge(m, src)
+}
+func (m *BufferCacheStatistic) XXX_Size() int {
+	return xxx_messageInfo_BufferCacheStatistic.Size(m)
+}
+func (m *BufferCacheStatistic) XXX_DiscardUnknown() {
+	xxx_messageInfo_BufferCacheStatistic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BufferCacheStatistic proto.InternalMessageInfo
+
+func (m *BufferCacheStatistic) GetTotalBuffers() int64 {
+	if m != nil {
+		return m.TotalBuffers
+	}
+	return 0
+}
+
+func (m *BufferCacheStatistic) GetUsedBuffers() int64 {
+	if m != nil {
+		return m.UsedBuffers
+	}
+	return 0
+}
+
+func (m *BufferCacheStatistic) GetUsageCountBuffers() []int64 {
+	if m != nil {
+		return m.UsageCountBuffers
+	}
+	return nil
+}
+
+func (m *BufferCacheStatistic) GetRelationStatistics() []*BufferCacheRelationStatistic {
+	if m != nil {
+		return m.RelationStatistics
+	}
+	return nil
+}
+
+func (m *BufferCacheStatistic) GetIndexStatistics() []*BufferCacheIndexStatistic {
+	if m != nil {
+		return m.IndexStatistics
+	}
+	return nil
+}
+
+type BufferCacheRelationStatistic struct {
+	RelationIdx          int32    `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	Buffers              int64    `protobuf:"varint,2,opt,name=buffers,proto3" json:"buffers,omitempty"`
+	DirtyBuffers         int64    `protobuf:"varint,3,opt,name=dirty_buffers,json=dirtyBuffers,proto3" json:"dirty_buffers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BufferCacheRelationStatistic) Reset()         { *m = BufferCacheRelationStatistic{} }
+func (m *BufferCacheRelationStatistic) String() string { return proto.CompactTextString(m) }
+func (*BufferCacheRelationStatistic) ProtoMessage()    {}
+func (*BufferCacheRelationStatistic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{40}
+}
+
+func (m *BufferCacheRelationStatistic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BufferCacheRelationStatistic.Unmarshal(m, b)
+}
+func (m *BufferCacheRelationStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BufferCacheRelationStatistic.Marshal(b, m, deterministic)
+}
+func (m *BufferCacheRelationStatistic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BufferCacheRelationStatistic.Merge(m, src)
+}
+func (m *BufferCacheRelationStatistic) XXX_Size() int {
+	return xxx_messageInfo_BufferCacheRelationStatistic.Size(m)
+}
+func (m *BufferCacheRelationStatistic) XXX_DiscardUnknown() {
+	xxx_messageInfo_BufferCacheRelationStatistic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BufferCacheRelationStatistic proto.InternalMessageInfo
+
+func (m *BufferCacheRelationStatistic) GetRelationIdx() int32 {
+	if m != nil {
+		return m.RelationIdx
+	}
+	return 0
+}
+
+func (m *BufferCacheRelationStatistic) GetBuffers() int64 {
+	if m != nil {
+		return m.Buffers
+	}
+	return 0
+}
+
+func (m *BufferCacheRelationStatistic) GetDirtyBuffers() int64 {
+	if m != nil {
+		return m.DirtyBuffers
+	}
+	return 0
+}
+
+type BufferCacheIndexStatistic struct {
+	IndexIdx             int32    `protobuf:"varint,1,opt,name=index_idx,json=indexIdx,proto3" json:"index_idx,omitempty"`
+	Buffers              int64    `protobuf:"varint,2,opt,name=buffers,proto3" json:"buffers,omitempty"`
+	DirtyBuffers         int64    `protobuf:"varint,3,opt,name=dirty_buffers,json=dirtyBuffers,proto3" json:"dirty_buffers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BufferCacheIndexStatistic) Reset()         { *m = BufferCacheIndexStatistic{} }
+func (m *BufferCacheIndexStatistic) String() string { return proto.CompactTextString(m) }
+func (*BufferCacheIndexStatistic) ProtoMessage()    {}
+func (*BufferCacheIndexStatistic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{41}
+}
+
+func (m *BufferCacheIndexStatistic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BufferCacheIndexStatistic.Unmarshal(m, b)
+}
+func (m *BufferCacheIndexStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BufferCacheIndexStatistic.Marshal(b, m, deterministic)
+}
+func (m *BufferCacheIndexStatistic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BufferCacheIndexStatistic.Merge(m, src)
+}
+func (m *BufferCacheIndexStatistic) XXX_Size() int {
+	return xxx_messageInfo_BufferCacheIndexStatistic.Size(m)
+}
+func (m *BufferCacheIndexStatistic) XXX_DiscardUnknown() {
+	xxx_messageInfo_BufferCacheIndexStatistic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BufferCacheIndexStatistic proto.InternalMessageInfo
+
+func (m *BufferCacheIndexStatistic) GetIndexIdx() int32 {
+	if m != nil {
+		return m.IndexIdx
+	}
+	return 0
+}
+
+func (m *BufferCacheIndexStatistic) GetBuffers() int64 {
+	if m != nil {
+		return m.Buffers
+	}
+	return 0
+}
+
+func (m *BufferCacheIndexStatistic) GetDirtyBuffers() int64 {
+	if m != nil {
+		return m.DirtyBuffers
+	}
+	return 0
+}
+
+type MaterializedViewInformation struct {
+	RelationIdx          int32          `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	Populated            bool           `protobuf:"varint,2,opt,name=populated,proto3" json:"populated,omitempty"`
+	SizeBytes            int64          `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	LastRefreshedAt      *NullTimestamp `protobuf:"bytes,4,opt,name=last_refreshed_at,json=lastRefreshedAt,proto3" json:"last_refreshed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MaterializedViewInformation) Reset()         { *m = MaterializedViewInformation{} }
+func (m *MaterializedViewInformation) String() string { return proto.CompactTextString(m) }
+func (*MaterializedViewInformation) ProtoMessage()    {}
+func (*MaterializedViewInformation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{42}
 }
 
-func (m *IndexInformation) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IndexInformation.Unmarshal(m, b)
+func (m *MaterializedViewInformation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaterializedViewInformation.Unmarshal(m, b)
 }
-func (m *IndexInformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IndexInformation.Marshal(b, m, deterministic)
+func (m *MaterializedViewInformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaterializedViewInformation.Marshal(b, m, deterministic)
 }
-func (m *IndexInformation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexInformation.Merge(m, src)
+func (m *MaterializedViewInformation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaterializedViewInformation.Merge(m, src)
 }
-func (m *IndexInformation) XXX_Size() int {
-	return xxx_messageInfo_IndexInformation.Size(m)
+func (m *MaterializedViewInformation) XXX_Size() int {
+	return xxx_messageInfo_MaterializedViewInformation.Size(m)
 }
-func (m *IndexInformation) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexInformation.DiscardUnknown(m)
+func (m *MaterializedViewInformation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaterializedViewInformation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IndexInformation proto.InternalMessageInfo
-
-func (m *IndexInformation) GetIndexIdx() int32 {
-	if m != nil {
-		return m.IndexIdx
-	}
-	return 0
-}
+var xxx_messageInfo_MaterializedViewInformation proto.InternalMessageInfo
 
-func (m *IndexInformation) GetRelationIdx() int32 {
+func (m *MaterializedViewInformation) GetRelationIdx() int32 {
 	if m != nil {
 		return m.RelationIdx
 	}
 	return 0
 }
 
-func (m *IndexInformation) GetColumns() []int32 {
+func (m *MaterializedViewInformation) GetPopulated() bool {
 	if m != nil {
-		return m.Columns
+		return m.Populated
 	}
-	return nil
+	return false
 }
 
-func (m *IndexInformation) GetIndexDef() string {
+func (m *MaterializedViewInformation) GetSizeBytes() int64 {
 	if m != nil {
-		return m.IndexDef
+		return m.SizeBytes
 	}
-	return ""
+	return 0
 }
 
-func (m *IndexInformation) GetConstraintDef() *NullString {
+func (m *MaterializedViewInformation) GetLastRefreshedAt() *NullTimestamp {
 	if m != nil {
-		return m.ConstraintDef
+		return m.LastRefreshedAt
 	}
 	return nil
 }
 
-func (m *IndexInformation) GetIsPrimary() bool {
-	if m != nil {
-		return m.IsPrimary
-	}
-	return false
+type LogicalReplication struct {
+	Publications         []*Publication            `protobuf:"bytes,1,rep,name=publications,proto3" json:"publications,omitempty"`
+	Subscriptions        []*Subscription           `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Slots                []*LogicalReplicationSlot `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *IndexInformation) GetIsUnique() bool {
-	if m != nil {
-		return m.IsUnique
-	}
-	return false
+func (m *LogicalReplication) Reset()         { *m = LogicalReplication{} }
+func (m *LogicalReplication) String() string { return proto.CompactTextString(m) }
+func (*LogicalReplication) ProtoMessage()    {}
+func (*LogicalReplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{43}
 }
 
-func (m *IndexInformation) GetIsValid() bool {
+func (m *LogicalReplication) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogicalReplication.Unmarshal(m, b)
+}
+func (m *LogicalReplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogicalReplication.Marshal(b, m, deterministic)
+}
+func (m *LogicalReplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogicalReplication.Merge(m, src)
+}
+func (m *LogicalReplication) XXX_Size() int {
+	return xxx_messageInfo_LogicalReplication.Size(m)
+}
+func (m *LogicalReplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogicalReplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogicalReplication proto.InternalMessageInfo
+
+func (m *LogicalReplication) GetPublications() []*Publication {
 	if m != nil {
-		return m.IsValid
+		return m.Publications
 	}
-	return false
+	return nil
 }
 
-func (m *IndexInformation) GetFillfactor() int32 {
+func (m *LogicalReplication) GetSubscriptions() []*Subscription {
 	if m != nil {
-		return m.Fillfactor
+		return m.Subscriptions
 	}
-	return 0
+	return nil
 }
 
-func (m *IndexInformation) GetIndexType() string {
+func (m *LogicalReplication) GetSlots() []*LogicalReplicationSlot {
 	if m != nil {
-		return m.IndexType
+		return m.Slots
 	}
-	return ""
+	return nil
 }
 
-type IndexStatistic struct {
-	IndexIdx             int32    `protobuf:"varint,1,opt,name=index_idx,json=indexIdx,proto3" json:"index_idx,omitempty"`
-	SizeBytes            int64    `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	IdxScan              int64    `protobuf:"varint,3,opt,name=idx_scan,json=idxScan,proto3" json:"idx_scan,omitempty"`
-	IdxTupRead           int64    `protobuf:"varint,4,opt,name=idx_tup_read,json=idxTupRead,proto3" json:"idx_tup_read,omitempty"`
-	IdxTupFetch          int64    `protobuf:"varint,6,opt,name=idx_tup_fetch,json=idxTupFetch,proto3" json:"idx_tup_fetch,omitempty"`
-	IdxBlksRead          int64    `protobuf:"varint,7,opt,name=idx_blks_read,json=idxBlksRead,proto3" json:"idx_blks_read,omitempty"`
-	IdxBlksHit           int64    `protobuf:"varint,8,opt,name=idx_blks_hit,json=idxBlksHit,proto3" json:"idx_blks_hit,omitempty"`
+type Publication struct {
+	DatabaseIdx          int32    `protobuf:"varint,1,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AllTables            bool     `protobuf:"varint,3,opt,name=all_tables,json=allTables,proto3" json:"all_tables,omitempty"`
+	PublishInsert        bool     `protobuf:"varint,4,opt,name=publish_insert,json=publishInsert,proto3" json:"publish_insert,omitempty"`
+	PublishUpdate        bool     `protobuf:"varint,5,opt,name=publish_update,json=publishUpdate,proto3" json:"publish_update,omitempty"`
+	PublishDelete        bool     `protobuf:"varint,6,opt,name=publish_delete,json=publishDelete,proto3" json:"publish_delete,omitempty"`
+	RelationIdx          []int32  `protobuf:"varint,7,rep,packed,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IndexStatistic) Reset()         { *m = IndexStatistic{} }
-func (m *IndexStatistic) String() string { return proto.CompactTextString(m) }
-func (*IndexStatistic) ProtoMessage()    {}
-func (*IndexStatistic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{18}
+func (m *Publication) Reset()         { *m = Publication{} }
+func (m *Publication) String() string { return proto.CompactTextString(m) }
+func (*Publication) ProtoMessage()    {}
+func (*Publication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{44}
 }
 
-func (m *IndexStatistic) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IndexStatistic.Unmarshal(m, b)
+func (m *Publication) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Publication.Unmarshal(m, b)
 }
-func (m *IndexStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IndexStatistic.Marshal(b, m, deterministic)
+func (m *Publication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Publication.Marshal(b, m, deterministic)
 }
-func (m *IndexStatistic) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexStatistic.Merge(m, src)
+func (m *Publication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Publication.Merge(m, src)
 }
-func (m *IndexStatistic) XXX_Size() int {
-	return xxx_messageInfo_IndexStatistic.Size(m)
+func (m *Publication) XXX_Size() int {
+	return xxx_messageInfo_Publication.Size(m)
 }
-func (m *IndexStatistic) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexStatistic.DiscardUnknown(m)
+func (m *Publication) XXX_DiscardUnknown() {
+	xxx_messageInfo_Publication.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IndexStatistic proto.InternalMessageInfo
+var xxx_messageInfo_Publication proto.InternalMessageInfo
 
-func (m *IndexStatistic) GetIndexIdx() int32 {
+func (m *Publication) GetDatabaseIdx() int32 {
 	if m != nil {
-		return m.IndexIdx
+		return m.DatabaseIdx
 	}
 	return 0
 }
 
-func (m *IndexStatistic) GetSizeBytes() int64 {
+func (m *Publication) GetName() string {
 	if m != nil {
-		return m.SizeBytes
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *IndexStatistic) GetIdxScan() int64 {
+func (m *Publication) GetAllTables() bool {
 	if m != nil {
-		return m.IdxScan
+		return m.AllTables
 	}
-	return 0
+	return false
 }
 
-func (m *IndexStatistic) GetIdxTupRead() int64 {
+func (m *Publication) GetPublishInsert() bool {
 	if m != nil {
-		return m.IdxTupRead
+		return m.PublishInsert
 	}
-	return 0
+	return false
 }
 
-func (m *IndexStatistic) GetIdxTupFetch() int64 {
+func (m *Publication) GetPublishUpdate() bool {
 	if m != nil {
-		return m.IdxTupFetch
+		return m.PublishUpdate
 	}
-	return 0
+	return false
 }
 
-func (m *IndexStatistic) GetIdxBlksRead() int64 {
+func (m *Publication) GetPublishDelete() bool {
 	if m != nil {
-		return m.IdxBlksRead
+		return m.PublishDelete
 	}
-	return 0
+	return false
 }
 
-func (m *IndexStatistic) GetIdxBlksHit() int64 {
+func (m *Publication) GetRelationIdx() []int32 {
 	if m != nil {
-		return m.IdxBlksHit
+		return m.RelationIdx
 	}
-	return 0
+	return nil
 }
 
-type FunctionInformation struct {
-	FunctionIdx          int32    `protobuf:"varint,1,opt,name=function_idx,json=functionIdx,proto3" json:"function_idx,omitempty"`
-	Language             string   `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
-	Source               string   `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
-	SourceBin            string   `protobuf:"bytes,5,opt,name=source_bin,json=sourceBin,proto3" json:"source_bin,omitempty"`
-	Config               []string `protobuf:"bytes,6,rep,name=config,proto3" json:"config,omitempty"`
-	Result               string   `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
-	Aggregate            bool     `protobuf:"varint,9,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
-	Window               bool     `protobuf:"varint,10,opt,name=window,proto3" json:"window,omitempty"`
-	SecurityDefiner      bool     `protobuf:"varint,11,opt,name=security_definer,json=securityDefiner,proto3" json:"security_definer,omitempty"`
-	Leakproof            bool     `protobuf:"varint,12,opt,name=leakproof,proto3" json:"leakproof,omitempty"`
-	Strict               bool     `protobuf:"varint,13,opt,name=strict,proto3" json:"strict,omitempty"`
-	ReturnsSet           bool     `protobuf:"varint,14,opt,name=returns_set,json=returnsSet,proto3" json:"returns_set,omitempty"`
-	Volatile             string   `protobuf:"bytes,15,opt,name=volatile,proto3" json:"volatile,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Subscription struct {
+	DatabaseIdx          int32       `protobuf:"varint,1,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	Name                 string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled              bool        `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	WorkerPid            *NullInt64  `protobuf:"bytes,4,opt,name=worker_pid,json=workerPid,proto3" json:"worker_pid,omitempty"`
+	ReceivedLsn          *NullString `protobuf:"bytes,5,opt,name=received_lsn,json=receivedLsn,proto3" json:"received_lsn,omitempty"`
+	LatestEndLsn         *NullString `protobuf:"bytes,6,opt,name=latest_end_lsn,json=latestEndLsn,proto3" json:"latest_end_lsn,omitempty"`
+	HasLagSecs           bool        `protobuf:"varint,7,opt,name=has_lag_secs,json=hasLagSecs,proto3" json:"has_lag_secs,omitempty"`
+	LagSecs              float64     `protobuf:"fixed64,8,opt,name=lag_secs,json=lagSecs,proto3" json:"lag_secs,omitempty"`
+	WorkerDown           bool        `protobuf:"varint,9,opt,name=worker_down,json=workerDown,proto3" json:"worker_down,omitempty"`
+	LagExceeded          bool        `protobuf:"varint,10,opt,name=lag_exceeded,json=lagExceeded,proto3" json:"lag_exceeded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *FunctionInformation) Reset()         { *m = FunctionInformation{} }
-func (m *FunctionInformation) String() string { return proto.CompactTextString(m) }
-func (*FunctionInformation) ProtoMessage()    {}
-func (*FunctionInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{19}
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{45}
 }
 
-func (m *FunctionInformation) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FunctionInformation.Unmarshal(m, b)
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Subscription.Unmarshal(m, b)
 }
-func (m *FunctionInformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FunctionInformation.Marshal(b, m, deterministic)
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
 }
-func (m *FunctionInformation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FunctionInformation.Merge(m, src)
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
 }
-func (m *FunctionInformation) XXX_Size() int {
-	return xxx_messageInfo_FunctionInformation.Size(m)
+func (m *Subscription) XXX_Size() int {
+	return xxx_messageInfo_Subscription.Size(m)
 }
-func (m *FunctionInformation) XXX_DiscardUnknown() {
-	xxx_messageInfo_FunctionInformation.DiscardUnknown(m)
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FunctionInformation proto.InternalMessageInfo
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
 
-func (m *FunctionInformation) GetFunctionIdx() int32 {
+func (m *Subscription) GetDatabaseIdx() int32 {
 	if m != nil {
-		return m.FunctionIdx
+		return m.DatabaseIdx
 	}
 	return 0
 }
 
-func (m *FunctionInformation) GetLanguage() string {
+func (m *Subscription) GetName() string {
 	if m != nil {
-		return m.Language
+		return m.Name
 	}
 	return ""
 }
 
-func (m *FunctionInformation) GetSource() string {
+func (m *Subscription) GetEnabled() bool {
 	if m != nil {
-		return m.Source
+		return m.Enabled
 	}
-	return ""
+	return false
 }
 
-func (m *FunctionInformation) GetSourceBin() string {
+func (m *Subscription) GetWorkerPid() *NullInt64 {
 	if m != nil {
-		return m.SourceBin
+		return m.WorkerPid
 	}
-	return ""
+	return nil
 }
 
-func (m *FunctionInformation) GetConfig() []string {
+func (m *Subscription) GetReceivedLsn() *NullString {
 	if m != nil {
-		return m.Config
+		return m.ReceivedLsn
 	}
 	return nil
 }
 
-func (m *FunctionInformation) GetResult() string {
+func (m *Subscription) GetLatestEndLsn() *NullString {
 	if m != nil {
-		return m.Result
+		return m.LatestEndLsn
 	}
-	return ""
+	return nil
 }
 
-func (m *FunctionInformation) GetAggregate() bool {
+func (m *Subscription) GetHasLagSecs() bool {
 	if m != nil {
-		return m.Aggregate
+		return m.HasLagSecs
 	}
 	return false
 }
 
-func (m *FunctionInformation) GetWindow() bool {
+func (m *Subscription) GetLagSecs() float64 {
 	if m != nil {
-		return m.Window
+		return m.LagSecs
 	}
-	return false
+	return 0
 }
 
-func (m *FunctionInformation) GetSecurityDefiner() bool {
+func (m *Subscription) GetWorkerDown() bool {
 	if m != nil {
-		return m.SecurityDefiner
+		return m.WorkerDown
 	}
 	return false
 }
 
-func (m *FunctionInformation) GetLeakproof() bool {
+func (m *Subscription) GetLagExceeded() bool {
 	if m != nil {
-		return m.Leakproof
+		return m.LagExceeded
 	}
 	return false
 }
 
-func (m *FunctionInformation) GetStrict() bool {
+type LogicalReplicationSlot struct {
+	DatabaseIdx       int32       `protobuf:"varint,1,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	SlotName          string      `protobuf:"bytes,2,opt,name=slot_name,json=slotName,proto3" json:"slot_name,omitempty"`
+	Plugin            string      `protobuf:"bytes,3,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Active            bool        `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	ActivePid         *NullInt64  `protobuf:"bytes,5,opt,name=active_pid,json=activePid,proto3" json:"active_pid,omitempty"`
+	RestartLsn        *NullString `protobuf:"bytes,6,opt,name=restart_lsn,json=restartLsn,proto3" json:"restart_lsn,omitempty"`
+	ConfirmedFlushLsn *NullString `protobuf:"bytes,7,opt,name=confirmed_flush_lsn,json=confirmedFlushLsn,proto3" json:"confirmed_flush_lsn,omitempty"`
+	// Bytes between restart_lsn and the current WAL insert location
+	RetainedWalBytes *NullInt64 `protobuf:"bytes,8,opt,name=retained_wal_bytes,json=retainedWalBytes,proto3" json:"retained_wal_bytes,omitempty"`
+	// Trend of retained_wal_bytes over the recent full snapshots
+	RetainedWalBytesPerSec *NullDouble `protobuf:"bytes,9,opt,name=retained_wal_bytes_per_sec,json=retainedWalBytesPerSec,proto3" json:"retained_wal_bytes_per_sec,omitempty"`
+	RetainedWalGrowing     bool        `protobuf:"varint,10,opt,name=retained_wal_growing,json=retainedWalGrowing,proto3" json:"retained_wal_growing,omitempty"`
+	// Set when the slot is inactive and retained_wal_growing is set (stalled consumer)
+	InactiveRetainingWal bool     `protobuf:"varint,11,opt,name=inactive_retaining_wal,json=inactiveRetainingWal,proto3" json:"inactive_retaining_wal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogicalReplicationSlot) Reset()         { *m = LogicalReplicationSlot{} }
+func (m *LogicalReplicationSlot) String() string { return proto.CompactTextString(m) }
+func (*LogicalReplicationSlot) ProtoMessage()    {}
+func (*LogicalReplicationSlot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6b4141022f7abf6, []int{46}
+}
+
+func (m *LogicalReplicationSlot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogicalReplicationSlot.Unmarshal(m, b)
+}
+func (m *LogicalReplicationSlot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogicalReplicationSlot.Marshal(b, m, deterministic)
+}
+func (m *LogicalReplicationSlot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogicalReplicationSlot.Merge(m, src)
+}
+func (m *LogicalReplicationSlot) XXX_Size() int {
+	return xxx_messageInfo_LogicalReplicationSlot.Size(m)
+}
+func (m *LogicalReplicationSlot) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogicalReplicationSlot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogicalReplicationSlot proto.InternalMessageInfo
+
+func (m *LogicalReplicationSlot) GetDatabaseIdx() int32 {
 	if m != nil {
-		return m.Strict
+		return m.DatabaseIdx
 	}
-	return false
+	return 0
 }
 
-func (m *FunctionInformation) GetReturnsSet() bool {
+func (m *LogicalReplicationSlot) GetSlotName() string {
 	if m != nil {
-		return m.ReturnsSet
+		return m.SlotName
 	}
-	return false
+	return ""
 }
 
-func (m *FunctionInformation) GetVolatile() string {
+func (m *LogicalReplicationSlot) GetPlugin() string {
 	if m != nil {
-		return m.Volatile
+		return m.Plugin
 	}
 	return ""
 }
 
-type FunctionStatistic struct {
-	FunctionIdx          int32    `protobuf:"varint,1,opt,name=function_idx,json=functionIdx,proto3" json:"function_idx,omitempty"`
-	Calls                int64    `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
-	TotalTime            float64  `protobuf:"fixed64,3,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
-	SelfTime             float64  `protobuf:"fixed64,4,opt,name=self_time,json=selfTime,proto3" json:"self_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *LogicalReplicationSlot) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
 }
 
-func (m *FunctionStatistic) Reset()         { *m = FunctionStatistic{} }
-func (m *FunctionStatistic) String() string { return proto.CompactTextString(m) }
-func (*FunctionStatistic) ProtoMessage()    {}
-func (*FunctionStatistic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6b4141022f7abf6, []int{20}
+func (m *LogicalReplicationSlot) GetActivePid() *NullInt64 {
+	if m != nil {
+		return m.ActivePid
+	}
+	return nil
 }
 
-func (m *FunctionStatistic) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FunctionStatistic.Unmarshal(m, b)
-}
-func (m *FunctionStatistic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FunctionStatistic.Marshal(b, m, deterministic)
-}
-func (m *FunctionStatistic) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FunctionStatistic.Merge(m, src)
-}
-func (m *FunctionStatistic) XXX_Size() int {
-	return xxx_messageInfo_FunctionStatistic.Size(m)
-}
-func (m *FunctionStatistic) XXX_DiscardUnknown() {
-	xxx_messageInfo_FunctionStatistic.DiscardUnknown(m)
+func (m *LogicalReplicationSlot) GetRestartLsn() *NullString {
+	if m != nil {
+		return m.RestartLsn
+	}
+	return nil
 }
 
-var xxx_messageInfo_FunctionStatistic proto.InternalMessageInfo
+func (m *LogicalReplicationSlot) GetConfirmedFlushLsn() *NullString {
+	if m != nil {
+		return m.ConfirmedFlushLsn
+	}
+	return nil
+}
 
-func (m *FunctionStatistic) GetFunctionIdx() int32 {
+func (m *LogicalReplicationSlot) GetRetainedWalBytes() *NullInt64 {
 	if m != nil {
-		return m.FunctionIdx
+		return m.RetainedWalBytes
 	}
-	return 0
+	return nil
 }
 
-func (m *FunctionStatistic) GetCalls() int64 {
+func (m *LogicalReplicationSlot) GetRetainedWalBytesPerSec() *NullDouble {
 	if m != nil {
-		return m.Calls
+		return m.RetainedWalBytesPerSec
 	}
-	return 0
+	return nil
 }
 
-func (m *FunctionStatistic) GetTotalTime() float64 {
+func (m *LogicalReplicationSlot) GetRetainedWalGrowing() bool {
 	if m != nil {
-		return m.TotalTime
+		return m.RetainedWalGrowing
 	}
-	return 0
+	return false
 }
 
-func (m *FunctionStatistic) GetSelfTime() float64 {
+func (m *LogicalReplicationSlot) GetInactiveRetainingWal() bool {
 	if m != nil {
-		return m.SelfTime
+		return m.InactiveRetainingWal
 	}
-	return 0
+	return false
 }
 
 func init() {
@@ -2649,6 +5102,8 @@ func init() {
 	proto.RegisterEnum("pganalyze.collector.BackendCountStatistic_BackendType", BackendCountStatistic_BackendType_name, BackendCountStatistic_BackendType_value)
 	proto.RegisterEnum("pganalyze.collector.RelationEvent_EventType", RelationEvent_EventType_name, RelationEvent_EventType_value)
 	proto.RegisterType((*FullSnapshot)(nil), "pganalyze.collector.FullSnapshot")
+	proto.RegisterMapType((map[string]string)(nil), "pganalyze.collector.FullSnapshot.LabelsEntry")
+	proto.RegisterType((*CollectionSectionStatus)(nil), "pganalyze.collector.CollectionSectionStatus")
 	proto.RegisterType((*CollectorStatistic)(nil), "pganalyze.collector.CollectorStatistic")
 	proto.RegisterType((*RoleInformation)(nil), "pganalyze.collector.RoleInformation")
 	proto.RegisterType((*DatabaseInformation)(nil), "pganalyze.collector.DatabaseInformation")
@@ -2658,6 +5113,27 @@ func init() {
 	proto.RegisterType((*StandbyInformation)(nil), "pganalyze.collector.StandbyInformation")
 	proto.RegisterType((*StandbyStatistic)(nil), "pganalyze.collector.StandbyStatistic")
 	proto.RegisterType((*BackendCountStatistic)(nil), "pganalyze.collector.BackendCountStatistic")
+	proto.RegisterType((*RecoveryConflictStatistic)(nil), "pganalyze.collector.RecoveryConflictStatistic")
+	proto.RegisterType((*SlruStatistic)(nil), "pganalyze.collector.SlruStatistic")
+	proto.RegisterType((*BgwriterStatistic)(nil), "pganalyze.collector.BgwriterStatistic")
+	proto.RegisterType((*ConnectionSaturation)(nil), "pganalyze.collector.ConnectionSaturation")
+	proto.RegisterType((*ConnectionSaturation_ConnectionUsage)(nil), "pganalyze.collector.ConnectionSaturation.ConnectionUsage")
+	proto.RegisterType((*ConnectionSaturation_ConnectionCount)(nil), "pganalyze.collector.ConnectionSaturation.ConnectionCount")
+	proto.RegisterType((*ConnectionSaturation_ConnectionPeak)(nil), "pganalyze.collector.ConnectionSaturation.ConnectionPeak")
+	proto.RegisterType((*CollectorPrivileges)(nil), "pganalyze.collector.CollectorPrivileges")
+	proto.RegisterType((*HbaRule)(nil), "pganalyze.collector.HbaRule")
+	proto.RegisterType((*RelationLabels)(nil), "pganalyze.collector.RelationLabels")
+	proto.RegisterMapType((map[string]string)(nil), "pganalyze.collector.RelationLabels.LabelsEntry")
+	proto.RegisterType((*DuplicateIndex)(nil), "pganalyze.collector.DuplicateIndex")
+	proto.RegisterType((*MissingForeignKeyIndex)(nil), "pganalyze.collector.MissingForeignKeyIndex")
+	proto.RegisterType((*XminHorizon)(nil), "pganalyze.collector.XminHorizon")
+	proto.RegisterType((*StatementStatsInfo)(nil), "pganalyze.collector.StatementStatsInfo")
+	proto.RegisterType((*Wraparound)(nil), "pganalyze.collector.Wraparound")
+	proto.RegisterType((*WraparoundDatabase)(nil), "pganalyze.collector.WraparoundDatabase")
+	proto.RegisterType((*WraparoundRelation)(nil), "pganalyze.collector.WraparoundRelation")
+	proto.RegisterType((*AutovacuumForecast)(nil), "pganalyze.collector.AutovacuumForecast")
+	proto.RegisterType((*AutovacuumSettings)(nil), "pganalyze.collector.AutovacuumSettings")
+	proto.RegisterType((*AutovacuumRelationForecast)(nil), "pganalyze.collector.AutovacuumRelationForecast")
 	proto.RegisterType((*TablespaceReference)(nil), "pganalyze.collector.TablespaceReference")
 	proto.RegisterType((*TablespaceInformation)(nil), "pganalyze.collector.TablespaceInformation")
 	proto.RegisterType((*QueryStatistic)(nil), "pganalyze.collector.QueryStatistic")
@@ -2672,265 +5148,460 @@ func init() {
 	proto.RegisterType((*IndexStatistic)(nil), "pganalyze.collector.IndexStatistic")
 	proto.RegisterType((*FunctionInformation)(nil), "pganalyze.collector.FunctionInformation")
 	proto.RegisterType((*FunctionStatistic)(nil), "pganalyze.collector.FunctionStatistic")
+	proto.RegisterType((*BufferCacheStatistic)(nil), "pganalyze.collector.BufferCacheStatistic")
+	proto.RegisterType((*BufferCacheRelationStatistic)(nil), "pganalyze.collector.BufferCacheRelationStatistic")
+	proto.RegisterType((*BufferCacheIndexStatistic)(nil), "pganalyze.collector.BufferCacheIndexStatistic")
+	proto.RegisterType((*MaterializedViewInformation)(nil), "pganalyze.collector.MaterializedViewInformation")
+	proto.RegisterType((*LogicalReplication)(nil), "pganalyze.collector.LogicalReplication")
+	proto.RegisterType((*Publication)(nil), "pganalyze.collector.Publication")
+	proto.RegisterType((*Subscription)(nil), "pganalyze.collector.Subscription")
+	proto.RegisterType((*LogicalReplicationSlot)(nil), "pganalyze.collector.LogicalReplicationSlot")
 }
 
 func init() { proto.RegisterFile("full_snapshot.proto", fileDescriptor_b6b4141022f7abf6) }
 
 var fileDescriptor_b6b4141022f7abf6 = []byte{
-	// 4066 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7a, 0x4b, 0x73, 0x1c, 0xc9,
-	0x71, 0xbf, 0x06, 0x83, 0xc7, 0x4c, 0xce, 0x13, 0x05, 0x80, 0x1c, 0x82, 0xbb, 0x5a, 0xec, 0x88,
-	0x5a, 0x42, 0x12, 0x85, 0xfd, 0x07, 0xf9, 0xb7, 0xb4, 0x21, 0x87, 0x2c, 0x0d, 0x81, 0xa1, 0x88,
-	0x25, 0x08, 0x50, 0x8d, 0x01, 0xb9, 0xab, 0x08, 0xbb, 0xa3, 0xa7, 0xbb, 0x66, 0x50, 0x42, 0x4f,
-	0x77, 0xb3, 0xaa, 0x1a, 0xc4, 0xd0, 0x3e, 0x28, 0xec, 0x70, 0x84, 0x23, 0x7c, 0xf0, 0x07, 0xf0,
-	0xc1, 0x1f, 0xc1, 0x3e, 0x38, 0x14, 0x3e, 0xfa, 0xe4, 0xf0, 0xe3, 0x66, 0x87, 0x7c, 0x92, 0xb5,
-	0xb6, 0xe5, 0x08, 0xdf, 0x7c, 0xf1, 0x17, 0x70, 0x64, 0x55, 0xf5, 0x6b, 0x30, 0x04, 0xb0, 0x0e,
-	0x5f, 0x10, 0x53, 0x99, 0xbf, 0xcc, 0xca, 0xae, 0xcc, 0xca, 0xca, 0xca, 0x02, 0xac, 0x8d, 0x62,
-	0xdf, 0xb7, 0x45, 0xe0, 0x44, 0xe2, 0x34, 0x94, 0x3b, 0x11, 0x0f, 0x65, 0x48, 0xd6, 0xa2, 0xb1,
-	0x13, 0x38, 0xfe, 0xf4, 0x2d, 0xdd, 0x71, 0x43, 0xdf, 0xa7, 0xae, 0x0c, 0xf9, 0xe6, 0x07, 0xe3,
-	0x30, 0x1c, 0xfb, 0xf4, 0x63, 0x05, 0x19, 0xc6, 0xa3, 0x8f, 0x25, 0x9b, 0x50, 0x21, 0x9d, 0x49,
-	0xa4, 0xa5, 0x36, 0xeb, 0xe2, 0xd4, 0xe1, 0xd4, 0xd3, 0xa3, 0xee, 0x5f, 0x6e, 0x40, 0xfd, 0x49,
-	0xec, 0xfb, 0xc7, 0x46, 0x35, 0xf9, 0xff, 0x70, 0x2b, 0x99, 0xc6, 0x3e, 0xa7, 0x5c, 0xb0, 0x30,
-	0xb0, 0x27, 0xce, 0x4f, 0x43, 0xde, 0x29, 0x6d, 0x95, 0xb6, 0x97, 0xac, 0xf5, 0x84, 0xfb, 0x52,
-	0x33, 0x9f, 0x23, 0x6f, 0xbe, 0x14, 0x0b, 0x42, 0xde, 0x59, 0x98, 0x2f, 0x85, 0x3c, 0xf2, 0x2d,
-	0x58, 0x4d, 0x0d, 0x4f, 0xc4, 0x3a, 0xe5, 0xad, 0xd2, 0x76, 0xd5, 0x6a, 0xa7, 0x0c, 0x23, 0x41,
-	0xde, 0x07, 0x18, 0x39, 0xcc, 0xa7, 0x9e, 0xcd, 0xe3, 0xa0, 0xb3, 0xb8, 0x55, 0xda, 0xae, 0x58,
-	0x55, 0x4d, 0xb1, 0xe2, 0x80, 0x7c, 0x0d, 0x1a, 0xa9, 0x05, 0x71, 0xcc, 0xbc, 0x0e, 0x28, 0x3d,
-	0xf5, 0x84, 0x78, 0x12, 0x33, 0x8f, 0x7c, 0x1f, 0xea, 0x46, 0x2f, 0xf5, 0x6c, 0x47, 0x76, 0x6a,
-	0x5b, 0xa5, 0xed, 0xda, 0xc3, 0xcd, 0x1d, 0xbd, 0x66, 0x3b, 0xc9, 0x9a, 0xed, 0x0c, 0x92, 0x35,
-	0xb3, 0x6a, 0x29, 0xbe, 0x27, 0xc9, 0x77, 0xe0, 0x76, 0x26, 0xce, 0x02, 0x49, 0xf9, 0xb9, 0xe3,
-	0xdb, 0x82, 0xba, 0xa2, 0x53, 0xdf, 0x2a, 0x6d, 0x37, 0xac, 0x8d, 0x94, 0xbd, 0x6f, 0xb8, 0xc7,
-	0xd4, 0x15, 0xe4, 0x33, 0x58, 0xcb, 0xbe, 0x53, 0x48, 0x47, 0x32, 0x21, 0x99, 0xdb, 0x59, 0x57,
-	0xb3, 0xdf, 0xdf, 0x99, 0xe3, 0xc6, 0x9d, 0xdd, 0xe4, 0xd7, 0x71, 0x02, 0xb7, 0x88, 0x7b, 0x89,
-	0x46, 0xbe, 0x01, 0xd9, 0x42, 0xd9, 0x94, 0xf3, 0x90, 0x8b, 0xce, 0xc6, 0x56, 0x79, 0xbb, 0x6a,
-	0xb5, 0x52, 0x7a, 0x5f, 0x91, 0xc9, 0x23, 0x58, 0x16, 0x53, 0x21, 0xe9, 0xa4, 0xe3, 0xa9, 0x79,
-	0xef, 0xce, 0x9d, 0xf7, 0x58, 0x41, 0x2c, 0x03, 0x25, 0x47, 0xd0, 0x8e, 0x42, 0x21, 0xc7, 0x9c,
-	0x8a, 0xd4, 0x41, 0x54, 0x89, 0xdf, 0x9b, 0x2b, 0xfe, 0xc2, 0x80, 0x8d, 0xd3, 0xac, 0x56, 0x54,
-	0x24, 0x90, 0x67, 0xd0, 0xe2, 0xa1, 0x4f, 0x6d, 0x4e, 0x47, 0x94, 0xd3, 0xc0, 0xa5, 0xa2, 0x33,
-	0xda, 0x2a, 0x6f, 0xd7, 0x1e, 0x76, 0xe7, 0xea, 0xb3, 0x42, 0x9f, 0x5a, 0x09, 0xd4, 0x6a, 0xf2,
-	0xfc, 0x50, 0x90, 0x57, 0xb0, 0xe6, 0x39, 0xd2, 0x19, 0x3a, 0xa2, 0xa0, 0x70, 0xac, 0x14, 0x7e,
-	0x34, 0x57, 0xe1, 0x9e, 0xc1, 0x67, 0x4a, 0x89, 0x37, 0x4b, 0x12, 0xe4, 0xc7, 0xb0, 0xaa, 0xac,
-	0x64, 0xc1, 0x28, 0xe4, 0x13, 0x47, 0xb2, 0x30, 0x10, 0x9d, 0x40, 0xa9, 0xbd, 0xf7, 0x4e, 0x3b,
-	0xf7, 0x33, 0xb0, 0xd5, 0xe6, 0x45, 0x82, 0x20, 0xbf, 0x0d, 0x1b, 0xa9, 0xad, 0x05, 0xb5, 0xa1,
-	0x52, 0xbb, 0x7d, 0xa5, 0xb5, 0x79, 0xd5, 0xeb, 0xde, 0x65, 0xa2, 0x20, 0x9f, 0x40, 0x45, 0x50,
-	0x29, 0x59, 0x30, 0x16, 0x9d, 0xb7, 0x4a, 0xe3, 0x7b, 0xf3, 0xfd, 0xab, 0x41, 0x56, 0x8a, 0x26,
-	0x8f, 0xa1, 0xc6, 0x69, 0xe4, 0x33, 0x57, 0x69, 0xea, 0xfc, 0xae, 0xf2, 0xee, 0xd6, 0xfc, 0xaf,
-	0xcc, 0x70, 0x56, 0x5e, 0x88, 0x78, 0xd0, 0x19, 0x3a, 0xee, 0x19, 0x0d, 0x3c, 0xdb, 0x0d, 0xe3,
-	0x40, 0x66, 0x41, 0x2e, 0x3a, 0xbf, 0xa7, 0xac, 0xf9, 0xe6, 0x5c, 0x85, 0x8f, 0xb5, 0xd0, 0x2e,
-	0xca, 0x64, 0x81, 0x7e, 0x6b, 0x38, 0x8f, 0x2c, 0xc8, 0xef, 0xc0, 0x86, 0x74, 0x86, 0x3e, 0x15,
-	0x91, 0xe3, 0x16, 0x1c, 0xfe, 0xfb, 0xa5, 0x2b, 0xd6, 0x70, 0x90, 0x8a, 0x64, 0x3e, 0x5f, 0x97,
-	0x97, 0x89, 0x82, 0x78, 0x70, 0x3b, 0xa7, 0xbf, 0xe0, 0xa4, 0x3f, 0x28, 0x5d, 0xf1, 0x15, 0xd9,
-	0x0c, 0x79, 0x3f, 0xdd, 0x92, 0xf3, 0xc8, 0x02, 0xb7, 0xd4, 0xeb, 0x98, 0xf2, 0x69, 0xfe, 0x03,
-	0xfe, 0x56, 0xab, 0xff, 0xda, 0x5c, 0xf5, 0x3f, 0x46, 0x74, 0x66, 0x7b, 0xeb, 0x75, 0x61, 0xac,
-	0xb2, 0x0b, 0xa7, 0xbe, 0xd2, 0x9e, 0xd7, 0xf9, 0x77, 0xa5, 0x2b, 0xb6, 0x81, 0x65, 0x04, 0x72,
-	0xdb, 0x80, 0xcf, 0x92, 0x94, 0xa9, 0x2c, 0xf0, 0xe8, 0x45, 0x5e, 0xed, 0xdf, 0x5f, 0x65, 0xea,
-	0x3e, 0xa2, 0x73, 0xa6, 0xb2, 0xc2, 0x58, 0x99, 0x3a, 0x8a, 0x03, 0x77, 0xd6, 0xd4, 0x7f, 0xb8,
-	0xca, 0xd4, 0x27, 0x46, 0x20, 0x67, 0xea, 0x68, 0x96, 0x24, 0xc8, 0x09, 0x10, 0xbd, 0xaa, 0x05,
-	0xb7, 0xfd, 0xa3, 0x56, 0xfc, 0xf5, 0x77, 0xaf, 0x6b, 0xde, 0x63, 0xab, 0xaf, 0x67, 0x28, 0x39,
-	0x67, 0xe5, 0x02, 0xfa, 0x9f, 0xae, 0x75, 0x56, 0x16, 0xca, 0xda, 0x59, 0xb9, 0x18, 0x66, 0x70,
-	0xe7, 0x94, 0x09, 0x19, 0x72, 0xe6, 0xda, 0x97, 0x34, 0xff, 0x42, 0x6b, 0x7e, 0x30, 0x57, 0xf3,
-	0x53, 0x23, 0x56, 0x9c, 0x41, 0x58, 0xb7, 0x4f, 0xe7, 0x33, 0xc8, 0x00, 0x9a, 0x7a, 0x06, 0x7a,
-	0x11, 0xf9, 0x0e, 0x0b, 0x44, 0xe7, 0x9f, 0xaf, 0xd2, 0xaf, 0xc4, 0xfb, 0x1a, 0x9a, 0x5f, 0x95,
-	0xc6, 0xeb, 0x1c, 0x43, 0x6d, 0xc2, 0x34, 0xda, 0x0a, 0x6b, 0xfd, 0xcb, 0xab, 0x36, 0x61, 0x12,
-	0x6f, 0x85, 0x44, 0xc6, 0x2f, 0x13, 0x8b, 0xd1, 0x9c, 0x5b, 0x9a, 0x7f, 0xb9, 0x49, 0x34, 0xe7,
-	0xce, 0x4a, 0x3e, 0x4b, 0x12, 0xe4, 0x00, 0x5a, 0xa9, 0x66, 0x7a, 0x4e, 0x03, 0x29, 0x3a, 0x5f,
-	0x94, 0xae, 0x3a, 0x7b, 0x0c, 0xb8, 0x8f, 0x58, 0xab, 0xc9, 0xf3, 0x43, 0x15, 0x70, 0x7a, 0x6f,
-	0x14, 0x16, 0xe1, 0x5f, 0xaf, 0x0a, 0x38, 0xb5, 0x3b, 0x0a, 0x01, 0xc7, 0x66, 0x28, 0xb9, 0x2d,
-	0x97, 0xfb, 0xf6, 0x7f, 0xbb, 0x76, 0xcb, 0xe5, 0x02, 0x8e, 0x15, 0xc6, 0xca, 0x5f, 0xe9, 0x96,
-	0x2b, 0x98, 0xfa, 0xeb, 0xab, 0xfc, 0x95, 0x6c, 0xba, 0x82, 0xbf, 0x46, 0x97, 0x89, 0xc5, 0x2d,
-	0x9d, 0xb3, 0xf9, 0x3f, 0x6e, 0xb2, 0xa5, 0x73, 0xfe, 0x1a, 0xcd, 0x92, 0xc4, 0xa7, 0x8b, 0x95,
-	0x8b, 0xf6, 0xf4, 0xd3, 0xc5, 0xca, 0xb4, 0xfd, 0xf6, 0xd3, 0xe5, 0xca, 0xaf, 0x4a, 0xed, 0x2f,
-	0x4a, 0x9f, 0x2e, 0x57, 0xfe, 0xbd, 0xd4, 0xfe, 0x75, 0xa9, 0xfb, 0x37, 0x0b, 0x40, 0x2e, 0x97,
-	0x48, 0x58, 0x23, 0x8e, 0xc3, 0xb4, 0x50, 0xd1, 0x15, 0x60, 0x75, 0x1c, 0x26, 0xc5, 0xc7, 0xf7,
-	0xe1, 0xee, 0x84, 0x4e, 0x42, 0x3e, 0xb5, 0x4f, 0xa9, 0x13, 0xd9, 0x8e, 0xef, 0x87, 0xae, 0x83,
-	0xb5, 0xdc, 0x70, 0x2a, 0xa9, 0xe8, 0x34, 0xb6, 0x4a, 0xdb, 0x8b, 0x56, 0x47, 0x43, 0x9e, 0x52,
-	0x27, 0xea, 0x25, 0x80, 0xc7, 0xc8, 0x27, 0x3b, 0xb0, 0x96, 0x17, 0x0f, 0x87, 0x3f, 0xa5, 0xae,
-	0x14, 0x9d, 0xa6, 0x12, 0x5b, 0xcd, 0xc4, 0x8e, 0x34, 0x23, 0x87, 0xd7, 0xd5, 0x94, 0x99, 0xa6,
-	0x95, 0xc7, 0xeb, 0x7a, 0x4b, 0xeb, 0xdf, 0x86, 0xb6, 0xc1, 0x73, 0x21, 0x0c, 0xb8, 0xad, 0xc0,
-	0x4d, 0x4d, 0xb7, 0x84, 0xd0, 0xc8, 0x6f, 0xc1, 0xaa, 0xe3, 0x4a, 0x76, 0x4e, 0xed, 0x71, 0xc8,
-	0xc3, 0x58, 0xb2, 0x80, 0x0a, 0x55, 0x4e, 0x2e, 0x59, 0x6d, 0xcd, 0xf8, 0x51, 0x4a, 0x27, 0x77,
-	0xa1, 0xea, 0x8e, 0x43, 0xdb, 0x75, 0x7c, 0x5f, 0x74, 0xbe, 0xba, 0x55, 0xda, 0x2e, 0x5b, 0x15,
-	0x77, 0x1c, 0xee, 0xe2, 0xb8, 0xfb, 0x17, 0x65, 0x68, 0xcd, 0x14, 0x2f, 0xe4, 0x0e, 0x54, 0x74,
-	0xf5, 0xe3, 0x5d, 0x98, 0xa2, 0x7f, 0x45, 0x95, 0x33, 0xde, 0x05, 0xe9, 0xc0, 0x0a, 0x0b, 0x4e,
-	0x29, 0x67, 0x52, 0x15, 0xf6, 0x15, 0x2b, 0x19, 0x92, 0x75, 0x58, 0xf2, 0xc3, 0x31, 0xd3, 0xf5,
-	0x7b, 0xc5, 0xd2, 0x03, 0x35, 0x37, 0xa7, 0x8e, 0xa4, 0xb6, 0x37, 0x34, 0x35, 0x7b, 0x45, 0x13,
-	0xf6, 0x86, 0xe4, 0x03, 0xa8, 0x19, 0x26, 0xaa, 0xef, 0x2c, 0x29, 0x36, 0x68, 0x12, 0xda, 0x84,
-	0xee, 0x14, 0x71, 0x44, 0xb9, 0x1d, 0x0b, 0xca, 0x3b, 0xcb, 0xba, 0xe4, 0x57, 0x94, 0x13, 0x41,
-	0x39, 0xd9, 0x2a, 0x56, 0x2e, 0x2b, 0x8a, 0x5f, 0xa8, 0x4b, 0xde, 0x07, 0x18, 0x4e, 0x23, 0x47,
-	0x08, 0x9b, 0xfb, 0xa2, 0x53, 0xd1, 0x0a, 0x34, 0xc5, 0xf2, 0x85, 0xae, 0x9e, 0x83, 0x80, 0xea,
-	0xe8, 0xf5, 0xd9, 0x84, 0xc9, 0x4e, 0x55, 0x7d, 0x70, 0x2b, 0xa3, 0x1f, 0x20, 0x99, 0x0c, 0x60,
-	0x1d, 0xa5, 0xde, 0x84, 0xdc, 0xb3, 0xcf, 0x1d, 0x9f, 0x79, 0x76, 0x1c, 0x48, 0xe6, 0xab, 0x18,
-	0x7b, 0x57, 0x02, 0x39, 0x8c, 0x7d, 0x3f, 0xbb, 0x49, 0x90, 0x44, 0xfe, 0x25, 0x8a, 0x9f, 0xa0,
-	0x34, 0xb9, 0x05, 0xcb, 0x6e, 0x18, 0x8c, 0xd8, 0xb8, 0x53, 0x53, 0x45, 0xbb, 0x19, 0xe1, 0xb2,
-	0x4d, 0xe8, 0x64, 0x48, 0xb9, 0x1d, 0x8e, 0x3a, 0xf5, 0xad, 0xf2, 0xf6, 0x92, 0x55, 0xd1, 0x84,
-	0xa3, 0x51, 0xf7, 0xaf, 0xca, 0xb0, 0x36, 0xa7, 0x30, 0x24, 0x1f, 0x42, 0x3d, 0xab, 0x30, 0x53,
-	0xd7, 0xd5, 0xd2, 0x72, 0xd1, 0xbb, 0x20, 0xf7, 0xa0, 0x19, 0xbe, 0x09, 0x28, 0xb7, 0x53, 0xff,
-	0xea, 0xeb, 0x59, 0x5d, 0x51, 0x2d, 0xe3, 0xe4, 0x4d, 0xa8, 0xd0, 0xc0, 0x0d, 0x3d, 0x16, 0x8c,
-	0xcd, 0x6d, 0x2c, 0x1d, 0x63, 0x00, 0xe0, 0x07, 0x3a, 0x92, 0x2a, 0x77, 0x56, 0xad, 0x64, 0x48,
-	0x36, 0x60, 0xd9, 0xb5, 0xe5, 0x34, 0xd2, 0x8e, 0xac, 0x5a, 0x4b, 0xee, 0x60, 0x1a, 0x51, 0x74,
-	0x32, 0x13, 0xb6, 0xa4, 0x93, 0x48, 0x09, 0x69, 0x27, 0x02, 0x13, 0x03, 0x43, 0x51, 0xb1, 0xec,
-	0xfb, 0xe1, 0x1b, 0x3b, 0x5b, 0x72, 0x61, 0x7c, 0xd9, 0x56, 0x8c, 0xdd, 0x8c, 0x3e, 0xd7, 0x63,
-	0x95, 0xf9, 0x1e, 0xc3, 0xfb, 0x22, 0x0f, 0xdf, 0xd2, 0xc0, 0xbe, 0x60, 0x9e, 0x72, 0x6b, 0xc3,
-	0xaa, 0x6a, 0xca, 0x67, 0xcc, 0x23, 0x0f, 0x61, 0x63, 0xc2, 0x02, 0x36, 0x89, 0x27, 0xf6, 0x24,
-	0xf6, 0x25, 0xbb, 0x70, 0x5c, 0xa9, 0x90, 0xa0, 0x90, 0x6b, 0x86, 0xf9, 0x3c, 0xe1, 0xa1, 0xcc,
-	0x0f, 0xe0, 0xbd, 0xec, 0xfe, 0x87, 0xa9, 0xc1, 0xb7, 0x5d, 0x47, 0x3a, 0x7e, 0x38, 0xb6, 0x71,
-	0x95, 0xd5, 0x75, 0xb2, 0x62, 0xdd, 0x49, 0x31, 0x07, 0x08, 0xd9, 0xd5, 0x08, 0xf4, 0x58, 0xf7,
-	0xe7, 0x65, 0x58, 0x31, 0x15, 0x38, 0x21, 0xb0, 0x18, 0x38, 0x13, 0xaa, 0xdc, 0x54, 0xb5, 0xd4,
-	0x6f, 0xbc, 0xc4, 0xba, 0x31, 0xe7, 0x34, 0x90, 0x18, 0x64, 0x31, 0x55, 0xee, 0xa9, 0x5a, 0x75,
-	0x43, 0x7c, 0x89, 0x34, 0xf2, 0x08, 0x16, 0xe3, 0x80, 0x49, 0xe5, 0x9a, 0xda, 0xc3, 0x0f, 0xde,
-	0x19, 0x7a, 0xc7, 0x92, 0x63, 0xa5, 0xaf, 0xc0, 0xe4, 0xb7, 0x00, 0x86, 0x61, 0x98, 0xa8, 0x5d,
-	0xbc, 0x99, 0x68, 0x15, 0x45, 0xf4, 0xa4, 0x3f, 0xc4, 0xbd, 0x26, 0x68, 0xa2, 0x60, 0xe9, 0x66,
-	0x0a, 0x40, 0xc9, 0x68, 0x0d, 0xdf, 0x85, 0x65, 0x11, 0xc6, 0xdc, 0xd5, 0x31, 0x70, 0x03, 0x61,
-	0x03, 0xc7, 0xa9, 0xf5, 0x2f, 0x7b, 0xc4, 0x7c, 0xaa, 0x42, 0xe3, 0x26, 0x53, 0x6b, 0x99, 0x27,
-	0xcc, 0xcf, 0x6b, 0xf0, 0x59, 0x40, 0x55, 0xc0, 0xdc, 0x5c, 0xc3, 0x01, 0x0b, 0x68, 0xf7, 0x67,
-	0x4b, 0x50, 0xcb, 0xdd, 0x7e, 0x54, 0x54, 0x63, 0x09, 0xeb, 0x86, 0xe7, 0x94, 0x4f, 0x95, 0x0f,
-	0x31, 0xaa, 0x03, 0xcb, 0x50, 0x30, 0xbc, 0x12, 0x4f, 0x5e, 0x60, 0x7c, 0xa8, 0x83, 0x24, 0x3b,
-	0x94, 0xd6, 0x0c, 0xf3, 0x33, 0x3f, 0x1c, 0x1f, 0x18, 0x16, 0x19, 0x00, 0x11, 0xd2, 0x09, 0xbc,
-	0x61, 0xe1, 0x6e, 0x50, 0xbb, 0xa2, 0xa2, 0x38, 0xd6, 0xf0, 0xac, 0x34, 0x5e, 0x15, 0x33, 0x14,
-	0x41, 0x7e, 0x02, 0xeb, 0x89, 0xd6, 0xc2, 0xf9, 0x5f, 0x57, 0x7a, 0xef, 0x5f, 0xa5, 0x37, 0x7f,
-	0xfa, 0xaf, 0x89, 0x4b, 0x34, 0x91, 0xb7, 0x38, 0x77, 0xf6, 0x37, 0xae, 0xb7, 0x38, 0x3b, 0xf9,
-	0x13, 0x8b, 0x73, 0x25, 0xcb, 0x87, 0x50, 0x67, 0xc2, 0x16, 0x92, 0x53, 0x67, 0x82, 0x39, 0x68,
-	0x5d, 0x27, 0x76, 0x26, 0x8e, 0x13, 0x12, 0xe6, 0x01, 0x4e, 0x5d, 0x8a, 0x27, 0x60, 0xba, 0xb2,
-	0x1b, 0x6a, 0x65, 0x5b, 0x86, 0x9e, 0xae, 0xea, 0x7d, 0x2c, 0xfb, 0x22, 0xdf, 0x99, 0x66, 0xc8,
-	0x5b, 0x0a, 0xd9, 0xd4, 0xe4, 0x14, 0x78, 0x0f, 0x9a, 0x4e, 0x14, 0xf9, 0x53, 0x75, 0xf2, 0xda,
-	0xbe, 0x33, 0xee, 0xdc, 0x56, 0x87, 0x65, 0x5d, 0x51, 0xf1, 0xe0, 0x3d, 0x70, 0xc6, 0xa4, 0x8f,
-	0x33, 0x2b, 0x75, 0x69, 0x63, 0xad, 0xd3, 0xb9, 0xb6, 0x8d, 0x64, 0x4c, 0x48, 0x09, 0xe4, 0xff,
-	0xc1, 0xfa, 0xac, 0x1a, 0xdb, 0x19, 0xd3, 0xce, 0x1d, 0x35, 0x25, 0x99, 0x81, 0xf7, 0xc6, 0xb4,
-	0xfb, 0x08, 0xda, 0xb3, 0xee, 0x56, 0x27, 0xa8, 0xcf, 0x30, 0xc8, 0x1c, 0xcf, 0xe3, 0x26, 0x95,
-	0x80, 0x26, 0xf5, 0x3c, 0x8f, 0x77, 0x7f, 0xb9, 0x00, 0xe4, 0xb2, 0x33, 0x51, 0x2e, 0x8d, 0x89,
-	0xf4, 0xa4, 0x80, 0xc4, 0xc3, 0xde, 0x45, 0xa1, 0x04, 0x58, 0x28, 0x96, 0x00, 0x6d, 0x28, 0x47,
-	0xcc, 0x53, 0xd9, 0xa7, 0x6c, 0xe1, 0x4f, 0x74, 0x06, 0x2e, 0x91, 0xd9, 0x1b, 0xb6, 0xca, 0x6a,
-	0xfa, 0x70, 0x68, 0xe5, 0xe8, 0x87, 0x98, 0xe0, 0xee, 0x43, 0xcb, 0x18, 0x7c, 0x1a, 0x0a, 0xa9,
-	0x90, 0xfa, 0xb4, 0x68, 0x6a, 0xf2, 0x53, 0x43, 0xcd, 0x7d, 0x59, 0x14, 0x72, 0xa9, 0x52, 0xc6,
-	0x52, 0xf2, 0x65, 0x2f, 0x42, 0x2e, 0xc9, 0x0f, 0xa0, 0x91, 0xb4, 0x1c, 0x84, 0x74, 0xb8, 0x34,
-	0x79, 0xe1, 0x2a, 0x27, 0xd4, 0x8d, 0xc0, 0x31, 0xe2, 0x55, 0xc3, 0x70, 0x1a, 0xb8, 0x76, 0xc4,
-	0x59, 0xc8, 0x99, 0x9c, 0x9a, 0x73, 0xa4, 0x8e, 0xc4, 0x17, 0x86, 0xa6, 0x2a, 0x10, 0x04, 0x61,
-	0x74, 0x53, 0x75, 0x88, 0x54, 0xad, 0x2a, 0x52, 0x30, 0x5c, 0x69, 0xf7, 0x67, 0x0b, 0xa9, 0x53,
-	0xb2, 0x22, 0xf4, 0xda, 0xc5, 0x5d, 0x87, 0x25, 0xad, 0x4f, 0x67, 0x77, 0x3d, 0x50, 0xf6, 0xe0,
-	0xf7, 0xa6, 0x51, 0x5a, 0x36, 0x0d, 0x4c, 0x1a, 0xc8, 0x34, 0x46, 0xbf, 0x0e, 0xcd, 0x37, 0x9c,
-	0xc9, 0x5c, 0xd4, 0xeb, 0x85, 0x6e, 0x28, 0x6a, 0x1e, 0x36, 0xf2, 0x63, 0x71, 0x9a, 0xc1, 0xf4,
-	0x2a, 0x37, 0x14, 0xf5, 0xaa, 0xad, 0xb1, 0x3c, 0x77, 0x6b, 0xdc, 0x81, 0x4a, 0xba, 0x29, 0x56,
-	0x94, 0xe3, 0x57, 0x86, 0x7a, 0x3f, 0x74, 0xff, 0x78, 0x19, 0x36, 0xe6, 0xb6, 0x71, 0xc8, 0x16,
-	0xd4, 0x4f, 0x1d, 0x61, 0x17, 0x4a, 0xc9, 0x8a, 0x05, 0xa7, 0x8e, 0x48, 0x0a, 0x8d, 0x2b, 0xa2,
-	0x6c, 0x1b, 0xda, 0x28, 0x5c, 0x28, 0x68, 0x74, 0x65, 0xd9, 0x3c, 0x75, 0xc4, 0x5e, 0xae, 0xa6,
-	0x99, 0x2d, 0x7b, 0x16, 0x2f, 0x97, 0x3d, 0xcf, 0x93, 0x05, 0xc7, 0x55, 0x68, 0x3e, 0xfc, 0xee,
-	0xcd, 0x7b, 0x51, 0x09, 0x55, 0xb9, 0x3b, 0xf1, 0xd4, 0xe7, 0x90, 0x44, 0x92, 0xae, 0x77, 0x96,
-	0x95, 0xd6, 0xef, 0x7c, 0x79, 0xad, 0x58, 0x20, 0x59, 0xb5, 0x61, 0x36, 0xc0, 0xcf, 0x7e, 0xe3,
-	0x30, 0xac, 0x0f, 0xec, 0x51, 0xc8, 0xd1, 0x2d, 0x67, 0xa6, 0x16, 0x6a, 0x1a, 0xfa, 0x93, 0x90,
-	0x1f, 0x84, 0xee, 0x19, 0x06, 0x91, 0x6a, 0xb5, 0x99, 0xb0, 0xd5, 0x83, 0xee, 0x9f, 0x96, 0xa0,
-	0x9e, 0x37, 0x99, 0xac, 0x42, 0xe3, 0xe4, 0xf0, 0xd9, 0xe1, 0xd1, 0xab, 0x43, 0xfb, 0x78, 0xd0,
-	0x1b, 0xf4, 0xdb, 0x5f, 0x21, 0x00, 0xcb, 0xbd, 0xdd, 0xc1, 0xfe, 0xcb, 0x7e, 0xbb, 0x44, 0x2a,
-	0xb0, 0xb8, 0xbf, 0x77, 0xd0, 0x6f, 0x2f, 0x90, 0xdb, 0xb0, 0x86, 0xbf, 0xec, 0xfd, 0x43, 0x7b,
-	0x60, 0xf5, 0x0e, 0x8f, 0x11, 0x72, 0x74, 0xd8, 0x2e, 0x93, 0x0f, 0xe0, 0xee, 0x1c, 0x86, 0xdd,
-	0x7b, 0x7c, 0x64, 0x0d, 0xfa, 0x7b, 0xed, 0x45, 0xb2, 0x09, 0xb7, 0x9e, 0xf4, 0x8e, 0x07, 0x2f,
-	0x7a, 0x83, 0xa7, 0xf6, 0x93, 0x93, 0x43, 0xcd, 0xde, 0xed, 0x1d, 0x1c, 0xb4, 0x97, 0x48, 0x1d,
-	0x2a, 0x7b, 0xfb, 0xc7, 0xbd, 0xc7, 0x07, 0xfd, 0xbd, 0xf6, 0x72, 0xf7, 0x8b, 0x12, 0xd4, 0x72,
-	0x9f, 0x4e, 0xda, 0x50, 0x4f, 0x8c, 0x1b, 0x7c, 0xfe, 0x02, 0x6d, 0xbb, 0x0d, 0x6b, 0xbd, 0x93,
-	0xc1, 0xd1, 0xcb, 0xde, 0xee, 0xc9, 0xc9, 0x73, 0xfb, 0xa0, 0x77, 0x72, 0xb8, 0xfb, 0xb4, 0x6f,
-	0xb5, 0x4b, 0x64, 0x03, 0x56, 0x73, 0x8c, 0x57, 0x47, 0xd6, 0xb3, 0xbe, 0xd5, 0x5e, 0x40, 0xf2,
-	0xe3, 0xde, 0xee, 0xb3, 0x1f, 0x59, 0x47, 0x27, 0x87, 0x7b, 0x09, 0xb9, 0x3c, 0x4b, 0xb6, 0xf6,
-	0x07, 0x7d, 0xab, 0xbd, 0x48, 0x08, 0x34, 0x77, 0x0f, 0xf6, 0xfb, 0x87, 0x03, 0x1b, 0xb9, 0xfd,
-	0xc3, 0xbd, 0xf6, 0x12, 0xda, 0xb0, 0xfb, 0xb4, 0xbf, 0xfb, 0xec, 0xc5, 0xd1, 0xfe, 0x21, 0xa2,
-	0x96, 0x49, 0x0d, 0x56, 0x8e, 0x07, 0x3d, 0x6b, 0x70, 0xf2, 0xa2, 0xbd, 0x42, 0x5a, 0x50, 0x7b,
-	0xd5, 0x3b, 0xb0, 0xfa, 0xbb, 0xfd, 0xfd, 0x97, 0x7d, 0xab, 0x5d, 0x21, 0x0d, 0xa8, 0xbe, 0xea,
-	0x1d, 0x1c, 0xf7, 0x0f, 0xf7, 0xfa, 0x56, 0xbb, 0x6a, 0x86, 0x66, 0x06, 0xe8, 0x7e, 0x03, 0xd6,
-	0xe6, 0xf4, 0x1b, 0xe7, 0xd5, 0x7a, 0xdd, 0x3f, 0x2b, 0xc1, 0xc6, 0xdc, 0xce, 0x21, 0xee, 0xde,
-	0x7c, 0x1f, 0x32, 0xcd, 0x21, 0x8d, 0x5c, 0x47, 0xd1, 0xbb, 0x20, 0x0f, 0x80, 0x78, 0x4c, 0x9c,
-	0xd9, 0x91, 0xc3, 0x25, 0xd3, 0xf7, 0xfb, 0x74, 0x1f, 0xb5, 0x91, 0xf3, 0x22, 0x61, 0xcc, 0xee,
-	0xb5, 0x72, 0x71, 0xaf, 0x65, 0xb7, 0x90, 0xc5, 0xfc, 0x2d, 0xa4, 0xfb, 0x5f, 0x8b, 0xd0, 0x2c,
-	0x36, 0x95, 0xf0, 0x62, 0x62, 0xda, 0x6c, 0xa9, 0x55, 0x15, 0xdd, 0x35, 0xd3, 0x79, 0x4d, 0x5f,
-	0x32, 0x17, 0x54, 0x8a, 0xd0, 0x03, 0x4c, 0xa1, 0x32, 0x94, 0x8e, 0xaf, 0x0e, 0x3a, 0x35, 0x75,
-	0xc9, 0xaa, 0x2a, 0x0a, 0x66, 0x66, 0x5c, 0x1a, 0x1e, 0xbe, 0x11, 0x6a, 0xdb, 0x96, 0x2d, 0xf5,
-	0x9b, 0x7c, 0x04, 0x2d, 0xfd, 0x48, 0x65, 0x0f, 0xfd, 0x33, 0x61, 0x9f, 0x32, 0xa9, 0x76, 0x6e,
-	0xd9, 0x6a, 0x68, 0xf2, 0x63, 0xff, 0x4c, 0x3c, 0x65, 0x12, 0x77, 0x4b, 0x1e, 0xc7, 0xa9, 0xe3,
-	0xa9, 0xcd, 0x58, 0xb6, 0x9a, 0x19, 0xd0, 0xa2, 0x8e, 0x87, 0x57, 0xf1, 0x3c, 0xd2, 0x63, 0x5c,
-	0x32, 0xea, 0x99, 0x5c, 0xb6, 0x9a, 0x81, 0xf7, 0x34, 0x63, 0x16, 0x8f, 0xd9, 0x55, 0xd2, 0x40,
-	0xed, 0xb5, 0x02, 0xfe, 0x95, 0x66, 0x60, 0xed, 0xa0, 0xef, 0x03, 0xa9, 0xc1, 0x55, 0x5d, 0x3b,
-	0x28, 0x6a, 0x62, 0xef, 0x47, 0xd0, 0xca, 0xa1, 0x94, 0xb9, 0xa0, 0xbf, 0x2b, 0x85, 0x29, 0x6b,
-	0x1f, 0x00, 0xc9, 0xe1, 0x12, 0x63, 0x6b, 0x0a, 0xda, 0x4e, 0xa1, 0x89, 0xad, 0x45, 0x74, 0x62,
-	0x6a, 0x7d, 0x06, 0x9d, 0xb3, 0x14, 0x2f, 0x63, 0x39, 0x13, 0x1a, 0xda, 0x52, 0xa4, 0xa6, 0x16,
-	0x7c, 0x13, 0x56, 0x33, 0x54, 0xa2, 0xb2, 0xa9, 0x80, 0xad, 0x04, 0x98, 0x68, 0xec, 0x42, 0x63,
-	0xe8, 0x9f, 0x29, 0x5d, 0xda, 0xc7, 0x2d, 0xe5, 0xe3, 0xda, 0xd0, 0x3f, 0x43, 0x5d, 0xca, 0xcb,
-	0xf7, 0xa0, 0x89, 0x18, 0x7d, 0x76, 0x29, 0x50, 0x5b, 0x81, 0xea, 0x43, 0xff, 0x0c, 0xf5, 0x50,
-	0x44, 0x75, 0x7f, 0x51, 0x82, 0xdb, 0xef, 0x68, 0x73, 0x5e, 0x7a, 0xba, 0x2b, 0xfd, 0x9f, 0x3d,
-	0xdd, 0x2d, 0x5c, 0xf5, 0x74, 0xb7, 0x0b, 0x90, 0xab, 0x6c, 0xcb, 0x37, 0xef, 0xfc, 0xe6, 0xc4,
-	0xba, 0x7f, 0x0e, 0xb0, 0x36, 0xa7, 0x03, 0x8a, 0x47, 0x57, 0xd6, 0x4b, 0xcd, 0x6e, 0xec, 0x69,
-	0x5f, 0xd4, 0xbb, 0xc0, 0xaa, 0x20, 0x85, 0xa8, 0xc3, 0xc6, 0xdc, 0x08, 0x13, 0xa2, 0xca, 0xa3,
-	0x4f, 0xa1, 0x75, 0xce, 0xe8, 0x1b, 0xdb, 0xa3, 0x23, 0x16, 0xb0, 0xb4, 0x78, 0xb8, 0xc1, 0x1d,
-	0xa7, 0x89, 0x72, 0x7b, 0xa9, 0x18, 0xd9, 0x57, 0xd7, 0xfb, 0x78, 0x12, 0x08, 0x95, 0x0b, 0x6a,
-	0x0f, 0x3f, 0xbe, 0x69, 0x3b, 0x77, 0x67, 0x57, 0xc9, 0x59, 0x89, 0x3c, 0x39, 0x81, 0x9a, 0x1b,
-	0x06, 0x42, 0x72, 0x87, 0x05, 0x52, 0x74, 0x96, 0x94, 0xba, 0x47, 0x5f, 0x42, 0x5d, 0x22, 0x6b,
-	0xe5, 0xf5, 0x60, 0xb1, 0x19, 0x51, 0x2e, 0x98, 0x90, 0x98, 0x59, 0xb3, 0x03, 0xb8, 0x6a, 0xb5,
-	0x72, 0x74, 0xb5, 0x2c, 0x5f, 0x05, 0x18, 0x31, 0xdf, 0x1f, 0x39, 0x38, 0x89, 0xda, 0xeb, 0x4b,
-	0x56, 0x8e, 0x82, 0x29, 0x11, 0x6b, 0x8c, 0x90, 0x79, 0x49, 0x6f, 0x68, 0xe5, 0xd4, 0x11, 0x47,
-	0xcc, 0x13, 0xe4, 0x13, 0xe8, 0x20, 0xcb, 0x34, 0xb7, 0x1c, 0x9c, 0xc9, 0x3d, 0x65, 0xbe, 0xc7,
-	0x69, 0xa0, 0x76, 0x76, 0xc5, 0xba, 0x75, 0xea, 0x88, 0xfd, 0x8c, 0xbd, 0x6b, 0xb8, 0x98, 0x21,
-	0x51, 0x52, 0x86, 0x8e, 0x90, 0x6a, 0x77, 0x57, 0x2c, 0x9c, 0x65, 0x80, 0xe3, 0x99, 0x9e, 0x44,
-	0xed, 0xc6, 0x3d, 0x89, 0xfa, 0xbb, 0x7b, 0x12, 0xdf, 0x06, 0x42, 0x2f, 0x5c, 0x3f, 0x16, 0xec,
-	0x9c, 0xfa, 0xaa, 0x90, 0x3b, 0xa3, 0x7a, 0x4f, 0x57, 0xac, 0xd5, 0x1c, 0xe7, 0x40, 0x31, 0xc8,
-	0x11, 0xac, 0x84, 0x91, 0xbe, 0x00, 0x36, 0x95, 0x47, 0x7e, 0xe3, 0xc6, 0x1e, 0x39, 0xd2, 0x72,
-	0xfd, 0x40, 0xf2, 0xa9, 0x95, 0x68, 0xd9, 0xfc, 0x1e, 0xd4, 0xf3, 0x0c, 0xbc, 0x1e, 0x9c, 0xd1,
-	0xa9, 0x39, 0xe9, 0xf0, 0x27, 0x1e, 0x0b, 0xf9, 0x66, 0x86, 0x1e, 0x7c, 0x6f, 0xe1, 0x93, 0xd2,
-	0xe6, 0xcf, 0x4b, 0xb0, 0xac, 0xc3, 0x26, 0x3d, 0x21, 0x17, 0x72, 0xdd, 0x90, 0xbb, 0x50, 0xc5,
-	0x2a, 0x4e, 0xfb, 0xd8, 0x34, 0xa2, 0x90, 0xa0, 0x9c, 0xbb, 0x07, 0x0d, 0x8f, 0x8e, 0x9c, 0xd8,
-	0xff, 0x92, 0x3d, 0x8d, 0xba, 0x91, 0xd2, 0x4d, 0x89, 0x3b, 0x50, 0x09, 0x42, 0x69, 0x07, 0xb1,
-	0xef, 0x9b, 0xfe, 0xe3, 0x4a, 0x10, 0x4a, 0x84, 0x93, 0x4d, 0xa8, 0x44, 0xa1, 0x60, 0x69, 0x55,
-	0xbc, 0x64, 0xa5, 0xe3, 0xcd, 0x5f, 0x2d, 0x00, 0x64, 0x01, 0x8a, 0x97, 0xb9, 0x51, 0xc8, 0x29,
-	0x1b, 0x07, 0xf6, 0x9c, 0xfd, 0x4c, 0x0c, 0xcf, 0xca, 0x6d, 0xeb, 0x79, 0x9f, 0x4b, 0x60, 0x31,
-	0xf7, 0xa5, 0xea, 0x37, 0x96, 0x02, 0x59, 0xf0, 0xe3, 0xfe, 0x4e, 0xea, 0xfd, 0x8c, 0xba, 0x47,
-	0x47, 0xa6, 0x2b, 0xa7, 0xb6, 0xed, 0x92, 0xea, 0x16, 0xa6, 0xbb, 0xf0, 0x3e, 0xb4, 0x12, 0xd3,
-	0x12, 0xc4, 0xb2, 0x42, 0x34, 0x0d, 0x79, 0xd7, 0x00, 0x77, 0x60, 0x2d, 0x01, 0xc6, 0x91, 0xe7,
-	0x48, 0xb3, 0xb5, 0x56, 0xd4, 0x74, 0xab, 0x86, 0x75, 0xa2, 0x38, 0x6a, 0xfd, 0x73, 0x78, 0x8f,
-	0xfa, 0x34, 0xc1, 0x57, 0x0a, 0xf8, 0x3d, 0xc5, 0x51, 0xf8, 0x07, 0x90, 0xac, 0x83, 0x3d, 0x71,
-	0xa4, 0x7b, 0xaa, 0xe1, 0xfa, 0x46, 0xd5, 0x36, 0x9c, 0xe7, 0xc8, 0x40, 0x74, 0xf7, 0x0f, 0x97,
-	0x61, 0xf5, 0xd2, 0xab, 0xce, 0x4d, 0xf2, 0x25, 0x5e, 0xd8, 0xd8, 0x5b, 0x6a, 0xba, 0xe7, 0xba,
-	0x10, 0xa9, 0x22, 0x45, 0x37, 0xce, 0xef, 0x40, 0x45, 0xd0, 0xd7, 0xb6, 0x70, 0x9d, 0xc0, 0xdc,
-	0x60, 0x57, 0x04, 0x7d, 0x7d, 0xec, 0x3a, 0x01, 0x5e, 0x57, 0x90, 0x25, 0xe3, 0x48, 0x1f, 0x8b,
-	0xba, 0x20, 0x01, 0x41, 0x5f, 0x0f, 0xe2, 0x48, 0x1d, 0x8a, 0x77, 0xa0, 0xc2, 0xbc, 0x0b, 0x2d,
-	0xac, 0xeb, 0x91, 0x15, 0xe6, 0x5d, 0x28, 0xe1, 0x2e, 0x34, 0x90, 0x85, 0xc2, 0x23, 0x2a, 0xdd,
-	0x53, 0x53, 0x86, 0xd4, 0x98, 0x77, 0x31, 0x88, 0xa3, 0x27, 0x48, 0x22, 0x9b, 0x50, 0x0d, 0x14,
-	0x82, 0x99, 0x06, 0x67, 0xd9, 0x5a, 0x09, 0x06, 0x71, 0xb4, 0x1f, 0x88, 0x8c, 0x17, 0x47, 0x9e,
-	0xa9, 0x32, 0x14, 0xef, 0x24, 0xf2, 0x32, 0x9e, 0x47, 0x7d, 0x53, 0x56, 0x28, 0xde, 0x1e, 0xf5,
-	0xc9, 0x87, 0xd0, 0xd0, 0x3c, 0xf5, 0x6f, 0x2f, 0x51, 0x52, 0x4f, 0x00, 0xf2, 0x9f, 0x86, 0x12,
-	0xc5, 0xdf, 0x03, 0x08, 0x6c, 0x9f, 0x9d, 0x53, 0xc4, 0x99, 0x22, 0xa2, 0x12, 0x1c, 0xb0, 0x73,
-	0x3a, 0x88, 0x23, 0xcd, 0xf5, 0xd4, 0xd1, 0x1d, 0x47, 0xa6, 0x68, 0xa8, 0x04, 0x7b, 0x78, 0x6e,
-	0xc7, 0x11, 0xf9, 0x36, 0xac, 0x05, 0xf6, 0x24, 0xf4, 0x6c, 0xc1, 0x30, 0x05, 0x9a, 0x8d, 0x65,
-	0x2a, 0x86, 0x76, 0xf0, 0x3c, 0xf4, 0x8e, 0x91, 0xd1, 0xd3, 0x74, 0x3c, 0xe5, 0xd5, 0xcb, 0x48,
-	0x56, 0x5b, 0x10, 0x5d, 0x5b, 0x20, 0x35, 0xad, 0x2d, 0xba, 0xd0, 0xc8, 0x50, 0x58, 0x2a, 0xad,
-	0xe9, 0xb5, 0x4a, 0x40, 0x58, 0x29, 0x99, 0xf5, 0xcc, 0x14, 0xad, 0xa7, 0xeb, 0x99, 0xea, 0xd9,
-	0x82, 0x7a, 0x8a, 0x41, 0x35, 0x1b, 0xfa, 0xd3, 0x0d, 0xc4, 0xd4, 0x5b, 0x2a, 0x0f, 0xe7, 0xf4,
-	0xdc, 0xd2, 0xf5, 0x96, 0x22, 0xa7, 0x9a, 0xb0, 0x26, 0xca, 0x70, 0xa8, 0xcb, 0x74, 0x7e, 0x52,
-	0x18, 0x6a, 0x43, 0x54, 0xd1, 0xa8, 0x8e, 0x41, 0xe5, 0xad, 0xea, 0x42, 0x43, 0x16, 0xcc, 0xd2,
-	0x1d, 0x9d, 0x9a, 0xcc, 0xd9, 0xb5, 0x0d, 0x6d, 0x3d, 0x5f, 0x2e, 0x54, 0x37, 0x75, 0xdd, 0xaa,
-	0xe8, 0xc7, 0x49, 0xbc, 0x76, 0xff, 0x7a, 0x01, 0x1a, 0x85, 0x77, 0xc8, 0x9b, 0xec, 0x81, 0x1f,
-	0x9a, 0x44, 0xb2, 0xa0, 0xee, 0xa5, 0x0f, 0xae, 0x7f, 0xdc, 0xdc, 0x51, 0x7f, 0xd5, 0x6d, 0x54,
-	0xa7, 0x9d, 0xdf, 0x84, 0x5a, 0xe8, 0xaa, 0x16, 0xa5, 0xaa, 0xb5, 0xca, 0xd7, 0xd6, 0x5a, 0x90,
-	0xc0, 0x75, 0xa9, 0xe5, 0x44, 0x11, 0x0f, 0x2f, 0xd8, 0x04, 0xd3, 0x48, 0x5e, 0x91, 0x7e, 0x01,
-	0xda, 0xc8, 0xb1, 0x8f, 0x52, 0xb9, 0xee, 0x09, 0x54, 0x53, 0x3b, 0xf0, 0xde, 0xfa, 0xbc, 0x77,
-	0x78, 0xd2, 0x3b, 0xb0, 0xf5, 0x95, 0xaf, 0xfd, 0x15, 0xbc, 0x8a, 0xe1, 0x15, 0x30, 0x21, 0x94,
-	0xf0, 0x3a, 0x67, 0x30, 0xbd, 0xc3, 0xde, 0xc1, 0xe7, 0x3f, 0xc1, 0x6b, 0x6c, 0x1b, 0xea, 0x0a,
-	0x94, 0x50, 0xca, 0xdd, 0xff, 0x5c, 0x80, 0xf6, 0xec, 0xcb, 0x2b, 0x1e, 0x2d, 0xe6, 0xf5, 0x36,
-	0xbb, 0xc7, 0xe8, 0xc7, 0x58, 0xdd, 0x51, 0x28, 0x2c, 0xf1, 0xc2, 0xe5, 0x25, 0xce, 0x25, 0xdc,
-	0x72, 0x31, 0xe1, 0xa6, 0x9a, 0xb3, 0x64, 0xad, 0x35, 0x63, 0x9e, 0x7e, 0x72, 0x29, 0x9d, 0xdf,
-	0xb0, 0x91, 0x3e, 0x93, 0xef, 0xdf, 0x07, 0x60, 0xc2, 0x8e, 0x38, 0x9b, 0x38, 0x7c, 0x9a, 0x3c,
-	0x8c, 0x31, 0xf1, 0x42, 0x13, 0x94, 0x0d, 0xc2, 0x8e, 0x03, 0xf6, 0x3a, 0xa6, 0xa6, 0x7d, 0x50,
-	0x61, 0xe2, 0x44, 0x8d, 0x55, 0x16, 0x13, 0xfa, 0x0d, 0x2b, 0xa9, 0x7a, 0x98, 0x50, 0x6f, 0x52,
-	0x33, 0x05, 0x53, 0xf5, 0x52, 0xc1, 0x84, 0xd3, 0xaa, 0x6f, 0x53, 0xe1, 0x65, 0x9e, 0x57, 0x15,
-	0x45, 0x25, 0xed, 0xff, 0x2e, 0x41, 0xb3, 0xf8, 0x1c, 0x7d, 0xf5, 0x3a, 0x5f, 0x9f, 0xab, 0xd3,
-	0x74, 0x5b, 0x2e, 0xa6, 0x5b, 0xb3, 0xf5, 0x67, 0x73, 0xb5, 0xce, 0xb6, 0xc9, 0x36, 0xbc, 0x36,
-	0x21, 0x5f, 0x4a, 0x32, 0x2b, 0xd7, 0x27, 0x99, 0xca, 0x6c, 0x92, 0xe9, 0xfe, 0x49, 0x19, 0xd6,
-	0xe6, 0x3c, 0x97, 0x63, 0x14, 0x65, 0x0f, 0xef, 0xd9, 0x46, 0x4d, 0x1f, 0xd1, 0xf5, 0x43, 0x9b,
-	0xef, 0x04, 0xe3, 0xd8, 0x19, 0xa7, 0xf5, 0x4d, 0x32, 0xc6, 0x4b, 0xb9, 0x79, 0x2e, 0xd1, 0x41,
-	0x94, 0xbc, 0x86, 0xe0, 0xa2, 0xe9, 0xb7, 0x8c, 0x21, 0x4b, 0xda, 0x7a, 0x55, 0x4d, 0x79, 0xcc,
-	0x82, 0xdc, 0x5d, 0x7e, 0xb9, 0xf0, 0xa2, 0x78, 0x0b, 0x96, 0x39, 0x15, 0xb1, 0x2f, 0xcd, 0x09,
-	0x6d, 0x46, 0xe4, 0x3d, 0xa8, 0x3a, 0xe3, 0x31, 0xa7, 0xe3, 0xa4, 0xbf, 0x59, 0xb1, 0x32, 0x02,
-	0x4a, 0xbd, 0x61, 0x81, 0x17, 0xbe, 0x31, 0x95, 0xac, 0x19, 0x61, 0x11, 0x2e, 0xa8, 0x1b, 0x73,
-	0x26, 0xa7, 0xfa, 0xd2, 0x41, 0xb9, 0x79, 0xfc, 0x6a, 0x25, 0xf4, 0x3d, 0x4d, 0xc6, 0x09, 0x7c,
-	0xea, 0x9c, 0x45, 0x3c, 0x54, 0x4f, 0x99, 0x6a, 0x82, 0x94, 0xa0, 0xbe, 0x52, 0x72, 0xe6, 0x4a,
-	0x53, 0xb1, 0x9a, 0x11, 0xf9, 0x00, 0x6a, 0x9c, 0xca, 0x98, 0x07, 0xc2, 0x16, 0x54, 0xaa, 0x9b,
-	0x67, 0xc5, 0x02, 0x43, 0x3a, 0xa6, 0x12, 0x97, 0xee, 0x3c, 0xc4, 0xfd, 0xe8, 0xeb, 0xfb, 0x66,
-	0xd5, 0x4a, 0xc7, 0xdd, 0x3f, 0x2a, 0xc1, 0xea, 0xa5, 0x7f, 0x31, 0xb8, 0x89, 0x3f, 0xfe, 0x57,
-	0x0d, 0x8c, 0xbb, 0x50, 0x15, 0xd4, 0x1f, 0x69, 0xee, 0xa2, 0xe2, 0x56, 0x90, 0x80, 0xcc, 0xe1,
-	0xb2, 0xca, 0x95, 0x8f, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x17, 0x58, 0xbb, 0x86, 0xc0, 0x2b,
-	0x00, 0x00,
+	// 7070 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x7c, 0x4d, 0x73, 0x24, 0x49,
+	0x96, 0xd0, 0xa6, 0x52, 0x1f, 0x99, 0x2f, 0x3f, 0x94, 0x72, 0xa9, 0xaa, 0xa2, 0x54, 0xd5, 0xdd,
+	0xea, 0xec, 0x9e, 0x69, 0xcd, 0x4c, 0x77, 0xcd, 0xd0, 0xbd, 0xcc, 0xce, 0xcc, 0xda, 0xec, 0xac,
+	0x4a, 0x52, 0x75, 0xa9, 0x5b, 0x5f, 0x1b, 0x92, 0xaa, 0xa6, 0x7b, 0x81, 0xb0, 0xc8, 0x0c, 0xcf,
+	0xcc, 0x58, 0x45, 0x46, 0x64, 0x85, 0x47, 0xe8, 0xa3, 0xf8, 0x1a, 0x76, 0xf9, 0x58, 0x8c, 0x03,
+	0xc6, 0x19, 0x83, 0xcb, 0x5e, 0xd6, 0xb8, 0xec, 0x1e, 0xb0, 0x05, 0x0e, 0x18, 0x18, 0x07, 0x0c,
+	0x16, 0xdb, 0x0b, 0x6b, 0x83, 0x19, 0x66, 0xcb, 0x0e, 0x30, 0xb0, 0x0c, 0x07, 0x7e, 0x01, 0x07,
+	0xb0, 0xf7, 0xdc, 0x3d, 0xc2, 0x23, 0x95, 0x4a, 0x65, 0xf7, 0x5c, 0xaa, 0xd2, 0xdf, 0x57, 0x3c,
+	0xf7, 0xe7, 0x1f, 0xcf, 0xdf, 0x7b, 0x2e, 0x58, 0xed, 0xa5, 0x41, 0xe0, 0x88, 0xd0, 0x1d, 0x89,
+	0x41, 0x94, 0x3c, 0x19, 0xc5, 0x51, 0x12, 0xb1, 0xd5, 0x51, 0xdf, 0x0d, 0xdd, 0xe0, 0xfa, 0x35,
+	0x7f, 0xd2, 0x8d, 0x82, 0x80, 0x77, 0x93, 0x28, 0x5e, 0x7f, 0xab, 0x1f, 0x45, 0xfd, 0x80, 0x7f,
+	0x93, 0x48, 0x3a, 0x69, 0xef, 0x9b, 0x89, 0x3f, 0xe4, 0x22, 0x71, 0x87, 0x23, 0xc9, 0xb5, 0x5e,
+	0x17, 0x03, 0x37, 0xe6, 0x9e, 0x6c, 0xb5, 0xff, 0xe9, 0x3b, 0x50, 0x7f, 0x96, 0x06, 0xc1, 0x89,
+	0x12, 0xcd, 0x7e, 0x11, 0xee, 0xeb, 0xcf, 0x38, 0x17, 0x3c, 0x16, 0x7e, 0x14, 0x3a, 0x43, 0xf7,
+	0x37, 0xa2, 0xd8, 0x2a, 0x6d, 0x94, 0x36, 0x17, 0xec, 0x35, 0x8d, 0x7d, 0x21, 0x91, 0x07, 0x88,
+	0x9b, 0xcc, 0xe5, 0x87, 0x51, 0x6c, 0xcd, 0x4d, 0xe6, 0x42, 0x1c, 0xfb, 0x06, 0xac, 0x64, 0x8a,
+	0x6b, 0x36, 0xab, 0xbc, 0x51, 0xda, 0xac, 0xda, 0xad, 0x0c, 0xa1, 0x38, 0xd8, 0x1b, 0x00, 0x3d,
+	0xd7, 0x0f, 0xb8, 0xe7, 0xc4, 0x69, 0x68, 0xcd, 0x6f, 0x94, 0x36, 0x2b, 0x76, 0x55, 0x42, 0xec,
+	0x34, 0x64, 0xef, 0x40, 0x23, 0xd3, 0x20, 0x4d, 0x7d, 0xcf, 0x02, 0x92, 0x53, 0xd7, 0xc0, 0xb3,
+	0xd4, 0xf7, 0xd8, 0xf7, 0xa1, 0xae, 0xe4, 0x72, 0xcf, 0x71, 0x13, 0xab, 0xb6, 0x51, 0xda, 0xac,
+	0x7d, 0xb8, 0xfe, 0x44, 0x8e, 0xd9, 0x13, 0x3d, 0x66, 0x4f, 0x4e, 0xf5, 0x98, 0xd9, 0xb5, 0x8c,
+	0x7e, 0x2b, 0x61, 0xdf, 0x86, 0x07, 0x39, 0xbb, 0x1f, 0x26, 0x3c, 0xbe, 0x70, 0x03, 0x47, 0xf0,
+	0xae, 0xb0, 0xea, 0x1b, 0xa5, 0xcd, 0x86, 0x7d, 0x2f, 0x43, 0xef, 0x29, 0xec, 0x09, 0xef, 0x0a,
+	0xf6, 0x36, 0xd4, 0x5f, 0xa5, 0x3c, 0xbe, 0x76, 0x44, 0x94, 0xc6, 0x5d, 0x6e, 0x35, 0x48, 0xb5,
+	0x1a, 0xc1, 0x4e, 0x08, 0xc4, 0x76, 0x61, 0x31, 0x70, 0x3b, 0x3c, 0x10, 0x56, 0x73, 0xa3, 0xbc,
+	0x59, 0xfb, 0xf0, 0x83, 0x27, 0x13, 0x8c, 0xfb, 0xc4, 0xb4, 0xd4, 0x93, 0x7d, 0xa2, 0xdf, 0x0d,
+	0x93, 0xf8, 0xda, 0x56, 0xcc, 0xec, 0x6b, 0xd0, 0x12, 0xdd, 0x01, 0x1f, 0xba, 0x4e, 0x1a, 0x76,
+	0x07, 0x6e, 0xd8, 0xe7, 0x9e, 0xb5, 0x4c, 0x43, 0xb5, 0x2c, 0xe1, 0x67, 0x1a, 0xcc, 0xde, 0x82,
+	0x9a, 0x22, 0x1d, 0xb8, 0x62, 0x60, 0xb5, 0x48, 0x27, 0x90, 0xa0, 0xe7, 0xae, 0x18, 0xb0, 0x6f,
+	0xc1, 0x5a, 0x2f, 0x8a, 0xbb, 0xdc, 0x73, 0x0a, 0x93, 0xcf, 0x5a, 0x21, 0x79, 0x4c, 0xe2, 0x0a,
+	0x73, 0xe7, 0x87, 0xb0, 0x9a, 0xdb, 0x53, 0x24, 0x6e, 0xe2, 0x8b, 0xc4, 0xef, 0x5a, 0x6b, 0x34,
+	0xca, 0xef, 0x4d, 0xec, 0xd1, 0xb6, 0xfe, 0x75, 0xa2, 0xc9, 0x6d, 0xd6, 0xbd, 0x01, 0xc3, 0x7e,
+	0xe5, 0x92, 0x79, 0x1c, 0x47, 0xb1, 0xb0, 0xee, 0x6d, 0x94, 0x37, 0xab, 0xf6, 0x72, 0x06, 0xdf,
+	0x25, 0x30, 0x0b, 0xe0, 0x91, 0x02, 0xe1, 0x24, 0x14, 0xfa, 0xff, 0xc4, 0x4d, 0x52, 0xc1, 0x85,
+	0x75, 0x9f, 0x86, 0xf7, 0xfd, 0x69, 0xca, 0xf8, 0x51, 0x78, 0xa2, 0xfe, 0x23, 0x2e, 0xfb, 0x61,
+	0x77, 0x32, 0x82, 0x0b, 0xf6, 0x11, 0x2c, 0x8a, 0x6b, 0x91, 0xf0, 0xa1, 0xe5, 0x51, 0x2f, 0x1f,
+	0x4d, 0x14, 0x7c, 0x42, 0x24, 0xb6, 0x22, 0x65, 0x47, 0xd0, 0x1a, 0x45, 0x22, 0xe9, 0xc7, 0x5c,
+	0x64, 0xd3, 0x9e, 0x13, 0xfb, 0xbb, 0x13, 0xd9, 0x8f, 0x15, 0xb1, 0x5a, 0x0a, 0xf6, 0xf2, 0xa8,
+	0x08, 0x60, 0x9f, 0xc2, 0x72, 0x1c, 0x05, 0xdc, 0x89, 0x79, 0x8f, 0xc7, 0x3c, 0xec, 0x72, 0x61,
+	0xf5, 0xa8, 0x9f, 0xed, 0x89, 0xf2, 0xec, 0x28, 0xe0, 0xb6, 0x26, 0xb5, 0x9b, 0xb1, 0xd9, 0x14,
+	0xec, 0x25, 0xac, 0x7a, 0x6e, 0xe2, 0x76, 0x5c, 0x51, 0x10, 0xd8, 0x27, 0x81, 0x5f, 0x9d, 0x28,
+	0x70, 0x47, 0xd1, 0xe7, 0x42, 0x99, 0x37, 0x0e, 0x12, 0xec, 0xd7, 0x60, 0x85, 0xb4, 0xf4, 0xc3,
+	0x5e, 0x14, 0x0f, 0x5d, 0x1c, 0x47, 0x61, 0x85, 0x1b, 0xe5, 0x5b, 0xfb, 0x8d, 0x7a, 0xee, 0xe5,
+	0xc4, 0x76, 0x2b, 0x2e, 0x02, 0x04, 0xfb, 0x8b, 0x70, 0x2f, 0xd3, 0xb5, 0x20, 0x36, 0x22, 0xb1,
+	0x9b, 0x53, 0xb5, 0x35, 0x45, 0xaf, 0x79, 0x37, 0x81, 0x82, 0x7d, 0x07, 0x2a, 0x82, 0x27, 0x89,
+	0x1f, 0xf6, 0x85, 0xf5, 0x9a, 0x24, 0x3e, 0x9e, 0x6c, 0x5f, 0x49, 0x64, 0x67, 0xd4, 0xec, 0x29,
+	0xd4, 0x62, 0x3e, 0x0a, 0xfc, 0x2e, 0x49, 0xb2, 0xfe, 0x32, 0x59, 0x77, 0x63, 0x72, 0x2f, 0x73,
+	0x3a, 0xdb, 0x64, 0x62, 0x1e, 0x58, 0x1d, 0xb7, 0x7b, 0xce, 0x43, 0xcf, 0xe9, 0x46, 0x69, 0x98,
+	0xe4, 0x4b, 0x4a, 0x58, 0x7f, 0x85, 0xb4, 0xf9, 0xfa, 0x44, 0x81, 0x4f, 0x25, 0xd3, 0x36, 0xf2,
+	0xe4, 0xcb, 0xea, 0x7e, 0x67, 0x12, 0x18, 0x87, 0x90, 0xc5, 0xbc, 0x1b, 0x5d, 0xe0, 0xfe, 0xd4,
+	0x8d, 0xc2, 0x5e, 0xe0, 0x77, 0x13, 0x61, 0xfd, 0x55, 0x92, 0xff, 0xe4, 0x16, 0x85, 0x25, 0xf9,
+	0xb6, 0xa2, 0xce, 0xbf, 0xb1, 0x12, 0x8f, 0xa1, 0x04, 0xdb, 0x86, 0xfa, 0xd5, 0xd0, 0x0f, 0x9d,
+	0x41, 0x14, 0xfb, 0xaf, 0xa3, 0xd0, 0xfa, 0x6b, 0x53, 0x46, 0xe2, 0x87, 0x43, 0x3f, 0x7c, 0x2e,
+	0xe9, 0xec, 0xda, 0x55, 0xde, 0x60, 0x3f, 0x00, 0xb8, 0x8c, 0xdd, 0x91, 0x1b, 0x47, 0x69, 0xe8,
+	0x59, 0x7f, 0x9d, 0x44, 0xbc, 0x35, 0x51, 0xc4, 0xcb, 0x8c, 0xcc, 0x36, 0x58, 0xd8, 0x67, 0xb0,
+	0xea, 0xa6, 0x49, 0x74, 0xe1, 0x76, 0xd3, 0x74, 0xe8, 0xf4, 0xa2, 0x98, 0x77, 0x5d, 0x91, 0x58,
+	0x7f, 0xab, 0x34, 0x65, 0x6b, 0xda, 0xca, 0x18, 0x9e, 0x29, 0x7a, 0x9b, 0xb9, 0x37, 0x60, 0x28,
+	0x3a, 0x88, 0xfa, 0x7e, 0xd7, 0x0d, 0x1c, 0xd3, 0xe2, 0x3f, 0x9a, 0x26, 0x7a, 0x5f, 0x32, 0x98,
+	0x96, 0x67, 0xc1, 0x0d, 0x18, 0xdb, 0x87, 0x65, 0x11, 0xc4, 0xa9, 0x69, 0xf7, 0xbf, 0x51, 0x9a,
+	0xb2, 0xae, 0x4f, 0x82, 0x38, 0xcd, 0x8d, 0xd1, 0x14, 0x66, 0x53, 0xb0, 0xbf, 0x04, 0xf7, 0x12,
+	0xb7, 0x13, 0x70, 0x31, 0x72, 0xbb, 0x85, 0x95, 0xfd, 0x9b, 0xa5, 0x29, 0x8b, 0xe5, 0x34, 0x63,
+	0xc9, 0x17, 0xf7, 0x5a, 0x72, 0x13, 0x28, 0x98, 0x07, 0x0f, 0x0c, 0xf9, 0x85, 0xd5, 0xf8, 0x5b,
+	0xa5, 0x29, 0xd3, 0x35, 0xff, 0x82, 0xb9, 0x20, 0xef, 0x27, 0x93, 0xc0, 0x82, 0x7d, 0x0e, 0x6b,
+	0x38, 0x1c, 0x7c, 0xc8, 0xd5, 0x82, 0x10, 0xf4, 0x29, 0xeb, 0x6f, 0x4e, 0x1b, 0xef, 0x13, 0xcd,
+	0x81, 0x3f, 0x04, 0xca, 0xb3, 0x99, 0xb8, 0x01, 0x63, 0x2f, 0x80, 0x75, 0xfa, 0x97, 0xb1, 0x9f,
+	0x70, 0xf3, 0xf8, 0xfa, 0xdb, 0x52, 0xf2, 0xe4, 0x9d, 0xef, 0xa9, 0xa2, 0x37, 0xd6, 0x40, 0x67,
+	0x1c, 0xc4, 0xbe, 0x07, 0xd5, 0x41, 0xc7, 0x75, 0xe2, 0x34, 0xe0, 0xc2, 0xfa, 0x3b, 0xa5, 0x29,
+	0x1b, 0xc9, 0xf3, 0x8e, 0x6b, 0xa7, 0x01, 0xb7, 0x2b, 0x03, 0xf9, 0x43, 0xb0, 0xbf, 0x00, 0x6b,
+	0x19, 0xda, 0x19, 0xc5, 0xfe, 0x85, 0x1f, 0xf0, 0x3e, 0x17, 0xd6, 0x6f, 0x4b, 0xad, 0x36, 0xa7,
+	0x9f, 0xaa, 0xc7, 0x19, 0x83, 0xbd, 0xda, 0xbd, 0x09, 0x64, 0x0e, 0xdc, 0xeb, 0x46, 0x61, 0xa8,
+	0x0f, 0x49, 0x37, 0x49, 0x63, 0x39, 0x7d, 0xff, 0xae, 0x14, 0xff, 0xb5, 0x5b, 0xc4, 0x6b, 0x96,
+	0x93, 0x8c, 0xc3, 0x5e, 0xeb, 0x4e, 0x80, 0xe2, 0x51, 0x27, 0x5d, 0x1f, 0x63, 0xbe, 0xfd, 0x3b,
+	0x39, 0x02, 0xef, 0x4c, 0x94, 0xfd, 0x6b, 0x48, 0x9d, 0x4f, 0xb5, 0xe5, 0x57, 0x85, 0xb6, 0x40,
+	0x1f, 0x23, 0xe6, 0x01, 0x09, 0x37, 0x65, 0xfe, 0xfb, 0xd2, 0x94, 0xe3, 0xc9, 0x56, 0x0c, 0xb9,
+	0x58, 0x16, 0x8f, 0x83, 0x04, 0xaa, 0xea, 0x87, 0x1e, 0xbf, 0x32, 0xc5, 0xfe, 0xe1, 0x34, 0x55,
+	0xf7, 0x90, 0xda, 0x50, 0xd5, 0x2f, 0xb4, 0x49, 0xd5, 0x5e, 0x1a, 0x76, 0xc7, 0x55, 0xfd, 0x0f,
+	0xd3, 0x54, 0x7d, 0xa6, 0x18, 0x0c, 0x55, 0x7b, 0xe3, 0x20, 0xc1, 0xce, 0x80, 0xc9, 0x51, 0x2d,
+	0xac, 0xb2, 0xff, 0x28, 0x05, 0x7f, 0xe5, 0xf6, 0x71, 0x35, 0x17, 0xd8, 0xca, 0xab, 0x31, 0x88,
+	0xc8, 0x8d, 0x65, 0x6c, 0x38, 0x7f, 0x7c, 0xa7, 0xb1, 0xf2, 0xa9, 0xbf, 0xfc, 0xaa, 0xd0, 0x16,
+	0xcc, 0x87, 0x87, 0x03, 0x5f, 0x24, 0x51, 0xec, 0x77, 0x9d, 0x1b, 0x92, 0x7f, 0x5c, 0x9a, 0xe2,
+	0x8a, 0x3d, 0x57, 0x6c, 0xc5, 0x2f, 0x08, 0xfb, 0xc1, 0x60, 0x32, 0x82, 0x9d, 0x42, 0x53, 0x7e,
+	0x81, 0x5f, 0x8d, 0x02, 0xd7, 0x0f, 0x85, 0xf5, 0x9f, 0xa6, 0xc9, 0x27, 0xf6, 0x5d, 0x49, 0x6a,
+	0x8e, 0x4a, 0xe3, 0x95, 0x81, 0xa0, 0x3d, 0x33, 0x9b, 0x6d, 0x85, 0xb1, 0xfe, 0x93, 0x69, 0x7b,
+	0xa6, 0x9e, 0x6f, 0x05, 0x07, 0x23, 0xbe, 0x09, 0x2c, 0xce, 0x66, 0x63, 0x68, 0xfe, 0xcb, 0x2c,
+	0xb3, 0xd9, 0xf0, 0x98, 0xe3, 0x71, 0x90, 0xc0, 0xb3, 0x23, 0x93, 0xcc, 0x2f, 0x78, 0x98, 0x08,
+	0xeb, 0x27, 0xd3, 0xce, 0x0e, 0x2d, 0x75, 0x17, 0x69, 0xed, 0x66, 0x6c, 0x36, 0x69, 0xc2, 0xc9,
+	0xb5, 0x51, 0x18, 0x84, 0xff, 0x3a, 0x6d, 0xc2, 0xd1, 0xea, 0x28, 0x4c, 0x38, 0x7f, 0x0c, 0x62,
+	0x2c, 0x39, 0xa3, 0xef, 0xff, 0xed, 0xce, 0x25, 0x67, 0x4c, 0x38, 0xbf, 0xd0, 0x26, 0x7b, 0x65,
+	0x4b, 0xae, 0xa0, 0xea, 0x4f, 0xa7, 0xd9, 0x4b, 0x2f, 0xba, 0x82, 0xbd, 0x7a, 0x37, 0x81, 0xc5,
+	0x25, 0x6d, 0xe8, 0xfc, 0x3f, 0x66, 0x59, 0xd2, 0x86, 0xbd, 0x7a, 0xe3, 0x20, 0xc1, 0x2e, 0xe1,
+	0xcd, 0xa1, 0x9b, 0xf0, 0xd8, 0x77, 0x03, 0xff, 0x35, 0xf7, 0x9c, 0x0b, 0x9f, 0x5f, 0x16, 0xbb,
+	0xf0, 0x67, 0xf2, 0x23, 0xdf, 0x9a, 0xf8, 0x91, 0x03, 0x83, 0xf7, 0x85, 0xcf, 0x2f, 0xcd, 0xae,
+	0x3c, 0x1e, 0xde, 0x8e, 0x24, 0xaf, 0xdc, 0x4b, 0xa5, 0xcf, 0x81, 0xa7, 0xb6, 0xe7, 0xe3, 0x1e,
+	0xf5, 0xbf, 0xa6, 0x19, 0x61, 0x47, 0x93, 0xcb, 0x0d, 0xb0, 0xe5, 0x19, 0x6d, 0xe4, 0x66, 0xe7,
+	0xf0, 0x68, 0xe8, 0x0b, 0xe1, 0x87, 0x7d, 0x72, 0xb5, 0xfc, 0x7e, 0xe8, 0x9c, 0xf3, 0xeb, 0x4c,
+	0xf8, 0xcf, 0xa4, 0xf0, 0x6f, 0x4c, 0xee, 0x88, 0x64, 0x7c, 0x26, 0xf9, 0x3e, 0xe5, 0xd7, 0xf2,
+	0x23, 0xd6, 0x70, 0x02, 0x9c, 0x3e, 0x76, 0x60, 0x4c, 0x74, 0x75, 0x85, 0xfe, 0xdf, 0xd3, 0xb4,
+	0xd7, 0x13, 0x5d, 0x5e, 0x9f, 0xf3, 0x99, 0x2e, 0xdb, 0xec, 0x00, 0xea, 0x9d, 0xb4, 0xd7, 0xe3,
+	0xb1, 0xd3, 0x75, 0xbb, 0x03, 0x6e, 0xfd, 0xcf, 0x69, 0x07, 0xe1, 0x53, 0xa2, 0xdc, 0x46, 0xc2,
+	0xdc, 0xba, 0xb5, 0x4e, 0x0e, 0x5d, 0xff, 0x2e, 0xd4, 0x8c, 0x7b, 0x3a, 0x6b, 0x41, 0xf9, 0x9c,
+	0x5f, 0x53, 0x28, 0xa5, 0x6a, 0xe3, 0x4f, 0xb6, 0x06, 0x0b, 0x17, 0x6e, 0x90, 0x72, 0x0a, 0x94,
+	0x54, 0x6d, 0xd9, 0xf8, 0xde, 0xdc, 0x77, 0x4a, 0x9f, 0xcc, 0x57, 0xae, 0x5a, 0xd7, 0x9f, 0xcc,
+	0x57, 0xae, 0x5b, 0xaf, 0x3f, 0x59, 0xac, 0xfc, 0x69, 0xa9, 0xf5, 0x93, 0xd2, 0x27, 0x8b, 0x95,
+	0xff, 0x5e, 0x6a, 0xfd, 0xb4, 0xd4, 0xfe, 0x07, 0x25, 0x78, 0x70, 0xcb, 0x6d, 0x95, 0x31, 0x98,
+	0x0f, 0xdd, 0x21, 0x57, 0x1f, 0xa1, 0xdf, 0xac, 0x09, 0x73, 0xd1, 0x39, 0x7d, 0xa2, 0x62, 0xcf,
+	0x45, 0xe7, 0xf8, 0x55, 0xba, 0x45, 0xab, 0x68, 0x8b, 0x6c, 0x60, 0x48, 0xc0, 0x53, 0x07, 0xb7,
+	0x33, 0x14, 0x14, 0x63, 0x29, 0xd9, 0xa0, 0x41, 0x07, 0x82, 0x3d, 0x82, 0x2a, 0x86, 0x93, 0x3c,
+	0x27, 0x4a, 0x13, 0x6b, 0x81, 0xa4, 0x55, 0x08, 0x70, 0x94, 0x26, 0xed, 0x7f, 0x3b, 0x07, 0xec,
+	0xe6, 0x75, 0x1e, 0xe3, 0x36, 0xfd, 0x28, 0xbb, 0xe6, 0xca, 0xa8, 0x4c, 0xb5, 0x1f, 0xe9, 0xab,
+	0xeb, 0xf7, 0xe1, 0xd1, 0x90, 0x0f, 0xa3, 0xf8, 0xda, 0x19, 0x70, 0x77, 0xe4, 0xb8, 0x41, 0x10,
+	0xe1, 0x54, 0xf2, 0x9c, 0xce, 0x75, 0xc2, 0x05, 0x85, 0x4a, 0xe6, 0x6d, 0x4b, 0x92, 0x3c, 0xe7,
+	0xee, 0x68, 0x4b, 0x13, 0x3c, 0x45, 0x3c, 0x7b, 0x02, 0xab, 0x26, 0x7b, 0xd4, 0xf9, 0x0d, 0x8e,
+	0xd7, 0x97, 0x26, 0xb1, 0xad, 0xe4, 0x6c, 0x47, 0x12, 0x61, 0xd0, 0xcb, 0xbb, 0xb8, 0xfa, 0xcc,
+	0xb2, 0x49, 0x2f, 0x6f, 0xeb, 0x52, 0xfe, 0x26, 0xb4, 0x14, 0x7d, 0x2c, 0x84, 0x22, 0x6e, 0x11,
+	0x71, 0x53, 0xc2, 0x6d, 0x21, 0x24, 0xe5, 0x37, 0x60, 0xc5, 0xed, 0x26, 0xfe, 0x05, 0x77, 0xfa,
+	0x51, 0x1c, 0xa5, 0x89, 0x1f, 0x72, 0x41, 0xa1, 0x8f, 0x05, 0xbb, 0x25, 0x11, 0x1f, 0x67, 0x70,
+	0x1c, 0xc8, 0x6e, 0x3f, 0x72, 0xba, 0x6e, 0x10, 0x08, 0xeb, 0xcd, 0x8d, 0xd2, 0x66, 0xd9, 0xae,
+	0x74, 0xfb, 0xd1, 0x36, 0xb6, 0xdb, 0xbf, 0x5f, 0x86, 0xe5, 0xb1, 0xab, 0x2f, 0x7b, 0x08, 0x15,
+	0x79, 0x77, 0xf6, 0xae, 0x54, 0x20, 0x6e, 0x09, 0xdb, 0x7b, 0xde, 0x15, 0xb3, 0x60, 0xc9, 0x0f,
+	0x07, 0x3c, 0xf6, 0x13, 0x65, 0x60, 0xdd, 0x44, 0x2b, 0xe3, 0xad, 0x42, 0xc6, 0xd4, 0x2a, 0xb6,
+	0x6c, 0xd0, 0xb7, 0x63, 0x8e, 0xab, 0xdd, 0xeb, 0xa8, 0x38, 0x5a, 0x45, 0x02, 0x76, 0x3a, 0x38,
+	0x05, 0x14, 0x12, 0xc5, 0x2b, 0x1b, 0x83, 0x04, 0xa1, 0x4e, 0x68, 0x4e, 0x91, 0x8e, 0x78, 0xec,
+	0xa4, 0x82, 0xc7, 0xd6, 0x22, 0xe1, 0xab, 0x04, 0x39, 0x13, 0x3c, 0x66, 0x1b, 0xc5, 0x7b, 0xef,
+	0x12, 0xe1, 0x4d, 0x10, 0x0a, 0xe8, 0x5c, 0x8f, 0x5c, 0x21, 0x9c, 0x38, 0x10, 0x56, 0x45, 0x0a,
+	0x90, 0x10, 0x5b, 0x46, 0xb0, 0x0c, 0x8f, 0x34, 0xf0, 0x87, 0x7e, 0x62, 0x55, 0xa9, 0xc3, 0xcb,
+	0x39, 0x7c, 0x1f, 0xc1, 0xec, 0x14, 0xd6, 0x90, 0xeb, 0x32, 0x8a, 0x3d, 0xe7, 0xc2, 0x0d, 0x7c,
+	0xcf, 0x49, 0xc3, 0xc4, 0x0f, 0x68, 0x8e, 0xdd, 0x76, 0xcc, 0x1d, 0xa6, 0x41, 0x90, 0x47, 0xf7,
+	0x98, 0xe6, 0x7f, 0x81, 0xec, 0x67, 0xc8, 0xcd, 0xee, 0xc3, 0x22, 0x5e, 0x83, 0xfd, 0xbe, 0x55,
+	0xa3, 0x00, 0x93, 0x6a, 0xe1, 0xb0, 0x0d, 0xf9, 0xb0, 0xc3, 0x63, 0x27, 0xea, 0x59, 0xf5, 0x8d,
+	0xf2, 0xe6, 0x82, 0x5d, 0x91, 0x80, 0xa3, 0x5e, 0xfb, 0x9f, 0x97, 0x61, 0x75, 0x42, 0x58, 0x01,
+	0x23, 0x7f, 0x79, 0x7c, 0x22, 0x33, 0x5d, 0x4d, 0xc3, 0xd0, 0x7c, 0xef, 0x42, 0x33, 0xba, 0x0c,
+	0x79, 0xec, 0x64, 0xf6, 0x95, 0x21, 0xd3, 0x3a, 0x41, 0x6d, 0x65, 0xe4, 0x75, 0xa8, 0xf0, 0xb0,
+	0x1b, 0x79, 0x7e, 0xd8, 0x57, 0x6b, 0x36, 0x6b, 0xe3, 0x04, 0xc0, 0x0e, 0xba, 0x09, 0x27, 0x73,
+	0x56, 0x6d, 0xdd, 0x64, 0xf7, 0x60, 0xb1, 0xeb, 0x24, 0xd7, 0x23, 0x69, 0xc8, 0xaa, 0xbd, 0xd0,
+	0x3d, 0xbd, 0x1e, 0x71, 0x34, 0xb2, 0x2f, 0x9c, 0x84, 0x0f, 0x47, 0xc4, 0x24, 0x8d, 0x08, 0xbe,
+	0x38, 0x55, 0x10, 0x9a, 0xcb, 0x41, 0x10, 0x5d, 0x3a, 0xf9, 0x90, 0x0b, 0x65, 0xcb, 0x16, 0x21,
+	0xf2, 0x1b, 0xc0, 0x64, 0x8b, 0x55, 0x26, 0x5b, 0x0c, 0x63, 0xb8, 0x71, 0xf4, 0x9a, 0x87, 0xce,
+	0x95, 0xef, 0x91, 0x59, 0x1b, 0x76, 0x55, 0x42, 0x7e, 0xe8, 0x7b, 0xec, 0x43, 0xb8, 0x37, 0xf4,
+	0x43, 0x7f, 0x98, 0x0e, 0x9d, 0x61, 0x1a, 0x24, 0xfe, 0x95, 0xdb, 0x4d, 0x88, 0x12, 0x88, 0x72,
+	0x55, 0x21, 0x0f, 0x34, 0x0e, 0x79, 0x7e, 0x00, 0x8f, 0xf3, 0x98, 0x2c, 0x6e, 0x0d, 0x81, 0xd3,
+	0x75, 0x13, 0x37, 0x88, 0xfa, 0x0e, 0x8e, 0x32, 0x85, 0x78, 0x2b, 0x59, 0x04, 0x8f, 0x7b, 0xfb,
+	0x48, 0xb2, 0x2d, 0x29, 0xd0, 0x62, 0xed, 0x3f, 0x28, 0xc3, 0x92, 0x8a, 0xdf, 0x4c, 0xdc, 0x3a,
+	0xdf, 0x81, 0x46, 0x37, 0x8d, 0x63, 0xbc, 0x6e, 0x9a, 0x1b, 0x75, 0x5d, 0x01, 0x5f, 0x20, 0x8c,
+	0x7d, 0x04, 0xf3, 0x69, 0xe8, 0x27, 0x56, 0x79, 0x4a, 0x68, 0x02, 0xa7, 0xde, 0x49, 0x12, 0x63,
+	0x9c, 0x88, 0x88, 0xd9, 0xaf, 0x00, 0x74, 0xa2, 0x48, 0x8b, 0x9d, 0x9f, 0x8d, 0xb5, 0x8a, 0x2c,
+	0xf2, 0xa3, 0xbf, 0x8a, 0x6b, 0x4d, 0x70, 0x2d, 0x60, 0x61, 0x36, 0x01, 0x40, 0x3c, 0x52, 0xc2,
+	0x2f, 0xc1, 0xa2, 0x0a, 0x49, 0x2f, 0xce, 0xc6, 0xac, 0xc8, 0xf1, 0xd3, 0xf2, 0x97, 0xd3, 0xf3,
+	0x03, 0x6e, 0x2d, 0xcd, 0xc6, 0x0d, 0x92, 0xe7, 0x99, 0x1f, 0x98, 0x12, 0x02, 0x3f, 0xe4, 0x56,
+	0xe5, 0x0b, 0x49, 0xd8, 0xf7, 0x43, 0xde, 0xfe, 0xd1, 0x02, 0xd4, 0xcc, 0x68, 0x09, 0xce, 0xea,
+	0xd0, 0xd1, 0x11, 0x28, 0xab, 0xa4, 0x66, 0x75, 0xa8, 0xc3, 0x55, 0x38, 0xbd, 0xb4, 0x25, 0xaf,
+	0x70, 0x7e, 0x04, 0x91, 0xda, 0xa5, 0xe4, 0xa1, 0xb4, 0xaa, 0x90, 0x3f, 0x0c, 0xa2, 0xfe, 0xbe,
+	0x42, 0xb1, 0x53, 0x60, 0x22, 0x71, 0x43, 0xaf, 0x53, 0xb8, 0xc1, 0xd6, 0xa6, 0xf8, 0xbd, 0x27,
+	0x92, 0x3c, 0xbf, 0xc0, 0xad, 0x88, 0x31, 0x88, 0x0e, 0x62, 0x90, 0xd4, 0x82, 0x8b, 0x57, 0xdf,
+	0x28, 0x4f, 0x8b, 0x61, 0x20, 0x83, 0xe9, 0xd8, 0xad, 0x8a, 0x1b, 0x30, 0x61, 0x6a, 0x6c, 0x78,
+	0xa8, 0x8d, 0xbb, 0x35, 0x36, 0x42, 0x18, 0x62, 0x0c, 0x42, 0x29, 0x0c, 0x5f, 0x38, 0x22, 0x89,
+	0xb9, 0x3b, 0xc4, 0x3d, 0x68, 0x4d, 0x6e, 0xec, 0xbe, 0x38, 0xd1, 0x20, 0xdc, 0x07, 0x62, 0xde,
+	0xe5, 0x78, 0x02, 0x66, 0x23, 0x7b, 0x8f, 0x46, 0x76, 0x59, 0xc1, 0xb3, 0x51, 0x7d, 0x0f, 0x7d,
+	0xb6, 0x51, 0xe0, 0x5e, 0xe7, 0x94, 0xf7, 0x89, 0xb2, 0x29, 0xc1, 0x19, 0xe1, 0xbb, 0xd0, 0x74,
+	0x47, 0xa3, 0xe0, 0x9a, 0x4e, 0x5e, 0x27, 0x70, 0xfb, 0xd6, 0x03, 0x3a, 0x2c, 0xeb, 0x04, 0xc5,
+	0x83, 0x77, 0xdf, 0xed, 0xb3, 0x5d, 0x68, 0x49, 0x3e, 0x27, 0x4b, 0x76, 0x59, 0xd6, 0x9d, 0xa9,
+	0x1d, 0xa5, 0x42, 0x06, 0xc0, 0x84, 0xc7, 0xb8, 0x18, 0xc7, 0xed, 0x73, 0xeb, 0x21, 0x7d, 0x92,
+	0x8d, 0x91, 0x6f, 0xf5, 0x79, 0xfb, 0x23, 0x68, 0x8d, 0x9b, 0x9b, 0x4e, 0xd0, 0xc0, 0xc7, 0x49,
+	0xe6, 0x7a, 0x5e, 0xac, 0xb6, 0x12, 0x90, 0xa0, 0x2d, 0xcf, 0x8b, 0xdb, 0x7f, 0x32, 0x07, 0xec,
+	0xa6, 0x31, 0x91, 0x2f, 0x9b, 0x13, 0xd9, 0x49, 0x01, 0xda, 0xc2, 0xde, 0x55, 0xc1, 0x05, 0x98,
+	0x2b, 0xba, 0x00, 0x2d, 0x28, 0x8f, 0x7c, 0x8f, 0x76, 0x9f, 0xb2, 0x8d, 0x3f, 0xd1, 0x18, 0xee,
+	0x28, 0x5b, 0x1b, 0x0e, 0xed, 0x6a, 0xf2, 0x70, 0x58, 0x36, 0xe0, 0x87, 0xb8, 0xc1, 0xbd, 0x07,
+	0xcb, 0x4a, 0xe1, 0x41, 0x24, 0x12, 0xa2, 0x94, 0xa7, 0x45, 0x53, 0x82, 0x9f, 0x2b, 0xa8, 0xd1,
+	0xb3, 0x51, 0x14, 0x27, 0xb4, 0x65, 0x2c, 0xe8, 0x9e, 0x1d, 0x47, 0x71, 0xc2, 0x7e, 0x00, 0x0d,
+	0x1d, 0xb0, 0x16, 0x89, 0x1b, 0x27, 0xd6, 0xd2, 0x9d, 0x46, 0xa8, 0x2b, 0x86, 0x13, 0xa4, 0xa7,
+	0x24, 0xde, 0x75, 0xd8, 0xc5, 0x38, 0x57, 0x14, 0xfb, 0xc9, 0xb5, 0x3a, 0x47, 0xea, 0x08, 0x3c,
+	0x56, 0x30, 0xf2, 0x40, 0x90, 0x88, 0x02, 0x78, 0x74, 0x88, 0x54, 0xed, 0x2a, 0x42, 0x28, 0xca,
+	0xd7, 0xfe, 0xd1, 0x5c, 0x66, 0x94, 0xdc, 0x09, 0xbd, 0x73, 0x70, 0xd7, 0x60, 0x41, 0xca, 0x53,
+	0x6e, 0x38, 0x35, 0x48, 0x1f, 0xec, 0x6f, 0x36, 0x4b, 0xcb, 0x2a, 0xa9, 0xc8, 0xc3, 0x24, 0x9b,
+	0xa3, 0x5f, 0x81, 0x26, 0x05, 0xfc, 0x72, 0x2a, 0x39, 0xd0, 0x0d, 0x82, 0x9a, 0x64, 0xbd, 0x20,
+	0x15, 0x83, 0x9c, 0x4c, 0x8e, 0x72, 0x83, 0xa0, 0xd3, 0x96, 0xc6, 0xe2, 0xc4, 0xa5, 0xf1, 0x10,
+	0x2a, 0xd9, 0xa2, 0x58, 0x22, 0xc3, 0x2f, 0x75, 0xe4, 0x7a, 0x68, 0xff, 0xbd, 0x45, 0xb8, 0x37,
+	0x31, 0x09, 0xc0, 0x36, 0xa0, 0x3e, 0x70, 0x85, 0x53, 0x70, 0x25, 0x2b, 0x36, 0x0c, 0x5c, 0xa1,
+	0x1d, 0x8d, 0x29, 0xb3, 0x6c, 0x13, 0x5a, 0xc8, 0x5c, 0x70, 0x68, 0xa4, 0x67, 0xd9, 0x1c, 0xb8,
+	0x62, 0xc7, 0xf0, 0x69, 0xc6, 0xdd, 0x9e, 0xf9, 0x9b, 0x6e, 0xcf, 0x81, 0x1e, 0x70, 0x1c, 0x85,
+	0xe6, 0x87, 0xbf, 0x34, 0x7b, 0x26, 0x43, 0x43, 0x11, 0xc0, 0xb5, 0xa5, 0x3e, 0x03, 0x3d, 0x93,
+	0xa4, 0xbf, 0xb3, 0x48, 0x52, 0xbf, 0xfd, 0xc5, 0xa5, 0xa2, 0x83, 0x64, 0xd7, 0x3a, 0x79, 0x03,
+	0xbb, 0x7d, 0xe9, 0xfa, 0x89, 0xba, 0xcd, 0xa2, 0x59, 0xce, 0x95, 0x2f, 0xd4, 0x54, 0xf0, 0x67,
+	0x51, 0xbc, 0x1f, 0x75, 0xe9, 0x56, 0x45, 0x89, 0x1a, 0x35, 0x6d, 0x65, 0xa3, 0xfd, 0x0f, 0x4b,
+	0x50, 0x37, 0x55, 0x66, 0x2b, 0xd0, 0x38, 0x3b, 0xfc, 0xf4, 0xf0, 0xe8, 0xe5, 0xa1, 0x73, 0x72,
+	0xba, 0x75, 0xba, 0xdb, 0xfa, 0x05, 0x06, 0xb0, 0xb8, 0xb5, 0x7d, 0xba, 0xf7, 0x62, 0xb7, 0x55,
+	0x62, 0x15, 0x98, 0xdf, 0xdb, 0xd9, 0xdf, 0x6d, 0xcd, 0xb1, 0x07, 0xb0, 0x8a, 0xbf, 0x9c, 0xbd,
+	0x43, 0xe7, 0xd4, 0xde, 0x3a, 0x3c, 0x41, 0x92, 0xa3, 0xc3, 0x56, 0x99, 0xbd, 0x05, 0x8f, 0x26,
+	0x20, 0x9c, 0xad, 0xa7, 0x47, 0xf6, 0xe9, 0xee, 0x4e, 0x6b, 0x9e, 0xad, 0xc3, 0xfd, 0x67, 0x5b,
+	0x27, 0xa7, 0xc7, 0x5b, 0xa7, 0xcf, 0x9d, 0x67, 0x67, 0x87, 0x12, 0xbd, 0xbd, 0xb5, 0xbf, 0xdf,
+	0x5a, 0x60, 0x75, 0xa8, 0xec, 0xec, 0x9d, 0x6c, 0x3d, 0xdd, 0xdf, 0xdd, 0x69, 0x2d, 0xb6, 0x7f,
+	0x52, 0x82, 0x9a, 0xd1, 0x75, 0xd6, 0x82, 0xba, 0x56, 0xee, 0xf4, 0xb3, 0x63, 0xd4, 0xed, 0x01,
+	0xac, 0x6e, 0x9d, 0x9d, 0x1e, 0xbd, 0xd8, 0xda, 0x3e, 0x3b, 0x3b, 0x70, 0xf6, 0xb7, 0xce, 0x0e,
+	0xb7, 0x9f, 0xef, 0xda, 0xad, 0x12, 0xbb, 0x07, 0x2b, 0x06, 0xe2, 0xe5, 0x91, 0xfd, 0xe9, 0xae,
+	0xdd, 0x9a, 0x43, 0xf0, 0xd3, 0xad, 0xed, 0x4f, 0x3f, 0xb6, 0x8f, 0xce, 0x0e, 0x77, 0x34, 0xb8,
+	0x3c, 0x0e, 0xb6, 0xf7, 0x4e, 0x77, 0xed, 0xd6, 0x3c, 0x63, 0xd0, 0xdc, 0xde, 0xdf, 0xdb, 0x3d,
+	0x3c, 0x75, 0x10, 0xbb, 0x7b, 0xb8, 0xd3, 0x5a, 0x40, 0x1d, 0xb6, 0x9f, 0xef, 0x6e, 0x7f, 0x7a,
+	0x7c, 0xb4, 0x77, 0x88, 0x54, 0x8b, 0xac, 0x06, 0x4b, 0x27, 0xa7, 0x5b, 0xf6, 0xe9, 0xd9, 0x71,
+	0x6b, 0x89, 0x2d, 0x43, 0xed, 0xe5, 0xd6, 0xbe, 0xbd, 0xbb, 0xbd, 0xbb, 0xf7, 0x62, 0xd7, 0x6e,
+	0x55, 0x58, 0x03, 0xaa, 0x2f, 0xb7, 0xf6, 0x4f, 0x76, 0x0f, 0x77, 0x76, 0xed, 0x56, 0x55, 0x35,
+	0xd5, 0x17, 0xa0, 0xfd, 0xff, 0x4a, 0xf0, 0xf0, 0xd6, 0x94, 0xd5, 0x2c, 0x1e, 0xba, 0x74, 0x70,
+	0x7b, 0x81, 0x93, 0xa7, 0x24, 0x68, 0x69, 0x94, 0xc9, 0xc1, 0xed, 0x05, 0x79, 0x02, 0x03, 0xf7,
+	0x26, 0x49, 0x4a, 0xb3, 0x44, 0xee, 0xc7, 0x55, 0x82, 0xd0, 0x04, 0xf9, 0x0a, 0x34, 0x25, 0x3a,
+	0x4b, 0xa6, 0xcf, 0x13, 0x49, 0x83, 0xa0, 0x59, 0x1e, 0x1d, 0x77, 0x64, 0x22, 0x93, 0x91, 0x84,
+	0x91, 0x2f, 0xf7, 0x8a, 0xb2, 0x2d, 0xb9, 0x9f, 0x6a, 0x68, 0x2e, 0xcf, 0xe3, 0xae, 0x47, 0x9f,
+	0x5c, 0x34, 0xe4, 0xed, 0x28, 0x60, 0xfb, 0x5f, 0x96, 0xa0, 0x51, 0xc8, 0x0d, 0x4d, 0x74, 0x74,
+	0xdf, 0x82, 0x5a, 0x27, 0x38, 0x17, 0xce, 0x6b, 0x1e, 0x47, 0xdc, 0x53, 0x3d, 0x04, 0x04, 0x7d,
+	0x4e, 0x10, 0xda, 0x71, 0x90, 0x60, 0xa0, 0x1c, 0x5d, 0xdc, 0x71, 0x82, 0x73, 0xf1, 0xdc, 0x4f,
+	0xf0, 0x72, 0x44, 0xa8, 0x98, 0xbb, 0x9e, 0xea, 0x13, 0xd1, 0xda, 0xdc, 0xf5, 0x70, 0x88, 0x09,
+	0x89, 0xfb, 0x61, 0xc2, 0x75, 0x5f, 0xe8, 0x63, 0x2f, 0x25, 0x88, 0x3d, 0x86, 0x6a, 0x12, 0xa7,
+	0x61, 0xd7, 0xc5, 0xfb, 0xb5, 0xec, 0x43, 0x0e, 0x68, 0xff, 0xf1, 0x02, 0xac, 0xdc, 0x48, 0xb4,
+	0x50, 0xf5, 0xc8, 0x80, 0x77, 0xcf, 0x47, 0x91, 0x1f, 0x26, 0x82, 0xce, 0x6c, 0x8f, 0x3a, 0x54,
+	0xb6, 0x5b, 0x06, 0x02, 0xcf, 0x1a, 0x8f, 0x86, 0xd4, 0x20, 0x8e, 0xf9, 0x2b, 0xd5, 0xc1, 0xa6,
+	0x01, 0xb6, 0xf9, 0x2b, 0x72, 0x12, 0x33, 0x88, 0x23, 0x37, 0x76, 0x14, 0x4d, 0x3d, 0x2e, 0xd9,
+	0xab, 0x39, 0x12, 0x75, 0xe7, 0x28, 0x1d, 0x1d, 0x07, 0x83, 0x87, 0x0e, 0x27, 0x62, 0x91, 0x01,
+	0x14, 0x96, 0xe3, 0x4e, 0xae, 0xc3, 0x2e, 0x71, 0x7c, 0x00, 0x4c, 0xda, 0x56, 0x38, 0x39, 0x56,
+	0x0d, 0xcc, 0x8a, 0xc2, 0x6c, 0x67, 0x08, 0x3c, 0x87, 0x32, 0xf2, 0x80, 0xbb, 0xa1, 0x1a, 0xa2,
+	0xba, 0xa6, 0x44, 0x18, 0x4e, 0xd3, 0xa1, 0x7b, 0xa5, 0x06, 0x59, 0xd1, 0xc9, 0x83, 0x61, 0x39,
+	0x87, 0x4b, 0xd2, 0xf7, 0x60, 0x59, 0xcb, 0x53, 0x3b, 0x1d, 0x6d, 0x59, 0x65, 0xbb, 0xa9, 0xc0,
+	0x6a, 0x47, 0xc0, 0xd1, 0x18, 0x23, 0x74, 0x7a, 0xd8, 0x3f, 0x3a, 0x76, 0xcb, 0xf6, 0x6a, 0x91,
+	0xfc, 0x19, 0xa2, 0x4c, 0x65, 0x29, 0x9a, 0x63, 0x41, 0x41, 0x59, 0x0a, 0xe0, 0x30, 0x07, 0x1e,
+	0xc5, 0xfc, 0x55, 0xca, 0x05, 0x5e, 0xdb, 0x0a, 0x96, 0xc1, 0xd3, 0xcd, 0xaa, 0xdd, 0x71, 0x1d,
+	0xd8, 0x89, 0xd2, 0x4e, 0xc0, 0xed, 0x87, 0x99, 0x8c, 0x6d, 0xc3, 0x8a, 0x28, 0x81, 0x7d, 0x0e,
+	0x0f, 0xdd, 0x8b, 0xbe, 0x33, 0xd9, 0x96, 0xf5, 0xd9, 0xc4, 0xdf, 0x77, 0x2f, 0xfa, 0xdb, 0x13,
+	0xec, 0xbd, 0x03, 0x6f, 0xf6, 0xe8, 0xcb, 0x61, 0xe2, 0x4c, 0xec, 0x05, 0x85, 0xad, 0x2a, 0xf6,
+	0x63, 0x4d, 0x65, 0x4f, 0x50, 0xb3, 0xfd, 0x6f, 0x16, 0x61, 0x6d, 0x52, 0x26, 0x0d, 0xad, 0x33,
+	0x74, 0xaf, 0x0a, 0x77, 0x6f, 0xb9, 0x2b, 0x35, 0x87, 0xee, 0x95, 0x79, 0xf3, 0x3e, 0x81, 0x25,
+	0x75, 0x67, 0xa1, 0xc9, 0x5c, 0xfb, 0xf0, 0xbb, 0x33, 0xa7, 0xeb, 0x0c, 0xe0, 0x99, 0x70, 0xfb,
+	0xdc, 0xd6, 0x92, 0xd8, 0x01, 0xcc, 0x8f, 0xb8, 0x7b, 0x6e, 0x95, 0x7f, 0x5e, 0x89, 0x24, 0x06,
+	0x77, 0x44, 0xfc, 0x5f, 0xd9, 0x55, 0xae, 0x88, 0x2a, 0x42, 0xa4, 0x99, 0x7e, 0x1d, 0xea, 0x84,
+	0x96, 0x69, 0x9d, 0x6b, 0x6b, 0x81, 0xee, 0x29, 0xdf, 0xf9, 0x32, 0x5f, 0x3d, 0x46, 0xa1, 0x35,
+	0x94, 0x26, 0x93, 0x47, 0xd7, 0xb8, 0x2e, 0xf9, 0x55, 0x97, 0x73, 0x4f, 0x38, 0x97, 0x6e, 0x1c,
+	0x3a, 0x23, 0x1e, 0x77, 0x71, 0xb0, 0x64, 0xc0, 0x83, 0x29, 0xdc, 0x4b, 0x37, 0x0e, 0x8f, 0x25,
+	0x66, 0xfd, 0x0f, 0x4b, 0xb0, 0x3c, 0xd6, 0x0f, 0xf6, 0x5d, 0x00, 0xe1, 0x0e, 0x47, 0x81, 0x2c,
+	0x19, 0x2b, 0xdd, 0xe9, 0xd2, 0x56, 0x15, 0xf5, 0x56, 0x82, 0xd1, 0x30, 0xd3, 0x8a, 0xd2, 0x9f,
+	0x32, 0x41, 0xec, 0x73, 0xa8, 0x25, 0xd1, 0xc8, 0x91, 0x4e, 0xb4, 0xb0, 0xca, 0x1b, 0xe5, 0x2f,
+	0x3b, 0xe8, 0xe4, 0xce, 0xd8, 0x90, 0x44, 0xa3, 0x6d, 0x29, 0x6c, 0x3d, 0x82, 0xe5, 0x31, 0xf4,
+	0xc4, 0x6b, 0x41, 0x69, 0xf2, 0xb5, 0xe0, 0x11, 0x54, 0xc9, 0x11, 0x24, 0x1a, 0xe9, 0x15, 0x93,
+	0x67, 0x48, 0xc8, 0xcc, 0xd3, 0x29, 0x1b, 0x9e, 0xce, 0xfa, 0x2b, 0x68, 0x16, 0xcd, 0x71, 0xa3,
+	0xe0, 0xae, 0xf4, 0xc5, 0x0a, 0xee, 0xee, 0x1c, 0x3f, 0x3c, 0xda, 0x56, 0x27, 0x64, 0xbb, 0xf1,
+	0x40, 0xa1, 0xa0, 0x24, 0x45, 0x29, 0x4b, 0x46, 0x94, 0x12, 0x01, 0x34, 0x29, 0xfb, 0xce, 0x30,
+	0x0a, 0xfd, 0x44, 0x95, 0x28, 0x56, 0xec, 0xea, 0xa8, 0x7f, 0x20, 0x01, 0xec, 0x6b, 0xb0, 0x32,
+	0xea, 0xd3, 0x59, 0x86, 0x3b, 0x98, 0xac, 0x32, 0xd0, 0x9e, 0xee, 0xa8, 0x8f, 0x67, 0xda, 0x16,
+	0x46, 0x24, 0xdc, 0x44, 0xb0, 0x5f, 0x81, 0x47, 0x23, 0x1e, 0x53, 0x72, 0x42, 0x07, 0xbf, 0xb8,
+	0xa7, 0xab, 0xce, 0x30, 0x84, 0x8e, 0x21, 0xc4, 0x87, 0x39, 0xc9, 0xbe, 0xa4, 0x38, 0xd1, 0xfa,
+	0xff, 0xde, 0x1c, 0x2c, 0xa9, 0xa4, 0x3f, 0x1e, 0xc0, 0x81, 0x1f, 0x72, 0x27, 0x4c, 0x87, 0x1d,
+	0xa5, 0xf5, 0x82, 0x0d, 0x08, 0x3a, 0x24, 0x08, 0x9e, 0xda, 0xe4, 0xdc, 0x4a, 0x6b, 0xd0, 0x6f,
+	0xec, 0xa8, 0xf6, 0x55, 0xe4, 0xf4, 0xa9, 0xda, 0x39, 0x00, 0x3b, 0x8a, 0x1d, 0x26, 0x23, 0x6a,
+	0x6d, 0xaa, 0x08, 0x41, 0x2b, 0x0a, 0x8c, 0x1c, 0xe2, 0x25, 0x95, 0x0b, 0xa1, 0x2e, 0x23, 0xba,
+	0x89, 0x98, 0x90, 0x27, 0x43, 0x57, 0x9c, 0xab, 0xeb, 0x87, 0x6e, 0xa2, 0x96, 0x6e, 0x9a, 0x0c,
+	0x9c, 0x21, 0x4f, 0x06, 0x91, 0x47, 0x27, 0x4c, 0xd5, 0x06, 0x04, 0x1d, 0x10, 0x04, 0x59, 0xa3,
+	0x91, 0xec, 0x7e, 0x85, 0x3e, 0xa8, 0x9b, 0x79, 0xd6, 0xa1, 0x6a, 0x66, 0x1d, 0x3e, 0x00, 0xa6,
+	0xc7, 0xe7, 0x82, 0xe3, 0xa8, 0x0b, 0x64, 0x05, 0x62, 0x5d, 0xc9, 0x31, 0xb6, 0x44, 0xb4, 0xff,
+	0x59, 0x09, 0x9a, 0xc5, 0x1c, 0x0e, 0x3a, 0x18, 0x79, 0x96, 0x36, 0xf7, 0xe1, 0x34, 0x0c, 0x7d,
+	0xb8, 0x8f, 0xb3, 0xfa, 0xca, 0x39, 0x5a, 0x62, 0xdf, 0x9c, 0x21, 0x37, 0x34, 0xa9, 0xc2, 0xf2,
+	0xe7, 0x48, 0xe8, 0xb4, 0xff, 0x71, 0x09, 0x9a, 0xc5, 0xdc, 0x19, 0x2e, 0x32, 0x95, 0x57, 0xcd,
+	0xd4, 0xae, 0x10, 0x00, 0x75, 0x7e, 0x1f, 0x18, 0x79, 0xad, 0x78, 0xf3, 0xc8, 0xa9, 0xe4, 0x22,
+	0x68, 0x69, 0xcc, 0x9e, 0xa6, 0xc6, 0xc1, 0xc5, 0xa0, 0xa8, 0x0e, 0xf6, 0x53, 0x03, 0x9d, 0xa4,
+	0x98, 0x77, 0x03, 0xd7, 0x1f, 0xa2, 0x93, 0xaa, 0x12, 0x18, 0xd2, 0x41, 0x6b, 0x19, 0x08, 0x4a,
+	0x61, 0xb4, 0x7f, 0xbf, 0x04, 0xf7, 0x27, 0xe7, 0xdf, 0x66, 0x19, 0x62, 0xe9, 0xb5, 0x8a, 0x24,
+	0x76, 0xf1, 0xb4, 0x35, 0xb6, 0x8d, 0x66, 0x0e, 0xa6, 0xcd, 0xe3, 0x6d, 0xda, 0x14, 0xd2, 0x61,
+	0xa8, 0xa6, 0xa5, 0x9c, 0xb5, 0x35, 0x09, 0x93, 0x13, 0xf3, 0x3d, 0x58, 0x16, 0x69, 0xbf, 0x2f,
+	0x0f, 0x56, 0xea, 0xbb, 0xba, 0x54, 0x37, 0x33, 0x30, 0xe9, 0xd5, 0xfe, 0xcf, 0x25, 0xa8, 0x19,
+	0x65, 0x63, 0x18, 0xbd, 0x57, 0x11, 0x4d, 0x69, 0x12, 0xd5, 0x62, 0x6f, 0x02, 0xf8, 0x1e, 0x0f,
+	0x13, 0xbf, 0xe7, 0xf3, 0x58, 0xe9, 0x65, 0x40, 0x70, 0x69, 0x61, 0xc1, 0x19, 0x0d, 0x5e, 0xc3,
+	0xa6, 0xdf, 0xe8, 0xef, 0xe2, 0xff, 0x14, 0x03, 0x92, 0x43, 0xb6, 0x84, 0xed, 0x2d, 0x3a, 0x13,
+	0x2a, 0x6e, 0x9f, 0xcb, 0xd2, 0x5f, 0x19, 0x77, 0x7d, 0xf3, 0x56, 0x67, 0x62, 0x2f, 0x4c, 0xbe,
+	0xfd, 0x8b, 0xf6, 0x92, 0xdb, 0xe7, 0x54, 0x0c, 0xbc, 0x09, 0xad, 0xc2, 0xa1, 0x84, 0xd2, 0xe5,
+	0x81, 0xd4, 0x34, 0x0e, 0x24, 0x8c, 0x2e, 0xfd, 0x8b, 0x12, 0x05, 0x8a, 0xc6, 0xab, 0x94, 0x2c,
+	0x58, 0xf2, 0xb8, 0xf4, 0xac, 0xa4, 0xb7, 0xab, 0x9b, 0xec, 0x97, 0x29, 0xca, 0x41, 0xee, 0xad,
+	0xe0, 0xda, 0x27, 0x98, 0xb6, 0xd9, 0x02, 0x91, 0xdb, 0x48, 0xcd, 0xf6, 0x81, 0x29, 0x39, 0x8e,
+	0xf0, 0x43, 0x8c, 0xcb, 0xba, 0x42, 0x07, 0xb4, 0xef, 0xea, 0x5c, 0x4b, 0x71, 0x9e, 0x20, 0xe3,
+	0xbe, 0x2b, 0x92, 0xf6, 0x8f, 0x4b, 0x00, 0x79, 0x2d, 0x1e, 0xfb, 0x2e, 0x3c, 0x34, 0xeb, 0xef,
+	0x62, 0xce, 0x5f, 0x73, 0x07, 0x9d, 0x1c, 0xec, 0xbd, 0xec, 0xc5, 0x7d, 0xa3, 0xb6, 0x8e, 0xf0,
+	0x07, 0xee, 0x15, 0x0e, 0xf5, 0xae, 0xb9, 0xc1, 0xcd, 0x4d, 0x09, 0x90, 0xe6, 0x9f, 0xcb, 0xca,
+	0x51, 0x73, 0x4e, 0x14, 0xa3, 0x27, 0xab, 0x3e, 0x66, 0xef, 0x12, 0x93, 0x95, 0x0d, 0xe5, 0x9c,
+	0xed, 0xdf, 0x29, 0x01, 0xbb, 0xf9, 0xa1, 0x59, 0x6e, 0x91, 0x0f, 0x60, 0xe9, 0xca, 0xf7, 0xa8,
+	0xc3, 0xf2, 0xe6, 0xb1, 0x78, 0xe5, 0x7b, 0xd8, 0xc1, 0xaf, 0xc3, 0x8a, 0xaa, 0xb3, 0x56, 0xc3,
+	0x33, 0x52, 0x8b, 0xb8, 0x64, 0x2f, 0x4b, 0xc4, 0x0b, 0x82, 0x1f, 0x77, 0x13, 0x19, 0x6b, 0xd2,
+	0x5f, 0x27, 0x42, 0xe9, 0x51, 0x35, 0x72, 0xe8, 0x71, 0x37, 0x69, 0xff, 0xb4, 0xa0, 0xa5, 0xee,
+	0xc7, 0x2c, 0x8b, 0xf8, 0x56, 0x2d, 0xdf, 0x85, 0xe6, 0x98, 0xd9, 0xe4, 0x15, 0xb0, 0xde, 0x33,
+	0x8d, 0x35, 0xb1, 0x2f, 0xf3, 0xb3, 0xf6, 0x65, 0x61, 0x42, 0x5f, 0x70, 0xba, 0xf7, 0x02, 0xb7,
+	0x8f, 0x95, 0xec, 0x72, 0x99, 0xe8, 0x66, 0xfb, 0x77, 0x4b, 0xc0, 0x6e, 0x16, 0x69, 0xb2, 0x6d,
+	0xa3, 0x68, 0x77, 0xb6, 0xfa, 0x4e, 0x95, 0xfe, 0x11, 0x46, 0xfd, 0xee, 0x81, 0x39, 0x5d, 0xa6,
+	0x1d, 0x19, 0xb9, 0x14, 0x3d, 0xcc, 0x5a, 0x11, 0x73, 0xda, 0xfc, 0xac, 0xa0, 0xaa, 0xfe, 0x1e,
+	0xf6, 0x8d, 0x87, 0xb8, 0xff, 0x7a, 0xca, 0x47, 0xd1, 0x4d, 0x74, 0xd4, 0xd4, 0x08, 0x26, 0x83,
+	0x98, 0x8b, 0x41, 0x14, 0xe8, 0x1b, 0xf9, 0xb2, 0x84, 0x9f, 0x6a, 0x30, 0xa6, 0xb4, 0x15, 0xa9,
+	0xe8, 0xba, 0x01, 0x77, 0x7a, 0x2e, 0x2a, 0xa6, 0x66, 0xd0, 0x8a, 0xfa, 0x22, 0x62, 0x9e, 0x11,
+	0x82, 0x92, 0x7b, 0xb2, 0x1b, 0x86, 0x6c, 0x75, 0x24, 0x28, 0x44, 0x2e, 0xfc, 0x5b, 0xb0, 0xa6,
+	0x89, 0x0b, 0xd2, 0xa5, 0xa9, 0x98, 0xc2, 0x19, 0xe2, 0xdb, 0xbf, 0x59, 0x86, 0xf5, 0xdb, 0x07,
+	0x65, 0x96, 0x39, 0x68, 0x1a, 0x70, 0xee, 0xcb, 0x1a, 0xf0, 0x4d, 0x00, 0x3c, 0x21, 0x63, 0xdf,
+	0xf3, 0xb8, 0x4e, 0x80, 0x1b, 0x10, 0xaa, 0x75, 0x40, 0x07, 0x2f, 0x49, 0x47, 0x41, 0x76, 0x24,
+	0x02, 0x82, 0x4e, 0x09, 0xc2, 0x7e, 0x19, 0xd6, 0xb5, 0x05, 0x62, 0xbf, 0xdf, 0xe7, 0xb1, 0x63,
+	0xd2, 0xcb, 0xab, 0xfa, 0x03, 0x65, 0x0b, 0x49, 0xb0, 0x93, 0x33, 0xef, 0x03, 0x1b, 0x46, 0x9e,
+	0x50, 0x3b, 0xa9, 0x52, 0xdd, 0x5a, 0x9c, 0x6d, 0x33, 0x45, 0x4e, 0xda, 0x49, 0xb7, 0x24, 0x95,
+	0x69, 0x04, 0xad, 0x0b, 0xd2, 0xa8, 0xdb, 0xbd, 0x36, 0x82, 0xd2, 0xe2, 0x20, 0xf2, 0x44, 0xfb,
+	0x6b, 0xb0, 0x3a, 0xa1, 0x70, 0x77, 0x52, 0xd8, 0xa7, 0xfd, 0xbb, 0x73, 0x70, 0x6f, 0x62, 0x09,
+	0x2e, 0x2e, 0x50, 0xb3, 0xa0, 0x37, 0x33, 0x56, 0x23, 0x87, 0x2a, 0x37, 0xc5, 0xf3, 0xc5, 0xb9,
+	0x33, 0x72, 0xe3, 0xc4, 0xcf, 0xec, 0xaa, 0xdc, 0x14, 0xc4, 0x1c, 0x6b, 0xc4, 0x78, 0x7c, 0xb9,
+	0x5c, 0x8c, 0x2f, 0xe7, 0x99, 0xf7, 0xf9, 0x42, 0xe6, 0x7d, 0x1d, 0x2a, 0x63, 0x31, 0xf3, 0xac,
+	0xcd, 0xbe, 0x0f, 0x20, 0xfc, 0xd7, 0xda, 0xb1, 0x99, 0x6d, 0x80, 0xab, 0xc8, 0x21, 0x8b, 0x36,
+	0xde, 0x07, 0x46, 0x21, 0xed, 0x82, 0xfe, 0x3a, 0xd3, 0x8d, 0x41, 0x6d, 0x53, 0xfd, 0xf6, 0x3f,
+	0x5a, 0x84, 0x66, 0xb1, 0xee, 0x10, 0x1d, 0x38, 0x55, 0x89, 0x99, 0x3b, 0x70, 0x04, 0x50, 0x2e,
+	0x99, 0xac, 0xf0, 0x90, 0x2b, 0x57, 0x36, 0xd0, 0x27, 0x4f, 0xa2, 0xc4, 0x0d, 0xcc, 0xb0, 0x52,
+	0x95, 0x20, 0x14, 0x5c, 0x60, 0x30, 0x1f, 0x47, 0x97, 0x7a, 0x46, 0xd2, 0x6f, 0xf6, 0x55, 0x58,
+	0x96, 0xaf, 0xb6, 0x9c, 0x2c, 0x00, 0x27, 0x27, 0x60, 0x43, 0x82, 0x9f, 0xaa, 0x30, 0xdc, 0x26,
+	0xb4, 0x4c, 0x3a, 0x8a, 0xc6, 0xc9, 0x50, 0x51, 0x33, 0x27, 0xa4, 0x98, 0xdc, 0x13, 0x58, 0x35,
+	0x29, 0x3d, 0x3f, 0x4e, 0x7c, 0xee, 0xa9, 0x19, 0xb5, 0x92, 0x13, 0xef, 0x48, 0xc4, 0x38, 0xbd,
+	0x0e, 0xe5, 0x55, 0xc6, 0xe9, 0x75, 0x40, 0xef, 0x5d, 0x68, 0xca, 0x64, 0x7c, 0xa6, 0xb0, 0x8c,
+	0x18, 0xd5, 0x09, 0xaa, 0xf5, 0xfd, 0x2a, 0x2c, 0x1b, 0x54, 0xa4, 0xae, 0x0c, 0x16, 0x35, 0x32,
+	0x32, 0xd2, 0xf6, 0x7d, 0x60, 0x06, 0x9d, 0x56, 0xb6, 0x26, 0xf7, 0xac, 0x8c, 0x54, 0xeb, 0x5a,
+	0xa4, 0xd6, 0xaa, 0xd6, 0xc7, 0xa8, 0x0d, 0x4d, 0xb1, 0x12, 0xc2, 0x50, 0xa1, 0x21, 0x35, 0x45,
+	0x68, 0xa6, 0xc1, 0xd7, 0x61, 0x25, 0xa7, 0xd2, 0x22, 0x9b, 0x72, 0x43, 0xd6, 0x84, 0x5a, 0x62,
+	0x1b, 0x1a, 0x9d, 0xe0, 0x5c, 0xde, 0x1f, 0xc9, 0xc6, 0xcb, 0x64, 0x63, 0x0c, 0x78, 0xa2, 0x2c,
+	0xb2, 0xf2, 0xbb, 0xd0, 0x44, 0x1a, 0x23, 0x26, 0xd5, 0x22, 0x22, 0x8c, 0x94, 0xe6, 0x81, 0xa6,
+	0x37, 0x00, 0x53, 0x33, 0xce, 0xb9, 0x2c, 0x45, 0x93, 0x0f, 0xaf, 0xaa, 0x03, 0x57, 0x7c, 0x4a,
+	0x00, 0xfc, 0x10, 0xdd, 0xee, 0xba, 0xa3, 0x54, 0xca, 0x60, 0xf2, 0x43, 0x08, 0xdc, 0x1e, 0xa5,
+	0x24, 0x62, 0x03, 0xea, 0xe2, 0x5a, 0xe4, 0x24, 0xab, 0x44, 0x02, 0xe2, 0x5a, 0x68, 0x8a, 0x36,
+	0x34, 0x7a, 0xb2, 0xe7, 0x6a, 0x15, 0xad, 0xc9, 0xf8, 0x6c, 0x8f, 0x7a, 0x2e, 0xd7, 0x09, 0x9e,
+	0xfe, 0x42, 0x69, 0x2b, 0x89, 0xee, 0xa9, 0xd3, 0x9f, 0x7a, 0xad, 0xee, 0x0f, 0x3f, 0x2e, 0xc1,
+	0x83, 0x5b, 0x0a, 0x77, 0x7f, 0xde, 0x48, 0xc0, 0x94, 0xa7, 0x77, 0x73, 0xd3, 0x9e, 0xde, 0x6d,
+	0x03, 0x18, 0x59, 0xf0, 0xf2, 0xec, 0xb5, 0xcc, 0x06, 0x5b, 0xfb, 0xf7, 0x00, 0x56, 0x27, 0xd4,
+	0xf4, 0xce, 0x72, 0x96, 0xbd, 0x03, 0x8d, 0x8c, 0xc4, 0xb8, 0xbb, 0x67, 0x7c, 0x94, 0x73, 0x79,
+	0x0e, 0xcb, 0x54, 0xee, 0xe9, 0xf1, 0x9e, 0x1f, 0xfa, 0x59, 0xa2, 0x71, 0x86, 0x7a, 0x88, 0x26,
+	0xf2, 0xed, 0x64, 0x6c, 0x6c, 0x8f, 0x4a, 0x81, 0xd2, 0xa1, 0x0a, 0x3d, 0xdc, 0x75, 0xcf, 0x35,
+	0x3a, 0x83, 0x6f, 0x06, 0xd2, 0x61, 0x68, 0x6b, 0x7e, 0x76, 0x06, 0xb5, 0xfc, 0xde, 0x26, 0x54,
+	0x60, 0xee, 0xa3, 0x2f, 0x20, 0x4e, 0xf3, 0xda, 0xa6, 0x1c, 0x74, 0x6c, 0x46, 0x3c, 0x16, 0xbe,
+	0x48, 0xf0, 0x44, 0xca, 0x93, 0x75, 0x55, 0x7b, 0xd9, 0x80, 0xd3, 0xb0, 0xbc, 0x09, 0xd0, 0xf3,
+	0x83, 0x40, 0x79, 0x1c, 0x4b, 0x32, 0x1c, 0x92, 0x43, 0xf0, 0x28, 0xc1, 0xd5, 0x11, 0xf9, 0x9e,
+	0xae, 0x23, 0x5b, 0x1a, 0xb8, 0xe2, 0xc8, 0xf7, 0xf0, 0xe1, 0x96, 0x85, 0x28, 0x55, 0x08, 0xe7,
+	0xe2, 0x97, 0xba, 0x03, 0x3f, 0xf0, 0x62, 0x1e, 0xd2, 0x46, 0x54, 0xb1, 0xef, 0x0f, 0x5c, 0xb1,
+	0x97, 0xa3, 0xb7, 0x15, 0x16, 0x37, 0x74, 0xe4, 0x4c, 0x22, 0xbc, 0xfd, 0x00, 0x91, 0xe2, 0x57,
+	0x4e, 0xb1, 0x3d, 0x56, 0xbf, 0x54, 0x9b, 0xb9, 0x7e, 0xa9, 0x7e, 0x7b, 0xfd, 0xd2, 0x07, 0x80,
+	0x71, 0xc8, 0x20, 0xc5, 0x10, 0x47, 0x40, 0x49, 0xdf, 0x73, 0xee, 0xa9, 0xf8, 0xf1, 0x8a, 0x81,
+	0xd9, 0x27, 0x04, 0x3b, 0xca, 0x83, 0x2b, 0xf2, 0xa1, 0xe8, 0x9f, 0x9f, 0xd9, 0x22, 0x47, 0x92,
+	0x4f, 0x86, 0x33, 0xb4, 0x94, 0xf5, 0xef, 0x41, 0xdd, 0x44, 0x7c, 0x91, 0x80, 0xc6, 0xfa, 0x1f,
+	0x94, 0x60, 0x51, 0x4e, 0x9b, 0xcc, 0xb3, 0x98, 0x33, 0x12, 0x4a, 0x8f, 0xe4, 0xcd, 0x4d, 0xda,
+	0x58, 0x15, 0xad, 0x21, 0x80, 0x8c, 0xbb, 0x03, 0x0d, 0x8f, 0xf7, 0xdc, 0x34, 0xf8, 0x82, 0xf5,
+	0x4f, 0x75, 0xc5, 0x25, 0x0b, 0x98, 0x1e, 0x42, 0x25, 0x8c, 0x12, 0x27, 0x4c, 0x83, 0x40, 0xd5,
+	0x2a, 0x2e, 0x85, 0x51, 0x82, 0xe4, 0xe8, 0x35, 0x8c, 0x22, 0xe1, 0x67, 0x19, 0xf4, 0x05, 0x3b,
+	0x6b, 0xaf, 0xff, 0xe9, 0x1c, 0x40, 0x3e, 0x41, 0xd5, 0x4b, 0x57, 0xaa, 0x53, 0x9e, 0xb0, 0x9e,
+	0x99, 0xc2, 0xd9, 0xc6, 0xb2, 0x9e, 0xd4, 0x5d, 0x1d, 0x9d, 0x2b, 0x1b, 0xd1, 0x39, 0x99, 0xa0,
+	0x53, 0xdf, 0xc1, 0xf5, 0xad, 0x6b, 0x03, 0x72, 0xe8, 0x0e, 0xef, 0xa9, 0x0a, 0x3e, 0x5a, 0xb6,
+	0x0b, 0x54, 0x59, 0xa8, 0x9b, 0x18, 0x08, 0xd1, 0xaa, 0x69, 0x8a, 0x45, 0xa2, 0x68, 0x2a, 0xf0,
+	0xb6, 0x22, 0x7c, 0x02, 0xab, 0x9a, 0x30, 0x1d, 0x79, 0x6e, 0xa2, 0x96, 0x96, 0x0c, 0xcf, 0xad,
+	0x28, 0xd4, 0x19, 0x61, 0x68, 0xfc, 0x0d, 0x7a, 0x8f, 0x07, 0x5c, 0xd3, 0x57, 0x0a, 0xf4, 0x3b,
+	0x84, 0x21, 0xfa, 0xf7, 0x41, 0x8f, 0x83, 0x33, 0x74, 0x93, 0xee, 0x40, 0x92, 0xcb, 0x40, 0x5e,
+	0x4b, 0x61, 0x0e, 0x10, 0x81, 0xd4, 0xed, 0xdf, 0xaa, 0xc2, 0xca, 0x8d, 0x77, 0x0a, 0xb3, 0xec,
+	0x97, 0x6f, 0x14, 0xfc, 0x39, 0xe9, 0x37, 0x19, 0xfe, 0xda, 0x43, 0xbc, 0x1a, 0xbc, 0xc2, 0xab,
+	0x48, 0xa8, 0x53, 0x90, 0x82, 0xbf, 0x3a, 0xe9, 0xba, 0x21, 0x1d, 0x74, 0xfc, 0x15, 0xfa, 0xe7,
+	0x66, 0x16, 0x12, 0x04, 0x7f, 0x75, 0x9a, 0x8e, 0xe8, 0x0c, 0x7f, 0x08, 0x15, 0xdf, 0xbb, 0x92,
+	0xcc, 0xd2, 0x7d, 0x5a, 0xf2, 0xbd, 0x2b, 0x62, 0x6e, 0x43, 0x03, 0x51, 0xc8, 0xdc, 0xe3, 0x49,
+	0x77, 0xa0, 0xbc, 0xa6, 0x9a, 0xef, 0x5d, 0x9d, 0xa6, 0xa3, 0x67, 0x08, 0x62, 0xeb, 0x50, 0x0d,
+	0x89, 0xc2, 0x0f, 0xb5, 0xeb, 0xbd, 0x14, 0x9e, 0xa6, 0xa3, 0xbd, 0x50, 0xe4, 0xb8, 0x74, 0xa4,
+	0x53, 0x69, 0x84, 0x3b, 0x1b, 0x79, 0x39, 0xce, 0xe3, 0x81, 0x55, 0xcd, 0x71, 0x3b, 0x3c, 0x60,
+	0x6f, 0x43, 0x43, 0xe2, 0xe8, 0xd9, 0xfa, 0x48, 0xbb, 0x3f, 0x80, 0xf8, 0xe7, 0x51, 0x82, 0xec,
+	0x8f, 0x01, 0x30, 0xb0, 0x7c, 0xc1, 0x91, 0x4e, 0xf9, 0x3c, 0x95, 0x70, 0xdf, 0xbf, 0xe0, 0xa7,
+	0xe9, 0x48, 0x62, 0xf5, 0xc5, 0x44, 0xf9, 0x38, 0x95, 0x50, 0xdd, 0x44, 0xd8, 0x07, 0xb0, 0x1a,
+	0xe2, 0x55, 0x61, 0xec, 0x1e, 0x22, 0x1d, 0x9c, 0x56, 0x78, 0x10, 0x79, 0x85, 0x7b, 0xc6, 0xbb,
+	0xd0, 0xa4, 0x2a, 0xea, 0xdc, 0x15, 0x62, 0xf2, 0x94, 0x47, 0x68, 0xe6, 0x0a, 0xb5, 0xa1, 0x91,
+	0x53, 0xa1, 0x67, 0xb7, 0x2a, 0xc7, 0x4a, 0x13, 0xa1, 0x63, 0xa7, 0xc6, 0x33, 0x17, 0xb4, 0x96,
+	0x8d, 0x67, 0x26, 0x67, 0x03, 0xea, 0x19, 0x0d, 0x8a, 0x91, 0x1e, 0x05, 0x28, 0x12, 0xe5, 0x1e,
+	0xd2, 0x3e, 0x6c, 0xc8, 0xb9, 0x2f, 0xdd, 0x43, 0x02, 0x67, 0x92, 0xd0, 0x85, 0xcb, 0xe9, 0x50,
+	0x96, 0xaa, 0x12, 0xcb, 0xc8, 0x50, 0x1a, 0x52, 0x15, 0x95, 0xb2, 0x14, 0x95, 0xa9, 0x55, 0x1b,
+	0x1a, 0x49, 0x41, 0x2d, 0x59, 0xfd, 0x55, 0x4b, 0x0c, 0xbd, 0x36, 0xa1, 0x25, 0xbf, 0x67, 0x4c,
+	0xd5, 0x75, 0xe9, 0x66, 0x13, 0xfc, 0x24, 0x9b, 0xaf, 0x9f, 0xc0, 0x4a, 0x4e, 0xe3, 0xf4, 0xe3,
+	0xe8, 0x32, 0x19, 0x58, 0x8f, 0x66, 0xba, 0xa5, 0x2c, 0x67, 0xb3, 0xfe, 0x63, 0x62, 0x63, 0x7b,
+	0xd0, 0x52, 0xb3, 0x84, 0xca, 0xb3, 0x29, 0x9f, 0xf6, 0x78, 0xb6, 0x44, 0x66, 0x73, 0x40, 0x73,
+	0xc9, 0x4d, 0xb8, 0xcc, 0xba, 0xed, 0x42, 0x53, 0x2f, 0x23, 0x25, 0xe8, 0x8d, 0xd9, 0x04, 0xd5,
+	0xd5, 0x6a, 0x93, 0x62, 0xbe, 0x8a, 0x89, 0x4a, 0x3f, 0x34, 0x87, 0x41, 0xd6, 0xb2, 0x37, 0x10,
+	0x9c, 0x8f, 0x02, 0x79, 0x8f, 0x43, 0x93, 0xec, 0x2d, 0xed, 0x3d, 0x0e, 0x73, 0xaa, 0x36, 0x34,
+	0x2e, 0x0a, 0x44, 0x1b, 0x72, 0xe4, 0x2f, 0x0c, 0x9a, 0xf7, 0xd5, 0x3b, 0x24, 0x2e, 0x4c, 0xc2,
+	0xb7, 0xe5, 0x7c, 0x56, 0x98, 0x8c, 0xba, 0xfd, 0xaf, 0xe7, 0xa0, 0x51, 0x78, 0xd7, 0x34, 0xcb,
+	0x0e, 0xf4, 0xab, 0x46, 0x92, 0xa5, 0x79, 0xcb, 0xeb, 0xb1, 0x82, 0xd0, 0x27, 0xf4, 0x2f, 0xd5,
+	0x0d, 0x11, 0x27, 0x86, 0x61, 0xa3, 0x2e, 0xa5, 0x53, 0xc9, 0xd3, 0x2d, 0xdf, 0x1d, 0x86, 0xd5,
+	0xe4, 0xd2, 0xd1, 0x75, 0x47, 0xa3, 0x38, 0xba, 0xf2, 0x87, 0x68, 0x66, 0x53, 0x90, 0xac, 0xd5,
+	0xbf, 0x67, 0xa0, 0x8f, 0x32, 0xbe, 0xf6, 0x19, 0x54, 0x33, 0x3d, 0xb0, 0xc2, 0xe8, 0x60, 0xeb,
+	0xf0, 0x6c, 0x6b, 0xdf, 0x91, 0xc5, 0x39, 0xad, 0x5f, 0xc0, 0xa2, 0x19, 0x2c, 0xd6, 0xd1, 0x80,
+	0x12, 0x16, 0xde, 0x28, 0x9a, 0xad, 0xc3, 0xad, 0xfd, 0xcf, 0x3e, 0xc7, 0x82, 0xa3, 0x16, 0xd4,
+	0x89, 0x48, 0x43, 0xca, 0xed, 0xdf, 0x29, 0x43, 0x6b, 0xfc, 0x25, 0xd7, 0xf4, 0xac, 0xc5, 0xf8,
+	0x10, 0xcf, 0xdd, 0x1c, 0x62, 0xe3, 0xb8, 0x2b, 0x17, 0x8f, 0xbb, 0x4c, 0x72, 0x7e, 0x54, 0x4a,
+	0xc9, 0x78, 0x4a, 0x3e, 0xbb, 0x71, 0x98, 0xce, 0x58, 0xf2, 0x3c, 0x76, 0xda, 0xbe, 0x01, 0xe0,
+	0x0b, 0xac, 0x31, 0x1c, 0xba, 0xf1, 0xb5, 0x7e, 0xc2, 0xe0, 0x8b, 0x63, 0x09, 0x20, 0x1d, 0x84,
+	0x93, 0x86, 0xfe, 0xab, 0x94, 0xab, 0x50, 0x40, 0xc5, 0x17, 0x67, 0xd4, 0xa6, 0x33, 0x44, 0xc8,
+	0xd7, 0x06, 0xda, 0xe7, 0xf4, 0x05, 0xbd, 0x1e, 0x18, 0x73, 0x57, 0xab, 0x37, 0xdc, 0x55, 0xfc,
+	0x2c, 0xf5, 0x8d, 0xa6, 0x97, 0x7a, 0x08, 0x43, 0x10, 0xb2, 0x99, 0x94, 0x8c, 0xfb, 0xd2, 0xb5,
+	0x2a, 0x5a, 0x5f, 0xf2, 0x69, 0x4b, 0xba, 0xa6, 0xca, 0x1c, 0x8e, 0x59, 0xa0, 0x4e, 0xea, 0x07,
+	0x09, 0xed, 0xf2, 0x15, 0x1b, 0x08, 0xf4, 0x14, 0x21, 0xed, 0x7f, 0x35, 0x07, 0xcd, 0xe2, 0xd3,
+	0xb8, 0xe9, 0x36, 0xba, 0xfb, 0x94, 0xcd, 0x0e, 0xca, 0x72, 0xf1, 0xa0, 0x54, 0x9b, 0xf6, 0xf8,
+	0x29, 0x2b, 0xcf, 0x49, 0xbd, 0x81, 0xde, 0x79, 0x94, 0xde, 0x38, 0x1e, 0x96, 0xee, 0x3e, 0x1e,
+	0x2a, 0x37, 0x8e, 0x87, 0x89, 0x9b, 0x6b, 0xf5, 0x4b, 0x6d, 0xae, 0xed, 0xbf, 0x5f, 0x86, 0xd5,
+	0x09, 0xcf, 0x00, 0x71, 0x36, 0xe7, 0x0f, 0x0a, 0xf3, 0x0d, 0x43, 0xc3, 0xd4, 0xd3, 0x8c, 0xc0,
+	0x0d, 0xfb, 0xa9, 0x8e, 0x89, 0x57, 0xed, 0xac, 0x6d, 0xa4, 0xa3, 0xe6, 0x0b, 0xe9, 0x28, 0x34,
+	0x00, 0xfd, 0x72, 0x3a, 0xbe, 0x0e, 0x6a, 0x55, 0x25, 0xe4, 0xa9, 0x1f, 0x1a, 0x91, 0xb0, 0xc5,
+	0x42, 0x24, 0xec, 0x3e, 0x2c, 0xc6, 0x5c, 0xa4, 0x41, 0xa2, 0xfc, 0x34, 0xd5, 0xc2, 0x24, 0xb0,
+	0xdb, 0xef, 0xc7, 0xbc, 0xaf, 0x2b, 0x62, 0x2b, 0x76, 0x0e, 0x40, 0xae, 0x4b, 0x3f, 0xf4, 0xa2,
+	0x4b, 0x75, 0x9f, 0x51, 0x2d, 0xfa, 0x63, 0x31, 0xbc, 0x9b, 0x62, 0x51, 0xad, 0xbc, 0x7a, 0xf2,
+	0x58, 0xcd, 0xbc, 0x65, 0x0d, 0xdf, 0x91, 0x60, 0xfc, 0x40, 0xc0, 0xdd, 0xf3, 0x51, 0x1c, 0xd1,
+	0xe3, 0x17, 0xfa, 0x40, 0x06, 0xa0, 0x5e, 0x26, 0xb1, 0xdf, 0x4d, 0xd4, 0xbd, 0x45, 0xb5, 0x70,
+	0xde, 0xc6, 0x3c, 0x49, 0xe3, 0x50, 0x38, 0x98, 0x8f, 0x6a, 0x12, 0x12, 0x14, 0xe8, 0x84, 0x27,
+	0x38, 0x74, 0x17, 0x11, 0xee, 0x0b, 0x81, 0x0c, 0x92, 0x54, 0xed, 0xac, 0xdd, 0xfe, 0xed, 0x12,
+	0xac, 0xdc, 0x78, 0x3a, 0x39, 0x8b, 0x3d, 0xbe, 0x54, 0xd4, 0xed, 0x11, 0x54, 0x05, 0x0f, 0x7a,
+	0x66, 0xdd, 0x56, 0x05, 0x01, 0x88, 0x6c, 0xff, 0xd1, 0x1c, 0xac, 0x4d, 0x7a, 0xea, 0x87, 0xb7,
+	0x7b, 0x29, 0x54, 0xd5, 0x36, 0xa9, 0x54, 0x56, 0x9d, 0x80, 0x92, 0x83, 0xb2, 0xd3, 0xa9, 0xc0,
+	0xc0, 0x99, 0xa2, 0x91, 0x6a, 0x61, 0x90, 0xc6, 0xd3, 0x24, 0x4f, 0x60, 0x35, 0x15, 0x98, 0x50,
+	0x94, 0x7f, 0xe7, 0x43, 0x53, 0xe2, 0xe6, 0x58, 0xb6, 0x57, 0x08, 0x45, 0xf5, 0x1b, 0x9a, 0xbe,
+	0x33, 0xf9, 0xd9, 0xb0, 0xbc, 0xf2, 0xff, 0xb9, 0xbb, 0x9e, 0x2a, 0xce, 0xf6, 0x80, 0xf8, 0xb3,
+	0x09, 0x6f, 0x73, 0x17, 0xa6, 0xfc, 0x55, 0x10, 0xe3, 0x03, 0x77, 0xbc, 0xd2, 0x6d, 0xff, 0xa8,
+	0x04, 0x8f, 0xa7, 0xe9, 0x33, 0xcb, 0x31, 0x6d, 0xc1, 0x52, 0x71, 0x40, 0x75, 0x13, 0x8d, 0x82,
+	0x11, 0xc2, 0x6b, 0x63, 0x18, 0xc9, 0x28, 0x04, 0x54, 0x23, 0xd8, 0xbe, 0x84, 0x87, 0xb7, 0x2a,
+	0x3c, 0x7d, 0xef, 0xfc, 0x39, 0x3f, 0xfc, 0x47, 0x25, 0x78, 0x34, 0xe5, 0xb1, 0xee, 0x2c, 0x5d,
+	0x7f, 0x0c, 0xd5, 0x51, 0x34, 0x4a, 0x03, 0x37, 0xe1, 0x5e, 0x56, 0xbc, 0xa2, 0x01, 0x63, 0x7b,
+	0x7b, 0x79, 0x7c, 0x6f, 0x3f, 0x84, 0x95, 0x00, 0x5d, 0xd7, 0x98, 0xf7, 0x30, 0xc7, 0x93, 0x7b,
+	0x16, 0xb3, 0xbd, 0x98, 0x5b, 0x46, 0x66, 0x5b, 0xf3, 0x6e, 0x25, 0xed, 0x3f, 0x2b, 0x01, 0xbb,
+	0xf9, 0xe7, 0x4c, 0xd8, 0x0e, 0xd4, 0x47, 0x69, 0x47, 0x37, 0x71, 0x61, 0x94, 0x6f, 0xfd, 0xb3,
+	0x2f, 0xc7, 0x39, 0xa1, 0x5d, 0xe0, 0x62, 0x1f, 0x43, 0x43, 0xa4, 0x1d, 0xd1, 0x8d, 0xfd, 0x91,
+	0x99, 0x89, 0x7b, 0x7b, 0xa2, 0x98, 0x13, 0x83, 0xd2, 0x2e, 0xf2, 0xb1, 0x2d, 0x58, 0x10, 0x41,
+	0x94, 0x15, 0x58, 0x7d, 0x63, 0xc6, 0xbf, 0xca, 0x72, 0x12, 0x44, 0x89, 0x2d, 0x39, 0xdb, 0xff,
+	0xb7, 0x04, 0x35, 0x43, 0xd3, 0x59, 0x52, 0xbe, 0x93, 0xa2, 0x04, 0x6f, 0x00, 0x60, 0x4d, 0x91,
+	0x4c, 0xa1, 0xa8, 0xbc, 0x54, 0xd5, 0x0d, 0x54, 0x11, 0x31, 0x06, 0x0c, 0x68, 0x04, 0xc4, 0x00,
+	0xaf, 0x99, 0x3c, 0xd6, 0x5e, 0x5f, 0x43, 0x41, 0xf7, 0x08, 0x68, 0x92, 0xc9, 0xfb, 0x80, 0xb5,
+	0x50, 0x20, 0x93, 0xce, 0xbe, 0x49, 0x26, 0x2f, 0xf9, 0xd6, 0x62, 0x81, 0x4c, 0xde, 0xef, 0x6f,
+	0xcc, 0xb9, 0xa5, 0x8d, 0xf2, 0xd8, 0x9c, 0x6b, 0xff, 0x93, 0x32, 0xd4, 0xcd, 0x01, 0xfe, 0xb2,
+	0xdd, 0x37, 0x32, 0x9e, 0xe5, 0x62, 0xc6, 0xf3, 0xfb, 0x00, 0x97, 0x51, 0x7c, 0xce, 0x63, 0x07,
+	0x9f, 0xb2, 0xcc, 0xcf, 0x96, 0xc9, 0x91, 0x1c, 0xc7, 0xbe, 0xc7, 0x9e, 0x42, 0x5d, 0xbd, 0x32,
+	0xf2, 0x9c, 0x40, 0x84, 0xb3, 0xba, 0x86, 0x35, 0xcd, 0xb4, 0x2f, 0x42, 0xbc, 0x16, 0xe1, 0x1a,
+	0x12, 0x89, 0xc3, 0x43, 0x29, 0x65, 0xc6, 0x67, 0x71, 0x75, 0xc9, 0xb6, 0x1b, 0x92, 0x18, 0xf5,
+	0xc8, 0x22, 0x70, 0xfb, 0x32, 0x40, 0xbd, 0x94, 0x3d, 0xb2, 0xd8, 0x77, 0xfb, 0x14, 0x95, 0x7e,
+	0x08, 0x95, 0x0c, 0x5b, 0xa1, 0xc3, 0x66, 0x29, 0x50, 0xa8, 0xb7, 0xa0, 0xa6, 0x86, 0xc1, 0x8b,
+	0x2e, 0x75, 0xb0, 0x52, 0x8d, 0xcc, 0x4e, 0x74, 0x49, 0x03, 0x8f, 0xbc, 0xb2, 0x56, 0x84, 0x7b,
+	0xea, 0x4c, 0xaf, 0x05, 0x6e, 0x7f, 0x57, 0x81, 0xda, 0xff, 0x67, 0x1e, 0xee, 0x4f, 0x9e, 0xcc,
+	0xb3, 0x98, 0x0d, 0x8f, 0xc2, 0x20, 0x2a, 0x54, 0xf0, 0x54, 0x10, 0x40, 0xb5, 0x3b, 0xf7, 0x61,
+	0x71, 0x14, 0xa4, 0xfa, 0x4d, 0x71, 0xd5, 0x56, 0x2d, 0x84, 0xcb, 0x47, 0xce, 0x6a, 0xbe, 0xaa,
+	0x16, 0x5a, 0x55, 0xfe, 0x22, 0xab, 0xce, 0x56, 0x2a, 0x53, 0x95, 0x1c, 0x68, 0x55, 0xf9, 0xc4,
+	0x31, 0x71, 0xe3, 0xe4, 0x8b, 0x98, 0x03, 0x14, 0x0f, 0x1a, 0xe3, 0x08, 0xff, 0x26, 0x5d, 0xd8,
+	0xf3, 0x63, 0x7c, 0xb6, 0xae, 0x1e, 0xe0, 0x88, 0x70, 0xd6, 0x17, 0x8b, 0x2b, 0x19, 0xef, 0x33,
+	0x7a, 0xa5, 0x23, 0xf0, 0x8f, 0x32, 0xb1, 0x98, 0x27, 0xae, 0x1f, 0x72, 0xcf, 0xb9, 0xc4, 0xa3,
+	0x9f, 0xf6, 0xd9, 0xca, 0x6c, 0xa9, 0x5d, 0xcd, 0xf9, 0xd2, 0x0d, 0xe4, 0x76, 0xfc, 0xeb, 0xb0,
+	0x7e, 0x53, 0x1a, 0x16, 0xaa, 0xe2, 0xe4, 0xb0, 0xaa, 0x77, 0x68, 0xa9, 0xeb, 0x94, 0xc7, 0xc5,
+	0x1e, 0xf3, 0xf8, 0x84, 0x77, 0xe5, 0x83, 0x36, 0x43, 0x38, 0xba, 0xc8, 0xf8, 0x78, 0x4f, 0x4e,
+	0x19, 0x66, 0x70, 0x7d, 0x2c, 0x31, 0xf8, 0x77, 0x1c, 0xfd, 0x50, 0x19, 0x4c, 0xa2, 0xf1, 0xda,
+	0x71, 0xe9, 0x06, 0xca, 0x31, 0x5c, 0xd3, 0x58, 0x5b, 0x23, 0x5f, 0xba, 0x41, 0x67, 0x91, 0xee,
+	0xb4, 0x1f, 0xfd, 0xff, 0x01, 0x00, 0xbe, 0xb6, 0x6d, 0xd3, 0xa6, 0x52, 0x00, 0x00,
 }
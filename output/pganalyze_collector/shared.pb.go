@@ -43,7 +43,7 @@ func (x QueryExplainInformation_ExplainFormat) String() string {
 }
 
 func (QueryExplainInformation_ExplainFormat) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{10, 0}
+	return fileDescriptor_d8a4e87e678c5ced, []int{12, 0}
 }
 
 type QueryExplainInformation_ExplainSource int32
@@ -74,7 +74,7 @@ func (x QueryExplainInformation_ExplainSource) String() string {
 }
 
 func (QueryExplainInformation_ExplainSource) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{10, 1}
+	return fileDescriptor_d8a4e87e678c5ced, []int{12, 1}
 }
 
 type SystemInformation_SystemType int32
@@ -108,7 +108,7 @@ func (x SystemInformation_SystemType) String() string {
 }
 
 func (SystemInformation_SystemType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{12, 0}
+	return fileDescriptor_d8a4e87e678c5ced, []int{14, 0}
 }
 
 type NullString struct {
@@ -158,6 +158,100 @@ func (m *NullString) GetValue() string {
 	return ""
 }
 
+type NullInt64 struct {
+	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Value                int64    `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NullInt64) Reset()         { *m = NullInt64{} }
+func (m *NullInt64) String() string { return proto.CompactTextString(m) }
+func (*NullInt64) ProtoMessage()    {}
+func (*NullInt64) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8a4e87e678c5ced, []int{1}
+}
+
+func (m *NullInt64) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NullInt64.Unmarshal(m, b)
+}
+func (m *NullInt64) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NullInt64.Marshal(b, m, deterministic)
+}
+func (m *NullInt64) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NullInt64.Merge(m, src)
+}
+func (m *NullInt64) XXX_Size() int {
+	return xxx_messageInfo_NullInt64.Size(m)
+}
+func (m *NullInt64) XXX_DiscardUnknown() {
+	xxx_messageInfo_NullInt64.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NullInt64 proto.InternalMessageInfo
+
+func (m *NullInt64) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *NullInt64) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type NullDouble struct {
+	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Value                float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NullDouble) Reset()         { *m = NullDouble{} }
+func (m *NullDouble) String() string { return proto.CompactTextString(m) }
+func (*NullDouble) ProtoMessage()    {}
+func (*NullDouble) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8a4e87e678c5ced, []int{2}
+}
+
+func (m *NullDouble) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NullDouble.Unmarshal(m, b)
+}
+func (m *NullDouble) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NullDouble.Marshal(b, m, deterministic)
+}
+func (m *NullDouble) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NullDouble.Merge(m, src)
+}
+func (m *NullDouble) XXX_Size() int {
+	return xxx_messageInfo_NullDouble.Size(m)
+}
+func (m *NullDouble) XXX_DiscardUnknown() {
+	xxx_messageInfo_NullDouble.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NullDouble proto.InternalMessageInfo
+
+func (m *NullDouble) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *NullDouble) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
 type NullTimestamp struct {
 	Valid                bool                 `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
 	Value                *timestamp.Timestamp `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -170,7 +264,7 @@ func (m *NullTimestamp) Reset()         { *m = NullTimestamp{} }
 func (m *NullTimestamp) String() string { return proto.CompactTextString(m) }
 func (*NullTimestamp) ProtoMessage()    {}
 func (*NullTimestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{1}
+	return fileDescriptor_d8a4e87e678c5ced, []int{3}
 }
 
 func (m *NullTimestamp) XXX_Unmarshal(b []byte) error {
@@ -206,9 +300,14 @@ func (m *NullTimestamp) GetValue() *timestamp.Timestamp {
 }
 
 type PostgresVersion struct {
-	Full                 string   `protobuf:"bytes,1,opt,name=full,proto3" json:"full,omitempty"`
-	Short                string   `protobuf:"bytes,2,opt,name=short,proto3" json:"short,omitempty"`
-	Numeric              int64    `protobuf:"varint,3,opt,name=numeric,proto3" json:"numeric,omitempty"`
+	Full    string `protobuf:"bytes,1,opt,name=full,proto3" json:"full,omitempty"`
+	Short   string `protobuf:"bytes,2,opt,name=short,proto3" json:"short,omitempty"`
+	Numeric int64  `protobuf:"varint,3,opt,name=numeric,proto3" json:"numeric,omitempty"`
+	// Compile-time settings of the server build (all values in bytes)
+	BlockSize            int64    `protobuf:"varint,4,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
+	WalBlockSize         int64    `protobuf:"varint,5,opt,name=wal_block_size,json=walBlockSize,proto3" json:"wal_block_size,omitempty"`
+	WalSegmentSize       int64    `protobuf:"varint,6,opt,name=wal_segment_size,json=walSegmentSize,proto3" json:"wal_segment_size,omitempty"`
+	SegmentSize          int64    `protobuf:"varint,7,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -218,7 +317,7 @@ func (m *PostgresVersion) Reset()         { *m = PostgresVersion{} }
 func (m *PostgresVersion) String() string { return proto.CompactTextString(m) }
 func (*PostgresVersion) ProtoMessage()    {}
 func (*PostgresVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{2}
+	return fileDescriptor_d8a4e87e678c5ced, []int{4}
 }
 
 func (m *PostgresVersion) XXX_Unmarshal(b []byte) error {
@@ -260,6 +359,34 @@ func (m *PostgresVersion) GetNumeric() int64 {
 	return 0
 }
 
+func (m *PostgresVersion) GetBlockSize() int64 {
+	if m != nil {
+		return m.BlockSize
+	}
+	return 0
+}
+
+func (m *PostgresVersion) GetWalBlockSize() int64 {
+	if m != nil {
+		return m.WalBlockSize
+	}
+	return 0
+}
+
+func (m *PostgresVersion) GetWalSegmentSize() int64 {
+	if m != nil {
+		return m.WalSegmentSize
+	}
+	return 0
+}
+
+func (m *PostgresVersion) GetSegmentSize() int64 {
+	if m != nil {
+		return m.SegmentSize
+	}
+	return 0
+}
+
 type RoleReference struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -271,7 +398,7 @@ func (m *RoleReference) Reset()         { *m = RoleReference{} }
 func (m *RoleReference) String() string { return proto.CompactTextString(m) }
 func (*RoleReference) ProtoMessage()    {}
 func (*RoleReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{3}
+	return fileDescriptor_d8a4e87e678c5ced, []int{5}
 }
 
 func (m *RoleReference) XXX_Unmarshal(b []byte) error {
@@ -310,7 +437,7 @@ func (m *DatabaseReference) Reset()         { *m = DatabaseReference{} }
 func (m *DatabaseReference) String() string { return proto.CompactTextString(m) }
 func (*DatabaseReference) ProtoMessage()    {}
 func (*DatabaseReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{4}
+	return fileDescriptor_d8a4e87e678c5ced, []int{6}
 }
 
 func (m *DatabaseReference) XXX_Unmarshal(b []byte) error {
@@ -351,7 +478,7 @@ func (m *RelationReference) Reset()         { *m = RelationReference{} }
 func (m *RelationReference) String() string { return proto.CompactTextString(m) }
 func (*RelationReference) ProtoMessage()    {}
 func (*RelationReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{5}
+	return fileDescriptor_d8a4e87e678c5ced, []int{7}
 }
 
 func (m *RelationReference) XXX_Unmarshal(b []byte) error {
@@ -406,7 +533,7 @@ func (m *IndexReference) Reset()         { *m = IndexReference{} }
 func (m *IndexReference) String() string { return proto.CompactTextString(m) }
 func (*IndexReference) ProtoMessage()    {}
 func (*IndexReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{6}
+	return fileDescriptor_d8a4e87e678c5ced, []int{8}
 }
 
 func (m *IndexReference) XXX_Unmarshal(b []byte) error {
@@ -462,7 +589,7 @@ func (m *FunctionReference) Reset()         { *m = FunctionReference{} }
 func (m *FunctionReference) String() string { return proto.CompactTextString(m) }
 func (*FunctionReference) ProtoMessage()    {}
 func (*FunctionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{7}
+	return fileDescriptor_d8a4e87e678c5ced, []int{9}
 }
 
 func (m *FunctionReference) XXX_Unmarshal(b []byte) error {
@@ -524,7 +651,7 @@ func (m *QueryReference) Reset()         { *m = QueryReference{} }
 func (m *QueryReference) String() string { return proto.CompactTextString(m) }
 func (*QueryReference) ProtoMessage()    {}
 func (*QueryReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{8}
+	return fileDescriptor_d8a4e87e678c5ced, []int{10}
 }
 
 func (m *QueryReference) XXX_Unmarshal(b []byte) error {
@@ -567,9 +694,12 @@ func (m *QueryReference) GetFingerprint() []byte {
 }
 
 type QueryInformation struct {
-	QueryIdx             int32    `protobuf:"varint,1,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
-	NormalizedQuery      string   `protobuf:"bytes,2,opt,name=normalized_query,json=normalizedQuery,proto3" json:"normalized_query,omitempty"`
-	QueryIds             []int64  `protobuf:"varint,3,rep,packed,name=query_ids,json=queryIds,proto3" json:"query_ids,omitempty"`
+	QueryIdx        int32   `protobuf:"varint,1,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
+	NormalizedQuery string  `protobuf:"bytes,2,opt,name=normalized_query,json=normalizedQuery,proto3" json:"normalized_query,omitempty"`
+	QueryIds        []int64 `protobuf:"varint,3,rep,packed,name=query_ids,json=queryIds,proto3" json:"query_ids,omitempty"`
+	// Set when normalized_query was left out since the query isn't ranked within
+	// query_text_max_statements (the query is only identified by query_ids)
+	QueryTextOmitted     bool     `protobuf:"varint,4,opt,name=query_text_omitted,json=queryTextOmitted,proto3" json:"query_text_omitted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -579,7 +709,7 @@ func (m *QueryInformation) Reset()         { *m = QueryInformation{} }
 func (m *QueryInformation) String() string { return proto.CompactTextString(m) }
 func (*QueryInformation) ProtoMessage()    {}
 func (*QueryInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{9}
+	return fileDescriptor_d8a4e87e678c5ced, []int{11}
 }
 
 func (m *QueryInformation) XXX_Unmarshal(b []byte) error {
@@ -621,6 +751,13 @@ func (m *QueryInformation) GetQueryIds() []int64 {
 	return nil
 }
 
+func (m *QueryInformation) GetQueryTextOmitted() bool {
+	if m != nil {
+		return m.QueryTextOmitted
+	}
+	return false
+}
+
 type QueryExplainInformation struct {
 	QueryIdx             int32                                 `protobuf:"varint,1,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
 	ExplainOutput        string                                `protobuf:"bytes,2,opt,name=explain_output,json=explainOutput,proto3" json:"explain_output,omitempty"`
@@ -636,7 +773,7 @@ func (m *QueryExplainInformation) Reset()         { *m = QueryExplainInformation
 func (m *QueryExplainInformation) String() string { return proto.CompactTextString(m) }
 func (*QueryExplainInformation) ProtoMessage()    {}
 func (*QueryExplainInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{10}
+	return fileDescriptor_d8a4e87e678c5ced, []int{12}
 }
 
 func (m *QueryExplainInformation) XXX_Unmarshal(b []byte) error {
@@ -721,7 +858,7 @@ func (m *System) Reset()         { *m = System{} }
 func (m *System) String() string { return proto.CompactTextString(m) }
 func (*System) ProtoMessage()    {}
 func (*System) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{11}
+	return fileDescriptor_d8a4e87e678c5ced, []int{13}
 }
 
 func (m *System) XXX_Unmarshal(b []byte) error {
@@ -891,7 +1028,7 @@ func (m *SystemInformation) Reset()         { *m = SystemInformation{} }
 func (m *SystemInformation) String() string { return proto.CompactTextString(m) }
 func (*SystemInformation) ProtoMessage()    {}
 func (*SystemInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{12}
+	return fileDescriptor_d8a4e87e678c5ced, []int{14}
 }
 
 func (m *SystemInformation) XXX_Unmarshal(b []byte) error {
@@ -990,7 +1127,7 @@ func (m *SystemInformationSelfHosted) Reset()         { *m = SystemInformationSe
 func (m *SystemInformationSelfHosted) String() string { return proto.CompactTextString(m) }
 func (*SystemInformationSelfHosted) ProtoMessage()    {}
 func (*SystemInformationSelfHosted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{13}
+	return fileDescriptor_d8a4e87e678c5ced, []int{15}
 }
 
 func (m *SystemInformationSelfHosted) XXX_Unmarshal(b []byte) error {
@@ -1110,7 +1247,7 @@ func (m *SystemInformationAmazonRDS) Reset()         { *m = SystemInformationAma
 func (m *SystemInformationAmazonRDS) String() string { return proto.CompactTextString(m) }
 func (*SystemInformationAmazonRDS) ProtoMessage()    {}
 func (*SystemInformationAmazonRDS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{14}
+	return fileDescriptor_d8a4e87e678c5ced, []int{16}
 }
 
 func (m *SystemInformationAmazonRDS) XXX_Unmarshal(b []byte) error {
@@ -1326,7 +1463,7 @@ func (m *SchedulerStatistic) Reset()         { *m = SchedulerStatistic{} }
 func (m *SchedulerStatistic) String() string { return proto.CompactTextString(m) }
 func (*SchedulerStatistic) ProtoMessage()    {}
 func (*SchedulerStatistic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{15}
+	return fileDescriptor_d8a4e87e678c5ced, []int{17}
 }
 
 func (m *SchedulerStatistic) XXX_Unmarshal(b []byte) error {
@@ -1398,7 +1535,7 @@ func (m *MemoryStatistic) Reset()         { *m = MemoryStatistic{} }
 func (m *MemoryStatistic) String() string { return proto.CompactTextString(m) }
 func (*MemoryStatistic) ProtoMessage()    {}
 func (*MemoryStatistic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{16}
+	return fileDescriptor_d8a4e87e678c5ced, []int{18}
 }
 
 func (m *MemoryStatistic) XXX_Unmarshal(b []byte) error {
@@ -1575,7 +1712,7 @@ func (m *CPUInformation) Reset()         { *m = CPUInformation{} }
 func (m *CPUInformation) String() string { return proto.CompactTextString(m) }
 func (*CPUInformation) ProtoMessage()    {}
 func (*CPUInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{17}
+	return fileDescriptor_d8a4e87e678c5ced, []int{19}
 }
 
 func (m *CPUInformation) XXX_Unmarshal(b []byte) error {
@@ -1649,7 +1786,7 @@ func (m *CPUReference) Reset()         { *m = CPUReference{} }
 func (m *CPUReference) String() string { return proto.CompactTextString(m) }
 func (*CPUReference) ProtoMessage()    {}
 func (*CPUReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{18}
+	return fileDescriptor_d8a4e87e678c5ced, []int{20}
 }
 
 func (m *CPUReference) XXX_Unmarshal(b []byte) error {
@@ -1698,7 +1835,7 @@ func (m *CPUStatistic) Reset()         { *m = CPUStatistic{} }
 func (m *CPUStatistic) String() string { return proto.CompactTextString(m) }
 func (*CPUStatistic) ProtoMessage()    {}
 func (*CPUStatistic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{19}
+	return fileDescriptor_d8a4e87e678c5ced, []int{21}
 }
 
 func (m *CPUStatistic) XXX_Unmarshal(b []byte) error {
@@ -1807,7 +1944,7 @@ func (m *NetworkReference) Reset()         { *m = NetworkReference{} }
 func (m *NetworkReference) String() string { return proto.CompactTextString(m) }
 func (*NetworkReference) ProtoMessage()    {}
 func (*NetworkReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{20}
+	return fileDescriptor_d8a4e87e678c5ced, []int{22}
 }
 
 func (m *NetworkReference) XXX_Unmarshal(b []byte) error {
@@ -1848,7 +1985,7 @@ func (m *NetworkStatistic) Reset()         { *m = NetworkStatistic{} }
 func (m *NetworkStatistic) String() string { return proto.CompactTextString(m) }
 func (*NetworkStatistic) ProtoMessage()    {}
 func (*NetworkStatistic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{21}
+	return fileDescriptor_d8a4e87e678c5ced, []int{23}
 }
 
 func (m *NetworkStatistic) XXX_Unmarshal(b []byte) error {
@@ -1901,7 +2038,7 @@ func (m *DiskReference) Reset()         { *m = DiskReference{} }
 func (m *DiskReference) String() string { return proto.CompactTextString(m) }
 func (*DiskReference) ProtoMessage()    {}
 func (*DiskReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{22}
+	return fileDescriptor_d8a4e87e678c5ced, []int{24}
 }
 
 func (m *DiskReference) XXX_Unmarshal(b []byte) error {
@@ -1944,7 +2081,7 @@ func (m *DiskInformation) Reset()         { *m = DiskInformation{} }
 func (m *DiskInformation) String() string { return proto.CompactTextString(m) }
 func (*DiskInformation) ProtoMessage()    {}
 func (*DiskInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{23}
+	return fileDescriptor_d8a4e87e678c5ced, []int{25}
 }
 
 func (m *DiskInformation) XXX_Unmarshal(b []byte) error {
@@ -2021,7 +2158,7 @@ func (m *DiskStatistic) Reset()         { *m = DiskStatistic{} }
 func (m *DiskStatistic) String() string { return proto.CompactTextString(m) }
 func (*DiskStatistic) ProtoMessage()    {}
 func (*DiskStatistic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{24}
+	return fileDescriptor_d8a4e87e678c5ced, []int{26}
 }
 
 func (m *DiskStatistic) XXX_Unmarshal(b []byte) error {
@@ -2130,7 +2267,7 @@ func (m *DiskPartitionReference) Reset()         { *m = DiskPartitionReference{}
 func (m *DiskPartitionReference) String() string { return proto.CompactTextString(m) }
 func (*DiskPartitionReference) ProtoMessage()    {}
 func (*DiskPartitionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{25}
+	return fileDescriptor_d8a4e87e678c5ced, []int{27}
 }
 
 func (m *DiskPartitionReference) XXX_Unmarshal(b []byte) error {
@@ -2173,7 +2310,7 @@ func (m *DiskPartitionInformation) Reset()         { *m = DiskPartitionInformati
 func (m *DiskPartitionInformation) String() string { return proto.CompactTextString(m) }
 func (*DiskPartitionInformation) ProtoMessage()    {}
 func (*DiskPartitionInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{26}
+	return fileDescriptor_d8a4e87e678c5ced, []int{28}
 }
 
 func (m *DiskPartitionInformation) XXX_Unmarshal(b []byte) error {
@@ -2242,7 +2379,7 @@ func (m *DiskPartitionStatistic) Reset()         { *m = DiskPartitionStatistic{}
 func (m *DiskPartitionStatistic) String() string { return proto.CompactTextString(m) }
 func (*DiskPartitionStatistic) ProtoMessage()    {}
 func (*DiskPartitionStatistic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8a4e87e678c5ced, []int{27}
+	return fileDescriptor_d8a4e87e678c5ced, []int{29}
 }
 
 func (m *DiskPartitionStatistic) XXX_Unmarshal(b []byte) error {
@@ -2289,6 +2426,8 @@ func init() {
 	proto.RegisterEnum("pganalyze.collector.QueryExplainInformation_ExplainSource", QueryExplainInformation_ExplainSource_name, QueryExplainInformation_ExplainSource_value)
 	proto.RegisterEnum("pganalyze.collector.SystemInformation_SystemType", SystemInformation_SystemType_name, SystemInformation_SystemType_value)
 	proto.RegisterType((*NullString)(nil), "pganalyze.collector.NullString")
+	proto.RegisterType((*NullInt64)(nil), "pganalyze.collector.NullInt64")
+	proto.RegisterType((*NullDouble)(nil), "pganalyze.collector.NullDouble")
 	proto.RegisterType((*NullTimestamp)(nil), "pganalyze.collector.NullTimestamp")
 	proto.RegisterType((*PostgresVersion)(nil), "pganalyze.collector.PostgresVersion")
 	proto.RegisterType((*RoleReference)(nil), "pganalyze.collector.RoleReference")
@@ -2321,203 +2460,210 @@ func init() {
 func init() { proto.RegisterFile("shared.proto", fileDescriptor_d8a4e87e678c5ced) }
 
 var fileDescriptor_d8a4e87e678c5ced = []byte{
-	// 3163 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x73, 0x1b, 0x49,
-	0x72, 0x16, 0xc4, 0x17, 0x90, 0x20, 0x5e, 0xc5, 0x17, 0x44, 0x6a, 0x46, 0x14, 0x34, 0x33, 0xe2,
-	0xce, 0xee, 0x72, 0x46, 0x5a, 0x8f, 0x67, 0xd7, 0xeb, 0x17, 0x86, 0x84, 0x46, 0xdc, 0xe5, 0x4b,
-	0x0d, 0xd0, 0x23, 0xcf, 0xa5, 0xa3, 0xd8, 0x5d, 0x00, 0x2b, 0xd4, 0xe8, 0x6e, 0x55, 0x55, 0x53,
-	0x04, 0xc3, 0xe7, 0xbd, 0xf8, 0xe6, 0x9b, 0xf7, 0xe6, 0x70, 0x84, 0xaf, 0xeb, 0xff, 0xe1, 0x93,
-	0xef, 0xfe, 0x0d, 0xfe, 0x0d, 0x8e, 0xca, 0xea, 0xea, 0x6e, 0x80, 0xa4, 0xa4, 0x71, 0xd8, 0x37,
-	0xd4, 0x97, 0x5f, 0x66, 0x65, 0x56, 0x75, 0x66, 0x65, 0x15, 0x60, 0x59, 0x5e, 0x50, 0xc1, 0xfc,
-	0xdd, 0x58, 0x44, 0x2a, 0x22, 0x2b, 0xf1, 0x88, 0x86, 0x34, 0x98, 0x5c, 0xb3, 0x5d, 0x2f, 0x0a,
-	0x02, 0xe6, 0xa9, 0x48, 0x6c, 0x3e, 0x1a, 0x45, 0xd1, 0x28, 0x60, 0x5f, 0x21, 0xe5, 0x3c, 0x19,
-	0x7e, 0xa5, 0xf8, 0x98, 0x49, 0x45, 0xc7, 0xb1, 0xd1, 0xea, 0xfc, 0x1a, 0xe0, 0x38, 0x09, 0x82,
-	0xbe, 0x12, 0x3c, 0x1c, 0x91, 0x55, 0x58, 0xb8, 0xa4, 0x01, 0xf7, 0xdb, 0xa5, 0xed, 0xd2, 0x4e,
-	0xd9, 0x31, 0x83, 0x14, 0x4d, 0x58, 0xfb, 0xfe, 0x76, 0x69, 0xa7, 0xe2, 0x98, 0x41, 0xe7, 0x07,
-	0xa8, 0x69, 0xcd, 0x81, 0x35, 0x78, 0x87, 0xf2, 0xd7, 0x45, 0xe5, 0xea, 0xf3, 0xcd, 0x5d, 0xe3,
-	0xd1, 0xae, 0xf5, 0x68, 0x37, 0x33, 0x60, 0x0d, 0x9f, 0x41, 0xe3, 0x34, 0x92, 0x6a, 0x24, 0x98,
-	0xfc, 0x3b, 0x26, 0x24, 0x8f, 0x42, 0x42, 0x60, 0x7e, 0x98, 0x04, 0x01, 0x5a, 0xae, 0x38, 0xf8,
-	0x5b, 0x4f, 0x27, 0x2f, 0x22, 0xa1, 0xac, 0x57, 0x38, 0x20, 0x6d, 0x58, 0x0a, 0x93, 0x31, 0x13,
-	0xdc, 0x6b, 0xcf, 0x6d, 0x97, 0x76, 0xe6, 0x1c, 0x3b, 0xec, 0x3c, 0x81, 0x9a, 0x13, 0x05, 0xcc,
-	0x61, 0x43, 0x26, 0x58, 0xe8, 0x31, 0x6d, 0x34, 0xa4, 0x63, 0x66, 0x8d, 0xea, 0xdf, 0x9d, 0xa7,
-	0xd0, 0xda, 0xa7, 0x8a, 0x9e, 0x53, 0xf9, 0x01, 0xe2, 0x3f, 0x40, 0xcb, 0x61, 0x01, 0x55, 0x3c,
-	0x0a, 0x73, 0xe2, 0x63, 0x58, 0xf6, 0x53, 0x6d, 0x97, 0xfb, 0x57, 0xa8, 0xb0, 0xe0, 0x54, 0x2d,
-	0x76, 0xe0, 0x5f, 0x91, 0x47, 0x50, 0x95, 0xde, 0x05, 0x1b, 0x53, 0x17, 0x4d, 0x1a, 0xdf, 0xc1,
-	0x40, 0xc7, 0x74, 0xcc, 0xc8, 0x13, 0xa8, 0x89, 0xd4, 0xb0, 0xa1, 0xcc, 0x21, 0x65, 0xd9, 0x82,
-	0x9a, 0xd4, 0x91, 0x50, 0x3f, 0x08, 0x7d, 0x76, 0xf5, 0x7f, 0x3b, 0xf5, 0x27, 0x00, 0x5c, 0x5b,
-	0x2d, 0xce, 0x5b, 0x41, 0x04, 0x27, 0xfd, 0x63, 0x09, 0x5a, 0x2f, 0x92, 0xd0, 0xfb, 0x7f, 0x89,
-	0x79, 0x98, 0x1a, 0x9e, 0x8a, 0xd9, 0x82, 0x48, 0x7a, 0x08, 0x15, 0x2a, 0x46, 0xc9, 0x98, 0x85,
-	0x4a, 0xb6, 0xe7, 0x8d, 0x73, 0x19, 0xd0, 0x89, 0xa1, 0xfe, 0x2a, 0x61, 0x62, 0xf2, 0x93, 0x1c,
-	0x7b, 0x00, 0x65, 0x11, 0x05, 0x46, 0x7c, 0x1f, 0xc5, 0x4b, 0x7a, 0xac, 0x45, 0xdb, 0x50, 0x1d,
-	0xf2, 0x70, 0xc4, 0x44, 0x2c, 0x78, 0xa8, 0xd0, 0xa1, 0x65, 0xa7, 0x08, 0x75, 0xde, 0x41, 0x13,
-	0x67, 0x3c, 0x08, 0x87, 0x91, 0x18, 0xe3, 0xde, 0x90, 0x2d, 0xa8, 0xbc, 0xd5, 0x58, 0x61, 0xc2,
-	0x32, 0x02, 0xda, 0xe4, 0xcf, 0xa0, 0x19, 0x6a, 0x66, 0xc0, 0xaf, 0x99, 0xef, 0x22, 0x9c, 0xae,
-	0x45, 0x23, 0xc7, 0xd1, 0x64, 0xd1, 0x8e, 0x6c, 0xcf, 0x6d, 0xcf, 0xed, 0xcc, 0x65, 0x76, 0x64,
-	0xe7, 0x9f, 0xe7, 0x61, 0x03, 0x69, 0xbd, 0xab, 0x38, 0xa0, 0x3c, 0xfc, 0x68, 0x07, 0x3e, 0x87,
-	0x3a, 0x33, 0x2a, 0x6e, 0x94, 0xa8, 0x38, 0xb1, 0xa9, 0x53, 0x4b, 0xd1, 0x13, 0x04, 0xf5, 0x6e,
-	0x58, 0x1a, 0x13, 0x22, 0x12, 0x76, 0x37, 0x52, 0xb0, 0xa7, 0x31, 0x42, 0x73, 0x5b, 0x66, 0x76,
-	0xdc, 0x92, 0xfa, 0xf3, 0xbf, 0xd8, 0xbd, 0xa5, 0x0c, 0xed, 0xde, 0xe1, 0xee, 0x6e, 0x0a, 0xbd,
-	0x40, 0x20, 0xf3, 0xc3, 0x0c, 0x8b, 0x53, 0xc8, 0x28, 0x11, 0x1e, 0x6b, 0x2f, 0xfc, 0xef, 0xa7,
-	0xe8, 0xa3, 0x85, 0x6c, 0x0a, 0x33, 0xec, 0x74, 0xa1, 0x36, 0xe5, 0x02, 0xd9, 0x80, 0x95, 0x41,
-	0xef, 0xf5, 0xc0, 0xed, 0xbd, 0x3e, 0x3d, 0xec, 0x1e, 0x1c, 0xbb, 0x2f, 0x4e, 0x9c, 0xa3, 0xee,
-	0xa0, 0x79, 0x4f, 0x0b, 0x7e, 0xd7, 0x3f, 0x39, 0x9e, 0x15, 0x94, 0x3a, 0xff, 0x58, 0xca, 0x6c,
-	0x18, 0xa3, 0x64, 0x1b, 0x1e, 0xf6, 0x07, 0xdd, 0x41, 0xef, 0xa8, 0x77, 0x3c, 0x70, 0x0f, 0x4f,
-	0xbe, 0xcf, 0x74, 0xfa, 0x27, 0x67, 0xce, 0x5e, 0xaf, 0x79, 0x8f, 0x3c, 0x82, 0xad, 0xee, 0xd9,
-	0xe0, 0x24, 0x13, 0xcc, 0x10, 0x4a, 0x64, 0x0b, 0x36, 0x7a, 0xaf, 0x07, 0x3d, 0xe7, 0xb8, 0x7b,
-	0x38, 0x2b, 0xbc, 0x4f, 0x36, 0x61, 0xfd, 0xfb, 0xde, 0x71, 0xcf, 0x39, 0xd8, 0x9b, 0x95, 0xcd,
-	0x75, 0xfe, 0x54, 0x85, 0xc5, 0xfe, 0x44, 0x2a, 0x36, 0x26, 0x67, 0x40, 0x24, 0xfe, 0x72, 0x79,
-	0xbe, 0x1c, 0xf8, 0x4d, 0x54, 0x9f, 0x7f, 0x71, 0xeb, 0x12, 0x1a, 0xc5, 0xc2, 0xe2, 0x39, 0x2d,
-	0x39, 0x0b, 0xe9, 0x2f, 0xcc, 0x9a, 0xf5, 0xd3, 0xef, 0xa7, 0x9c, 0xb2, 0x7c, 0x9d, 0x73, 0xa9,
-	0x50, 0x7a, 0x51, 0x6c, 0xf3, 0xb8, 0x6a, 0xb0, 0xbe, 0x86, 0xc8, 0x6b, 0x58, 0xd1, 0x99, 0xef,
-	0x27, 0x01, 0x13, 0xae, 0x54, 0x54, 0x71, 0xa9, 0xb8, 0xd7, 0x06, 0xf4, 0xeb, 0xe9, 0xed, 0x7e,
-	0x59, 0x7e, 0xdf, 0xd2, 0x1d, 0x22, 0x6f, 0x60, 0xe4, 0x04, 0x9a, 0x63, 0x36, 0x8e, 0xc4, 0xa4,
-	0x60, 0xb6, 0x8a, 0x66, 0x3f, 0xbb, 0xd5, 0xec, 0x11, 0x92, 0x73, 0x9b, 0x8d, 0xf1, 0x34, 0x40,
-	0x0e, 0xa1, 0xe1, 0xc5, 0xc9, 0xd4, 0xf2, 0x2d, 0xa3, 0xbd, 0x27, 0xb7, 0xda, 0xdb, 0x3b, 0x3d,
-	0x2b, 0xae, 0x5d, 0xdd, 0x8b, 0x93, 0xe2, 0xc2, 0xbd, 0x04, 0x8d, 0xb8, 0xc2, 0x16, 0x28, 0xd9,
-	0xae, 0x6d, 0xcf, 0xed, 0x54, 0x9f, 0x3f, 0xbe, 0xcb, 0x58, 0x56, 0xca, 0x9c, 0x9a, 0x17, 0x27,
-	0xd9, 0x48, 0x5a, 0x4b, 0x59, 0x94, 0xb2, 0x5d, 0x7f, 0xbf, 0xa5, 0x3c, 0x46, 0x6d, 0x29, 0x1b,
-	0x49, 0x32, 0x00, 0x12, 0x32, 0xf5, 0x2e, 0x12, 0x6f, 0x8a, 0x7e, 0x35, 0xd0, 0xda, 0xe7, 0xb7,
-	0x5a, 0x3b, 0x36, 0xf4, 0xdc, 0xb7, 0x56, 0x38, 0x83, 0x4c, 0x59, 0x2d, 0xf8, 0xd8, 0xfc, 0xb0,
-	0xd5, 0xdc, 0x4f, 0x6b, 0xb5, 0xe0, 0xeb, 0xef, 0xa1, 0xe1, 0x73, 0x39, 0xe5, 0x68, 0x0b, 0x4d,
-	0x76, 0x6e, 0x35, 0xb9, 0xcf, 0x65, 0xc1, 0xcb, 0xba, 0x5f, 0x1c, 0x4a, 0xf2, 0x0a, 0x5a, 0x68,
-	0xac, 0xb0, 0xb7, 0xb2, 0x4d, 0xd0, 0xdc, 0x67, 0x77, 0x9a, 0x2b, 0xee, 0x6e, 0xd3, 0x9f, 0x06,
-	0x72, 0xff, 0x0a, 0x21, 0xaf, 0x7c, 0xc0, 0xbf, 0x3c, 0x5e, 0xf4, 0xaf, 0x10, 0xec, 0x08, 0x1e,
-	0xa0, 0xb1, 0x98, 0x0a, 0xc5, 0xf1, 0x5c, 0x2c, 0x84, 0xbd, 0x8a, 0x66, 0x7f, 0x7e, 0xa7, 0xd9,
-	0x53, 0xab, 0x94, 0xc7, 0xbf, 0xe1, 0xdf, 0x8a, 0x4b, 0x32, 0x86, 0xad, 0x99, 0x89, 0xa6, 0x96,
-	0x64, 0x0d, 0xa7, 0xfa, 0xe5, 0x87, 0xa7, 0x2a, 0xae, 0xcd, 0x03, 0xff, 0x0e, 0xc9, 0x6d, 0x71,
-	0x15, 0x96, 0x6b, 0xfd, 0x63, 0xe3, 0xca, 0xd7, 0x6d, 0x3a, 0xae, 0xc2, 0x02, 0xbe, 0x84, 0xc7,
-	0xfa, 0xa4, 0x77, 0x7d, 0x2e, 0xd0, 0xc0, 0xc4, 0x9d, 0x0d, 0xd3, 0xbf, 0x6a, 0x7f, 0x8a, 0x07,
-	0xe4, 0x27, 0x9a, 0xb8, 0x6f, 0x79, 0xd3, 0x51, 0xf9, 0x57, 0xe4, 0x1b, 0xd8, 0xb8, 0x0a, 0xa2,
-	0xd1, 0x6d, 0xfa, 0x8f, 0x50, 0x7f, 0x55, 0x8b, 0x6f, 0xa8, 0x7d, 0x01, 0x0d, 0x54, 0x4b, 0x24,
-	0xf3, 0xdd, 0xf3, 0x89, 0x62, 0xb2, 0xbd, 0xbd, 0x5d, 0xda, 0x99, 0x77, 0x6a, 0x1a, 0x3e, 0x93,
-	0xcc, 0xff, 0x4e, 0x83, 0x9d, 0xff, 0x98, 0x83, 0xd6, 0x8d, 0xc2, 0x4b, 0x7a, 0x30, 0xaf, 0x26,
-	0xb1, 0x69, 0x39, 0xeb, 0xcf, 0x9f, 0x7d, 0x5c, 0xb9, 0x4e, 0x91, 0xc1, 0x24, 0x66, 0x0e, 0xaa,
-	0x93, 0x3e, 0x54, 0x25, 0x0b, 0x86, 0xee, 0x45, 0x24, 0x15, 0xf3, 0xd3, 0x16, 0xfc, 0xeb, 0x8f,
-	0xb3, 0xd6, 0x67, 0xc1, 0xf0, 0x25, 0xea, 0xbd, 0xbc, 0xe7, 0x80, 0xcc, 0x46, 0xe4, 0x14, 0x80,
-	0x8e, 0xe9, 0xb5, 0xfe, 0x26, 0xb1, 0x3b, 0xd1, 0x36, 0xbf, 0xfa, 0x38, 0x9b, 0x5d, 0xd4, 0x73,
-	0xf6, 0xfb, 0x2f, 0xef, 0x39, 0x15, 0x63, 0xc4, 0xf1, 0x25, 0xf9, 0x16, 0x2a, 0xe7, 0x51, 0xa4,
-	0x5c, 0x7d, 0x39, 0x49, 0x4f, 0x82, 0xf7, 0xdd, 0x13, 0xca, 0x9a, 0xac, 0x87, 0x9d, 0x3f, 0x94,
-	0x00, 0xf2, 0xa0, 0xc9, 0x3a, 0x90, 0x7e, 0xef, 0xf0, 0x85, 0xfb, 0xf2, 0xa4, 0x3f, 0xe8, 0xed,
-	0xbb, 0xfd, 0xbf, 0xef, 0x0f, 0x7a, 0x47, 0xcd, 0x7b, 0x64, 0x0d, 0x5a, 0xdd, 0xa3, 0xee, 0x8f,
-	0x27, 0xc7, 0xae, 0xb3, 0xdf, 0xb7, 0x70, 0x89, 0xb4, 0xa0, 0xf6, 0xb2, 0xe7, 0x9c, 0xfc, 0xfe,
-	0xcc, 0x42, 0xf7, 0xf5, 0xc1, 0xfb, 0xfd, 0xc9, 0xc9, 0xf7, 0x87, 0x3d, 0x77, 0xef, 0xf0, 0xe4,
-	0x6c, 0xdf, 0xed, 0xbf, 0x3a, 0xb4, 0xc2, 0x39, 0xf2, 0x00, 0xd6, 0xba, 0x3f, 0x9e, 0x39, 0x3d,
-	0x77, 0xbf, 0x3b, 0xe8, 0x7e, 0xd7, 0xed, 0xf7, 0xac, 0x68, 0xfe, 0xbb, 0x45, 0x98, 0xd7, 0x79,
-	0xd3, 0xf9, 0xa7, 0x39, 0xd8, 0x7a, 0xcf, 0x4a, 0x92, 0x4d, 0x28, 0xeb, 0xbd, 0x28, 0x5c, 0x27,
-	0xb2, 0x31, 0xe9, 0xc0, 0x32, 0x15, 0xde, 0x05, 0x57, 0xcc, 0x53, 0x89, 0xb0, 0x7d, 0xf2, 0x14,
-	0xa6, 0x7b, 0xc8, 0x28, 0x66, 0x82, 0x2a, 0x1e, 0x8e, 0x5c, 0x73, 0xac, 0xa6, 0x87, 0x6c, 0x23,
-	0xc3, 0xd3, 0xf3, 0x7f, 0x13, 0xca, 0x71, 0x40, 0x95, 0xf6, 0x22, 0x6d, 0x97, 0xb3, 0x31, 0x79,
-	0x0a, 0x0d, 0xfb, 0xdb, 0x1d, 0xd2, 0x31, 0x0f, 0x26, 0xd8, 0x5b, 0x55, 0x9c, 0xba, 0x85, 0x5f,
-	0x20, 0xaa, 0xe7, 0xcb, 0x88, 0x97, 0xe6, 0x32, 0xd6, 0x5e, 0x34, 0xf3, 0x59, 0xdc, 0xde, 0xd1,
-	0x7e, 0x05, 0x6b, 0x97, 0x5c, 0xa8, 0x44, 0xf7, 0xb1, 0xe6, 0xfa, 0x92, 0xfa, 0xb7, 0x84, 0xfc,
-	0xd5, 0x69, 0x61, 0xea, 0xe4, 0xe7, 0x50, 0x7f, 0xc3, 0x44, 0xc8, 0x82, 0xcc, 0x7a, 0xd9, 0xb4,
-	0xa4, 0x06, 0xb5, 0xb6, 0xff, 0x12, 0x36, 0xb3, 0x5e, 0x3e, 0xeb, 0x3e, 0x58, 0xa8, 0xf8, 0x90,
-	0x33, 0xd1, 0xae, 0xa0, 0x4a, 0xdb, 0x32, 0xd2, 0xf5, 0xcf, 0xe4, 0x9d, 0x7f, 0x05, 0xd8, 0xbc,
-	0xfb, 0x53, 0x24, 0xeb, 0xb0, 0x28, 0xd8, 0xc8, 0x36, 0x47, 0x15, 0x27, 0x1d, 0x69, 0xdf, 0x78,
-	0x28, 0x15, 0x0d, 0x3d, 0xe6, 0x7a, 0x01, 0x95, 0xd2, 0xb6, 0xcb, 0x16, 0xdd, 0xd3, 0xa0, 0xbe,
-	0xdd, 0x64, 0x34, 0xee, 0xa7, 0xbb, 0x01, 0x16, 0x3a, 0xf0, 0xb5, 0x7d, 0x5d, 0xe4, 0x12, 0x7b,
-	0x6b, 0x49, 0x47, 0xe4, 0xe7, 0xd0, 0xa2, 0x97, 0x94, 0x07, 0xf4, 0x9c, 0x07, 0x5c, 0x4d, 0xdc,
-	0xeb, 0x28, 0x64, 0xe9, 0x36, 0x34, 0x8b, 0x82, 0x1f, 0xa3, 0x90, 0x91, 0xaf, 0x60, 0x25, 0x4e,
-	0xce, 0x03, 0xee, 0x05, 0x13, 0x97, 0x7a, 0x1e, 0x93, 0x92, 0x9f, 0x07, 0x0c, 0xf7, 0xa2, 0xec,
-	0x10, 0x2b, 0xea, 0x66, 0x12, 0x7d, 0xb7, 0x19, 0x27, 0x81, 0xe2, 0x2e, 0xbd, 0xc6, 0x1d, 0x28,
-	0x3b, 0x4b, 0x38, 0xee, 0x5e, 0x93, 0xbf, 0x86, 0x2d, 0xc9, 0xbc, 0x28, 0xf4, 0xa9, 0x98, 0xb8,
-	0x37, 0x5d, 0x30, 0x3b, 0xf0, 0x20, 0xa3, 0x74, 0x67, 0x7d, 0xf9, 0x1c, 0xea, 0x1e, 0x75, 0x3d,
-	0x26, 0xf4, 0xfa, 0x7a, 0x54, 0xb1, 0x74, 0x07, 0x6a, 0x1e, 0xdd, 0xcb, 0x41, 0xf2, 0x5b, 0xd8,
-	0xa4, 0x89, 0x8a, 0xdc, 0x31, 0x0f, 0x23, 0x61, 0xf7, 0xd7, 0x4d, 0xe2, 0x91, 0xa0, 0xbe, 0x49,
-	0xf3, 0xb2, 0xb3, 0xa1, 0x19, 0x47, 0x9a, 0x90, 0x6e, 0xf5, 0x99, 0x11, 0x93, 0xbf, 0x85, 0x87,
-	0x31, 0x1e, 0x79, 0x82, 0xf9, 0xee, 0x98, 0xf2, 0x50, 0xb1, 0x10, 0x97, 0xf8, 0x1d, 0x0f, 0xfd,
-	0xe8, 0x1d, 0x36, 0x62, 0x15, 0x67, 0x33, 0xe3, 0x1c, 0xe5, 0x94, 0x1f, 0x90, 0x41, 0xfe, 0x1c,
-	0x36, 0x72, 0x0b, 0xe7, 0xd4, 0x7b, 0x93, 0xc4, 0x56, 0xb9, 0x8e, 0xca, 0x6b, 0x99, 0xf8, 0x3b,
-	0x94, 0xa6, 0x7a, 0xa7, 0xb0, 0x1e, 0x50, 0xc5, 0xa4, 0x72, 0x05, 0x93, 0x2a, 0x12, 0xf4, 0x3c,
-	0x60, 0xa6, 0x32, 0xd5, 0x3e, 0x58, 0x99, 0x56, 0x8d, 0xa6, 0x93, 0x29, 0x6a, 0x11, 0xf9, 0x1b,
-	0x78, 0x98, 0xce, 0x2f, 0x98, 0xd2, 0x5f, 0x65, 0x14, 0xba, 0x31, 0x13, 0x3c, 0xf2, 0x5d, 0x9f,
-	0x4e, 0x74, 0xbf, 0xa5, 0x8f, 0x91, 0x07, 0x86, 0xe3, 0x58, 0xca, 0x29, 0x32, 0xf6, 0xe9, 0x44,
-	0xea, 0x74, 0x1d, 0x53, 0xa9, 0x98, 0xd0, 0xa7, 0x89, 0xc0, 0xe2, 0xd1, 0x34, 0xe9, 0x6a, 0xe0,
-	0xb3, 0x14, 0xd5, 0x87, 0x0e, 0x0f, 0xb9, 0xe2, 0x34, 0x70, 0xfd, 0x73, 0x73, 0x95, 0x6e, 0xd9,
-	0x6f, 0x16, 0xe1, 0xfd, 0x73, 0xbc, 0x4b, 0xff, 0x06, 0xc0, 0x13, 0x8c, 0x2a, 0xe6, 0xbb, 0x54,
-	0xb5, 0xc9, 0x07, 0xe3, 0xaa, 0xa4, 0xec, 0xae, 0xd2, 0x1f, 0x22, 0x0b, 0x2f, 0xf4, 0x3a, 0xfb,
-	0xee, 0x38, 0x0a, 0xb9, 0x8a, 0x04, 0x0f, 0x47, 0xed, 0x15, 0xf3, 0x21, 0x5a, 0xd1, 0x51, 0x26,
-	0x21, 0xcf, 0x60, 0x35, 0x66, 0x02, 0xf3, 0x0e, 0x53, 0x24, 0x94, 0x7c, 0x74, 0xa1, 0x74, 0x17,
-	0xa3, 0x35, 0x56, 0x0a, 0xb2, 0x83, 0x54, 0x44, 0x76, 0x61, 0x25, 0x4e, 0x5f, 0x80, 0x5c, 0x7d,
-	0x86, 0xb2, 0xab, 0x38, 0x12, 0xaa, 0xbd, 0x86, 0x1a, 0x2d, 0x2b, 0x3a, 0x8c, 0x46, 0x3d, 0x14,
-	0x90, 0x5f, 0x02, 0xe1, 0x74, 0xec, 0xd2, 0x44, 0x5d, 0xe8, 0xb5, 0xf3, 0x4c, 0xaf, 0xbe, 0x6e,
-	0xe8, 0x9c, 0x8e, 0xbb, 0x53, 0x02, 0x1d, 0x82, 0xcf, 0x02, 0x66, 0xf6, 0x41, 0x44, 0xba, 0xb6,
-	0x6a, 0xfe, 0x86, 0x09, 0xc1, 0x8a, 0x4e, 0x33, 0x09, 0xf9, 0x33, 0x58, 0x8f, 0xa9, 0xa0, 0x63,
-	0xa6, 0xb7, 0x80, 0xc6, 0x71, 0x60, 0xae, 0x18, 0x89, 0x6c, 0xef, 0x98, 0xda, 0x96, 0x49, 0xbb,
-	0x5a, 0xd8, 0x37, 0xf9, 0x3d, 0xa5, 0x15, 0x8f, 0xa4, 0x74, 0x59, 0xa8, 0xbf, 0x09, 0xbf, 0xfd,
-	0x33, 0x9c, 0x29, 0xd7, 0x3a, 0x1d, 0x49, 0xd9, 0x33, 0x32, 0xb2, 0x07, 0x9f, 0x16, 0xe6, 0xd2,
-	0xf9, 0x93, 0x5d, 0xc6, 0x53, 0xed, 0x2f, 0x51, 0x7b, 0x2b, 0x9f, 0x33, 0x51, 0x51, 0x7a, 0x03,
-	0xb5, 0x46, 0x7e, 0x01, 0x84, 0x4b, 0x97, 0x26, 0x22, 0x12, 0xd4, 0xb5, 0xeb, 0xd5, 0x7e, 0x8e,
-	0x8a, 0x4d, 0x2e, 0xbb, 0x28, 0xb0, 0x8f, 0x6c, 0xbf, 0x9b, 0x2f, 0x57, 0x9b, 0xcb, 0x9d, 0x3f,
-	0x96, 0x80, 0xdc, 0xbc, 0x69, 0x91, 0x2f, 0xa1, 0x15, 0x44, 0xd4, 0x77, 0xe9, 0x25, 0x13, 0x74,
-	0xc4, 0xdc, 0x67, 0x63, 0x6e, 0x0a, 0x65, 0xc9, 0x69, 0x68, 0x41, 0xd7, 0xe0, 0x1a, 0xbe, 0xc1,
-	0xfd, 0x46, 0x73, 0xef, 0xdf, 0xe0, 0x6a, 0x58, 0xbb, 0x38, 0x6d, 0x17, 0xc9, 0x73, 0x48, 0x6e,
-	0x16, 0x0d, 0x6b, 0xbc, 0xf3, 0x6f, 0x8b, 0xd0, 0x98, 0xb9, 0xaf, 0xe9, 0xc2, 0xab, 0x22, 0x45,
-	0x83, 0xb4, 0xbb, 0x2a, 0x61, 0x77, 0x05, 0x08, 0x61, 0x6b, 0xa5, 0x6f, 0xa3, 0x1e, 0xd5, 0x11,
-	0xa5, 0x8c, 0xfb, 0xc8, 0xa8, 0x1a, 0xcc, 0x50, 0x9e, 0x40, 0xed, 0x3c, 0x19, 0x0e, 0x99, 0x90,
-	0x29, 0x67, 0x0e, 0x39, 0xcb, 0x29, 0x68, 0x48, 0x9f, 0x00, 0x0c, 0x05, 0x63, 0x29, 0x63, 0x1e,
-	0x19, 0x15, 0x8d, 0x18, 0xf1, 0x53, 0x68, 0xbc, 0x13, 0x5c, 0x31, 0x9d, 0xbf, 0x29, 0x67, 0x01,
-	0x39, 0xf5, 0x0c, 0x36, 0xc4, 0x47, 0x50, 0xf5, 0xb9, 0x50, 0x93, 0x94, 0xb4, 0x68, 0x1c, 0x46,
-	0x28, 0x9b, 0x48, 0x06, 0xf4, 0x3c, 0x95, 0x2f, 0x99, 0x89, 0x34, 0x92, 0xc5, 0x33, 0xa6, 0x71,
-	0x9c, 0xc5, 0x53, 0x36, 0xf1, 0x18, 0xcc, 0x50, 0xbe, 0x84, 0x56, 0xac, 0x57, 0x53, 0xe9, 0xef,
-	0xc0, 0xc6, 0x54, 0x41, 0x5e, 0x43, 0x0b, 0x06, 0x88, 0x67, 0xe6, 0xa8, 0xa7, 0xf8, 0xa5, 0x0d,
-	0x0c, 0x8c, 0x39, 0x83, 0x19, 0x0a, 0x1e, 0x81, 0x53, 0xa4, 0xaa, 0xe9, 0x61, 0x2d, 0x9a, 0xad,
-	0x40, 0x7a, 0x8c, 0x04, 0x96, 0xb7, 0x6c, 0x56, 0x20, 0x83, 0x0d, 0xf1, 0x0b, 0x68, 0xc8, 0x77,
-	0x34, 0x2e, 0x36, 0xc5, 0x35, 0x63, 0x50, 0xc3, 0x59, 0x53, 0x4c, 0x76, 0xa0, 0x89, 0xbc, 0xe2,
-	0xfe, 0xd6, 0x8d, 0x45, 0x8d, 0x0f, 0xf2, 0x3d, 0x7e, 0x06, 0x6b, 0x17, 0xc9, 0x88, 0xb9, 0x3a,
-	0x38, 0xe9, 0x4a, 0x7e, 0x6d, 0x1d, 0x58, 0x45, 0x3a, 0xd1, 0xc2, 0x53, 0x2d, 0xeb, 0xf3, 0xeb,
-	0xdc, 0x89, 0x82, 0x8a, 0xde, 0x47, 0xac, 0x2c, 0xf3, 0x4e, 0x2d, 0x23, 0xbf, 0x10, 0x8c, 0x69,
-	0x27, 0x0a, 0x3c, 0x74, 0x05, 0x6b, 0xca, 0xbc, 0x53, 0xcf, 0x88, 0xe8, 0x89, 0xae, 0x57, 0x05,
-	0xa6, 0x60, 0x92, 0x89, 0x4b, 0xe6, 0x63, 0x41, 0x99, 0x77, 0x5a, 0x19, 0xd9, 0x49, 0x05, 0xfa,
-	0xdb, 0x2f, 0x3a, 0x9d, 0x88, 0x38, 0x48, 0x64, 0xbb, 0x8d, 0xf4, 0x66, 0xee, 0xb1, 0xc1, 0xb1,
-	0x4f, 0x88, 0xe3, 0x20, 0xad, 0x5e, 0x69, 0x78, 0x9f, 0x1a, 0x72, 0x41, 0x60, 0xae, 0x13, 0xff,
-	0x5d, 0x82, 0xfa, 0xf4, 0x43, 0x04, 0x59, 0x85, 0x85, 0x71, 0xe4, 0x33, 0xfb, 0x7a, 0x6e, 0x06,
-	0x3a, 0x3a, 0x4c, 0x84, 0xe2, 0x9a, 0x99, 0x37, 0xd0, 0x3a, 0xe2, 0xf9, 0x7a, 0x6d, 0x41, 0x45,
-	0xc6, 0x4c, 0x97, 0xfb, 0x8b, 0xeb, 0x34, 0x41, 0xcb, 0x08, 0x1c, 0x5d, 0x5c, 0xe3, 0x8b, 0x4f,
-	0xe4, 0xbd, 0x61, 0xca, 0xf5, 0xa2, 0x24, 0x34, 0xaf, 0x80, 0x0b, 0x4e, 0xd5, 0x60, 0x7b, 0x1a,
-	0xc2, 0x6a, 0x7e, 0x31, 0x91, 0xdc, 0xa3, 0x81, 0xeb, 0x45, 0x82, 0xa5, 0xcc, 0x05, 0x64, 0xb6,
-	0xac, 0x68, 0x2f, 0x12, 0xcc, 0xf0, 0xb1, 0x32, 0x8c, 0x66, 0xe9, 0x8b, 0x48, 0x6f, 0xa6, 0x92,
-	0x8c, 0xdd, 0x79, 0x0a, 0xcb, 0xc5, 0xb7, 0x12, 0xb2, 0x01, 0x4b, 0xa8, 0x95, 0xfe, 0x0f, 0x51,
-	0x71, 0x16, 0xf5, 0xf0, 0xc0, 0xef, 0xfc, 0xcb, 0x1c, 0x32, 0xf3, 0xfa, 0xa1, 0x99, 0x71, 0x52,
-	0x78, 0x29, 0x5d, 0xf4, 0xe2, 0x44, 0x5f, 0xdd, 0x1e, 0xc3, 0xb2, 0x3e, 0x67, 0xf5, 0x19, 0xed,
-	0xb1, 0x50, 0xa5, 0x15, 0xac, 0xaa, 0xb1, 0x53, 0x03, 0xe9, 0xc4, 0x48, 0xfb, 0x50, 0x4b, 0x32,
-	0x0b, 0x53, 0x33, 0xa8, 0xa5, 0x3d, 0x86, 0x65, 0xee, 0x07, 0x2c, 0x23, 0xcd, 0x1b, 0x4b, 0x1a,
-	0x2b, 0x50, 0x42, 0xee, 0xe5, 0x94, 0x05, 0x43, 0xd1, 0x58, 0x61, 0x32, 0x1e, 0xbd, 0xa3, 0x5c,
-	0x65, 0xa4, 0x45, 0x33, 0x99, 0x41, 0x2d, 0x4d, 0x37, 0xa2, 0xe2, 0x6d, 0xc6, 0x59, 0x42, 0x0e,
-	0x70, 0xf1, 0xd6, 0x12, 0x74, 0x56, 0x45, 0x43, 0xe5, 0x16, 0x59, 0x65, 0x64, 0xd5, 0x35, 0x7e,
-	0x90, 0x33, 0x9f, 0x40, 0x4d, 0x2a, 0x46, 0x83, 0x8c, 0x56, 0x41, 0xda, 0x32, 0x82, 0x05, 0xd2,
-	0x28, 0xd1, 0x7d, 0x92, 0x25, 0x81, 0x21, 0x21, 0x68, 0x49, 0xbf, 0x00, 0x62, 0x48, 0x53, 0x41,
-	0x56, 0x4d, 0x99, 0x47, 0xc9, 0x71, 0x1e, 0x69, 0xe7, 0x37, 0xd0, 0x9c, 0x7d, 0x60, 0x32, 0x35,
-	0x48, 0x31, 0x31, 0xa4, 0x1e, 0x73, 0x0b, 0x17, 0xa7, 0x5a, 0x86, 0xe2, 0xbf, 0x13, 0xff, 0x59,
-	0xca, 0x74, 0xa7, 0x8e, 0x08, 0xfb, 0x12, 0x95, 0x6f, 0x33, 0xa4, 0x90, 0xde, 0xea, 0x63, 0xf8,
-	0x4c, 0x09, 0x1a, 0xca, 0x31, 0x57, 0xae, 0xba, 0x10, 0x51, 0x32, 0xba, 0x88, 0x13, 0x65, 0xd2,
-	0x41, 0x7b, 0xeb, 0x9a, 0x2e, 0x38, 0x3d, 0x3a, 0xb6, 0x2d, 0x77, 0x90, 0x51, 0x31, 0x45, 0x4e,
-	0x99, 0xe8, 0x23, 0x8f, 0x1c, 0xc2, 0x13, 0xc1, 0x3c, 0xa6, 0xeb, 0xe5, 0xfb, 0xcc, 0x99, 0x53,
-	0xe6, 0x51, 0x4a, 0xbd, 0xcb, 0x5a, 0xe7, 0x6b, 0xa8, 0x4d, 0x3d, 0x63, 0xe1, 0x09, 0xc2, 0x2e,
-	0xf9, 0xf4, 0x42, 0x80, 0x81, 0x70, 0x15, 0xfe, 0xbd, 0x04, 0x8d, 0x99, 0xa7, 0x2a, 0x7d, 0x13,
-	0x30, 0x6f, 0x5d, 0xd9, 0x0a, 0x2c, 0xe1, 0xe3, 0x95, 0x7f, 0xa5, 0x53, 0x1b, 0x45, 0xf8, 0xd6,
-	0x90, 0x3e, 0xe6, 0x6a, 0x00, 0x6f, 0xd3, 0x0f, 0xa1, 0x92, 0xbd, 0xb2, 0xda, 0x7f, 0x83, 0x32,
-	0x00, 0x6f, 0x86, 0x22, 0xba, 0xe4, 0xba, 0x69, 0x67, 0xbe, 0xcb, 0xa3, 0xd8, 0x1c, 0x8d, 0x35,
-	0xa7, 0x51, 0xc0, 0x0f, 0xa2, 0x58, 0x6a, 0x43, 0x2c, 0xf4, 0xc4, 0x24, 0x56, 0xcc, 0xc7, 0xef,
-	0xbb, 0xec, 0xe4, 0x40, 0xe7, 0x4f, 0xf3, 0x26, 0xca, 0x7c, 0xd7, 0xde, 0xe3, 0xf0, 0x6f, 0x61,
-	0x53, 0x30, 0xea, 0xbb, 0xe9, 0x65, 0x37, 0x0a, 0x6f, 0xec, 0x52, 0xc9, 0xd9, 0xd0, 0x8c, 0x93,
-	0x8c, 0x90, 0x6f, 0xce, 0x37, 0x80, 0x22, 0xe9, 0x8e, 0x99, 0x18, 0x31, 0x7f, 0x76, 0x43, 0x4a,
-	0xce, 0x2a, 0x8a, 0x8f, 0x50, 0x9a, 0xab, 0x3d, 0x83, 0x35, 0xb3, 0x81, 0x38, 0x73, 0x41, 0xc9,
-	0x64, 0x33, 0x41, 0xa1, 0xc3, 0x68, 0x41, 0x65, 0x07, 0x9a, 0xf4, 0x72, 0x64, 0x14, 0xf4, 0x95,
-	0x20, 0xf4, 0x26, 0x69, 0x62, 0xd7, 0xe9, 0xe5, 0x48, 0x73, 0x0f, 0x0d, 0x4a, 0xfe, 0x0a, 0xb6,
-	0xb0, 0x4b, 0xb8, 0x23, 0x22, 0x93, 0xe8, 0x6d, 0xa4, 0xdc, 0x16, 0xd2, 0xb7, 0x60, 0x64, 0xb7,
-	0xc5, 0x64, 0x0a, 0xc0, 0x9a, 0x91, 0xcf, 0x06, 0xf5, 0x2d, 0xb4, 0x4d, 0x50, 0x5a, 0xac, 0x58,
-	0x58, 0x54, 0x34, 0x35, 0xc1, 0x04, 0xfd, 0x83, 0x11, 0xe7, 0x8a, 0x5f, 0xea, 0x5b, 0xeb, 0xc8,
-	0x35, 0x4e, 0xdb, 0xd8, 0x4c, 0x79, 0x68, 0xd0, 0xcb, 0x91, 0xe6, 0x33, 0x1b, 0xdc, 0x67, 0xa0,
-	0xc3, 0x75, 0xdf, 0x26, 0x2c, 0x31, 0xe7, 0x0c, 0x96, 0x88, 0x05, 0x67, 0x99, 0x5e, 0x8e, 0x5e,
-	0x69, 0x50, 0x1f, 0x32, 0xba, 0x1d, 0x4f, 0x14, 0xcf, 0x5e, 0x0d, 0x6c, 0x8d, 0x58, 0x36, 0xab,
-	0x5b, 0x10, 0xd9, 0x2a, 0xf1, 0x6b, 0x58, 0xbf, 0xfd, 0x99, 0x93, 0x7c, 0x0a, 0x30, 0xd6, 0xa7,
-	0x42, 0x1c, 0xf1, 0x50, 0xd9, 0xf4, 0xc8, 0x91, 0xce, 0x7f, 0x95, 0xa0, 0x7d, 0xd7, 0xb3, 0xa5,
-	0x2e, 0x55, 0xb7, 0xbc, 0xf1, 0x99, 0x0f, 0xb0, 0xe9, 0xcf, 0xbe, 0xef, 0x15, 0x3f, 0xd2, 0xfb,
-	0xd3, 0x1f, 0xe9, 0x53, 0x68, 0x0c, 0x79, 0xc0, 0xd2, 0x03, 0x02, 0x73, 0xcb, 0xa4, 0x4f, 0x3d,
-	0x87, 0x31, 0xc3, 0xa6, 0x89, 0x51, 0x9c, 0xfd, 0xb1, 0x59, 0x20, 0x9e, 0xc4, 0x0a, 0xfb, 0xb0,
-	0xdc, 0x2b, 0x4c, 0x7d, 0xf3, 0x4e, 0x50, 0xcb, 0x50, 0xcc, 0xfe, 0x3f, 0x94, 0x66, 0x56, 0x26,
-	0xcf, 0xa9, 0x9f, 0x16, 0xdc, 0x27, 0x00, 0x85, 0x16, 0xcd, 0x14, 0xbf, 0x4a, 0x92, 0xb5, 0x67,
-	0x33, 0x9d, 0xf7, 0xdc, 0x6c, 0xe7, 0x7d, 0xbe, 0x88, 0x77, 0xc8, 0x5f, 0xfd, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xb0, 0xb2, 0x4d, 0xfe, 0xa2, 0x20, 0x00, 0x00,
+	// 3275 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x77, 0x1b, 0x47,
+	0x72, 0x17, 0xc4, 0x4f, 0x14, 0x88, 0xaf, 0xa6, 0x28, 0x42, 0xa4, 0x64, 0x51, 0x90, 0x6d, 0x69,
+	0xbd, 0x5e, 0xca, 0xd2, 0xae, 0xd7, 0xbb, 0xd9, 0x7c, 0x41, 0x24, 0x64, 0x71, 0x97, 0x5f, 0x1e,
+	0x80, 0xb1, 0xe3, 0xcb, 0xbc, 0xe6, 0x4c, 0x13, 0x9c, 0xa7, 0xc1, 0xcc, 0xb8, 0xbb, 0x87, 0x22,
+	0xf8, 0x72, 0xde, 0x4b, 0x6e, 0xb9, 0x25, 0xb7, 0xbc, 0xbc, 0xe4, 0xba, 0xf9, 0x3f, 0x72, 0xca,
+	0x3d, 0xf7, 0xdc, 0xf2, 0x37, 0xe4, 0x55, 0xf5, 0xf4, 0xcc, 0x00, 0x22, 0x2d, 0x6d, 0x5e, 0xf6,
+	0x86, 0xfe, 0xd5, 0xaf, 0xaa, 0xab, 0xba, 0xa7, 0xab, 0xab, 0x0b, 0xb0, 0xa2, 0xce, 0xb9, 0x14,
+	0xfe, 0x76, 0x22, 0x63, 0x1d, 0xb3, 0xd5, 0x64, 0xc4, 0x23, 0x1e, 0x4e, 0xae, 0xc4, 0xb6, 0x17,
+	0x87, 0xa1, 0xf0, 0x74, 0x2c, 0x37, 0x1e, 0x8e, 0xe2, 0x78, 0x14, 0x8a, 0x67, 0x44, 0x39, 0x4d,
+	0xcf, 0x9e, 0xe9, 0x60, 0x2c, 0x94, 0xe6, 0xe3, 0xc4, 0x68, 0x75, 0x7f, 0x05, 0x70, 0x98, 0x86,
+	0xe1, 0x40, 0xcb, 0x20, 0x1a, 0xb1, 0x3b, 0xb0, 0x70, 0xc1, 0xc3, 0xc0, 0xef, 0x54, 0xb6, 0x2a,
+	0x4f, 0x97, 0x1d, 0x33, 0xc8, 0xd0, 0x54, 0x74, 0x6e, 0x6f, 0x55, 0x9e, 0x56, 0x1d, 0x33, 0xe8,
+	0x7e, 0x05, 0x55, 0xd4, 0xdc, 0x8b, 0xf4, 0x2f, 0x7f, 0xf1, 0x21, 0x8a, 0x73, 0x56, 0x31, 0x9b,
+	0x72, 0x37, 0x4e, 0x4f, 0x43, 0xf1, 0x21, 0x9a, 0x15, 0xab, 0xf9, 0x2d, 0xd4, 0x51, 0x73, 0x68,
+	0x63, 0xb8, 0x41, 0xf9, 0x8b, 0xb2, 0x72, 0xed, 0xc5, 0xc6, 0xb6, 0x59, 0x84, 0x6d, 0xbb, 0x08,
+	0xdb, 0xb9, 0x01, 0x6b, 0xf8, 0xbf, 0x2b, 0xd0, 0x3c, 0x8e, 0x95, 0x1e, 0x49, 0xa1, 0xfe, 0x46,
+	0x48, 0x15, 0xc4, 0x11, 0x63, 0x30, 0x7f, 0x96, 0x86, 0x21, 0x99, 0xae, 0x3a, 0xf4, 0x1b, 0xe7,
+	0x53, 0xe7, 0xb1, 0xd4, 0x76, 0x25, 0x68, 0xc0, 0x3a, 0xb0, 0x14, 0xa5, 0x63, 0x21, 0x03, 0xaf,
+	0x33, 0x47, 0x81, 0xda, 0x21, 0x7b, 0x00, 0x70, 0x1a, 0xc6, 0xde, 0x1b, 0x57, 0x05, 0x57, 0xa2,
+	0x33, 0x4f, 0xc2, 0x2a, 0x21, 0x83, 0xe0, 0x4a, 0xb0, 0x8f, 0xa1, 0xf1, 0x96, 0x87, 0x6e, 0x89,
+	0xb2, 0x40, 0x94, 0x95, 0xb7, 0x3c, 0x7c, 0x99, 0xb3, 0x9e, 0x42, 0x0b, 0x59, 0x4a, 0x8c, 0xc6,
+	0x22, 0xd2, 0x86, 0xb7, 0x48, 0x3c, 0xd4, 0x1e, 0x18, 0x98, 0x98, 0x8f, 0x60, 0x65, 0x8a, 0xb5,
+	0x44, 0xac, 0x9a, 0x2a, 0x28, 0xdd, 0xc7, 0x50, 0x77, 0xe2, 0x50, 0x38, 0xe2, 0x4c, 0x48, 0x11,
+	0x79, 0x02, 0xc3, 0x8c, 0xf8, 0x58, 0xd8, 0x30, 0xf1, 0x77, 0xf7, 0x09, 0xb4, 0x77, 0xb9, 0xe6,
+	0xa7, 0x5c, 0xbd, 0x87, 0xf8, 0x77, 0xd0, 0x76, 0x44, 0xc8, 0x75, 0x10, 0x47, 0x05, 0xf1, 0x11,
+	0xac, 0xf8, 0x99, 0xb6, 0x1b, 0xf8, 0x97, 0xa4, 0xb0, 0xe0, 0xd4, 0x2c, 0xb6, 0xe7, 0x5f, 0xb2,
+	0x87, 0x50, 0x53, 0xde, 0xb9, 0x18, 0x73, 0x97, 0x4c, 0x9a, 0xd5, 0x04, 0x03, 0x1d, 0xf2, 0xb1,
+	0x60, 0x8f, 0xa1, 0x2e, 0x33, 0xc3, 0x86, 0x32, 0x47, 0x94, 0x15, 0x0b, 0x22, 0xa9, 0xab, 0xa0,
+	0xb1, 0x17, 0xf9, 0xe2, 0xf2, 0xff, 0x77, 0xea, 0x07, 0x00, 0x01, 0x5a, 0x2d, 0xcf, 0x5b, 0x25,
+	0x84, 0x26, 0xfd, 0xa7, 0x0a, 0xb4, 0x5f, 0xa5, 0x91, 0xf7, 0x27, 0x89, 0xf9, 0x2c, 0x33, 0x3c,
+	0x15, 0xb3, 0x05, 0x89, 0x74, 0x1f, 0xaa, 0x5c, 0x8e, 0x52, 0xdc, 0x4f, 0x45, 0x1f, 0x54, 0xd5,
+	0x29, 0x80, 0x6e, 0x02, 0x8d, 0x6f, 0x52, 0x21, 0x27, 0x7f, 0x94, 0x63, 0xf7, 0x60, 0x59, 0xc6,
+	0xa1, 0x11, 0xdf, 0x26, 0xf1, 0x12, 0x8e, 0x51, 0xb4, 0x05, 0xb5, 0xb3, 0x20, 0x1a, 0x09, 0x99,
+	0xc8, 0x20, 0xd2, 0xe4, 0xd0, 0x8a, 0x53, 0x86, 0xba, 0xff, 0x5a, 0x81, 0x16, 0x4d, 0xb9, 0x17,
+	0x9d, 0xc5, 0x72, 0x4c, 0x9b, 0xc3, 0x36, 0xa1, 0xfa, 0x03, 0x62, 0xa5, 0x19, 0x97, 0x09, 0x40,
+	0x9b, 0x3f, 0x81, 0x56, 0x84, 0xcc, 0x30, 0xb8, 0x12, 0xbe, 0x4b, 0x70, 0xb6, 0x18, 0xcd, 0x02,
+	0x27, 0x93, 0x65, 0x3b, 0xaa, 0x33, 0xb7, 0x35, 0xf7, 0x74, 0x2e, 0xb7, 0xa3, 0xd8, 0xe7, 0xc0,
+	0x8c, 0x50, 0x8b, 0x4b, 0xed, 0xc6, 0xe3, 0x40, 0x6b, 0xe1, 0xd3, 0x92, 0x2c, 0x3b, 0x2d, 0x92,
+	0x0c, 0xc5, 0xa5, 0x3e, 0x32, 0x78, 0xf7, 0x1f, 0xe7, 0x61, 0x9d, 0x8c, 0xf6, 0x2f, 0x93, 0x90,
+	0x07, 0xd1, 0x07, 0xbb, 0xfb, 0x09, 0x34, 0x84, 0x51, 0x71, 0xe3, 0x54, 0x27, 0xa9, 0x3d, 0xfb,
+	0xf5, 0x0c, 0x3d, 0x22, 0x10, 0x37, 0xcf, 0xd2, 0x84, 0x94, 0xb1, 0xb4, 0x9b, 0x97, 0x81, 0x7d,
+	0xc4, 0x18, 0x2f, 0x6c, 0x99, 0xd9, 0xc9, 0xdd, 0xc6, 0x8b, 0x3f, 0xdb, 0xbe, 0x26, 0x77, 0x6f,
+	0xdf, 0xe0, 0xee, 0x76, 0x06, 0xbd, 0x22, 0x20, 0xf7, 0xc3, 0x0c, 0xcb, 0x53, 0xa8, 0x38, 0x95,
+	0x9e, 0x49, 0x29, 0xff, 0xc7, 0x29, 0x06, 0x64, 0x21, 0x9f, 0xc2, 0x0c, 0xbb, 0x3d, 0xa8, 0x4f,
+	0xb9, 0xc0, 0xd6, 0x61, 0x75, 0xd8, 0xff, 0x6e, 0xe8, 0xf6, 0xbf, 0x3b, 0xde, 0xef, 0xed, 0x1d,
+	0xba, 0xaf, 0x8e, 0x9c, 0x83, 0xde, 0xb0, 0x75, 0x0b, 0x05, 0xbf, 0x1d, 0x1c, 0x1d, 0xce, 0x0a,
+	0x2a, 0xdd, 0xbf, 0xaf, 0xe4, 0x36, 0x8c, 0x51, 0xb6, 0x05, 0xf7, 0x07, 0xc3, 0xde, 0xb0, 0x7f,
+	0xd0, 0x3f, 0x1c, 0xba, 0xfb, 0x47, 0x5f, 0xe7, 0x3a, 0x83, 0xa3, 0x13, 0x67, 0xa7, 0xdf, 0xba,
+	0xc5, 0x1e, 0xc2, 0x66, 0xef, 0x64, 0x78, 0x94, 0x0b, 0x66, 0x08, 0x15, 0xb6, 0x09, 0xeb, 0xfd,
+	0xef, 0x86, 0x7d, 0xe7, 0xb0, 0xb7, 0x3f, 0x2b, 0xbc, 0xcd, 0x36, 0xe0, 0xee, 0xd7, 0xfd, 0xc3,
+	0xbe, 0xb3, 0xb7, 0x33, 0x2b, 0x9b, 0xeb, 0xfe, 0xa1, 0x06, 0x8b, 0x83, 0x89, 0xd2, 0x62, 0xcc,
+	0x4e, 0x80, 0x29, 0xfa, 0xe5, 0x06, 0xc5, 0x72, 0xd0, 0x37, 0x51, 0x7b, 0xf1, 0xe9, 0xb5, 0x4b,
+	0x68, 0x14, 0x4b, 0x8b, 0xe7, 0xb4, 0xd5, 0x2c, 0x84, 0x5f, 0x98, 0x35, 0xeb, 0x67, 0xdf, 0xcf,
+	0x72, 0xc6, 0xf2, 0x29, 0x6b, 0x1b, 0xa1, 0xf2, 0xe2, 0xc4, 0x1e, 0xfb, 0x9a, 0xc1, 0x06, 0x08,
+	0xb1, 0xef, 0x60, 0x15, 0x13, 0x85, 0x9f, 0x86, 0x42, 0xba, 0x4a, 0x73, 0x1d, 0x28, 0x1d, 0x78,
+	0x1d, 0x20, 0xbf, 0x9e, 0x5c, 0xef, 0x97, 0xe5, 0x0f, 0x2c, 0xdd, 0x61, 0xea, 0x1d, 0x8c, 0x1d,
+	0x41, 0x6b, 0x2c, 0xc6, 0xb1, 0x9c, 0x94, 0xcc, 0xd6, 0xc8, 0xec, 0xc7, 0xd7, 0x9a, 0x3d, 0x20,
+	0x72, 0x61, 0xb3, 0x39, 0x9e, 0x06, 0xd8, 0x3e, 0x34, 0xbd, 0x24, 0x9d, 0x5a, 0xbe, 0x15, 0xb2,
+	0xf7, 0xf8, 0x5a, 0x7b, 0x3b, 0xc7, 0x27, 0xe5, 0xb5, 0x6b, 0x78, 0x49, 0x5a, 0x5e, 0xb8, 0xd7,
+	0x80, 0x88, 0x2b, 0x6d, 0x3e, 0x53, 0x9d, 0xfa, 0xd6, 0xdc, 0xd3, 0xda, 0x8b, 0x47, 0x37, 0x19,
+	0xcb, 0x33, 0x9f, 0x53, 0xf7, 0x92, 0x34, 0x1f, 0x29, 0x6b, 0x29, 0x8f, 0x52, 0x75, 0x1a, 0x3f,
+	0x6e, 0xa9, 0x88, 0x11, 0x2d, 0xe5, 0x23, 0xc5, 0x86, 0xc0, 0x22, 0xa1, 0xdf, 0xc6, 0xf2, 0x4d,
+	0xd9, 0xaf, 0x26, 0x59, 0xfb, 0xe4, 0x5a, 0x6b, 0x87, 0x86, 0x5e, 0xf8, 0xd6, 0x8e, 0x66, 0x90,
+	0x29, 0xab, 0x25, 0x1f, 0x5b, 0xef, 0xb7, 0x5a, 0xf8, 0xd9, 0x8e, 0x66, 0x10, 0xc5, 0x7e, 0x07,
+	0x4d, 0x3f, 0x50, 0x53, 0x8e, 0xb6, 0xc9, 0x64, 0xf7, 0x5a, 0x93, 0xbb, 0x81, 0x2a, 0x79, 0xd9,
+	0xf0, 0xcb, 0x43, 0xc5, 0xbe, 0x81, 0x36, 0x19, 0x2b, 0xed, 0xad, 0xea, 0xb0, 0xad, 0xb9, 0x1b,
+	0x3f, 0x16, 0x34, 0x57, 0xde, 0xdd, 0x96, 0x3f, 0x0d, 0x14, 0xfe, 0x95, 0x42, 0x5e, 0x7d, 0x8f,
+	0x7f, 0x45, 0xbc, 0x0d, 0xbf, 0x3c, 0x54, 0x6c, 0x04, 0xf7, 0xc8, 0x58, 0xc2, 0xa5, 0x0e, 0xe8,
+	0x1a, 0x2d, 0x85, 0x7d, 0x87, 0xcc, 0xfe, 0xf4, 0x46, 0xb3, 0xc7, 0x56, 0xa9, 0x88, 0x7f, 0xdd,
+	0xbf, 0x16, 0x57, 0x6c, 0x0c, 0x9b, 0x33, 0x13, 0x4d, 0x2d, 0xc9, 0x1a, 0x4d, 0xf5, 0xb3, 0xf7,
+	0x4f, 0x55, 0x5e, 0x9b, 0x7b, 0xfe, 0x0d, 0x92, 0xeb, 0xe2, 0x2a, 0x2d, 0xd7, 0xdd, 0x0f, 0x8d,
+	0xab, 0x58, 0xb7, 0x75, 0xff, 0x5a, 0x1c, 0xcf, 0xc8, 0x23, 0x9f, 0x6b, 0xee, 0xfa, 0x81, 0x24,
+	0x03, 0x13, 0x77, 0x36, 0x4c, 0xff, 0xb2, 0xf3, 0x11, 0x5d, 0x90, 0x0f, 0x90, 0xb8, 0x6b, 0x79,
+	0xd3, 0x51, 0xf9, 0x97, 0xec, 0x4b, 0x58, 0xbf, 0x0c, 0xe3, 0xd1, 0x75, 0xfa, 0x0f, 0x49, 0xff,
+	0x0e, 0x8a, 0xdf, 0x51, 0xfb, 0x14, 0x9a, 0xa4, 0x96, 0x2a, 0xe1, 0xbb, 0xa7, 0x13, 0x2d, 0x54,
+	0x67, 0x6b, 0xab, 0xf2, 0x74, 0xde, 0xa9, 0x23, 0x7c, 0xa2, 0x84, 0xff, 0x12, 0xc1, 0xee, 0x7f,
+	0xcc, 0x41, 0xfb, 0x9d, 0xc4, 0xcb, 0xfa, 0x30, 0xaf, 0x27, 0x89, 0xa9, 0x50, 0x1b, 0x2f, 0x9e,
+	0x7f, 0x58, 0xba, 0xce, 0x90, 0xe1, 0x24, 0x11, 0x0e, 0xa9, 0xb3, 0x01, 0xd4, 0x94, 0x08, 0xcf,
+	0xdc, 0xf3, 0x58, 0x69, 0x61, 0xd2, 0x75, 0xed, 0xc5, 0x17, 0x1f, 0x66, 0x6d, 0x20, 0xc2, 0xb3,
+	0xd7, 0xa4, 0xf7, 0xfa, 0x96, 0x03, 0x2a, 0x1f, 0xb1, 0x63, 0x00, 0x3e, 0xe6, 0x57, 0xf8, 0x4d,
+	0x52, 0x2d, 0x83, 0x36, 0x9f, 0x7d, 0x98, 0xcd, 0x1e, 0xe9, 0x39, 0xbb, 0x83, 0xd7, 0xb7, 0x9c,
+	0xaa, 0x31, 0xe2, 0xf8, 0x8a, 0x7d, 0x05, 0xd5, 0xd3, 0x38, 0xd6, 0x2e, 0xbe, 0xe8, 0x3a, 0xf0,
+	0xde, 0x97, 0xce, 0x32, 0x92, 0x71, 0xd8, 0xfd, 0x7d, 0x05, 0xa0, 0x08, 0x9a, 0xdd, 0x05, 0x36,
+	0xe8, 0xef, 0xbf, 0x72, 0x5f, 0x1f, 0x0d, 0x86, 0xfd, 0x5d, 0x77, 0xf0, 0xb7, 0x83, 0x61, 0xff,
+	0xa0, 0x75, 0x8b, 0xad, 0x41, 0xbb, 0x77, 0xd0, 0xfb, 0xfe, 0xe8, 0xd0, 0x75, 0x76, 0x07, 0x16,
+	0xae, 0xb0, 0x36, 0xd4, 0x5f, 0xf7, 0x9d, 0xa3, 0xdf, 0x9d, 0x58, 0xe8, 0x36, 0x5e, 0xbc, 0x5f,
+	0x1f, 0x1d, 0x7d, 0xbd, 0xdf, 0x77, 0x77, 0xf6, 0x8f, 0x4e, 0x76, 0xdd, 0xc1, 0x37, 0xfb, 0x56,
+	0x38, 0xc7, 0xee, 0xc1, 0x5a, 0xef, 0xfb, 0x13, 0xa7, 0xef, 0xee, 0xf6, 0x86, 0xbd, 0x97, 0xbd,
+	0x41, 0xdf, 0x8a, 0xe6, 0x5f, 0x2e, 0xc2, 0x3c, 0x9e, 0x9b, 0xee, 0x3f, 0xcc, 0xc1, 0xe6, 0x8f,
+	0xac, 0x24, 0xdb, 0x80, 0x65, 0xdc, 0x8b, 0xd2, 0xeb, 0x23, 0x1f, 0xb3, 0x2e, 0xac, 0x70, 0xe9,
+	0x9d, 0x07, 0x5a, 0x78, 0x3a, 0x95, 0xb6, 0xac, 0x9e, 0xc2, 0xb0, 0xe2, 0x8c, 0x13, 0x21, 0xb9,
+	0x0e, 0xa2, 0x91, 0x6b, 0xae, 0xd5, 0xec, 0x92, 0x6d, 0xe6, 0x78, 0x76, 0xff, 0x6f, 0xc0, 0x72,
+	0x12, 0x72, 0x8d, 0x5e, 0x64, 0xd5, 0x75, 0x3e, 0x66, 0x4f, 0xa0, 0x69, 0x7f, 0xbb, 0x67, 0x7c,
+	0x1c, 0x84, 0x13, 0xaa, 0xad, 0xaa, 0x4e, 0xc3, 0xc2, 0xaf, 0x08, 0xc5, 0xf9, 0x72, 0xe2, 0x85,
+	0x79, 0x4d, 0xd2, 0x83, 0xad, 0xea, 0xe4, 0x06, 0xec, 0x23, 0xf3, 0xe7, 0xb0, 0x76, 0x11, 0x48,
+	0x9d, 0x62, 0xd5, 0x6b, 0x5e, 0x3b, 0x99, 0x7f, 0x4b, 0xc4, 0xbf, 0x33, 0x2d, 0xcc, 0x9c, 0xfc,
+	0x04, 0x1a, 0x6f, 0x84, 0x8c, 0x44, 0x98, 0x5b, 0x5f, 0x36, 0x25, 0xa9, 0x41, 0xad, 0xed, 0x3f,
+	0x87, 0x8d, 0xbc, 0xf4, 0xcf, 0xab, 0x0f, 0x11, 0xe9, 0xe0, 0x2c, 0x10, 0xb2, 0x53, 0x25, 0x95,
+	0x8e, 0x65, 0x64, 0xeb, 0x9f, 0xcb, 0xbb, 0xff, 0x02, 0xb0, 0x71, 0xf3, 0xa7, 0xc8, 0xee, 0xc2,
+	0xa2, 0x14, 0x23, 0x5b, 0x1c, 0x55, 0x9d, 0x6c, 0x84, 0xbe, 0x05, 0x91, 0xd2, 0x3c, 0xf2, 0x84,
+	0xeb, 0x85, 0x5c, 0x29, 0x5b, 0x2e, 0x5b, 0x74, 0x07, 0x41, 0x7c, 0x0c, 0xe5, 0xb4, 0xc0, 0xcf,
+	0x76, 0x03, 0x2c, 0xb4, 0xe7, 0xa3, 0x7d, 0x4c, 0x72, 0xa9, 0x7d, 0xe4, 0x64, 0x23, 0xf6, 0x53,
+	0x68, 0xf3, 0x0b, 0x1e, 0x84, 0xfc, 0x34, 0x08, 0x03, 0x3d, 0x71, 0xaf, 0xe2, 0x48, 0x64, 0xdb,
+	0xd0, 0x2a, 0x0b, 0xbe, 0x8f, 0x23, 0xc1, 0x9e, 0xc1, 0x6a, 0x92, 0x9e, 0x86, 0x81, 0x17, 0x4e,
+	0x5c, 0xee, 0x79, 0x42, 0xa9, 0xe0, 0x34, 0x34, 0x8f, 0xe7, 0x65, 0x87, 0x59, 0x51, 0x2f, 0x97,
+	0xe0, 0x53, 0x68, 0x9c, 0x86, 0x3a, 0x70, 0xf9, 0x15, 0xed, 0xc0, 0xb2, 0xb3, 0x44, 0xe3, 0xde,
+	0x15, 0xfb, 0x4b, 0xd8, 0x54, 0xc2, 0x8b, 0x23, 0x9f, 0xcb, 0x89, 0xfb, 0xae, 0x0b, 0x66, 0x07,
+	0xee, 0xe5, 0x94, 0xde, 0xac, 0x2f, 0x9f, 0x40, 0xc3, 0xe3, 0xae, 0x27, 0x24, 0xae, 0xaf, 0xc7,
+	0xb5, 0xc8, 0x76, 0xa0, 0xee, 0xf1, 0x9d, 0x02, 0x64, 0xbf, 0x81, 0x0d, 0x9e, 0xea, 0xd8, 0x1d,
+	0x07, 0x51, 0x2c, 0xed, 0xfe, 0xba, 0x69, 0x32, 0x92, 0xdc, 0x37, 0xc7, 0x7c, 0xd9, 0x59, 0x47,
+	0xc6, 0x01, 0x12, 0xb2, 0xad, 0x3e, 0x31, 0x62, 0xf6, 0xd7, 0x70, 0x3f, 0xa1, 0x2b, 0x4f, 0x0a,
+	0xdf, 0x1d, 0xf3, 0x20, 0xd2, 0x22, 0xa2, 0x25, 0x7e, 0x1b, 0x44, 0x7e, 0xfc, 0x96, 0x0a, 0xb1,
+	0xaa, 0xb3, 0x91, 0x73, 0x0e, 0x0a, 0xca, 0xb7, 0xc4, 0x60, 0xbf, 0x84, 0xf5, 0xc2, 0xc2, 0x29,
+	0xf7, 0xde, 0xa4, 0x89, 0x55, 0x6e, 0x90, 0xf2, 0x5a, 0x2e, 0x7e, 0x49, 0xd2, 0x4c, 0xef, 0x18,
+	0xee, 0x86, 0x5c, 0x0b, 0xa5, 0x5d, 0x29, 0x94, 0x8e, 0x25, 0x3f, 0x0d, 0x85, 0xc9, 0x4c, 0xf5,
+	0xf7, 0x66, 0xa6, 0x3b, 0x46, 0xd3, 0xc9, 0x15, 0x51, 0xc4, 0xfe, 0x0a, 0xee, 0x67, 0xf3, 0x4b,
+	0xa1, 0xf1, 0xab, 0x8c, 0x23, 0x37, 0x11, 0x32, 0x88, 0x7d, 0xd7, 0xe7, 0x13, 0xac, 0xb7, 0xf0,
+	0x1a, 0xb9, 0x67, 0x38, 0x8e, 0xa5, 0x1c, 0x13, 0x63, 0x97, 0x4f, 0x14, 0x1e, 0xd7, 0x31, 0x57,
+	0x5a, 0x48, 0xbc, 0x4d, 0x24, 0x25, 0x8f, 0x96, 0x39, 0xae, 0x06, 0x3e, 0xc9, 0x50, 0xbc, 0x74,
+	0x82, 0x28, 0xd0, 0x01, 0x0f, 0x5d, 0xff, 0xd4, 0xbc, 0xbc, 0xdb, 0xf6, 0x9b, 0x25, 0x78, 0xf7,
+	0x94, 0x9e, 0xde, 0xbf, 0x06, 0xf0, 0xa4, 0xe0, 0x5a, 0xf8, 0x2e, 0xd7, 0x1d, 0xf6, 0xde, 0xb8,
+	0xaa, 0x19, 0xbb, 0xa7, 0xf1, 0x43, 0x14, 0xd1, 0x39, 0xae, 0xb3, 0xef, 0x8e, 0xe3, 0x28, 0xd0,
+	0x31, 0xb6, 0xdb, 0x3a, 0xab, 0xe6, 0x43, 0xb4, 0xa2, 0x83, 0x5c, 0xc2, 0x9e, 0xc3, 0x9d, 0x44,
+	0x48, 0x3a, 0x77, 0x74, 0x44, 0x22, 0x15, 0x8c, 0xce, 0x35, 0x56, 0x31, 0xa8, 0xb1, 0x5a, 0x92,
+	0xed, 0x65, 0x22, 0xb6, 0x0d, 0xab, 0x49, 0xd6, 0xc2, 0x72, 0xf1, 0x0e, 0x15, 0x97, 0x09, 0x76,
+	0xaa, 0xd6, 0x48, 0xa3, 0x6d, 0x45, 0xfb, 0xf1, 0xa8, 0x4f, 0x02, 0xf6, 0x33, 0x60, 0x01, 0x1f,
+	0xbb, 0x3c, 0xd5, 0xe7, 0xb8, 0x76, 0x9e, 0xa9, 0xd5, 0xef, 0x1a, 0x7a, 0xc0, 0xc7, 0xbd, 0x29,
+	0x01, 0x86, 0xe0, 0x8b, 0x50, 0x98, 0x7d, 0x90, 0x31, 0xe6, 0x56, 0xe4, 0xaf, 0x9b, 0x10, 0xac,
+	0xe8, 0x38, 0x97, 0xb0, 0x5f, 0xc0, 0xdd, 0x84, 0x4b, 0x3e, 0x16, 0xb8, 0x05, 0x3c, 0x49, 0x42,
+	0xf3, 0xc4, 0x48, 0x55, 0xe7, 0xa9, 0xc9, 0x6d, 0xb9, 0xb4, 0x87, 0xc2, 0x01, 0xc9, 0xa6, 0xb5,
+	0x92, 0x91, 0x52, 0xae, 0x88, 0xf0, 0x9b, 0xf0, 0x3b, 0x3f, 0xa1, 0x99, 0x0a, 0xad, 0xe3, 0x91,
+	0x52, 0x7d, 0x23, 0x63, 0x3b, 0xf0, 0x51, 0x69, 0x2e, 0x3c, 0x3f, 0xf9, 0x63, 0x3c, 0xd3, 0xfe,
+	0x8c, 0xb4, 0x37, 0x8b, 0x39, 0x53, 0x1d, 0x67, 0x2f, 0x50, 0x6b, 0xe4, 0x73, 0x60, 0x81, 0x72,
+	0x79, 0x2a, 0x63, 0xc9, 0x5d, 0xbb, 0x5e, 0x9d, 0x17, 0xa6, 0xa1, 0x10, 0xa8, 0x1e, 0x09, 0x6c,
+	0x97, 0xf0, 0xb7, 0xf3, 0xcb, 0xb5, 0xd6, 0x0a, 0x76, 0x83, 0xd8, 0xbb, 0x2f, 0x2d, 0xf6, 0x19,
+	0xb4, 0xc3, 0x98, 0xfb, 0x2e, 0xbf, 0x10, 0x92, 0x8f, 0x84, 0xfb, 0x7c, 0x1c, 0x98, 0x44, 0x59,
+	0x71, 0x9a, 0x28, 0xe8, 0x19, 0x1c, 0xe1, 0x77, 0xb8, 0x5f, 0x22, 0xf7, 0xf6, 0x3b, 0x5c, 0x84,
+	0xd1, 0xc5, 0x69, 0xbb, 0x44, 0x9e, 0x23, 0x72, 0xab, 0x6c, 0x18, 0xf1, 0xee, 0xbf, 0x2d, 0x42,
+	0x73, 0xe6, 0xbd, 0x86, 0x89, 0x57, 0xc7, 0x1a, 0x9b, 0x8e, 0x54, 0x5d, 0x55, 0xa8, 0xba, 0x02,
+	0x82, 0xa8, 0xb4, 0xc2, 0xd7, 0xa8, 0xc7, 0x31, 0xa2, 0x8c, 0x71, 0x9b, 0x18, 0x35, 0x83, 0x19,
+	0xca, 0x63, 0xa8, 0x9f, 0xa6, 0x67, 0x67, 0x42, 0xaa, 0x8c, 0x33, 0x47, 0x9c, 0x95, 0x0c, 0x34,
+	0xa4, 0x07, 0x00, 0x67, 0x52, 0x88, 0x8c, 0x31, 0x4f, 0x8c, 0x2a, 0x22, 0x46, 0xfc, 0x04, 0x9a,
+	0x6f, 0x65, 0xa0, 0x05, 0x9e, 0xdf, 0x8c, 0xb3, 0x40, 0x9c, 0x46, 0x0e, 0x1b, 0xe2, 0x43, 0xa8,
+	0xf9, 0x81, 0xd4, 0x93, 0x8c, 0xb4, 0x68, 0x1c, 0x26, 0x28, 0x9f, 0x48, 0x85, 0xfc, 0x34, 0x93,
+	0x2f, 0x99, 0x89, 0x10, 0xc9, 0xe3, 0x19, 0xf3, 0x24, 0xc9, 0xe3, 0x59, 0x36, 0xf1, 0x18, 0xcc,
+	0x50, 0x3e, 0x83, 0x76, 0x82, 0xab, 0xa9, 0xf1, 0x3b, 0xb0, 0x31, 0x55, 0x89, 0xd7, 0x44, 0xc1,
+	0x90, 0xf0, 0xdc, 0x1c, 0xf7, 0x74, 0x70, 0x61, 0x03, 0x03, 0x63, 0xce, 0x60, 0x86, 0x42, 0x57,
+	0xe0, 0x14, 0xa9, 0x66, 0x6a, 0xd8, 0x20, 0x2a, 0xd3, 0x9e, 0x40, 0x33, 0xbb, 0x46, 0x42, 0xcb,
+	0x5b, 0x31, 0x2b, 0x90, 0xc3, 0x86, 0xf8, 0x29, 0x34, 0xd5, 0x5b, 0x9e, 0x94, 0x8b, 0xe2, 0xba,
+	0x31, 0x88, 0x70, 0x5e, 0x14, 0x63, 0x9f, 0x98, 0x78, 0xe5, 0xfd, 0x6d, 0x18, 0x8b, 0x88, 0x0f,
+	0x8b, 0x3d, 0x7e, 0x0e, 0x6b, 0xe7, 0xe9, 0x48, 0xb8, 0x18, 0x9c, 0xa2, 0x56, 0x71, 0x46, 0xbf,
+	0x43, 0x74, 0x86, 0xc2, 0x63, 0x94, 0x61, 0xcb, 0x38, 0x77, 0xa2, 0xa4, 0x82, 0xfb, 0x48, 0x99,
+	0x65, 0xde, 0xa9, 0xe7, 0xe4, 0x57, 0x52, 0x50, 0xb3, 0xba, 0xc4, 0x23, 0x57, 0x28, 0xa7, 0xcc,
+	0x3b, 0x8d, 0x9c, 0x48, 0x9e, 0x60, 0xbe, 0x2a, 0x31, 0xa5, 0x50, 0x42, 0x5e, 0x08, 0x9f, 0x12,
+	0xca, 0xbc, 0xd3, 0xce, 0xc9, 0x4e, 0x26, 0xc0, 0x6f, 0xbf, 0xec, 0x74, 0x2a, 0x93, 0x30, 0x55,
+	0x9d, 0x0e, 0xd1, 0x5b, 0x85, 0xc7, 0x06, 0xa7, 0x3a, 0x21, 0x49, 0xc2, 0x2c, 0x7b, 0x65, 0xe1,
+	0x7d, 0x64, 0xc8, 0x25, 0x81, 0x79, 0x4e, 0xfc, 0x4f, 0x05, 0x1a, 0xd3, 0x8d, 0x08, 0xec, 0xf4,
+	0x8f, 0x63, 0x5f, 0xd8, 0xf6, 0xbf, 0x19, 0x60, 0x74, 0x74, 0x10, 0xca, 0x6b, 0x66, 0x5a, 0xa6,
+	0x0d, 0xc2, 0x8b, 0xf5, 0xc2, 0x8e, 0x4f, 0x22, 0x30, 0xdd, 0x9f, 0x5f, 0x65, 0x07, 0x74, 0x99,
+	0x80, 0x83, 0xf3, 0x2b, 0xea, 0xf8, 0xc4, 0xde, 0x1b, 0xa1, 0x5d, 0x2f, 0x4e, 0x23, 0xd3, 0x05,
+	0x5c, 0x70, 0x6a, 0x06, 0xdb, 0x41, 0x88, 0xb2, 0xf9, 0xf9, 0x44, 0x05, 0x1e, 0x0f, 0x5d, 0x2f,
+	0x96, 0x22, 0x63, 0x2e, 0x10, 0xb3, 0x6d, 0x45, 0x3b, 0xb1, 0x14, 0x86, 0x4f, 0x99, 0x61, 0x34,
+	0x4b, 0x5f, 0x24, 0x7a, 0x2b, 0x93, 0xe4, 0xec, 0xee, 0x13, 0x58, 0x29, 0xf7, 0x4a, 0xd8, 0x3a,
+	0x2c, 0x91, 0x56, 0xf6, 0x4f, 0x4a, 0xd5, 0x59, 0xc4, 0xe1, 0x9e, 0xdf, 0xfd, 0xe7, 0x39, 0x62,
+	0x16, 0xf9, 0x03, 0x99, 0x49, 0x5a, 0xea, 0x94, 0x2e, 0x62, 0xc7, 0xc6, 0xbf, 0xc4, 0x98, 0xf0,
+	0x9e, 0xc5, 0x3b, 0xda, 0x13, 0x91, 0xce, 0x32, 0x58, 0x0d, 0xb1, 0x63, 0x03, 0xe1, 0xc1, 0xc8,
+	0xea, 0x50, 0x4b, 0x32, 0x0b, 0x53, 0x37, 0xa8, 0xa5, 0x3d, 0x82, 0x95, 0xc0, 0x0f, 0x45, 0x4e,
+	0x9a, 0x37, 0x96, 0x10, 0x2b, 0x51, 0xa2, 0xc0, 0x2b, 0x28, 0x0b, 0x86, 0x82, 0x58, 0x69, 0xb2,
+	0x20, 0x7e, 0xcb, 0x03, 0x9d, 0x93, 0x16, 0xcd, 0x64, 0x06, 0xb5, 0x34, 0x2c, 0x44, 0xe5, 0x0f,
+	0x39, 0x67, 0x89, 0x38, 0x10, 0xc8, 0x1f, 0x2c, 0x01, 0x4f, 0x55, 0x7c, 0xa6, 0xdd, 0x32, 0x6b,
+	0x99, 0x58, 0x0d, 0xc4, 0xf7, 0x0a, 0xe6, 0x63, 0xa8, 0x2b, 0x2d, 0x78, 0x98, 0xd3, 0xaa, 0x44,
+	0x5b, 0x21, 0xb0, 0x44, 0x1a, 0xa5, 0x58, 0x27, 0x59, 0x12, 0x18, 0x12, 0x81, 0x96, 0xf4, 0x39,
+	0x30, 0x43, 0x9a, 0x0a, 0xb2, 0x66, 0xd2, 0x3c, 0x49, 0x0e, 0x8b, 0x48, 0xbb, 0xbf, 0x86, 0xd6,
+	0x6c, 0x83, 0xc9, 0xe4, 0x20, 0x2d, 0xe4, 0x19, 0xf7, 0x84, 0x5b, 0x7a, 0x38, 0xd5, 0x73, 0x94,
+	0xfe, 0xcc, 0xf8, 0xcf, 0x4a, 0xae, 0x3b, 0x75, 0x45, 0xd8, 0x4e, 0x54, 0xb1, 0xcd, 0x90, 0x41,
+	0xb8, 0xd5, 0x87, 0xf0, 0xb1, 0x96, 0x3c, 0x52, 0xe3, 0x40, 0xbb, 0xfa, 0x5c, 0xc6, 0xe9, 0xe8,
+	0x3c, 0x49, 0xb5, 0x39, 0x0e, 0xe8, 0xad, 0x6b, 0xaa, 0xe0, 0xec, 0xea, 0xd8, 0xb2, 0xdc, 0x61,
+	0x4e, 0xa5, 0x23, 0x72, 0x2c, 0xe4, 0x80, 0x78, 0x6c, 0x1f, 0x1e, 0x4b, 0xe1, 0x09, 0xcc, 0x97,
+	0x3f, 0x66, 0xce, 0xdc, 0x32, 0x0f, 0x33, 0xea, 0x4d, 0xd6, 0xba, 0x5f, 0x40, 0x7d, 0xaa, 0x8d,
+	0x45, 0x37, 0x88, 0xb8, 0x08, 0xa6, 0x17, 0x02, 0x0c, 0x44, 0xab, 0xf0, 0xef, 0x15, 0x68, 0xce,
+	0xb4, 0xaa, 0xf0, 0x25, 0x60, 0x7a, 0x5d, 0xf9, 0x0a, 0x2c, 0xe1, 0x18, 0xc3, 0xdf, 0x84, 0x2a,
+	0x89, 0xa8, 0xd7, 0x90, 0x35, 0x73, 0x11, 0xa0, 0xd7, 0xf4, 0x7d, 0xa8, 0xe6, 0x5d, 0x56, 0xfb,
+	0xe7, 0x51, 0x0e, 0xd0, 0xcb, 0x50, 0xc6, 0x17, 0x01, 0x16, 0xed, 0xc2, 0x77, 0x83, 0x38, 0x31,
+	0x57, 0x63, 0xdd, 0x69, 0x96, 0xf0, 0xbd, 0x38, 0x51, 0x68, 0x48, 0x44, 0x9e, 0x9c, 0x24, 0xd8,
+	0x83, 0x58, 0xa0, 0x22, 0xa4, 0x00, 0xba, 0x7f, 0x98, 0x37, 0x51, 0x16, 0xbb, 0xf6, 0x23, 0x0e,
+	0xff, 0x06, 0x36, 0xa4, 0xe0, 0xbe, 0x9b, 0x3d, 0x76, 0xe3, 0xe8, 0x9d, 0x5d, 0xaa, 0x38, 0xeb,
+	0xc8, 0x38, 0xca, 0x09, 0xc5, 0xe6, 0x7c, 0x09, 0x24, 0x52, 0xee, 0x58, 0xc8, 0x91, 0xf0, 0x67,
+	0x37, 0xa4, 0xe2, 0xdc, 0x21, 0xf1, 0x01, 0x49, 0x0b, 0xb5, 0xe7, 0xb0, 0x66, 0x36, 0x90, 0x66,
+	0x2e, 0x29, 0x99, 0xd3, 0xcc, 0x48, 0xe8, 0x08, 0x5e, 0x52, 0x79, 0x0a, 0x2d, 0x7e, 0x31, 0x32,
+	0x0a, 0x21, 0xd7, 0x22, 0xf2, 0x26, 0xd9, 0xc1, 0x6e, 0xf0, 0x8b, 0x11, 0x72, 0xf7, 0x0d, 0xca,
+	0xfe, 0x02, 0x36, 0xa9, 0x4a, 0xb8, 0x21, 0x22, 0x73, 0xd0, 0x3b, 0x44, 0xb9, 0x2e, 0xa4, 0xaf,
+	0xc0, 0xc8, 0xae, 0x8b, 0xc9, 0x24, 0x80, 0x35, 0x23, 0x9f, 0x0d, 0xea, 0x2b, 0xe8, 0x98, 0xa0,
+	0x50, 0xac, 0x45, 0x54, 0x56, 0x34, 0x39, 0xc1, 0x04, 0xfd, 0xad, 0x11, 0x17, 0x8a, 0x9f, 0xe1,
+	0xab, 0x75, 0xe4, 0x1a, 0xa7, 0x6d, 0x6c, 0x26, 0x3d, 0x34, 0xf9, 0xc5, 0x08, 0xf9, 0xc2, 0x06,
+	0xf7, 0x31, 0x60, 0xb8, 0xf8, 0xc7, 0x58, 0x6a, 0xee, 0x19, 0x4a, 0x11, 0x0b, 0xce, 0x0a, 0xbf,
+	0x18, 0x7d, 0x83, 0x20, 0xfd, 0xd5, 0xfb, 0x0c, 0x56, 0x53, 0x1d, 0xe4, 0x5d, 0x03, 0x9b, 0x23,
+	0x56, 0xcc, 0xea, 0x96, 0x44, 0x36, 0x4b, 0xfc, 0x0a, 0xee, 0x5e, 0xdf, 0xe6, 0x64, 0x1f, 0x01,
+	0x8c, 0xf1, 0x56, 0x48, 0x62, 0xfc, 0x8f, 0x2f, 0x3b, 0x1e, 0x05, 0xd2, 0xfd, 0xaf, 0x0a, 0x74,
+	0x6e, 0x6a, 0x5b, 0x62, 0xaa, 0xba, 0xa6, 0xc7, 0x67, 0x3e, 0xc0, 0x96, 0x3f, 0xdb, 0xdf, 0x2b,
+	0x7f, 0xa4, 0xb7, 0xa7, 0x3f, 0xd2, 0x27, 0xd0, 0x3c, 0x0b, 0x42, 0x91, 0x5d, 0x10, 0x74, 0xb6,
+	0xcc, 0xf1, 0x69, 0x14, 0x30, 0x9d, 0xb0, 0x69, 0x62, 0x9c, 0xe4, 0xff, 0x83, 0x96, 0x88, 0x47,
+	0x89, 0xa6, 0x3a, 0xac, 0xf0, 0x8a, 0x8e, 0xbe, 0xe9, 0x13, 0xd4, 0x73, 0x94, 0x4e, 0xff, 0xef,
+	0x2b, 0x33, 0x2b, 0x53, 0x9c, 0xa9, 0x3f, 0x2e, 0xb8, 0x07, 0x00, 0xa5, 0x12, 0xcd, 0x24, 0xbf,
+	0x6a, 0x9a, 0x97, 0x67, 0x33, 0x95, 0xf7, 0xdc, 0x6c, 0xe5, 0x7d, 0xba, 0x48, 0x6f, 0xc8, 0x9f,
+	0xff, 0xef, 0x00, 0x6f, 0xb5, 0x37, 0xe7, 0xd7, 0x21, 0x00, 0x00,
 }
@@ -0,0 +1,101 @@
+package output
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// uploadRateLimiter - Token bucket shared by all uploads of this process, so the
+// configured upload_max_bytes_per_sec applies to the total outbound rate
+type uploadRateLimiter struct {
+	mutex       sync.Mutex
+	bytesPerSec int
+	tokens      float64
+	last        time.Time
+}
+
+var uploadLimiter uploadRateLimiter
+
+// Log throttled uploads once they've been delayed by at least this much
+const uploadThrottleLogThreshold = 5 * time.Second
+
+// SetUploadRateLimit - Sets the maximum rate for uploads (in bytes/sec), disabled when 0
+func SetUploadRateLimit(bytesPerSec int) {
+	uploadLimiter.mutex.Lock()
+	defer uploadLimiter.mutex.Unlock()
+
+	uploadLimiter.bytesPerSec = bytesPerSec
+	uploadLimiter.tokens = float64(bytesPerSec)
+	uploadLimiter.last = time.Now()
+}
+
+func (l *uploadRateLimiter) limit() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.bytesPerSec
+}
+
+// reserve - Takes n bytes from the bucket (n must not exceed one second worth of
+// bytes, the bucket size), and returns how long to wait before sending them
+func (l *uploadRateLimiter) reserve(n int) time.Duration {
+	l.mutex.Lock()
+	if l.bytesPerSec <= 0 {
+		l.mutex.Unlock()
+		return 0
+	}
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * float64(l.bytesPerSec)
+	if l.tokens > float64(l.bytesPerSec) {
+		l.tokens = float64(l.bytesPerSec)
+	}
+	l.last = now
+
+	// Take the tokens right away (going negative if needed), so concurrent
+	// uploads queue up behind each other instead of all waking up at once
+	l.tokens -= float64(n)
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / float64(l.bytesPerSec) * float64(time.Second))
+	}
+	l.mutex.Unlock()
+
+	return delay
+}
+
+// rateLimitedReader - Paces reads of a request body according to the upload limiter
+//
+// If a deadline timer is set, the deadline is moved back by the time spent waiting,
+// so waiting for other uploads to finish doesn't count against this upload's timeout.
+type rateLimitedReader struct {
+	reader    io.Reader
+	chunkSize int
+	waited    time.Duration
+
+	deadline      time.Time
+	deadlineTimer *time.Timer
+}
+
+func newRateLimitedReader(reader io.Reader, bytesPerSec int) *rateLimitedReader {
+	return &rateLimitedReader{reader: reader, chunkSize: bytesPerSec}
+}
+
+func (r *rateLimitedReader) Read(p []byte) (int, error) {
+	if len(p) > r.chunkSize {
+		p = p[:r.chunkSize]
+	}
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		delay := uploadLimiter.reserve(n)
+		if delay > 0 {
+			if r.deadlineTimer != nil && r.deadlineTimer.Stop() {
+				r.deadline = r.deadline.Add(delay)
+				r.deadlineTimer.Reset(time.Until(r.deadline))
+			}
+			time.Sleep(delay)
+			r.waited += delay
+		}
+	}
+	return n, err
+}
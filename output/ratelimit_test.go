@@ -0,0 +1,37 @@
+package output
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestRateLimitedReaderDeadline(t *testing.T) {
+	SetUploadRateLimit(1000)
+	defer SetUploadRateLimit(0)
+
+	// Reading takes about 1.5 seconds at this rate (the first second worth of
+	// bytes is in the bucket already), well past the deadline of 500ms
+	expired := make(chan struct{})
+	reader := newRateLimitedReader(bytes.NewReader(make([]byte, 2500)), 1000)
+	reader.deadline = time.Now().Add(500 * time.Millisecond)
+	reader.deadlineTimer = time.AfterFunc(500*time.Millisecond, func() { close(expired) })
+	defer reader.deadlineTimer.Stop()
+
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(data) != 2500 {
+		t.Errorf("Expected to read 2500 bytes, got %d", len(data))
+	}
+	if reader.waited < time.Second {
+		t.Errorf("Expected reads to be delayed by at least 1s, got %s", reader.waited)
+	}
+	select {
+	case <-expired:
+		t.Errorf("Expected deadline to be extended by the time spent waiting")
+	default:
+	}
+}
@@ -0,0 +1,83 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+var unsafeFilenameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+func snapshotToJSON(s snapshot.FullSnapshot) ([]byte, error) {
+	var marshaler jsonpb.Marshaler
+	dataJSON, err := marshaler.MarshalToString(&s)
+	if err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	json.Indent(&out, []byte(dataJSON), "", "\t")
+	return out.Bytes(), nil
+}
+
+// recordSnapshot - Saves the full snapshot as JSON (--record-snapshots), named by
+// database and collection time, so it can be submitted again later with --replay
+func recordSnapshot(server state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, s snapshot.FullSnapshot, collectedAt time.Time) {
+	out, err := snapshotToJSON(s)
+	if err != nil {
+		logger.PrintError("Failed to transform snapshot to JSON for recording: %s", err)
+		return
+	}
+
+	name := unsafeFilenameChars.ReplaceAllString(server.Config.GetDbName(), "_") + "_" + collectedAt.UTC().Format("20060102T150405Z") + ".json"
+	filename := filepath.Join(collectionOpts.RecordSnapshotsDir, name)
+
+	err = os.MkdirAll(collectionOpts.RecordSnapshotsDir, 0700)
+	if err == nil {
+		err = ioutil.WriteFile(filename, out, 0600)
+	}
+	if err != nil {
+		logger.PrintError("Failed to record snapshot: %s", err)
+		return
+	}
+	logger.PrintVerbose("Recorded snapshot to %s", filename)
+}
+
+func readRecordedSnapshot(filename string) (snapshot.FullSnapshot, error) {
+	s := snapshot.FullSnapshot{}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return s, err
+	}
+	defer file.Close()
+
+	err = jsonpb.Unmarshal(file, &s)
+	if err != nil {
+		return s, fmt.Errorf("Failed to read recorded snapshot: %s", err)
+	}
+	return s, nil
+}
+
+// rememberLastSnapshot - Keeps the snapshot that's about to be submitted in memory,
+// so it can be inspected through the control server (/debug/last_snapshot)
+//
+// This has to be the exact snapshot that gets submitted, so any filtering needs to
+// happen before it's passed in here, not on a separate copy.
+func rememberLastSnapshot(server state.Server, collectionOpts state.CollectionOpts, logger *util.Logger, s snapshot.FullSnapshot) {
+	out, err := snapshotToJSON(s)
+	if err != nil {
+		logger.PrintVerbose("Failed to transform snapshot to JSON for the control server: %s", err)
+		return
+	}
+	collectionOpts.LastSnapshots.Set(server.Config.SectionName, out)
+}
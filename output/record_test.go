@@ -0,0 +1,135 @@
+package output
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// uploadedSnapshots - Reads all snapshots that were uploaded to the local grant directory
+func uploadedSnapshots(t *testing.T, server state.Server) []snapshot.FullSnapshot {
+	files, err := filepath.Glob(server.Grant.LocalDir + "*")
+	if err != nil {
+		t.Fatalf("Could not list uploaded snapshots: %s", err)
+	}
+
+	var snapshots []snapshot.FullSnapshot
+	for _, file := range files {
+		compressedData, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatalf("Could not read uploaded snapshot: %s", err)
+		}
+		r, err := zlib.NewReader(bytes.NewReader(compressedData))
+		if err != nil {
+			t.Fatalf("Could not decompress uploaded snapshot: %s", err)
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatalf("Could not decompress uploaded snapshot: %s", err)
+		}
+		s := snapshot.FullSnapshot{}
+		if err = proto.Unmarshal(data, &s); err != nil {
+			t.Fatalf("Could not unmarshal uploaded snapshot: %s", err)
+		}
+		snapshots = append(snapshots, s)
+	}
+	return snapshots
+}
+
+func recordTestAPI() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+}
+
+func TestRememberLastSnapshot(t *testing.T) {
+	api := recordTestAPI()
+	defer api.Close()
+
+	server, cleanup := bufferTestServer(t, api.URL)
+	defer cleanup()
+	server.Config.FilterQuerySample = "all"
+	collectionOpts := state.CollectionOpts{SubmitCollectedData: true, LastSnapshots: &state.LastSnapshots{}}
+	collectionOpts.LastSnapshots.SetEnabled(true)
+
+	s := snapshot.FullSnapshot{
+		QueryInformations: []*snapshot.QueryInformation{{QueryIdx: 0, NormalizedQuery: "SELECT * FROM users WHERE id = 1"}},
+	}
+	err := submitFull(s, server, collectionOpts, bufferTestLogger(), time.Now().Truncate(time.Second), true, false, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	uploaded := uploadedSnapshots(t, server)
+	if len(uploaded) != 1 {
+		t.Fatalf("Expected 1 uploaded snapshot, got %d", len(uploaded))
+	}
+	expected, err := snapshotToJSON(uploaded[0])
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	actual, exists := collectionOpts.LastSnapshots.Get(server.Config.SectionName)
+	if !exists {
+		t.Fatalf("Expected last snapshot to be kept")
+	}
+	if !bytes.Equal(actual, expected) {
+		t.Errorf("Expected last snapshot to match the submitted snapshot:\n%s\ngot:\n%s", expected, actual)
+	}
+}
+
+func TestRecordAndReplaySnapshot(t *testing.T) {
+	api := recordTestAPI()
+	defer api.Close()
+
+	server, cleanup := bufferTestServer(t, api.URL)
+	defer cleanup()
+	recordDir := filepath.Join(filepath.Dir(server.Config.SnapshotBufferDir), "recorded")
+	collectionOpts := state.CollectionOpts{SubmitCollectedData: true, RecordSnapshotsDir: recordDir}
+	logger := bufferTestLogger()
+
+	newState := state.PersistedState{CollectedAt: time.Now().Add(-time.Hour).Truncate(time.Second)}
+	err := SendFull(server, collectionOpts, logger, newState, state.DiffState{}, state.TransientState{}, 600)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	recorded, err := filepath.Glob(filepath.Join(recordDir, "*.json"))
+	if err != nil || len(recorded) != 1 {
+		t.Fatalf("Expected 1 recorded snapshot, got %v (%v)", recorded, err)
+	}
+	submitted := uploadedSnapshots(t, server)
+	if len(submitted) != 1 {
+		t.Fatalf("Expected 1 submitted snapshot, got %d", len(submitted))
+	}
+
+	// Replay (twice) into a fresh upload directory, without recording again
+	os.RemoveAll(server.Grant.LocalDir)
+	collectionOpts.RecordSnapshotsDir = ""
+	for i := 0; i < 2; i++ {
+		err = SendFullFromFile(server, collectionOpts, logger, recorded[0])
+		if err != nil {
+			t.Fatalf("Unexpected error replaying snapshot: %s", err)
+		}
+	}
+
+	// Replays of the same snapshot are written to the same file name (the snapshot UUID)
+	replayed := uploadedSnapshots(t, server)
+	if len(replayed) != 1 {
+		t.Fatalf("Expected replays to keep the snapshot UUID (1 uploaded file), got %d files", len(replayed))
+	}
+	if !proto.Equal(&replayed[0], &submitted[0]) {
+		t.Errorf("Expected replayed snapshot to be identical to the submitted one:\n%v\ngot:\n%v", submitted[0], replayed[0])
+	}
+	if replayed[0].SnapshotUuid == "" || replayed[0].CollectorVersion != util.CollectorNameAndVersion || replayed[0].CollectedIntervalSecs != 600 {
+		t.Errorf("Unexpected replayed snapshot metadata: %v", replayed[0])
+	}
+}
@@ -31,7 +31,7 @@ func submitReportRun(server state.Server, report reports.Report, logger *util.Lo
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Add("Accept", "application/json,text/plain")
 
-	resp, err := server.Config.HTTPClient.Do(req)
+	resp, err := server.Config.HTTPClient.Do(req.WithContext(util.ShutdownContext()))
 	// TODO: We could consider re-running on error (e.g. if it was a temporary server issue)
 	if err != nil {
 		return err
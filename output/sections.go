@@ -0,0 +1,57 @@
+package output
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+// optionalSnapshotSections - Full snapshot sections that can be left out when the
+// server doesn't process them (everything else is always sent)
+var optionalSnapshotSections = map[string]func(s *snapshot.FullSnapshot){
+	"collection_section_statuses": func(s *snapshot.FullSnapshot) { s.CollectionSectionStatuses = nil },
+	"recovery_conflicts":          func(s *snapshot.FullSnapshot) { s.RecoveryConflicts = nil },
+	"slru_stats":                  func(s *snapshot.FullSnapshot) { s.SlruStatistics = nil },
+	"bgwriter_stats":              func(s *snapshot.FullSnapshot) { s.BgwriterStatistic = nil },
+	"hba_rules":                   func(s *snapshot.FullSnapshot) { s.HbaRules = nil },
+	"collector_privileges":        func(s *snapshot.FullSnapshot) { s.CollectorPrivileges = nil },
+	"relation_labels":             func(s *snapshot.FullSnapshot) { s.RelationLabels = nil },
+	"xmin_horizon":                func(s *snapshot.FullSnapshot) { s.XminHorizon = nil },
+	"connection_saturation":       func(s *snapshot.FullSnapshot) { s.ConnectionSaturation = nil },
+	"wraparound":                  func(s *snapshot.FullSnapshot) { s.Wraparound = nil },
+	"autovacuum_forecast":         func(s *snapshot.FullSnapshot) { s.AutovacuumForecast = nil },
+	"buffer_cache":                func(s *snapshot.FullSnapshot) { s.BufferCache = nil },
+	"materialized_views":          func(s *snapshot.FullSnapshot) { s.MaterializedViewInformations = nil },
+	"duplicate_indices":           func(s *snapshot.FullSnapshot) { s.DuplicateIndices = nil },
+	"missing_fk_indices":          func(s *snapshot.FullSnapshot) { s.MissingForeignKeyIndices = nil },
+	"logical_replication":         func(s *snapshot.FullSnapshot) { s.LogicalReplication = nil },
+	"statement_stats_info":        func(s *snapshot.FullSnapshot) { s.StatementStatsInfo = nil },
+}
+
+// omitUnsupportedSections - Removes optional sections not listed in the server's
+// advertised snapshot sections, to avoid sending data the server discards
+func omitUnsupportedSections(s *snapshot.FullSnapshot, features state.GrantFeatures) {
+	if features.SnapshotSections == nil {
+		return
+	}
+
+	supported := make(map[string]bool)
+	for _, name := range features.SnapshotSections {
+		supported[name] = true
+	}
+
+	for name, omit := range optionalSnapshotSections {
+		if !supported[name] {
+			omit(s)
+		}
+	}
+}
+
+// omitUnchangedSchema - Leaves out table, index and function definitions, and
+// tells the server to reuse the ones it received last (references are kept so
+// that statistics can still be matched up)
+func omitUnchangedSchema(s *snapshot.FullSnapshot) {
+	s.SchemaUnchanged = true
+	s.RelationInformations = nil
+	s.IndexInformations = nil
+	s.FunctionInformations = nil
+}
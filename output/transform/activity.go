@@ -1,6 +1,9 @@
 package transform
 
 import (
+	"math"
+	"sort"
+
 	"github.com/golang/protobuf/ptypes"
 	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/state"
@@ -101,5 +104,56 @@ func ActivityStateToCompactActivitySnapshot(server state.Server, activityState s
 		}
 	}
 
+	for _, operation := range activityState.Operations {
+		progress := snapshot.OperationProgress{
+			BackendIdentity: operation.BackendIdentity,
+			Command:         operation.Command,
+			Phase:           operation.Phase,
+			WorkDone:        operation.WorkDone,
+			WorkTotal:       operation.WorkTotal,
+		}
+
+		if operation.WorkTotal > 0 {
+			progress.HasPercentDone = true
+			progress.PercentDone = math.Min(100.0, float64(operation.WorkDone)/float64(operation.WorkTotal)*100.0)
+		}
+
+		if operation.RoleName != "" {
+			progress.RoleIdx, r.RoleReferences = upsertRoleReference(r.RoleReferences, operation.RoleName)
+		} else {
+			progress.RoleIdx = -1
+		}
+
+		progress.DatabaseIdx, r.DatabaseReferences = upsertDatabaseReference(r.DatabaseReferences, operation.DatabaseName)
+		if operation.SchemaName.Valid && operation.RelationName.Valid {
+			relationRef := snapshot.RelationReference{
+				DatabaseIdx:  progress.DatabaseIdx,
+				SchemaName:   operation.SchemaName.String,
+				RelationName: operation.RelationName.String,
+			}
+			progress.HasRelationIdx = true
+			progress.RelationIdx = int32(len(r.RelationReferences))
+			r.RelationReferences = append(r.RelationReferences, &relationRef)
+		}
+
+		if operation.StartedAt.Valid {
+			progress.StartedAt, _ = ptypes.TimestampProto(operation.StartedAt.Time)
+		}
+
+		s.Progress = append(s.Progress, &progress)
+	}
+
+	var excludedBackendTypes []string
+	for backendType := range activityState.ExcludedBackendCounts {
+		excludedBackendTypes = append(excludedBackendTypes, backendType)
+	}
+	sort.Strings(excludedBackendTypes)
+	for _, backendType := range excludedBackendTypes {
+		s.ExcludedBackendCounts = append(s.ExcludedBackendCounts, &snapshot.ExcludedBackendCount{
+			BackendType: backendType,
+			Count:       activityState.ExcludedBackendCounts[backendType],
+		})
+	}
+
 	return s, r
 }
@@ -1,6 +1,10 @@
 package transform
 
 import (
+	"sort"
+	"time"
+	"unicode/utf8"
+
 	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/state"
 )
@@ -17,3 +21,34 @@ func transformCollectorStats(s snapshot.FullSnapshot, newState state.PersistedSt
 	}
 	return s
 }
+
+// Error messages are only meant to give a hint, avoid sending very long ones
+const maxCollectionStatusErrorLength = 500
+
+func transformCollectionStatus(s snapshot.FullSnapshot, transientState state.TransientState) snapshot.FullSnapshot {
+	names := make([]string, 0, len(transientState.CollectionStatus))
+	for name := range transientState.CollectionStatus {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		status := transientState.CollectionStatus[name]
+		errorMessage := status.Error
+		if len(errorMessage) > maxCollectionStatusErrorLength {
+			cut := maxCollectionStatusErrorLength
+			for cut > 0 && !utf8.RuneStart(errorMessage[cut]) {
+				cut--
+			}
+			errorMessage = errorMessage[:cut]
+		}
+		s.CollectionSectionStatuses = append(s.CollectionSectionStatuses, &snapshot.CollectionSectionStatus{
+			Name:       name,
+			Ok:         status.Ok,
+			Error:      errorMessage,
+			DurationMs: float64(status.Duration) / float64(time.Millisecond),
+			TimedOut:   status.TimedOut,
+		})
+	}
+	return s
+}
@@ -14,6 +14,7 @@ func LogStateToLogSnapshot(logState state.LogState) (snapshot.CompactLogSnapshot
 	var r snapshot.CompactSnapshot_BaseRefs
 	s, r = transformPostgresQuerySamples(s, r, logState)
 	s, r = transformSystemLogs(s, r, logState)
+	s, r = transformPostgresLockEvents(s, r, logState)
 	return s, r
 }
 
@@ -97,6 +98,17 @@ func transformPostgresQuerySamples(s snapshot.CompactLogSnapshot, r snapshot.Com
 			ExplainOutput: sampleIn.ExplainOutput,
 			ExplainError:  sampleIn.ExplainError,
 		}
+		if sampleIn.HasExplainSummary {
+			sample.ExplainSummary = &snapshot.QueryExplainSummary{
+				NodeType:      sampleIn.ExplainSummary.NodeType,
+				StartupCost:   sampleIn.ExplainSummary.StartupCost,
+				TotalCost:     sampleIn.ExplainSummary.TotalCost,
+				PlanRows:      sampleIn.ExplainSummary.PlanRows,
+				HasActualRows: sampleIn.ExplainSummary.HasActualRows,
+				ActualRows:    sampleIn.ExplainSummary.ActualRows,
+				Misestimated:  sampleIn.ExplainSummary.Misestimated,
+			}
+		}
 		s.QuerySamples = append(s.QuerySamples, &sample)
 	}
 
@@ -142,6 +154,63 @@ func transformSystemLogs(s snapshot.CompactLogSnapshot, r snapshot.CompactSnapsh
 	return s, r
 }
 
+func transformPostgresLockEvents(s snapshot.CompactLogSnapshot, r snapshot.CompactSnapshot_BaseRefs, logState state.LogState) (snapshot.CompactLogSnapshot, snapshot.CompactSnapshot_BaseRefs) {
+	for _, eventIn := range logState.LockEvents {
+		event := snapshot.LockEvent{
+			LogLineUuid:  eventIn.LogLineUUID.String(),
+			BackendPid:   eventIn.BackendPid,
+			LockMode:     eventIn.LockMode,
+			LockType:     eventIn.LockType,
+			BlockingPids: eventIn.BlockingPids,
+		}
+		event.OccurredAt, _ = ptypes.TimestampProto(eventIn.OccurredAt)
+
+		switch eventIn.Kind {
+		case state.LockEventWaiting:
+			event.Kind = snapshot.LockEvent_LOCK_WAITING
+		case state.LockEventAcquired:
+			event.Kind = snapshot.LockEvent_LOCK_ACQUIRED
+		case state.LockEventLockTimeout:
+			event.Kind = snapshot.LockEvent_LOCK_TIMEOUT
+		case state.LockEventStatementTimeout:
+			event.Kind = snapshot.LockEvent_STATEMENT_TIMEOUT
+		}
+
+		if eventIn.RelationOid.Valid {
+			event.RelationOid = &snapshot.NullInt64{Valid: true, Value: eventIn.RelationOid.Int64}
+		}
+		if eventIn.DatabaseOid.Valid {
+			event.LockDatabaseOid = &snapshot.NullInt64{Valid: true, Value: eventIn.DatabaseOid.Int64}
+		}
+		if eventIn.WaitedMs.Valid {
+			event.WaitedMs = &snapshot.NullDouble{Valid: true, Value: eventIn.WaitedMs.Float64}
+		}
+
+		if eventIn.Username != "" {
+			event.RoleIdx, r.RoleReferences = upsertRoleReference(r.RoleReferences, eventIn.Username)
+			event.HasRoleIdx = true
+		}
+		if eventIn.Database != "" {
+			event.DatabaseIdx, r.DatabaseReferences = upsertDatabaseReference(r.DatabaseReferences, eventIn.Database)
+			event.HasDatabaseIdx = true
+		}
+		if event.HasRoleIdx && event.HasDatabaseIdx && eventIn.Query != "" {
+			event.QueryIdx, r.QueryReferences, r.QueryInformations = upsertQueryReferenceAndInformationSimple(
+				r.QueryReferences,
+				r.QueryInformations,
+				event.RoleIdx,
+				event.DatabaseIdx,
+				eventIn.Query,
+			)
+			event.HasQueryIdx = true
+		}
+
+		s.LockEvents = append(s.LockEvents, &event)
+	}
+
+	return s, r
+}
+
 func transformSystemLogLine(r *snapshot.CompactSnapshot_BaseRefs, logFileIdx int32, logLineIn state.LogLine) snapshot.LogLineInformation {
 	occurredAt, _ := ptypes.TimestampProto(logLineIn.OccurredAt)
 
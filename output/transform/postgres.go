@@ -7,17 +7,42 @@ import (
 
 type OidToIdx map[state.Oid]int32
 
+// DatabaseObjectOid - Identifies an object (e.g. a table) across multiple databases
+type DatabaseObjectOid struct {
+	DatabaseOid state.Oid
+	Oid         state.Oid
+}
+
+type DatabaseObjectOidToIdx map[DatabaseObjectOid]int32
+
 func transformPostgres(s snapshot.FullSnapshot, newState state.PersistedState, diffState state.DiffState, transientState state.TransientState) snapshot.FullSnapshot {
 	s, roleOidToIdx := transformPostgresRoles(s, transientState)
 	s, databaseOidToIdx := transformPostgresDatabases(s, transientState, roleOidToIdx)
+	s = transformPostgresTablespaces(s, newState, transientState, roleOidToIdx)
 
 	s = transformPostgresVersion(s, transientState)
+	s = transformPostgresPrivileges(s, transientState)
 	s = transformPostgresConfig(s, transientState)
 	s = transformPostgresReplication(s, transientState, roleOidToIdx)
 	s = transformPostgresStatements(s, newState, diffState, transientState, roleOidToIdx, databaseOidToIdx)
-	s = transformPostgresRelations(s, newState, diffState, roleOidToIdx, databaseOidToIdx)
+	s = transformPostgresStatementStatsInfo(s, transientState)
+	s, relationOidToIdx, indexOidToIdx := transformPostgresRelations(s, newState, diffState, roleOidToIdx, databaseOidToIdx)
 	s = transformPostgresFunctions(s, newState, diffState, roleOidToIdx, databaseOidToIdx)
 	s = transformPostgresBackendCounts(s, transientState, roleOidToIdx, databaseOidToIdx)
+	s = transformPostgresRecoveryConflicts(s, diffState, transientState, databaseOidToIdx)
+	s = transformPostgresSlruStats(s, diffState)
+	s = transformPostgresBgwriterStats(s, diffState)
+	s = transformPostgresHbaRules(s, transientState)
+	s = transformPostgresXminHorizon(s, transientState)
+	s = transformPostgresConnectionSaturation(s, transientState)
+	s = transformPostgresWraparound(s, transientState, databaseOidToIdx, relationOidToIdx)
+	s = transformPostgresAutovacuumForecast(s, transientState, relationOidToIdx)
+	s = transformPostgresBufferCache(s, transientState, relationOidToIdx, indexOidToIdx)
+	s = transformPostgresMaterializedViews(s, transientState, relationOidToIdx)
+	s = transformPostgresDuplicateIndices(s, transientState, indexOidToIdx)
+	s = transformPostgresMissingForeignKeyIndices(s, transientState, relationOidToIdx)
+	s = transformPostgresRelationLabels(s, transientState, relationOidToIdx)
+	s = transformPostgresLogicalReplication(s, transientState, databaseOidToIdx, relationOidToIdx)
 
 	return s
 }
@@ -133,6 +158,11 @@ func transformPostgresVersion(s snapshot.FullSnapshot, transientState state.Tran
 		Full:    transientState.Version.Full,
 		Short:   transientState.Version.Short,
 		Numeric: int64(transientState.Version.Numeric),
+
+		BlockSize:      transientState.Version.BlockSize,
+		WalBlockSize:   transientState.Version.WalBlockSize,
+		WalSegmentSize: transientState.Version.WalSegmentSize,
+		SegmentSize:    transientState.Version.SegmentSize,
 	}
 	return s
 }
@@ -0,0 +1,46 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformAutovacuumSettings(settings state.PostgresAutovacuumSettings) *snapshot.AutovacuumSettings {
+	return &snapshot.AutovacuumSettings{
+		Enabled:            settings.Enabled,
+		VacuumThreshold:    settings.VacuumThreshold,
+		VacuumScaleFactor:  settings.VacuumScaleFactor,
+		AnalyzeThreshold:   settings.AnalyzeThreshold,
+		AnalyzeScaleFactor: settings.AnalyzeScaleFactor,
+	}
+}
+
+func transformPostgresAutovacuumForecast(s snapshot.FullSnapshot, transientState state.TransientState, relationOidToIdx DatabaseObjectOidToIdx) snapshot.FullSnapshot {
+	if !transientState.HasAutovacuumForecast {
+		return s
+	}
+
+	forecast := transientState.AutovacuumForecast
+	s.AutovacuumForecast = &snapshot.AutovacuumForecast{Settings: transformAutovacuumSettings(forecast.Settings)}
+
+	for _, relation := range forecast.Relations {
+		relationIdx, exists := relationOidToIdx[DatabaseObjectOid{relation.DatabaseOid, relation.RelationOid}]
+		if !exists {
+			continue
+		}
+		f := snapshot.AutovacuumRelationForecast{
+			RelationIdx:             relationIdx,
+			Settings:                transformAutovacuumSettings(relation.Settings),
+			Overridden:              relation.Overridden,
+			DeadTuples:              relation.DeadTuples,
+			VacuumTriggerDeadTuples: relation.VacuumTriggerDeadTuples,
+			AnalyzeTriggerMods:      relation.AnalyzeTriggerMods,
+		}
+		if relation.ModsSinceAnalyze.Valid {
+			f.ModsSinceAnalyze = &snapshot.NullInt64{Valid: true, Value: relation.ModsSinceAnalyze.Int64}
+		}
+		s.AutovacuumForecast.Relations = append(s.AutovacuumForecast.Relations, &f)
+	}
+
+	return s
+}
@@ -56,5 +56,7 @@ func transformBackendWithoutRefs(backend state.PostgresBackend) snapshot.Backend
 		b.BackendType = backend.BackendType.String
 	}
 
+	b.QueryTruncated = backend.QueryTruncated
+
 	return b
 }
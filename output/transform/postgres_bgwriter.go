@@ -0,0 +1,35 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresBgwriterStats(s snapshot.FullSnapshot, diffState state.DiffState) snapshot.FullSnapshot {
+	if diffState.BgwriterStats == nil {
+		return s
+	}
+
+	stats := diffState.BgwriterStats
+	s.BgwriterStatistic = &snapshot.BgwriterStatistic{
+		CheckpointsTimed:             stats.CheckpointsTimed,
+		CheckpointsReq:               stats.CheckpointsReq,
+		CheckpointWriteTime:          stats.CheckpointWriteTime,
+		CheckpointSyncTime:           stats.CheckpointSyncTime,
+		BuffersCheckpoint:            stats.BuffersCheckpoint,
+		BuffersClean:                 stats.BuffersClean,
+		MaxwrittenClean:              stats.MaxwrittenClean,
+		BuffersBackend:               stats.BuffersBackend,
+		BuffersBackendFsync:          stats.BuffersBackendFsync,
+		BuffersAlloc:                 stats.BuffersAlloc,
+		FrequentRequestedCheckpoints: stats.FrequentRequestedCheckpoints(),
+	}
+	if ratio := stats.RequestedCheckpointsRatio(); ratio.Valid {
+		s.BgwriterStatistic.RequestedCheckpointsRatio = &snapshot.NullDouble{Valid: true, Value: ratio.Float64}
+	}
+	if avgWriteTime := stats.AvgCheckpointWriteTime(); avgWriteTime.Valid {
+		s.BgwriterStatistic.AvgCheckpointWriteTime = &snapshot.NullDouble{Valid: true, Value: avgWriteTime.Float64}
+	}
+
+	return s
+}
@@ -0,0 +1,39 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresBufferCache(s snapshot.FullSnapshot, transientState state.TransientState, relationOidToIdx DatabaseObjectOidToIdx, indexOidToIdx DatabaseObjectOidToIdx) snapshot.FullSnapshot {
+	if !transientState.HasBufferCacheStats {
+		return s
+	}
+
+	stats := transientState.BufferCacheStats
+	s.BufferCache = &snapshot.BufferCacheStatistic{
+		TotalBuffers:      stats.TotalBuffers,
+		UsedBuffers:       stats.UsedBuffers,
+		UsageCountBuffers: stats.UsageCountBuffers,
+	}
+
+	// Relations that we don't track (e.g. system catalogs, or ignored tables) are skipped
+	for _, relation := range stats.Relations {
+		key := DatabaseObjectOid{stats.DatabaseOid, relation.RelationOid}
+		if idx, exists := relationOidToIdx[key]; exists {
+			s.BufferCache.RelationStatistics = append(s.BufferCache.RelationStatistics, &snapshot.BufferCacheRelationStatistic{
+				RelationIdx:  idx,
+				Buffers:      relation.Buffers,
+				DirtyBuffers: relation.DirtyBuffers,
+			})
+		} else if idx, exists := indexOidToIdx[key]; exists {
+			s.BufferCache.IndexStatistics = append(s.BufferCache.IndexStatistics, &snapshot.BufferCacheIndexStatistic{
+				IndexIdx:     idx,
+				Buffers:      relation.Buffers,
+				DirtyBuffers: relation.DirtyBuffers,
+			})
+		}
+	}
+
+	return s
+}
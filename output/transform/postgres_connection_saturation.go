@@ -0,0 +1,42 @@
+package transform
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresConnectionSaturation(s snapshot.FullSnapshot, transientState state.TransientState) snapshot.FullSnapshot {
+	if !transientState.HasConnectionSaturation {
+		return s
+	}
+
+	saturation := transientState.ConnectionSaturation
+	s.ConnectionSaturation = &snapshot.ConnectionSaturation{
+		MaxConnections:     saturation.MaxConnections,
+		Current:            transformConnectionUsage(saturation.Current),
+		Peak:               transformConnectionUsage(saturation.Peak),
+		PeakRatio:          saturation.PeakRatio(),
+		ExceedsWarnPercent: saturation.ExceedsWarnPercent,
+	}
+	for _, sample := range saturation.PeakHistory {
+		peak := snapshot.ConnectionSaturation_ConnectionPeak{Connections: sample.Connections}
+		peak.CollectedAt, _ = ptypes.TimestampProto(sample.CollectedAt)
+		s.ConnectionSaturation.PeakHistory = append(s.ConnectionSaturation.PeakHistory, &peak)
+	}
+
+	return s
+}
+
+func transformConnectionUsage(usage state.PostgresConnectionUsage) *snapshot.ConnectionSaturation_ConnectionUsage {
+	u := snapshot.ConnectionSaturation_ConnectionUsage{Connections: usage.Connections}
+	u.SampledAt, _ = ptypes.TimestampProto(usage.SampledAt)
+	for _, client := range usage.TopClients {
+		u.TopClients = append(u.TopClients, &snapshot.ConnectionSaturation_ConnectionCount{
+			ApplicationName: client.ApplicationName,
+			RoleName:        client.RoleName,
+			Count:           client.Count,
+		})
+	}
+	return &u
+}
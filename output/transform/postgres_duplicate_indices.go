@@ -0,0 +1,26 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresDuplicateIndices(s snapshot.FullSnapshot, transientState state.TransientState, indexOidToIdx DatabaseObjectOidToIdx) snapshot.FullSnapshot {
+	for _, duplicate := range transientState.DuplicateIndices {
+		indexIdx, exists := indexOidToIdx[DatabaseObjectOid{duplicate.DatabaseOid, duplicate.IndexOid}]
+		if !exists {
+			continue
+		}
+		coveringIndexIdx, exists := indexOidToIdx[DatabaseObjectOid{duplicate.DatabaseOid, duplicate.CoveringIndexOid}]
+		if !exists {
+			continue
+		}
+		s.DuplicateIndices = append(s.DuplicateIndices, &snapshot.DuplicateIndex{
+			IndexIdx:         indexIdx,
+			CoveringIndexIdx: coveringIndexIdx,
+			Exact:            duplicate.Exact,
+			ReclaimableBytes: duplicate.ReclaimableBytes,
+		})
+	}
+	return s
+}
@@ -0,0 +1,25 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresHbaRules(s snapshot.FullSnapshot, transientState state.TransientState) snapshot.FullSnapshot {
+	for _, rule := range transientState.HbaRules {
+		s.HbaRules = append(s.HbaRules, &snapshot.HbaRule{
+			LineNumber:        rule.LineNumber,
+			Type:              rule.Type,
+			Databases:         rule.Databases,
+			UserNames:         rule.UserNames,
+			Address:           rule.Address,
+			Netmask:           rule.Netmask,
+			AuthMethod:        rule.AuthMethod,
+			Options:           rule.Options,
+			Error:             rule.Error,
+			PermissiveReasons: rule.PermissiveReasons,
+		})
+	}
+
+	return s
+}
@@ -0,0 +1,80 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresLogicalReplication(s snapshot.FullSnapshot, transientState state.TransientState, databaseOidToIdx OidToIdx, relationOidToIdx DatabaseObjectOidToIdx) snapshot.FullSnapshot {
+	logicalReplication := transientState.LogicalReplication
+	if len(logicalReplication.Publications) == 0 && len(logicalReplication.Subscriptions) == 0 && len(logicalReplication.Slots) == 0 {
+		return s
+	}
+
+	s.LogicalReplication = &snapshot.LogicalReplication{}
+
+	for _, publication := range logicalReplication.Publications {
+		databaseIdx, exists := databaseOidToIdx[publication.DatabaseOid]
+		if !exists {
+			continue
+		}
+		p := snapshot.Publication{
+			DatabaseIdx:   databaseIdx,
+			Name:          publication.Name,
+			AllTables:     publication.AllTables,
+			PublishInsert: publication.Insert,
+			PublishUpdate: publication.Update,
+			PublishDelete: publication.Delete,
+		}
+		for _, relationOid := range publication.RelationOids {
+			if relationIdx, exists := relationOidToIdx[DatabaseObjectOid{publication.DatabaseOid, relationOid}]; exists {
+				p.RelationIdx = append(p.RelationIdx, relationIdx)
+			}
+		}
+		s.LogicalReplication.Publications = append(s.LogicalReplication.Publications, &p)
+	}
+
+	for _, subscription := range logicalReplication.Subscriptions {
+		databaseIdx, exists := databaseOidToIdx[subscription.DatabaseOid]
+		if !exists {
+			continue
+		}
+		s.LogicalReplication.Subscriptions = append(s.LogicalReplication.Subscriptions, &snapshot.Subscription{
+			DatabaseIdx:  databaseIdx,
+			Name:         subscription.Name,
+			Enabled:      subscription.Enabled,
+			WorkerPid:    &snapshot.NullInt64{Valid: subscription.WorkerPid.Valid, Value: subscription.WorkerPid.Int64},
+			ReceivedLsn:  &snapshot.NullString{Valid: subscription.ReceivedLsn.Valid, Value: subscription.ReceivedLsn.String},
+			LatestEndLsn: &snapshot.NullString{Valid: subscription.LatestEndLsn.Valid, Value: subscription.LatestEndLsn.String},
+			HasLagSecs:   subscription.LagSecs.Valid,
+			LagSecs:      subscription.LagSecs.Float64,
+			WorkerDown:   subscription.WorkerDown,
+			LagExceeded:  subscription.LagExceeded,
+		})
+	}
+
+	for _, slot := range logicalReplication.Slots {
+		databaseIdx, exists := databaseOidToIdx[slot.DatabaseOid]
+		if !exists {
+			continue
+		}
+		info := snapshot.LogicalReplicationSlot{
+			DatabaseIdx:          databaseIdx,
+			SlotName:             slot.SlotName,
+			Plugin:               slot.Plugin,
+			Active:               slot.Active,
+			ActivePid:            &snapshot.NullInt64{Valid: slot.ActivePid.Valid, Value: slot.ActivePid.Int64},
+			RestartLsn:           &snapshot.NullString{Valid: slot.RestartLsn.Valid, Value: slot.RestartLsn.String},
+			ConfirmedFlushLsn:    &snapshot.NullString{Valid: slot.ConfirmedFlushLsn.Valid, Value: slot.ConfirmedFlushLsn.String},
+			RetainedWalBytes:     &snapshot.NullInt64{Valid: slot.RetainedWalBytes.Valid, Value: slot.RetainedWalBytes.Int64},
+			RetainedWalGrowing:   slot.RetainedWalGrowing,
+			InactiveRetainingWal: slot.InactiveRetainingWal,
+		}
+		if slot.RetainedWalBytesPerSec.Valid {
+			info.RetainedWalBytesPerSec = &snapshot.NullDouble{Valid: true, Value: slot.RetainedWalBytesPerSec.Float64}
+		}
+		s.LogicalReplication.Slots = append(s.LogicalReplication.Slots, &info)
+	}
+
+	return s
+}
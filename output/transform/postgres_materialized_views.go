@@ -0,0 +1,22 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresMaterializedViews(s snapshot.FullSnapshot, transientState state.TransientState, relationOidToIdx DatabaseObjectOidToIdx) snapshot.FullSnapshot {
+	for _, view := range transientState.MaterializedViews {
+		relationIdx, exists := relationOidToIdx[DatabaseObjectOid{view.DatabaseOid, view.RelationOid}]
+		if !exists {
+			continue
+		}
+		s.MaterializedViewInformations = append(s.MaterializedViewInformations, &snapshot.MaterializedViewInformation{
+			RelationIdx:     relationIdx,
+			Populated:       view.IsPopulated,
+			SizeBytes:       view.SizeBytes,
+			LastRefreshedAt: snapshot.NullTimeToNullTimestamp(view.LastRefreshedAt),
+		})
+	}
+	return s
+}
@@ -0,0 +1,22 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresMissingForeignKeyIndices(s snapshot.FullSnapshot, transientState state.TransientState, relationOidToIdx DatabaseObjectOidToIdx) snapshot.FullSnapshot {
+	for _, missing := range transientState.MissingForeignKeyIndices {
+		relationIdx, exists := relationOidToIdx[DatabaseObjectOid{missing.DatabaseOid, missing.RelationOid}]
+		if !exists {
+			continue
+		}
+		s.MissingForeignKeyIndices = append(s.MissingForeignKeyIndices, &snapshot.MissingForeignKeyIndex{
+			RelationIdx:    relationIdx,
+			ConstraintName: missing.ConstraintName,
+			ColumnNames:    missing.ColumnNames,
+			SuggestedIndex: missing.SuggestedIndex,
+		})
+	}
+	return s
+}
@@ -0,0 +1,22 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresPrivileges(s snapshot.FullSnapshot, transientState state.TransientState) snapshot.FullSnapshot {
+	if !transientState.HasPrivileges {
+		return s
+	}
+
+	privileges := transientState.Privileges
+	s.CollectorPrivileges = &snapshot.CollectorPrivileges{
+		Superuser:                 privileges.Superuser,
+		PgMonitor:                 privileges.MonitoringRole,
+		PgReadAllStats:            privileges.ReadAllStats,
+		PermissionLimitedSections: privileges.PermissionLimitedSections,
+	}
+
+	return s
+}
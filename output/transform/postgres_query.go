@@ -45,13 +45,18 @@ func upsertQueryReferenceAndInformation(s *snapshot.FullSnapshot, statementTexts
 		normalizedQuery = "<insufficient privilege>"
 	} else if value.statement.Collector {
 		normalizedQuery = "<pganalyze-collector>"
+	} else if value.statement.BelowMinCalls {
+		normalizedQuery = "<other queries below min calls>"
+	} else if value.statement.OutsideTopStatements {
+		normalizedQuery = "<other queries outside top statements>"
 	} else {
 		normalizedQuery, _ = statementTexts[key.fingerprint]
 	}
 	queryInformation := snapshot.QueryInformation{
-		QueryIdx:        idx,
-		NormalizedQuery: normalizedQuery,
-		QueryIds:        value.queryIDs,
+		QueryIdx:         idx,
+		NormalizedQuery:  normalizedQuery,
+		QueryIds:         value.queryIDs,
+		QueryTextOmitted: value.statement.QueryTextOmitted,
 	}
 	s.QueryInformations = append(s.QueryInformations, &queryInformation)
 
@@ -0,0 +1,26 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresRecoveryConflicts(s snapshot.FullSnapshot, diffState state.DiffState, transientState state.TransientState, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
+	for _, database := range transientState.Databases {
+		stats, exists := diffState.RecoveryConflicts[database.Oid]
+		if !exists {
+			continue
+		}
+
+		s.RecoveryConflicts = append(s.RecoveryConflicts, &snapshot.RecoveryConflictStatistic{
+			DatabaseIdx:     databaseOidToIdx[database.Oid],
+			ConflTablespace: stats.ConflTablespace,
+			ConflLock:       stats.ConflLock,
+			ConflSnapshot:   stats.ConflSnapshot,
+			ConflBufferpin:  stats.ConflBufferpin,
+			ConflDeadlock:   stats.ConflDeadlock,
+		})
+	}
+
+	return s
+}
@@ -0,0 +1,28 @@
+package transform
+
+import (
+	"sort"
+
+	"github.com/pganalyze/collector/config"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresRelationLabels(s snapshot.FullSnapshot, transientState state.TransientState, relationOidToIdx DatabaseObjectOidToIdx) snapshot.FullSnapshot {
+	var entries []*snapshot.RelationLabels
+	for relationOid, labels := range transientState.RelationLabels {
+		relationIdx, exists := relationOidToIdx[DatabaseObjectOid{transientState.RelationLabelsDatabaseOid, relationOid}]
+		if !exists {
+			continue
+		}
+		// Same validation as for snapshot-wide labels, invalid or oversized ones are left out
+		labels, _ = config.MergeDynamicLabels(nil, labels)
+		entries = append(entries, &snapshot.RelationLabels{RelationIdx: relationIdx, Labels: labels})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].RelationIdx < entries[j].RelationIdx
+	})
+	s.RelationLabels = entries
+
+	return s
+}
@@ -7,7 +7,10 @@ import (
 	"github.com/pganalyze/collector/state"
 )
 
-func transformPostgresRelations(s snapshot.FullSnapshot, newState state.PersistedState, diffState state.DiffState, roleOidToIdx OidToIdx, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
+func transformPostgresRelations(s snapshot.FullSnapshot, newState state.PersistedState, diffState state.DiffState, roleOidToIdx OidToIdx, databaseOidToIdx OidToIdx) (snapshot.FullSnapshot, DatabaseObjectOidToIdx, DatabaseObjectOidToIdx) {
+	relationOidToIdx := make(DatabaseObjectOidToIdx)
+	indexOidToIdx := make(DatabaseObjectOidToIdx)
+
 	for _, relation := range newState.Relations {
 		ref := snapshot.RelationReference{
 			DatabaseIdx:  databaseOidToIdx[relation.DatabaseOid],
@@ -16,6 +19,7 @@ func transformPostgresRelations(s snapshot.FullSnapshot, newState state.Persiste
 		}
 		idx := int32(len(s.RelationReferences))
 		s.RelationReferences = append(s.RelationReferences, &ref)
+		relationOidToIdx[DatabaseObjectOid{relation.DatabaseOid, relation.Oid}] = idx
 
 		// Information
 		info := snapshot.RelationInformation{
@@ -73,31 +77,44 @@ func transformPostgresRelations(s snapshot.FullSnapshot, newState state.Persiste
 		stats, exists := diffState.RelationStats[relation.Oid]
 		if exists {
 			statistic := snapshot.RelationStatistic{
-				RelationIdx:    idx,
-				SizeBytes:      stats.SizeBytes,
-				ToastSizeBytes: stats.ToastSizeBytes,
-				SeqScan:        stats.SeqScan,
-				SeqTupRead:     stats.SeqTupRead,
-				IdxScan:        stats.IdxScan,
-				IdxTupFetch:    stats.IdxTupFetch,
-				NTupIns:        stats.NTupIns,
-				NTupUpd:        stats.NTupUpd,
-				NTupDel:        stats.NTupDel,
-				NTupHotUpd:     stats.NTupHotUpd,
-				NLiveTup:       stats.NLiveTup,
-				NDeadTup:       stats.NDeadTup,
-				HeapBlksRead:   stats.HeapBlksRead,
-				HeapBlksHit:    stats.HeapBlksHit,
-				IdxBlksRead:    stats.IdxBlksRead,
-				IdxBlksHit:     stats.IdxBlksHit,
-				ToastBlksRead:  stats.ToastBlksRead,
-				ToastBlksHit:   stats.ToastBlksHit,
-				TidxBlksRead:   stats.TidxBlksRead,
-				TidxBlksHit:    stats.TidxBlksHit,
+				RelationIdx:      idx,
+				SizeBytes:        stats.SizeBytes,
+				ToastSizeBytes:   stats.ToastSizeBytes,
+				MainSizeBytes:    stats.MainSizeBytes,
+				FsmSizeBytes:     stats.FsmSizeBytes,
+				VmSizeBytes:      stats.VmSizeBytes,
+				IndexesSizeBytes: stats.IndexesSizeBytes,
+				SeqScan:          stats.SeqScan,
+				SeqTupRead:       stats.SeqTupRead,
+				IdxScan:          stats.IdxScan,
+				IdxTupFetch:      stats.IdxTupFetch,
+				NTupIns:          stats.NTupIns,
+				NTupUpd:          stats.NTupUpd,
+				NTupDel:          stats.NTupDel,
+				NTupHotUpd:       stats.NTupHotUpd,
+				NLiveTup:         stats.NLiveTup,
+				NDeadTup:         stats.NDeadTup,
+				HeapBlksRead:     stats.HeapBlksRead,
+				HeapBlksHit:      stats.HeapBlksHit,
+				IdxBlksRead:      stats.IdxBlksRead,
+				IdxBlksHit:       stats.IdxBlksHit,
+				ToastBlksRead:    stats.ToastBlksRead,
+				ToastBlksHit:     stats.ToastBlksHit,
+				TidxBlksRead:     stats.TidxBlksRead,
+				TidxBlksHit:      stats.TidxBlksHit,
 			}
 			if stats.NModSinceAnalyze.Valid {
 				statistic.NModSinceAnalyze = stats.NModSinceAnalyze.Int64
 			}
+			if stats.SizeBytesGrowth.Valid {
+				statistic.SizeBytesGrowth = &snapshot.NullInt64{Valid: true, Value: stats.SizeBytesGrowth.Int64}
+			}
+			if ratio := stats.HotUpdateRatio(); ratio.Valid {
+				statistic.HotUpdateRatio = &snapshot.NullDouble{Valid: true, Value: ratio.Float64}
+			}
+			if ratio := stats.SeqScanRatio(); ratio.Valid {
+				statistic.SeqScanRatio = &snapshot.NullDouble{Valid: true, Value: ratio.Float64}
+			}
 			s.RelationStatistics = append(s.RelationStatistics, &statistic)
 
 			// Events
@@ -116,6 +133,7 @@ func transformPostgresRelations(s snapshot.FullSnapshot, newState state.Persiste
 			}
 			indexIdx := int32(len(s.IndexReferences))
 			s.IndexReferences = append(s.IndexReferences, &ref)
+			indexOidToIdx[DatabaseObjectOid{relation.DatabaseOid, index.IndexOid}] = indexIdx
 
 			// Information
 			indexInfo := snapshot.IndexInformation{
@@ -126,6 +144,8 @@ func transformPostgresRelations(s snapshot.FullSnapshot, newState state.Persiste
 				IsPrimary:   index.IsPrimary,
 				IsUnique:    index.IsUnique,
 				IsValid:     index.IsValid,
+				IsReady:     index.IsReady,
+				BeingBuilt:  index.IsBeingBuilt,
 				Fillfactor:  index.Fillfactor(),
 			}
 			if index.ConstraintDef.Valid {
@@ -148,12 +168,15 @@ func transformPostgresRelations(s snapshot.FullSnapshot, newState state.Persiste
 					IdxBlksRead: indexStats.IdxBlksRead,
 					IdxBlksHit:  indexStats.IdxBlksHit,
 				}
+				if indexStats.SizeBytesGrowth.Valid {
+					statistic.SizeBytesGrowth = &snapshot.NullInt64{Valid: true, Value: indexStats.SizeBytesGrowth.Int64}
+				}
 				s.IndexStatistics = append(s.IndexStatistics, &statistic)
 			}
 		}
 	}
 
-	return s
+	return s, relationOidToIdx, indexOidToIdx
 }
 
 func addRelationEvents(relationIdx int32, events []*snapshot.RelationEvent, count int64, lastTime null.Time, eventType snapshot.RelationEvent_EventType) []*snapshot.RelationEvent {
@@ -0,0 +1,30 @@
+package transform
+
+import (
+	"sort"
+
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresSlruStats(s snapshot.FullSnapshot, diffState state.DiffState) snapshot.FullSnapshot {
+	var names []string
+	for name := range diffState.SlruStats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		stats := diffState.SlruStats[name]
+		s.SlruStatistics = append(s.SlruStatistics, &snapshot.SlruStatistic{
+			Name:        name,
+			BlksZeroed:  stats.BlksZeroed,
+			BlksHit:     stats.BlksHit,
+			BlksRead:    stats.BlksRead,
+			BlksWritten: stats.BlksWritten,
+			Truncates:   stats.Truncates,
+		})
+	}
+
+	return s
+}
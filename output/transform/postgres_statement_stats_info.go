@@ -0,0 +1,22 @@
+package transform
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresStatementStatsInfo(s snapshot.FullSnapshot, transientState state.TransientState) snapshot.FullSnapshot {
+	if !transientState.HasStatementStatsInfo {
+		return s
+	}
+
+	info := transientState.StatementStatsInfo
+	s.StatementStatsInfo = &snapshot.StatementStatsInfo{
+		Dealloc:          info.Dealloc,
+		DeallocSinceLast: &snapshot.NullInt64{Valid: transientState.StatementDeallocDiff.Valid, Value: transientState.StatementDeallocDiff.Int64},
+	}
+	s.StatementStatsInfo.StatsReset, _ = ptypes.TimestampProto(info.StatsReset)
+
+	return s
+}
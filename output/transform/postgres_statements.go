@@ -62,10 +62,17 @@ func transformQueryStatistic(stats state.DiffedPostgresStatementStats, idx int32
 		TempBlksWritten:   stats.TempBlksWritten,
 		BlkReadTime:       stats.BlkReadTime,
 		BlkWriteTime:      stats.BlkWriteTime,
+		HasKcache:         stats.HasKcache,
+		UserCpuTime:       stats.UserCPUTime,
+		SysCpuTime:        stats.SysCPUTime,
+		FsReadBytes:       stats.FsReadBytes,
+		FsWriteBytes:      stats.FsWriteBytes,
 	}
 }
 
 func transformPostgresStatements(s snapshot.FullSnapshot, newState state.PersistedState, diffState state.DiffState, transientState state.TransientState, roleOidToIdx OidToIdx, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
+	s.QuerySource = transientState.QuerySource
+
 	// Statement stats from this snapshot
 	groupedStatements := groupStatements(transientState.Statements, transientState.StatementTexts, diffState.StatementStats)
 	for key, value := range groupedStatements {
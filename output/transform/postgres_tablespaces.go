@@ -0,0 +1,51 @@
+package transform
+
+import (
+	"strings"
+
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresTablespaces(s snapshot.FullSnapshot, newState state.PersistedState, transientState state.TransientState, roleOidToIdx OidToIdx) snapshot.FullSnapshot {
+	mountpoints := sortedMountpoints(newState.System.DiskPartitions)
+
+	for _, tablespace := range transientState.Tablespaces {
+		idx := int32(len(s.TablespaceReferences))
+		s.TablespaceReferences = append(s.TablespaceReferences, &snapshot.TablespaceReference{Name: tablespace.Name})
+
+		info := snapshot.TablespaceInformation{
+			TablespaceIdx: idx,
+			RoleIdx:       roleOidToIdx[tablespace.OwnerOid],
+			Config:        tablespace.Config,
+			Location:      tablespace.Location,
+			SizeBytes:     &snapshot.NullInt64{Valid: tablespace.SizeBytes.Valid, Value: tablespace.SizeBytes.Int64},
+		}
+
+		mountpoint := newState.System.DataDirectoryPartition
+		if tablespace.Location != "" {
+			mountpoint = tablespaceMountpoint(tablespace.Location, mountpoints)
+		}
+		for partitionIdx, m := range mountpoints {
+			if mountpoint != "" && m == mountpoint {
+				info.DiskPartitionIdx = int32(partitionIdx)
+				info.HasDiskPartition = true
+			}
+		}
+
+		s.TablespaceInformations = append(s.TablespaceInformations, &info)
+	}
+
+	return s
+}
+
+// tablespaceMountpoint - Finds the partition the tablespace lives on, i.e. the longest
+// mountpoint that is either the location itself, or one of its parent directories
+func tablespaceMountpoint(location string, mountpoints []string) (mountpoint string) {
+	for _, m := range mountpoints {
+		if (location == m || strings.HasPrefix(location, strings.TrimSuffix(m, "/")+"/")) && len(m) > len(mountpoint) {
+			mountpoint = m
+		}
+	}
+	return
+}
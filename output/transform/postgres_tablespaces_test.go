@@ -0,0 +1,44 @@
+package transform_test
+
+import (
+	"testing"
+
+	"github.com/pganalyze/collector/output/transform"
+	"github.com/pganalyze/collector/state"
+)
+
+var tablespacePartitionTests = []struct {
+	name               string
+	location           string
+	hasDiskPartition   bool
+	expectedMountpoint string
+}{
+	{"data directory", "", true, "/var/lib/postgresql"},
+	{"on root partition", "/srv/tablespace", true, "/"},
+	{"nested partition", "/data/ssd/tablespace", true, "/data/ssd"},
+	{"mountpoint itself", "/data", true, "/data"},
+	{"sibling directory with common prefix", "/database/tablespace", true, "/"},
+	{"sibling partition with common prefix", "/data/ssd2/tablespace", true, "/data"},
+}
+
+func TestTablespacePartitions(t *testing.T) {
+	partitions := state.DiskPartitionMap{"/": {}, "/data": {}, "/data/ssd": {}, "/var/lib/postgresql": {}}
+	// Partitions are referenced by their index in mountpoint order
+	mountpoints := []string{"/", "/data", "/data/ssd", "/var/lib/postgresql"}
+
+	for _, test := range tablespacePartitionTests {
+		newState := state.PersistedState{System: state.SystemState{DiskPartitions: partitions, DataDirectoryPartition: "/var/lib/postgresql"}}
+		transientState := state.TransientState{Tablespaces: []state.PostgresTablespace{{Name: "test", Location: test.location}}}
+
+		s := transform.StateToSnapshot(newState, state.DiffState{}, transientState)
+		if len(s.TablespaceInformations) != 1 {
+			t.Fatalf("%s: expected 1 tablespace, got %d", test.name, len(s.TablespaceInformations))
+		}
+		info := s.TablespaceInformations[0]
+		if info.HasDiskPartition != test.hasDiskPartition {
+			t.Errorf("%s: expected has disk partition %t, got %t", test.name, test.hasDiskPartition, info.HasDiskPartition)
+		} else if info.HasDiskPartition && mountpoints[info.DiskPartitionIdx] != test.expectedMountpoint {
+			t.Errorf("%s: expected partition %s, got %s", test.name, test.expectedMountpoint, mountpoints[info.DiskPartitionIdx])
+		}
+	}
+}
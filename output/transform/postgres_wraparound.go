@@ -0,0 +1,45 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresWraparound(s snapshot.FullSnapshot, transientState state.TransientState, databaseOidToIdx OidToIdx, relationOidToIdx DatabaseObjectOidToIdx) snapshot.FullSnapshot {
+	if !transientState.HasWraparound {
+		return s
+	}
+
+	wraparound := transientState.Wraparound
+	s.Wraparound = &snapshot.Wraparound{AutovacuumFreezeMaxAge: wraparound.AutovacuumFreezeMaxAge}
+
+	for _, database := range wraparound.Databases {
+		databaseIdx, exists := databaseOidToIdx[database.DatabaseOid]
+		if !exists {
+			continue
+		}
+		s.Wraparound.Databases = append(s.Wraparound.Databases, &snapshot.WraparoundDatabase{
+			DatabaseIdx:     databaseIdx,
+			XidAge:          database.XidAge,
+			ForcedVacuumPct: database.ForcedVacuumPct,
+			WraparoundPct:   database.WraparoundPct,
+		})
+	}
+
+	for _, relation := range wraparound.Relations {
+		relationIdx, exists := relationOidToIdx[DatabaseObjectOid{relation.DatabaseOid, relation.RelationOid}]
+		if !exists {
+			continue
+		}
+		s.Wraparound.Relations = append(s.Wraparound.Relations, &snapshot.WraparoundRelation{
+			RelationIdx:     relationIdx,
+			XidAge:          relation.XidAge,
+			FreezeMaxAge:    relation.FreezeMaxAge,
+			ForcedVacuumPct: relation.ForcedVacuumPct,
+			WraparoundPct:   relation.WraparoundPct,
+			Flagged:         relation.Flagged,
+		})
+	}
+
+	return s
+}
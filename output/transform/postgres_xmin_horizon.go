@@ -0,0 +1,24 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresXminHorizon(s snapshot.FullSnapshot, transientState state.TransientState) snapshot.FullSnapshot {
+	if !transientState.HasXminHorizon {
+		return s
+	}
+
+	horizon := transientState.XminHorizon
+	s.XminHorizon = &snapshot.XminHorizon{
+		Source:         horizon.Source,
+		Identifier:     horizon.Identifier,
+		Xmin:           uint32(horizon.Xmin),
+		XminAge:        horizon.XminAge,
+		AgeSecs:        &snapshot.NullInt64{Valid: horizon.AgeSecs.Valid, Value: horizon.AgeSecs.Int64},
+		ExceedsWarnAge: horizon.ExceedsWarnAge,
+	}
+
+	return s
+}
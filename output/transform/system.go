@@ -223,13 +223,7 @@ func transformSystem(systemState state.SystemState, diffState state.DiffState) *
 		}
 	}
 
-	mountpoints := []string{}
-	for k := range systemState.DiskPartitions {
-		mountpoints = append(mountpoints, k)
-	}
-	sort.Strings(mountpoints)
-
-	for _, mountpoint := range mountpoints {
+	for _, mountpoint := range sortedMountpoints(systemState.DiskPartitions) {
 		diskPartition := systemState.DiskPartitions[mountpoint]
 		ref := snapshot.DiskPartitionReference{
 			Mountpoint: mountpoint,
@@ -263,3 +257,13 @@ func transformSystem(systemState state.SystemState, diffState state.DiffState) *
 
 	return system
 }
+
+// sortedMountpoints - Disk partitions are referenced by their position in this list
+func sortedMountpoints(partitions state.DiskPartitionMap) []string {
+	mountpoints := []string{}
+	for k := range partitions {
+		mountpoints = append(mountpoints, k)
+	}
+	sort.Strings(mountpoints)
+	return mountpoints
+}
@@ -11,6 +11,7 @@ func StateToSnapshot(newState state.PersistedState, diffState state.DiffState, t
 	s = transformPostgres(s, newState, diffState, transientState)
 	s = systemStateToFullSnapshot(s, newState, diffState)
 	s = transformCollectorStats(s, newState, diffState)
+	s = transformCollectionStatus(s, transientState)
 
 	return s
 }
@@ -2,13 +2,16 @@ package output
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
@@ -69,13 +72,40 @@ func uploadToS3(httpClient *http.Client, S3URL string, S3Fields map[string]strin
 
 	writer.Close()
 
-	req, err := http.NewRequest("POST", S3URL, &formBytes)
+	ctx, cancel := context.WithCancel(util.ShutdownContext())
+	defer cancel()
+
+	var requestBody io.Reader = &formBytes
+	var limitedBody *rateLimitedReader
+	if bytesPerSec := uploadLimiter.limit(); bytesPerSec > 0 {
+		limitedBody = newRateLimitedReader(&formBytes, bytesPerSec)
+		requestBody = limitedBody
+
+		// The limiter is shared by concurrent uploads, so how long this upload waits
+		// depends on the others - instead of the fixed client timeout, use a deadline
+		// for this upload that gets extended by the time it actually waited
+		if httpClient.Timeout > 0 {
+			limitedBody.deadline = time.Now().Add(httpClient.Timeout)
+			limitedBody.deadlineTimer = time.AfterFunc(httpClient.Timeout, cancel)
+			defer limitedBody.deadlineTimer.Stop()
+
+			limitedClient := *httpClient
+			limitedClient.Timeout = 0
+			httpClient = &limitedClient
+		}
+	}
+
+	req, err := http.NewRequest("POST", S3URL, requestBody)
 	if err != nil {
 		return "", err
 	}
+	req.ContentLength = int64(formBytes.Len())
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 
-	resp, err := httpClient.Do(req)
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if limitedBody != nil && limitedBody.waited >= uploadThrottleLogThreshold {
+		logger.PrintInfo("Upload of %.1f MB was delayed by %s due to upload_max_bytes_per_sec", float64(len(data))/1024.0/1024.0, limitedBody.waited.Round(time.Second))
+	}
 	if err != nil {
 		return "", err
 	}
@@ -54,7 +54,7 @@ func processActivityForServer(server state.Server, globalCollectionOpts state.Co
 		return newState, false, fmt.Errorf("Error: Your PostgreSQL server version (%s) is too old, 9.2 or newer is required", activity.Version.Short)
 	}
 
-	activity.Backends, err = postgres.GetBackends(logger, connection, activity.Version, server.Config.SystemType)
+	activity.Backends, activity.ExcludedBackendCounts, err = postgres.GetBackends(logger, connection, activity.Version, server.Config.SystemType, server.Config.GetApplicationName(globalCollectionOpts.CollectorApplicationName), server.Config.GetActivityBackendTypes())
 	if err != nil {
 		return newState, false, errors.Wrap(err, "error collecting pg_stat_activity")
 	}
@@ -64,7 +64,19 @@ func processActivityForServer(server state.Server, globalCollectionOpts state.Co
 		return newState, false, errors.Wrap(err, "error collecting pg_stat_vacuum_progress")
 	}
 
+	activity.Operations, err = postgres.GetOperationProgress(connection, activity.Version)
+	if err != nil {
+		logger.PrintWarning("Error collecting progress of long-running operations: %s", err)
+		err = nil
+	}
+
 	activity.CollectedAt = time.Now()
+	if globalCollectionOpts.ActivityHistory.Enabled() {
+		globalCollectionOpts.ActivityHistory.AddActivity(server.Config.SectionName, output.FilterActivityQueryTexts(server, activity))
+	}
+
+	// Remember connection spikes in between full snapshots, which only sample once
+	newState.ConnectionPeak = state.HigherConnectionUsage(newState.ConnectionPeak, state.ConnectionUsageFromBackends(activity.Backends, activity.CollectedAt))
 
 	err = output.SubmitCompactActivitySnapshot(server, newGrant, globalCollectionOpts, logger, activity)
 	if err != nil {
@@ -86,6 +98,7 @@ func CollectActivityFromAllServers(servers []state.Server, globalCollectionOpts
 			continue
 		}
 
+		idx := idx // Captured by the goroutine below
 		wg.Add(1)
 		go func(server *state.Server) {
 			prefixedLogger := logger.WithPrefixAndRememberErrors(server.Config.SectionName)
@@ -100,6 +113,7 @@ func CollectActivityFromAllServers(servers []state.Server, globalCollectionOpts
 				servers[idx].StateMutex.Unlock()
 				allSuccessful = false
 				prefixedLogger.PrintError("Could not collect activity for server: %s", err)
+				globalCollectionOpts.ActivityHistory.AddFailure(server.Config.SectionName, "activity", err)
 				if server.Config.ErrorCallback != "" {
 					go runCompletionCallback("error", server.Config.ErrorCallback, server.Config.SectionName, "activity", err, prefixedLogger)
 				}
@@ -0,0 +1,88 @@
+package runner
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pganalyze/collector/grant"
+	"github.com/pganalyze/collector/output"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+func backfillFilenames(path string) ([]string, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fi.IsDir() {
+		return []string{path}, nil
+	}
+
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Submit the oldest files first, so the server receives them in order
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+
+	var filenames []string
+	for _, f := range files {
+		if f.Mode().IsRegular() {
+			filenames = append(filenames, filepath.Join(path, f.Name()))
+		}
+	}
+
+	return filenames, nil
+}
+
+// BackfillFullSnapshots - Submits full snapshots previously written to disk (a single file, or all files in a directory),
+// keeping their original collected_at time
+func BackfillFullSnapshots(servers []state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger, path string) (allSuccessful bool) {
+	if len(servers) != 1 {
+		logger.PrintError("Error: Submitting snapshot files requires a configuration with exactly one server (found %d)", len(servers))
+		return false
+	}
+
+	server := servers[0]
+	prefixedLogger := logger.WithPrefix(server.Config.SectionName)
+
+	filenames, err := backfillFilenames(path)
+	if err != nil {
+		prefixedLogger.PrintError("Could not read snapshot files: %s", err)
+		return false
+	}
+
+	if !globalCollectionOpts.ForceEmptyGrant {
+		server.Grant, err = grant.GetDefaultGrant(server, globalCollectionOpts, prefixedLogger)
+		if err != nil {
+			prefixedLogger.PrintError("Could not acquire snapshot grant: %s", err)
+			return false
+		}
+	}
+
+	allSuccessful = true
+	for _, filename := range filenames {
+		err = output.SendFullFromFile(server, globalCollectionOpts, prefixedLogger, filename)
+		if err != nil {
+			allSuccessful = false
+			prefixedLogger.PrintError("Could not submit snapshot file %s: %s", filename, err)
+			continue
+		}
+		prefixedLogger.PrintVerbose("Submitted snapshot file %s", filename)
+	}
+
+	if len(filenames) == 0 {
+		prefixedLogger.PrintWarning("No snapshot files found in %s", path)
+	} else {
+		prefixedLogger.PrintInfo("Processed %d snapshot file(s)", len(filenames))
+	}
+
+	return
+}
@@ -1,14 +1,21 @@
 package runner
 
 import (
+	"hash/fnv"
+	"sort"
+
+	"github.com/guregu/null"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
 )
 
-func diffState(logger *util.Logger, prevState state.PersistedState, newState state.PersistedState, collectedIntervalSecs uint32) (diffState state.DiffState) {
+func diffState(logger *util.Logger, prevState state.PersistedState, newState state.PersistedState, collectedIntervalSecs uint32, sizeGrowth bool) (diffState state.DiffState) {
 	diffState.StatementStats = diffStatements(newState.StatementStats, prevState.StatementStats)
-	diffState.RelationStats = diffRelationStats(newState.RelationStats, prevState.RelationStats)
-	diffState.IndexStats = diffIndexStats(newState.IndexStats, prevState.IndexStats)
+	diffState.RelationStats = diffRelationStats(newState.RelationStats, prevState.RelationStats, sizeGrowth)
+	diffState.IndexStats = diffIndexStats(newState.IndexStats, prevState.IndexStats, sizeGrowth)
+	diffState.RecoveryConflicts = diffRecoveryConflicts(newState.RecoveryConflicts, prevState.RecoveryConflicts)
+	diffState.SlruStats = diffSlruStats(newState.SlruStats, prevState.SlruStats)
+	diffState.BgwriterStats = diffBgwriterStats(logger, newState.BgwriterStats, prevState.BgwriterStats)
 	diffState.SystemCPUStats = diffSystemCPUStats(newState.System.CPUStats, prevState.System.CPUStats)
 	diffState.SystemNetworkStats = diffSystemNetworkStats(newState.System.NetworkStats, prevState.System.NetworkStats, collectedIntervalSecs)
 	diffState.SystemDiskStats = diffSystemDiskStats(newState.System.DiskStats, prevState.System.DiskStats, collectedIntervalSecs)
@@ -39,20 +46,213 @@ func diffStatements(new state.PostgresStatementStatsMap, prev state.PostgresStat
 	return
 }
 
-func diffRelationStats(new state.PostgresRelationStatsMap, prev state.PostgresRelationStatsMap) (diff state.DiffedPostgresRelationStatsMap) {
+// Query ID used for the combined entry of statements below query_stats_min_calls
+// (derived from a fixed string, to make collisions with real query IDs unlikely)
+var belowMinCallsQueryID = func() int64 {
+	h := fnv.New64a()
+	h.Write([]byte("<other queries below min calls>"))
+	return int64(h.Sum64())
+}()
+
+// combineStatementsBelowMinCalls - Replaces all statements that were called less than minCalls
+// times with a single combined entry per database and role, registering that entry in statements
+func combineStatementsBelowMinCalls(diff state.DiffedPostgresStatementStatsMap, statements state.PostgresStatementMap, minCalls int64) state.DiffedPostgresStatementStatsMap {
+	combined := make(state.DiffedPostgresStatementStatsMap)
+
+	for key, stats := range diff {
+		if stats.Calls >= minCalls {
+			combined[key] = stats
+			continue
+		}
+
+		otherKey := state.PostgresStatementKey{DatabaseOid: key.DatabaseOid, UserOid: key.UserOid, QueryID: belowMinCallsQueryID}
+		if statements != nil {
+			statements[otherKey] = state.PostgresStatement{BelowMinCalls: true, Fingerprint: util.FingerprintQuery("<other queries below min calls>")}
+		}
+		combined[otherKey] = combined[otherKey].Add(stats)
+	}
+
+	return combined
+}
+
+// combineHistoricStatements - Applies combine to each interval of historic statement statistics
+//
+// This returns a new map, since the given one is shared with the previous state,
+// which has to stay unchanged in case submitting the snapshot fails.
+func combineHistoricStatements(historic state.HistoricStatementStatsMap, combine func(state.DiffedPostgresStatementStatsMap) state.DiffedPostgresStatementStatsMap) state.HistoricStatementStatsMap {
+	if historic == nil {
+		return nil
+	}
+
+	combined := make(state.HistoricStatementStatsMap, len(historic))
+	for timeKey, diffedStats := range historic {
+		combined[timeKey] = combine(diffedStats)
+	}
+
+	return combined
+}
+
+// Query ID used for the combined entry of statements outside query_stats_max_statements
+var outsideTopStatementsQueryID = func() int64 {
+	h := fnv.New64a()
+	h.Write([]byte("<other queries outside top statements>"))
+	return int64(h.Sum64())
+}()
+
+// statementRankValue - Value of the given query_stats_rank_by metric (see
+// config.QueryStatsRankByMetrics) for a diffed statement
+func statementRankValue(stats state.DiffedPostgresStatementStats, metric string) float64 {
+	switch metric {
+	case "mean_exec_time":
+		if stats.Calls == 0 {
+			return 0
+		}
+		return stats.TotalTime / float64(stats.Calls)
+	case "calls":
+		return float64(stats.Calls)
+	case "rows":
+		return float64(stats.Rows)
+	case "shared_blks_hit":
+		return float64(stats.SharedBlksHit)
+	case "shared_blks_read":
+		return float64(stats.SharedBlksRead)
+	case "shared_blks_dirtied":
+		return float64(stats.SharedBlksDirtied)
+	case "shared_blks_written":
+		return float64(stats.SharedBlksWritten)
+	case "local_blks_read":
+		return float64(stats.LocalBlksRead)
+	case "local_blks_written":
+		return float64(stats.LocalBlksWritten)
+	case "temp_blks_read":
+		return float64(stats.TempBlksRead)
+	case "temp_blks_written":
+		return float64(stats.TempBlksWritten)
+	case "blk_read_time":
+		return stats.BlkReadTime
+	case "blk_write_time":
+		return stats.BlkWriteTime
+	}
+	return stats.TotalTime
+}
+
+// combineStatementsOutsideTop - Keeps the maxStatements statements ranking highest by the
+// given metric, and replaces all others with a single combined entry per database and
+// role (registering that entry in statements), so that totals still add up
+//
+// Entries that already combine statements (e.g. those below query_stats_min_calls) are
+// always kept, and don't count towards maxStatements.
+func combineStatementsOutsideTop(diff state.DiffedPostgresStatementStatsMap, statements state.PostgresStatementMap, maxStatements int, metric string) state.DiffedPostgresStatementStatsMap {
+	var ranked []state.PostgresStatementKey
+	combined := make(state.DiffedPostgresStatementStatsMap)
+
+	for key, stats := range diff {
+		if key.QueryID == belowMinCallsQueryID {
+			combined[key] = stats
+			continue
+		}
+		ranked = append(ranked, key)
+	}
+	if len(ranked) <= maxStatements {
+		return diff
+	}
+
+	sortStatementsByRank(ranked, diff, metric)
+
+	for idx, key := range ranked {
+		if idx < maxStatements {
+			combined[key] = diff[key]
+			continue
+		}
+
+		otherKey := state.PostgresStatementKey{DatabaseOid: key.DatabaseOid, UserOid: key.UserOid, QueryID: outsideTopStatementsQueryID}
+		if statements != nil {
+			statements[otherKey] = state.PostgresStatement{OutsideTopStatements: true, Fingerprint: util.FingerprintQuery("<other queries outside top statements>")}
+		}
+		combined[otherKey] = combined[otherKey].Add(diff[key])
+	}
+
+	return combined
+}
+
+// sortStatementsByRank - Sorts the given statements by the metric, highest first
+func sortStatementsByRank(ranked []state.PostgresStatementKey, diff state.DiffedPostgresStatementStatsMap, metric string) {
+	sort.Slice(ranked, func(i, j int) bool {
+		a := statementRankValue(diff[ranked[i]], metric)
+		b := statementRankValue(diff[ranked[j]], metric)
+		if a != b {
+			return a > b
+		}
+		// Make the cut-off deterministic for statements with the same value
+		if ranked[i].QueryID != ranked[j].QueryID {
+			return ranked[i].QueryID < ranked[j].QueryID
+		}
+		if ranked[i].DatabaseOid != ranked[j].DatabaseOid {
+			return ranked[i].DatabaseOid < ranked[j].DatabaseOid
+		}
+		return ranked[i].UserOid < ranked[j].UserOid
+	})
+}
+
+// omitQueryTextOutsideTop - Drops the query text of all statements not ranked within
+// the top maxStatements (keeping their statistics), and marks them in statements
+//
+// Texts are shared by all statements with the same fingerprint, so a text is only
+// dropped when none of the statements using it are ranked within the top.
+func omitQueryTextOutsideTop(diff state.DiffedPostgresStatementStatsMap, statements state.PostgresStatementMap, statementTexts state.PostgresStatementTextMap, maxStatements int, metric string) {
+	var ranked []state.PostgresStatementKey
+	for key := range diff {
+		statement, exists := statements[key]
+		if !exists || statement.BelowMinCalls || statement.OutsideTopStatements {
+			continue
+		}
+		ranked = append(ranked, key)
+	}
+	sortStatementsByRank(ranked, diff, metric)
+
+	topFingerprints := make(map[[21]byte]bool)
+	for idx, key := range ranked {
+		if idx >= maxStatements {
+			break
+		}
+		topFingerprints[statements[key].Fingerprint] = true
+	}
+
+	for key, statement := range statements {
+		if statement.Unidentified || statement.InsufficientPrivilege || statement.Collector || statement.BelowMinCalls || statement.OutsideTopStatements {
+			continue
+		}
+		if !topFingerprints[statement.Fingerprint] {
+			statement.QueryTextOmitted = true
+			statements[key] = statement
+			delete(statementTexts, statement.Fingerprint)
+		}
+	}
+}
+
+func diffRelationStats(new state.PostgresRelationStatsMap, prev state.PostgresRelationStatsMap, sizeGrowth bool) (diff state.DiffedPostgresRelationStatsMap) {
 	followUpRun := len(prev) > 0
 
 	diff = make(state.DiffedPostgresRelationStatsMap)
 	for key, stats := range new {
 		prevStats, exists := prev[key]
 		if exists {
-			diff[key] = stats.DiffSince(prevStats)
+			diffedStats := stats.DiffSince(prevStats)
+			if sizeGrowth {
+				diffedStats.SizeBytesGrowth = null.IntFrom(stats.SizeBytes - prevStats.SizeBytes)
+			}
+			diff[key] = diffedStats
 		} else if followUpRun { // New since the last run
+			// Relations that were created in between collections have no growth value
 			diff[key] = stats.DiffSince(state.PostgresRelationStats{})
 		} else {
 			diff[key] = state.DiffedPostgresRelationStats{
 				SizeBytes:        stats.SizeBytes,
 				ToastSizeBytes:   stats.ToastSizeBytes,
+				MainSizeBytes:    stats.MainSizeBytes,
+				FsmSizeBytes:     stats.FsmSizeBytes,
+				VmSizeBytes:      stats.VmSizeBytes,
+				IndexesSizeBytes: stats.IndexesSizeBytes,
 				NLiveTup:         stats.NLiveTup,
 				NDeadTup:         stats.NDeadTup,
 				NModSinceAnalyze: stats.NModSinceAnalyze,
@@ -67,14 +267,18 @@ func diffRelationStats(new state.PostgresRelationStatsMap, prev state.PostgresRe
 	return
 }
 
-func diffIndexStats(new state.PostgresIndexStatsMap, prev state.PostgresIndexStatsMap) (diff state.DiffedPostgresIndexStatsMap) {
+func diffIndexStats(new state.PostgresIndexStatsMap, prev state.PostgresIndexStatsMap, sizeGrowth bool) (diff state.DiffedPostgresIndexStatsMap) {
 	followUpRun := len(prev) > 0
 
 	diff = make(state.DiffedPostgresIndexStatsMap)
 	for key, stats := range new {
 		prevStats, exists := prev[key]
 		if exists {
-			diff[key] = stats.DiffSince(prevStats)
+			diffedStats := stats.DiffSince(prevStats)
+			if sizeGrowth {
+				diffedStats.SizeBytesGrowth = null.IntFrom(stats.SizeBytes - prevStats.SizeBytes)
+			}
+			diff[key] = diffedStats
 		} else if followUpRun { // New since the last run
 			diff[key] = stats.DiffSince(state.PostgresIndexStats{})
 		} else {
@@ -87,6 +291,44 @@ func diffIndexStats(new state.PostgresIndexStatsMap, prev state.PostgresIndexSta
 	return
 }
 
+func diffRecoveryConflicts(new state.PostgresRecoveryConflictStatsMap, prev state.PostgresRecoveryConflictStatsMap) (diff state.DiffedPostgresRecoveryConflictStatsMap) {
+	diff = make(state.DiffedPostgresRecoveryConflictStatsMap)
+	for databaseOid, stats := range new {
+		prevStats, exists := prev[databaseOid]
+		if exists {
+			diff[databaseOid] = stats.DiffSince(prevStats)
+		}
+	}
+
+	return
+}
+
+func diffSlruStats(new state.PostgresSlruStatsMap, prev state.PostgresSlruStatsMap) (diff state.DiffedPostgresSlruStatsMap) {
+	diff = make(state.DiffedPostgresSlruStatsMap)
+	for name, stats := range new {
+		prevStats, exists := prev[name]
+		if exists {
+			diff[name] = stats.DiffSince(prevStats)
+		}
+	}
+
+	return
+}
+
+func diffBgwriterStats(logger *util.Logger, new *state.PostgresBgwriterStats, prev *state.PostgresBgwriterStats) *state.DiffedPostgresBgwriterStats {
+	if new == nil || prev == nil {
+		return nil
+	}
+
+	diff := new.DiffSince(*prev)
+	if diff.FrequentRequestedCheckpoints() {
+		logger.PrintWarning("Most checkpoints since the last snapshot were requested instead of timed (%d requested, %d timed), consider increasing max_wal_size",
+			diff.CheckpointsReq, diff.CheckpointsTimed)
+	}
+
+	return &diff
+}
+
 func diffSystemCPUStats(new state.CPUStatisticMap, prev state.CPUStatisticMap) (diff state.DiffedSystemCPUStatsMap) {
 	diff = make(state.DiffedSystemCPUStatsMap)
 	for cpuID, stats := range new {
@@ -0,0 +1,282 @@
+package runner
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+func diffTestKey(databaseOid state.Oid, queryID int64) state.PostgresStatementKey {
+	return state.PostgresStatementKey{DatabaseOid: databaseOid, UserOid: 10, QueryID: queryID}
+}
+
+var combineStatementsBelowMinCallsTests = []struct {
+	name     string
+	minCalls int64
+	diff     state.DiffedPostgresStatementStatsMap
+	expected state.DiffedPostgresStatementStatsMap
+}{
+	{
+		"all above min calls",
+		5,
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, 1): {Calls: 5, TotalTime: 10},
+			diffTestKey(1, 2): {Calls: 100, TotalTime: 20},
+		},
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, 1): {Calls: 5, TotalTime: 10},
+			diffTestKey(1, 2): {Calls: 100, TotalTime: 20},
+		},
+	},
+	{
+		"combined per database",
+		5,
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, 1): {Calls: 1, TotalTime: 1, Rows: 1},
+			diffTestKey(1, 2): {Calls: 4, TotalTime: 2, Rows: 3},
+			diffTestKey(1, 3): {Calls: 10, TotalTime: 30},
+			diffTestKey(2, 1): {Calls: 2, TotalTime: 5},
+		},
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, belowMinCallsQueryID): {Calls: 5, TotalTime: 3, Rows: 4},
+			diffTestKey(1, 3):                    {Calls: 10, TotalTime: 30},
+			diffTestKey(2, belowMinCallsQueryID): {Calls: 2, TotalTime: 5},
+		},
+	},
+}
+
+func TestCombineStatementsBelowMinCalls(t *testing.T) {
+	for _, test := range combineStatementsBelowMinCallsTests {
+		statements := make(state.PostgresStatementMap)
+		actual := combineStatementsBelowMinCalls(test.diff, statements, test.minCalls)
+		if len(actual) != len(test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+		}
+		for key, expected := range test.expected {
+			if actual[key] != expected {
+				t.Errorf("%s: expected %+v for %+v, got %+v", test.name, expected, key, actual[key])
+			}
+			if key.QueryID == belowMinCallsQueryID && !statements[key].BelowMinCalls {
+				t.Errorf("%s: expected combined entry %+v to be registered as statement", test.name, key)
+			}
+		}
+		for key, statement := range statements {
+			if _, exists := test.expected[key]; !exists || !statement.BelowMinCalls {
+				t.Errorf("%s: unexpected statement registered for %+v: %+v", test.name, key, statement)
+			}
+		}
+	}
+}
+
+func TestCombineHistoricStatements(t *testing.T) {
+	timeKey := state.PostgresStatementStatsTimeKey{CollectedAt: time.Now(), CollectedIntervalSecs: 60}
+	historic := state.HistoricStatementStatsMap{
+		timeKey: {
+			diffTestKey(1, 1): {Calls: 1},
+			diffTestKey(1, 2): {Calls: 10},
+		},
+	}
+
+	combined := combineHistoricStatements(historic, func(diff state.DiffedPostgresStatementStatsMap) state.DiffedPostgresStatementStatsMap {
+		return combineStatementsBelowMinCalls(diff, nil, 5)
+	})
+
+	if len(combined[timeKey]) != 2 || combined[timeKey][diffTestKey(1, belowMinCallsQueryID)].Calls != 1 {
+		t.Errorf("Expected historic statements to be combined, got %v", combined[timeKey])
+	}
+	if len(historic[timeKey]) != 2 || historic[timeKey][diffTestKey(1, 1)].Calls != 1 {
+		t.Errorf("Expected the original historic statements to be unchanged, got %v", historic[timeKey])
+	}
+	if combineHistoricStatements(nil, nil) != nil {
+		t.Errorf("Expected no historic statements to stay nil")
+	}
+}
+
+var combineStatementsOutsideTopTests = []struct {
+	name          string
+	maxStatements int
+	metric        string
+	diff          state.DiffedPostgresStatementStatsMap
+	expected      state.DiffedPostgresStatementStatsMap
+}{
+	{
+		"within max statements",
+		2,
+		"total_time",
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, 1): {Calls: 1, TotalTime: 10},
+			diffTestKey(1, 2): {Calls: 2, TotalTime: 20},
+		},
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, 1): {Calls: 1, TotalTime: 10},
+			diffTestKey(1, 2): {Calls: 2, TotalTime: 20},
+		},
+	},
+	{
+		"by total time",
+		1,
+		"total_time",
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, 1): {Calls: 100, TotalTime: 10},
+			diffTestKey(1, 2): {Calls: 2, TotalTime: 20},
+			diffTestKey(2, 3): {Calls: 3, TotalTime: 5},
+		},
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, 2): {Calls: 2, TotalTime: 20},
+			diffTestKey(1, outsideTopStatementsQueryID): {Calls: 100, TotalTime: 10},
+			diffTestKey(2, outsideTopStatementsQueryID): {Calls: 3, TotalTime: 5},
+		},
+	},
+	{
+		"by calls",
+		1,
+		"calls",
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, 1): {Calls: 100, TotalTime: 10},
+			diffTestKey(1, 2): {Calls: 2, TotalTime: 20},
+			diffTestKey(1, 3): {Calls: 3, TotalTime: 5},
+		},
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, 1): {Calls: 100, TotalTime: 10},
+			diffTestKey(1, outsideTopStatementsQueryID): {Calls: 5, TotalTime: 25},
+		},
+	},
+	{
+		"by mean time",
+		1,
+		"mean_exec_time",
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, 1): {Calls: 100, TotalTime: 100},
+			diffTestKey(1, 2): {Calls: 2, TotalTime: 20},
+		},
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, 2): {Calls: 2, TotalTime: 20},
+			diffTestKey(1, outsideTopStatementsQueryID): {Calls: 100, TotalTime: 100},
+		},
+	},
+	{
+		"ties broken by query ID",
+		1,
+		"total_time",
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, 2): {Calls: 1, TotalTime: 10},
+			diffTestKey(1, 1): {Calls: 1, TotalTime: 10},
+		},
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, 1): {Calls: 1, TotalTime: 10},
+			diffTestKey(1, outsideTopStatementsQueryID): {Calls: 1, TotalTime: 10},
+		},
+	},
+	{
+		"entry below min calls kept and not counted",
+		1,
+		"total_time",
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, belowMinCallsQueryID): {Calls: 4, TotalTime: 1000},
+			diffTestKey(1, 1):                    {Calls: 10, TotalTime: 10},
+			diffTestKey(1, 2):                    {Calls: 10, TotalTime: 20},
+		},
+		state.DiffedPostgresStatementStatsMap{
+			diffTestKey(1, belowMinCallsQueryID):        {Calls: 4, TotalTime: 1000},
+			diffTestKey(1, 2):                           {Calls: 10, TotalTime: 20},
+			diffTestKey(1, outsideTopStatementsQueryID): {Calls: 10, TotalTime: 10},
+		},
+	},
+}
+
+func TestCombineStatementsOutsideTop(t *testing.T) {
+	for _, test := range combineStatementsOutsideTopTests {
+		statements := make(state.PostgresStatementMap)
+		actual := combineStatementsOutsideTop(test.diff, statements, test.maxStatements, test.metric)
+		if len(actual) != len(test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+		}
+		for key, expected := range test.expected {
+			if actual[key] != expected {
+				t.Errorf("%s: expected %+v for %+v, got %+v", test.name, expected, key, actual[key])
+			}
+			if key.QueryID == outsideTopStatementsQueryID && !statements[key].OutsideTopStatements {
+				t.Errorf("%s: expected combined entry %+v to be registered as statement", test.name, key)
+			}
+		}
+	}
+}
+
+func TestOmitQueryTextOutsideTop(t *testing.T) {
+	fingerprints := [][21]byte{{1}, {2}, {3}, {4}}
+	statements := state.PostgresStatementMap{
+		diffTestKey(1, 1):                    {Fingerprint: fingerprints[0]},
+		diffTestKey(1, 2):                    {Fingerprint: fingerprints[1]},
+		diffTestKey(2, 2):                    {Fingerprint: fingerprints[1]}, // Same query in another database
+		diffTestKey(1, 3):                    {Fingerprint: fingerprints[2]},
+		diffTestKey(1, 4):                    {Fingerprint: fingerprints[3], Collector: true},
+		diffTestKey(1, belowMinCallsQueryID): {BelowMinCalls: true},
+	}
+	statementTexts := state.PostgresStatementTextMap{
+		fingerprints[0]: "SELECT 1",
+		fingerprints[1]: "SELECT 2",
+		fingerprints[2]: "SELECT 3",
+		fingerprints[3]: "SELECT 4",
+	}
+	diff := state.DiffedPostgresStatementStatsMap{
+		diffTestKey(1, 1):                    {Calls: 1, TotalTime: 100},
+		diffTestKey(1, 2):                    {Calls: 1, TotalTime: 1},
+		diffTestKey(2, 2):                    {Calls: 1, TotalTime: 50},
+		diffTestKey(1, 3):                    {Calls: 1, TotalTime: 10},
+		diffTestKey(1, 4):                    {Calls: 1, TotalTime: 1},
+		diffTestKey(1, belowMinCallsQueryID): {Calls: 1, TotalTime: 1000},
+	}
+
+	omitQueryTextOutsideTop(diff, statements, statementTexts, 2, "total_time")
+
+	expectedOmitted := map[state.PostgresStatementKey]bool{
+		diffTestKey(1, 1): false,
+		diffTestKey(1, 2): false, // Not in the top itself, but shares the text with one that is
+		diffTestKey(2, 2): false,
+		diffTestKey(1, 3): true,
+		diffTestKey(1, 4): false,
+	}
+	for key, expected := range expectedOmitted {
+		if statements[key].QueryTextOmitted != expected {
+			t.Errorf("Expected query text of %+v to be omitted: %t", key, expected)
+		}
+	}
+	if statements[diffTestKey(1, belowMinCallsQueryID)].QueryTextOmitted {
+		t.Errorf("Expected combined entry not to be marked as omitted")
+	}
+	if _, exists := statementTexts[fingerprints[2]]; exists {
+		t.Errorf("Expected query text outside the top statements to be removed")
+	}
+	if len(statementTexts) != 3 {
+		t.Errorf("Expected 3 query texts to be kept, got %v", statementTexts)
+	}
+}
+
+func TestDiffBgwriterStats(t *testing.T) {
+	var logOutput bytes.Buffer
+	logger := &util.Logger{Destination: log.New(&logOutput, "", 0)}
+
+	prev := &state.PostgresBgwriterStats{CheckpointsTimed: 10, CheckpointsReq: 2}
+	if diffBgwriterStats(logger, prev, nil) != nil || diffBgwriterStats(logger, nil, prev) != nil {
+		t.Errorf("Expected no diff without both previous and current statistics")
+	}
+
+	diff := diffBgwriterStats(logger, &state.PostgresBgwriterStats{CheckpointsTimed: 11, CheckpointsReq: 4}, prev)
+	if diff == nil || diff.CheckpointsTimed != 1 || diff.CheckpointsReq != 2 {
+		t.Errorf("Unexpected diff: %+v", diff)
+	}
+	if !strings.Contains(logOutput.String(), "consider increasing max_wal_size") {
+		t.Errorf("Expected a warning about requested checkpoints, got %q", logOutput.String())
+	}
+
+	logOutput.Reset()
+	diffBgwriterStats(logger, &state.PostgresBgwriterStats{CheckpointsTimed: 15, CheckpointsReq: 3}, prev)
+	if logOutput.Len() != 0 {
+		t.Errorf("Expected no warning when most checkpoints were timed, got %q", logOutput.String())
+	}
+}
@@ -18,38 +18,91 @@ import (
 	"github.com/pganalyze/collector/output"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
+	"github.com/pkg/errors"
 )
 
+const collectionRetryDelay = 5 * time.Second
+
 func collectDiffAndSubmit(server state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) (state.PersistedState, error) {
 	var newState state.PersistedState
+	var transientState state.TransientState
 	var err error
 	var connection *sql.DB
 
-	connection, err = postgres.EstablishConnection(server, logger, globalCollectionOpts, "")
-	if err != nil {
-		return newState, fmt.Errorf("Failed to connect to database: %s", err)
-	}
+	err = retryTransientErrors(server.Config.CollectionRetries, collectionRetryDelay, logger, func() error {
+		connection, err = postgres.EstablishConnection(server, logger, globalCollectionOpts, "")
+		if err != nil {
+			return errors.Wrap(err, "Failed to connect to database")
+		}
 
-	newState, transientState, err := input.CollectFull(server, connection, globalCollectionOpts, logger)
-	if err != nil {
+		newState, transientState, err = input.CollectFull(server, connection, globalCollectionOpts, logger)
+
+		// This is the easiest way to avoid opening multiple connections to different databases on the same instance
 		connection.Close()
+
+		return err
+	})
+	if err != nil {
 		return newState, err
 	}
 
-	// This is the easiest way to avoid opening multiple connections to different databases on the same instance
-	connection.Close()
-
 	collectedIntervalSecs := uint32(newState.CollectedAt.Sub(server.PrevState.CollectedAt) / time.Second)
 	if collectedIntervalSecs == 0 {
 		collectedIntervalSecs = 1 // Avoid divide by zero errors for fast consecutive runs
 	}
 
-	diffState := diffState(logger, server.PrevState, newState, collectedIntervalSecs)
+	prevState := comparablePrevState(logger, server.PrevState, newState)
+	diffState := diffState(logger, prevState, newState, collectedIntervalSecs, server.Config.EnableSizeGrowth)
+
+	transientState.HistoricStatementStats = prevState.UnidentifiedStatementStats
+
+	newState.ForceFullSnapshotCounter = server.PrevState.ForceFullSnapshotCounter + 1
+	if server.Config.ForceFullSnapshotEvery > 0 && newState.ForceFullSnapshotCounter >= server.Config.ForceFullSnapshotEvery {
+		logger.PrintVerbose("Sending forced full snapshot without incremental optimizations")
+		transientState.ForcedFullSnapshot = true
+		newState.ForceFullSnapshotCounter = 0
+	}
+
+	if server.Config.QueryStatsMinCalls > 0 && !transientState.ForcedFullSnapshot {
+		minCalls := int64(server.Config.QueryStatsMinCalls)
+		diffState.StatementStats = combineStatementsBelowMinCalls(diffState.StatementStats, transientState.Statements, minCalls)
+		transientState.HistoricStatementStats = combineHistoricStatements(transientState.HistoricStatementStats, func(diffedStats state.DiffedPostgresStatementStatsMap) state.DiffedPostgresStatementStatsMap {
+			return combineStatementsBelowMinCalls(diffedStats, transientState.Statements, minCalls)
+		})
+	}
+	if server.Config.QueryStatsMaxStatements > 0 && !transientState.ForcedFullSnapshot {
+		rankBy := server.Config.GetQueryStatsRankBy()
+		diffState.StatementStats = combineStatementsOutsideTop(diffState.StatementStats, transientState.Statements, server.Config.QueryStatsMaxStatements, rankBy)
+		transientState.HistoricStatementStats = combineHistoricStatements(transientState.HistoricStatementStats, func(diffedStats state.DiffedPostgresStatementStatsMap) state.DiffedPostgresStatementStatsMap {
+			return combineStatementsOutsideTop(diffedStats, transientState.Statements, server.Config.QueryStatsMaxStatements, rankBy)
+		})
+	}
 
-	transientState.HistoricStatementStats = server.PrevState.UnidentifiedStatementStats
+	if server.Config.QueryTextMaxStatements > 0 {
+		omitQueryTextOutsideTop(diffState.StatementStats, transientState.Statements, transientState.StatementTexts, server.Config.QueryTextMaxStatements, server.Config.GetQueryStatsRankBy())
+	}
+
+	newState.SchemaHash = state.SchemaHash(newState.Relations, newState.Functions)
+	newState.SchemaSentAt = newState.CollectedAt
+	if !transientState.ForcedFullSnapshot && state.SchemaUnchanged(server.Config, server.Grant.Config.Features, prevState.SchemaHash, newState.SchemaHash, prevState.SchemaSentAt, newState.CollectedAt) {
+		transientState.SchemaUnchanged = true
+		newState.SchemaSentAt = prevState.SchemaSentAt
+	}
+
+	// Updated by output.SendFull for each additional destination that receives the schema
+	newState.DestinationSchemaSentAt = make(map[string]time.Time)
+	for _, destination := range server.Config.APIDestinations {
+		if sentAt, ok := prevState.DestinationSchemaSentAt[destination.APIBaseURL]; ok {
+			newState.DestinationSchemaSentAt[destination.APIBaseURL] = sentAt
+		}
+	}
 
 	err = output.SendFull(server, globalCollectionOpts, logger, newState, diffState, transientState, collectedIntervalSecs)
-	if err != nil {
+	if err == output.ErrSnapshotBuffered {
+		// The buffered snapshot gets submitted later on, so continue with the new state
+		// as if it was submitted (otherwise its statistics would be counted twice)
+		err = nil
+	} else if err != nil {
 		return newState, err
 	}
 
@@ -62,6 +115,41 @@ func collectDiffAndSubmit(server state.Server, globalCollectionOpts state.Collec
 	return newState, nil
 }
 
+// comparablePrevState - The previous state that the new state can be diffed against,
+// which is empty if the server changed between primary and standby since the previous
+// run (this includes the statement statistics collected in between full snapshots)
+func comparablePrevState(logger *util.Logger, prevState state.PersistedState, newState state.PersistedState) state.PersistedState {
+	if prevState.CollectedAt.IsZero() || prevState.InRecovery == newState.InRecovery {
+		return prevState
+	}
+
+	if newState.InRecovery {
+		logger.PrintInfo("Server is now a standby (was a primary on the previous run), most likely due to a failover")
+	} else {
+		logger.PrintInfo("Server is now a primary (was a standby on the previous run), most likely due to a promotion or failover")
+	}
+	// Statistics counters are not comparable when we're now connected to a different server,
+	// so treat this like the first run instead of sending incorrect differences
+	return state.PersistedState{}
+}
+
+// retryTransientErrors - Runs collect until it succeeds, fails with an error that
+// is not transient (see postgres.IsTransientError), or ran out of retries
+func retryTransientErrors(retries int, delay time.Duration, logger *util.Logger, collect func() error) error {
+	errorMessageCount := len(logger.ErrorMessages)
+	for attempt := 0; ; attempt++ {
+		err := collect()
+		if err == nil || attempt >= retries || !postgres.IsTransientError(err) {
+			return err
+		}
+		logger.PrintWarning("Collection failed with a transient database error, retrying in %s: %s", delay, err)
+		time.Sleep(delay)
+
+		// Errors of the failed attempt shouldn't be reported with the snapshot
+		logger.ErrorMessages = logger.ErrorMessages[:errorMessageCount]
+	}
+}
+
 func capturePanic(f func()) (err interface{}, stackTrace []byte) {
 	defer func() {
 		if err = recover(); err != nil {
@@ -85,6 +173,9 @@ func processDatabase(server state.Server, globalCollectionOpts state.CollectionO
 		if err != nil {
 			if server.Grant.Valid {
 				logger.PrintVerbose("Could not acquire snapshot grant, reusing previous grant: %s", err)
+			} else if server.Config.SnapshotBufferDir != "" && globalCollectionOpts.SubmitCollectedData && !globalCollectionOpts.TestRun {
+				// Most likely the API is unreachable, collect anyway so the snapshot ends up in the buffer
+				logger.PrintWarning("Could not acquire snapshot grant, buffering snapshot for later submission: %s", err)
 			} else {
 				return state.PersistedState{}, state.Grant{}, err
 			}
@@ -124,13 +215,21 @@ func processDatabase(server state.Server, globalCollectionOpts state.CollectionO
 	return newState, newGrant, err
 }
 
+// Servers on different full snapshot schedules write the state file independently,
+// so keep the latest state of every server around to not lose the others' states
+var stateFileMutex sync.Mutex
+var stateFileStates = make(map[config.ServerIdentifier]state.PersistedState)
+
 func writeStateFile(servers []state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
-	stateOnDisk := state.StateOnDisk{PrevStateByServer: make(map[config.ServerIdentifier]state.PersistedState), FormatVersion: state.StateOnDiskFormatVersion}
+	stateFileMutex.Lock()
+	defer stateFileMutex.Unlock()
 
 	for _, server := range servers {
-		stateOnDisk.PrevStateByServer[server.Config.Identifier] = server.PrevState
+		stateFileStates[server.Config.Identifier] = server.PrevState
 	}
 
+	stateOnDisk := state.StateOnDisk{PrevStateByServer: stateFileStates, FormatVersion: state.StateOnDiskFormatVersion}
+
 	file, err := os.Create(globalCollectionOpts.StateFilename)
 	if err != nil {
 		logger.PrintWarning("Could not write out state file to %s because of error: %s", globalCollectionOpts.StateFilename, err)
@@ -146,6 +245,10 @@ func writeStateFile(servers []state.Server, globalCollectionOpts state.Collectio
 func ReadStateFile(servers []state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
 	var stateOnDisk state.StateOnDisk
 
+	stateFileMutex.Lock()
+	defer stateFileMutex.Unlock()
+	stateFileStates = make(map[config.ServerIdentifier]state.PersistedState)
+
 	file, err := os.Open(globalCollectionOpts.StateFilename)
 	if err != nil {
 		logger.PrintVerbose("Did not open state file: %s", err)
@@ -167,6 +270,10 @@ func ReadStateFile(servers []state.Server, globalCollectionOpts state.Collection
 	for idx, server := range servers {
 		prevState, exist := stateOnDisk.PrevStateByServer[server.Config.Identifier]
 		if exist {
+			if prevState.ExplainSampling == nil {
+				prevState.ExplainSampling = server.PrevState.ExplainSampling
+			}
+			stateFileStates[server.Config.Identifier] = prevState
 			prefixedLogger := logger.WithPrefix(server.Config.SectionName)
 			prefixedLogger.PrintVerbose("Successfully recovered state from on-disk file")
 			servers[idx].PrevState = prevState
@@ -190,11 +297,21 @@ func runCompletionCallback(callbackType string, callbackCmd string, sectionName
 
 // CollectAllServers - Collects statistics from all servers and sends them as full snapshots to the pganalyze service
 func CollectAllServers(servers []state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) (allSuccessful bool) {
+	indices := make([]int, len(servers))
+	for idx := range servers {
+		indices[idx] = idx
+	}
+	return CollectServers(servers, indices, globalCollectionOpts, logger)
+}
+
+// CollectServers - Collects statistics from the servers at the given indices, and sends them as full snapshots to the pganalyze service
+func CollectServers(servers []state.Server, indices []int, globalCollectionOpts state.CollectionOpts, logger *util.Logger) (allSuccessful bool) {
 	var wg sync.WaitGroup
+	var selectedServers []state.Server
 
 	allSuccessful = true
 
-	for idx := range servers {
+	for _, idx := range indices {
 		wg.Add(1)
 		go func(server *state.Server) {
 			var err error
@@ -211,6 +328,7 @@ func CollectAllServers(servers []state.Server, globalCollectionOpts state.Collec
 				server.StateMutex.Unlock()
 				allSuccessful = false
 				prefixedLogger.PrintError("Could not process server: %s", err)
+				globalCollectionOpts.ActivityHistory.AddFailure(server.Config.SectionName, "full", err)
 				if grant.Valid && !globalCollectionOpts.TestRun && globalCollectionOpts.SubmitCollectedData {
 					server.Grant = grant
 					err = output.SendFailedFull(*server, globalCollectionOpts, prefixedLogger)
@@ -236,7 +354,10 @@ func CollectAllServers(servers []state.Server, globalCollectionOpts state.Collec
 	wg.Wait()
 
 	if globalCollectionOpts.WriteStateUpdate {
-		writeStateFile(servers, globalCollectionOpts, logger)
+		for _, idx := range indices {
+			selectedServers = append(selectedServers, servers[idx])
+		}
+		writeStateFile(selectedServers, globalCollectionOpts, logger)
 	}
 
 	return
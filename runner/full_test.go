@@ -0,0 +1,94 @@
+package runner
+
+import (
+	"io/ioutil"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+	"github.com/pkg/errors"
+)
+
+var retryTransientErrorsTests = []struct {
+	name             string
+	retries          int
+	errs             []error
+	expectedAttempts int
+	expectedErr      error
+}{
+	{"success", 2, []error{nil}, 1, nil},
+	{"transient error, then success", 2, []error{&pq.Error{Code: "40001"}, nil}, 2, nil},
+	{"wrapped transient error, then success", 2, []error{errors.Wrap(&pq.Error{Code: "57P01"}, "Error collecting pg_stat_statements"), nil}, 2, nil},
+	{"non-transient error", 2, []error{&pq.Error{Code: "42501"}}, 1, &pq.Error{Code: "42501"}},
+	{"out of retries", 1, []error{&pq.Error{Code: "40001"}, &pq.Error{Code: "40P01"}}, 2, &pq.Error{Code: "40P01"}},
+	{"retries disabled", 0, []error{&pq.Error{Code: "40001"}}, 1, &pq.Error{Code: "40001"}},
+}
+
+func TestRetryTransientErrors(t *testing.T) {
+	for _, test := range retryTransientErrorsTests {
+		logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0), RememberErrors: true}
+		logger.PrintError("Error before collecting")
+
+		attempts := 0
+		err := retryTransientErrors(test.retries, 0, logger, func() error {
+			err := test.errs[attempts]
+			attempts++
+			logger.PrintError("Error during attempt %d", attempts)
+			return err
+		})
+
+		if attempts != test.expectedAttempts {
+			t.Errorf("%s: expected %d attempts, got %d", test.name, test.expectedAttempts, attempts)
+		}
+		if (err == nil) != (test.expectedErr == nil) || (err != nil && err.Error() != test.expectedErr.Error()) {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.expectedErr, err)
+		}
+		// Only the errors of the successful (or last) attempt are kept
+		if len(logger.ErrorMessages) != 2 {
+			t.Errorf("%s: expected 2 error messages, got %v", test.name, logger.ErrorMessages)
+		}
+	}
+}
+
+var comparablePrevStateTests = []struct {
+	name            string
+	prevCollected   bool
+	prevInRecovery  bool
+	newInRecovery   bool
+	expectPrevState bool
+}{
+	{"no full snapshot yet", false, false, true, true},
+	{"still a primary", true, false, false, true},
+	{"still a standby", true, true, true, true},
+	{"failover to standby", true, false, true, false},
+	{"promotion to primary", true, true, false, false},
+}
+
+func TestComparablePrevState(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+	timeKey := state.PostgresStatementStatsTimeKey{CollectedAt: time.Now().Add(-time.Minute), CollectedIntervalSecs: 60}
+
+	for _, test := range comparablePrevStateTests {
+		prevState := state.PersistedState{
+			InRecovery:                 test.prevInRecovery,
+			StatementStats:             state.PostgresStatementStatsMap{{QueryID: 1}: {Calls: 10}},
+			UnidentifiedStatementStats: state.HistoricStatementStatsMap{timeKey: {{QueryID: 1}: {Calls: 5}}},
+		}
+		if test.prevCollected {
+			prevState.CollectedAt = time.Now().Add(-10 * time.Minute)
+		}
+		newState := state.PersistedState{CollectedAt: time.Now(), InRecovery: test.newInRecovery}
+
+		actual := comparablePrevState(logger, prevState, newState)
+		hasHistoric := len(actual.UnidentifiedStatementStats) > 0
+		if hasHistoric != test.expectPrevState {
+			t.Errorf("%s: expected historic statement stats to be sent: %t, got %v", test.name, test.expectPrevState, actual.UnidentifiedStatementStats)
+		}
+		if (len(actual.StatementStats) > 0) != test.expectPrevState {
+			t.Errorf("%s: expected statement stats to be diffed against the previous run: %t", test.name, test.expectPrevState)
+		}
+	}
+}
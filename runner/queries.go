@@ -35,10 +35,15 @@ func gatherQueryStatsForServer(server state.Server, globalCollectionOpts state.C
 		return newState, nil
 	}
 
+	statementSource, err := postgres.GetStatementSource(server.Config.StatementSource)
+	if err != nil {
+		return newState, err
+	}
+
 	newState.LastStatementStatsAt = time.Now()
-	_, _, newState.StatementStats, err = postgres.GetStatements(logger, connection, globalCollectionOpts, postgresVersion, false, systemType)
+	_, _, newState.StatementStats, newState.StatementSourceCursor, err = statementSource.GetStatements(logger, connection, globalCollectionOpts, postgresVersion, false, systemType, server.PrevState)
 	if err != nil {
-		return newState, errors.Wrap(err, "error collecting pg_stat_statements")
+		return newState, errors.Wrap(err, "error collecting "+statementSource.Name())
 	}
 
 	// Don't calculate any diffs on the first run (but still update the state)
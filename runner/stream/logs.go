@@ -28,7 +28,7 @@ func ProcessLogs(server state.Server, logLines []state.LogLine, globalCollection
 	if server.Config.EnableLogExplain {
 		db, err := postgres.EstablishConnection(server, prefixedLogger, globalCollectionOpts, "")
 		if err == nil {
-			logState.QuerySamples = postgres.RunExplain(db, server.Config.GetDbName(), logState.QuerySamples)
+			logState.QuerySamples = postgres.RunExplain(db, server, globalCollectionOpts, logState.QuerySamples)
 			db.Close()
 		}
 	}
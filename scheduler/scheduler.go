@@ -9,9 +9,41 @@ import (
 
 type Group struct {
 	interval *cronexpr.Expression
+
+	// Set when the cron expression is overridden with a fixed interval (for testing/debugging)
+	fixedInterval time.Duration
+}
+
+// FixedIntervalGroup - Returns a group that runs every given interval, instead
+// of following a cron expression
+func FixedIntervalGroup(interval time.Duration) Group {
+	return Group{fixedInterval: interval}
+}
+
+func (group Group) scheduleFixed(runner func(), logger *util.Logger, logName string) chan bool {
+	stop := make(chan bool)
+	go func() {
+		ticker := time.NewTicker(group.fixedInterval)
+		defer ticker.Stop()
+		for {
+			logger.PrintVerbose("Scheduled next run for %s in %+v (fixed interval)", logName, group.fixedInterval)
+
+			select {
+			case <-ticker.C:
+				runner()
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return stop
 }
 
 func (group Group) Schedule(runner func(), logger *util.Logger, logName string) chan bool {
+	if group.fixedInterval != 0 {
+		return group.scheduleFixed(runner, logger, logName)
+	}
+
 	stop := make(chan bool)
 	go func() {
 		for {
@@ -35,6 +67,15 @@ func (group Group) Schedule(runner func(), logger *util.Logger, logName string)
 // ScheduleSecondary - Behaves almost like Schedule, but ignores the point in time
 // where the primary group also has a run (to avoid overlapping statistics)
 func (group Group) ScheduleSecondary(runner func(), logger *util.Logger, logName string, primaryGroup Group) chan bool {
+	if group.fixedInterval != 0 {
+		return group.scheduleFixed(runner, logger, logName)
+	}
+
+	// There is no predictable overlap with a primary group on a fixed interval
+	if primaryGroup.fixedInterval != 0 {
+		return group.Schedule(runner, logger, logName)
+	}
+
 	stop := make(chan bool)
 	go func() {
 		for {
@@ -66,6 +107,16 @@ func (group Group) ScheduleSecondary(runner func(), logger *util.Logger, logName
 	return stop
 }
 
+// ParseGroup - Returns a group that runs according to the given cron expression
+// (with a leading seconds field, e.g. "0 */5 * * * * *" for every 5 minutes)
+func ParseGroup(expr string) (Group, error) {
+	interval, err := cronexpr.Parse(expr)
+	if err != nil {
+		return Group{}, err
+	}
+	return Group{interval: interval}, nil
+}
+
 func GetSchedulerGroups() (groups map[string]Group, err error) {
 	tenSecondInterval, err := cronexpr.Parse("*/10 * * * * * *")
 	if err != nil {
@@ -1,8 +1,12 @@
 package scheduler
 
 import (
+	"io/ioutil"
+	"log"
 	"testing"
 	"time"
+
+	"github.com/pganalyze/collector/util"
 )
 
 func TestScheduler(t *testing.T) {
@@ -19,3 +23,41 @@ func TestScheduler(t *testing.T) {
 		t.Errorf("\nNext run:\n\texpected %s\n\tactual %s\n\n", expectedNextRun, actualNextRun)
 	}
 }
+
+func TestSchedulerFixedInterval(t *testing.T) {
+	group := FixedIntervalGroup(10 * time.Millisecond)
+	logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+
+	runs := make(chan bool, 10)
+	stop := group.Schedule(func() { runs <- true }, logger, "test")
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-runs:
+		case <-time.After(time.Second):
+			t.Fatalf("Fixed interval group did not run within one second")
+		}
+	}
+
+	stop <- true
+}
+
+func TestParseGroup(t *testing.T) {
+	group, err := ParseGroup("0 */5 * * * * *")
+	if err != nil {
+		t.Fatalf("Error: %v\n", err)
+	}
+
+	someTime := time.Date(2013, 1, 1, 0, 6, 0, 0, time.UTC)
+	expectedNextRun := time.Date(2013, 1, 1, 0, 10, 0, 0, time.UTC)
+	actualNextRun := group.interval.Next(someTime)
+
+	if expectedNextRun != actualNextRun {
+		t.Errorf("\nNext run:\n\texpected %s\n\tactual %s\n\n", expectedNextRun, actualNextRun)
+	}
+
+	_, err = ParseGroup("not a cron expression")
+	if err == nil {
+		t.Errorf("Expected error for invalid cron expression")
+	}
+}
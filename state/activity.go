@@ -8,5 +8,11 @@ type ActivityState struct {
 	Version  PostgresVersion
 	Backends []PostgresBackend
 
+	// Number of backends left out of Backends by type (see activity_backend_types)
+	ExcludedBackendCounts map[string]int32
+
 	Vacuums []PostgresVacuumProgress
+
+	// Other long-running operations, e.g. CREATE INDEX (Postgres 12+)
+	Operations []PostgresOperationProgress
 }
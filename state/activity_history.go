@@ -0,0 +1,146 @@
+package state
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// ActivityHistory - Rolling in-memory buffer of recent activity snapshots (and
+// collection failures) for each server, kept locally to inspect what a database
+// was doing leading up to an incident
+//
+// Entries are stored JSON-encoded, so the memory used is bounded by the encoded
+// size - when either the age or size limit is exceeded the oldest entries are dropped.
+type ActivityHistory struct {
+	mutex      sync.Mutex
+	maxAge     time.Duration
+	maxBytes   int
+	entries    []activityHistoryEntry
+	totalBytes int
+}
+
+type activityHistoryEntry struct {
+	serverName  string
+	collectedAt time.Time
+	data        []byte
+}
+
+// ActivityHistoryEntry - A single activity snapshot (or failed collection) as
+// returned when dumping the history
+type ActivityHistoryEntry struct {
+	Server      string         `json:"server"`
+	Kind        string         `json:"kind"` // "activity" or "full"
+	CollectedAt time.Time      `json:"collected_at"`
+	Error       string         `json:"error,omitempty"`
+	Activity    *ActivityState `json:"activity,omitempty"`
+}
+
+// SetLimits - Changes how long entries are retained, and how many bytes all
+// entries may use in total, dropping entries as needed (disabled when 0)
+func (h *ActivityHistory) SetLimits(maxAge time.Duration, maxBytes int) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.maxAge = maxAge
+	h.maxBytes = maxBytes
+	h.trim(time.Now())
+}
+
+// Enabled - Whether the history retains any entries
+func (h *ActivityHistory) Enabled() bool {
+	if h == nil {
+		return false
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	return h.maxAge > 0 && h.maxBytes > 0
+}
+
+// AddActivity - Remembers an activity snapshot collected for the given server
+func (h *ActivityHistory) AddActivity(serverName string, activity ActivityState) {
+	h.add(ActivityHistoryEntry{Server: serverName, Kind: "activity", CollectedAt: activity.CollectedAt, Activity: &activity})
+}
+
+// AddFailure - Remembers that a collection ("activity" or "full") failed for the given server
+func (h *ActivityHistory) AddFailure(serverName string, kind string, collectionErr error) {
+	h.add(ActivityHistoryEntry{Server: serverName, Kind: kind, CollectedAt: time.Now(), Error: collectionErr.Error()})
+}
+
+func (h *ActivityHistory) add(entry ActivityHistoryEntry) {
+	if !h.Enabled() {
+		return
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	// A single entry that doesn't fit at all would otherwise evict everything else
+	if len(data) > h.maxBytes {
+		return
+	}
+
+	h.entries = append(h.entries, activityHistoryEntry{serverName: entry.Server, collectedAt: entry.CollectedAt, data: data})
+	h.totalBytes += len(data)
+	h.trim(time.Now())
+}
+
+// trim - Drops the oldest entries until the limits are met (the caller holds the mutex)
+func (h *ActivityHistory) trim(now time.Time) {
+	drop := 0
+	for drop < len(h.entries) {
+		entry := h.entries[drop]
+		if h.totalBytes <= h.maxBytes && now.Sub(entry.collectedAt) <= h.maxAge {
+			break
+		}
+		h.totalBytes -= len(entry.data)
+		drop++
+	}
+	if drop == 0 {
+		return
+	}
+
+	// Copy to a new slice so the dropped entries' memory is actually released
+	h.entries = append([]activityHistoryEntry(nil), h.entries[drop:]...)
+}
+
+// WriteJSON - Writes all retained entries (optionally only those of one server)
+// as a JSON array, oldest first
+func (h *ActivityHistory) WriteJSON(w io.Writer, serverName string) error {
+	h.mutex.Lock()
+	h.trim(time.Now())
+	entries := h.entries
+	h.mutex.Unlock()
+
+	_, err := io.WriteString(w, "[")
+	if err != nil {
+		return err
+	}
+	first := true
+	for _, entry := range entries {
+		if serverName != "" && entry.serverName != serverName {
+			continue
+		}
+		if !first {
+			_, err = io.WriteString(w, ",\n")
+			if err != nil {
+				return err
+			}
+		}
+		first = false
+		_, err = w.Write(entry.data)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
@@ -0,0 +1,39 @@
+package state
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// CollectionSectionStatus - Outcome of collecting one section of a full snapshot
+// (e.g. pg_stat_statements, or the schema of a particular database)
+type CollectionSectionStatus struct {
+	Ok       bool
+	Error    string
+	Duration time.Duration
+
+	// Whether the section was canceled by statement_timeout
+	TimedOut bool
+}
+
+// CollectionSectionStatusMap - Collection outcome by section name
+type CollectionSectionStatusMap map[string]CollectionSectionStatus
+
+// Record - Remembers the outcome of a section that started collecting at the given time
+func (m CollectionSectionStatusMap) Record(name string, start time.Time, err error) {
+	status := CollectionSectionStatus{Ok: err == nil, Duration: time.Since(start)}
+	if err != nil {
+		status.Error = err.Error()
+		status.TimedOut = isStatementTimeout(err)
+	}
+	m[name] = status
+}
+
+// Needs the pq.Error as returned by the driver, either directly or wrapped with
+// errors.Wrap (fmt.Errorf loses the underlying error)
+func isStatementTimeout(err error) bool {
+	pqErr, ok := errors.Cause(err).(*pq.Error)
+	return ok && pqErr.Code == "57014" // query_canceled
+}
@@ -0,0 +1,74 @@
+package state
+
+import (
+	"bytes"
+	"encoding/gob"
+	"sync"
+	"time"
+)
+
+// ExplainSampling - Remembers when EXPLAIN last succeeded for each query fingerprint
+// of a server, so each query is only explained once per explain_sample_interval
+//
+// This is part of the persisted state, but shared (by pointer) between runs, since
+// queries get explained by log processing that runs in between full snapshots.
+type ExplainSampling struct {
+	mutex           sync.Mutex
+	lastExplainedAt map[[21]byte]time.Time
+}
+
+// Allow - Whether the query with the given fingerprint should be explained now,
+// i.e. it wasn't explained successfully within the interval
+func (e *ExplainSampling) Allow(fingerprint [21]byte, interval time.Duration, now time.Time) bool {
+	if e == nil {
+		return true
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	lastExplainedAt, exists := e.lastExplainedAt[fingerprint]
+	return !exists || now.Sub(lastExplainedAt) >= interval
+}
+
+// Record - Remembers that the query with the given fingerprint was explained
+func (e *ExplainSampling) Record(fingerprint [21]byte, interval time.Duration, now time.Time) {
+	if e == nil {
+		return
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.lastExplainedAt == nil {
+		e.lastExplainedAt = make(map[[21]byte]time.Time)
+	}
+	e.lastExplainedAt[fingerprint] = now
+
+	// Forget queries that would be explained again anyway, to not grow without bounds
+	for k, lastExplainedAt := range e.lastExplainedAt {
+		if now.Sub(lastExplainedAt) >= interval {
+			delete(e.lastExplainedAt, k)
+		}
+	}
+}
+
+// GobEncode - Encodes the sampling state for the state file (while holding the lock,
+// since log processing may record queries at the same time)
+func (e *ExplainSampling) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	err := gob.NewEncoder(&buf).Encode(e.lastExplainedAt)
+	return buf.Bytes(), err
+}
+
+// GobDecode - Decodes the sampling state from the state file
+func (e *ExplainSampling) GobDecode(data []byte) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(&e.lastExplainedAt)
+}
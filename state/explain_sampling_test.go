@@ -0,0 +1,69 @@
+package state_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/state"
+)
+
+func TestExplainSampling(t *testing.T) {
+	now := time.Now()
+	interval := time.Hour
+	fp1 := [21]byte{1}
+	fp2 := [21]byte{2}
+
+	var nilSampling *state.ExplainSampling
+	if !nilSampling.Allow(fp1, interval, now) {
+		t.Errorf("Expected queries to be explained without sampling state")
+	}
+
+	sampling := &state.ExplainSampling{}
+	if !sampling.Allow(fp1, interval, now) {
+		t.Errorf("Expected query seen for the first time to be explained")
+	}
+	if !sampling.Allow(fp1, interval, now) {
+		t.Errorf("Expected query to be explained again when the previous EXPLAIN was not recorded (e.g. because it failed)")
+	}
+
+	sampling.Record(fp1, interval, now)
+	if sampling.Allow(fp1, interval, now.Add(30*time.Minute)) {
+		t.Errorf("Expected query not to be explained again within the interval")
+	}
+	if !sampling.Allow(fp2, interval, now.Add(30*time.Minute)) {
+		t.Errorf("Expected other query to be explained")
+	}
+	if !sampling.Allow(fp1, interval, now.Add(interval)) {
+		t.Errorf("Expected query to be explained again after the interval")
+	}
+}
+
+func TestExplainSamplingPersisted(t *testing.T) {
+	now := time.Now()
+	interval := time.Hour
+	fp := [21]byte{1}
+
+	for _, recorded := range []bool{false, true} {
+		prevState := state.PersistedState{ExplainSampling: &state.ExplainSampling{}}
+		if recorded {
+			prevState.ExplainSampling.Record(fp, interval, now)
+		}
+
+		var buf bytes.Buffer
+		err := gob.NewEncoder(&buf).Encode(prevState)
+		if err != nil {
+			t.Fatalf("Could not encode state: %s", err)
+		}
+		var decoded state.PersistedState
+		err = gob.NewDecoder(&buf).Decode(&decoded)
+		if err != nil {
+			t.Fatalf("Could not decode state: %s", err)
+		}
+
+		if decoded.ExplainSampling.Allow(fp, interval, now.Add(time.Minute)) == recorded {
+			t.Errorf("Expected EXPLAIN of query to be recorded: %t, after reading back the state", recorded)
+		}
+	}
+}
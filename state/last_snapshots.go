@@ -0,0 +1,74 @@
+package state
+
+import (
+	"sort"
+	"sync"
+)
+
+// LastSnapshots - The most recently submitted full snapshot of each server (as
+// pretty-printed JSON), kept to show exactly what the collector sent, after all
+// redactions, truncations and filters were applied
+type LastSnapshots struct {
+	mutex     sync.Mutex
+	enabled   bool
+	snapshots map[string][]byte
+}
+
+// SetEnabled - Changes whether snapshots are retained (forgets all of them when disabled)
+func (l *LastSnapshots) SetEnabled(enabled bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.enabled = enabled
+	if !enabled {
+		l.snapshots = nil
+	}
+}
+
+// Enabled - Whether snapshots are retained
+func (l *LastSnapshots) Enabled() bool {
+	if l == nil {
+		return false
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.enabled
+}
+
+// Set - Remembers the snapshot submitted for the given server, replacing the previous one
+func (l *LastSnapshots) Set(serverName string, data []byte) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.enabled {
+		return
+	}
+	if l.snapshots == nil {
+		l.snapshots = make(map[string][]byte)
+	}
+	l.snapshots[serverName] = data
+}
+
+// Get - Returns the last snapshot submitted for the given server
+func (l *LastSnapshots) Get(serverName string) (data []byte, exists bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	data, exists = l.snapshots[serverName]
+	return
+}
+
+// ServerNames - Returns the (sorted) names of all servers that have a snapshot
+func (l *LastSnapshots) ServerNames() []string {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	var names []string
+	for name := range l.snapshots {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
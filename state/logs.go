@@ -27,6 +27,9 @@ type LogState struct {
 
 	LogFiles     []LogFile
 	QuerySamples []PostgresQuerySample
+
+	// Extracted from the log lines of LogFiles when sending the logs
+	LockEvents []PostgresLockEvent
 }
 
 // LogFile - Log file that we are uploading for reference in log line metadata
@@ -125,6 +128,10 @@ type LogLine struct {
 	ByteContentStart int64
 	ByteEnd          int64
 
+	// Where the content was cut off when truncating overly long lines (0 = not
+	// truncated) - the remainder up to ByteEnd was not analyzed
+	ByteTruncatedStart int64
+
 	OccurredAt  time.Time
 	Username    string
 	Database    string
@@ -0,0 +1,39 @@
+package state
+
+import "github.com/guregu/null"
+
+// PostgresAutovacuumSettings - Settings that determine when autovacuum processes a table
+type PostgresAutovacuumSettings struct {
+	Enabled            bool
+	VacuumThreshold    int64
+	VacuumScaleFactor  float64
+	AnalyzeThreshold   int64
+	AnalyzeScaleFactor float64
+}
+
+// PostgresAutovacuumForecast - When autovacuum will next VACUUM (or ANALYZE) each
+// table, based on the effective settings and the current statistics
+type PostgresAutovacuumForecast struct {
+	Settings PostgresAutovacuumSettings // Server-wide settings (from postgresql.conf)
+
+	Relations []PostgresRelationAutovacuumForecast
+}
+
+type PostgresRelationAutovacuumForecast struct {
+	DatabaseOid Oid
+	RelationOid Oid
+
+	// Server-wide settings overlayed with the table's storage parameters, and
+	// whether any storage parameters were set
+	Settings   PostgresAutovacuumSettings
+	Overridden bool
+
+	// Autovacuum runs VACUUM once the dead rows exceed the trigger (threshold
+	// plus scale factor times the estimated row count)
+	DeadTuples              int64
+	VacuumTriggerDeadTuples int64
+
+	// Autovacuum runs ANALYZE once the rows modified exceed the trigger (9.4+)
+	ModsSinceAnalyze   null.Int
+	AnalyzeTriggerMods int64
+}
@@ -31,6 +31,9 @@ type PostgresBackend struct {
 
 	Query null.String // Text of this backend's most recent query
 
+	// Whether Query was cut off by Postgres since it exceeded track_activity_query_size
+	QueryTruncated bool
+
 	// Current overall state of this backend. Possible values are:
 	// - active: The backend is executing a query.
 	// - idle: The backend is waiting for a new client command.
@@ -0,0 +1,87 @@
+package state
+
+import (
+	"time"
+
+	"github.com/guregu/null"
+)
+
+// PostgresBgwriterStats - Activity of the background writer and checkpointer processes
+//
+// See https://www.postgresql.org/docs/current/monitoring-stats.html#MONITORING-PG-STAT-BGWRITER-VIEW
+type PostgresBgwriterStats struct {
+	CheckpointsTimed    int64     // Number of scheduled checkpoints (checkpoint_timeout was reached)
+	CheckpointsReq      int64     // Number of requested checkpoints (e.g. max_wal_size was reached, or CHECKPOINT was run)
+	CheckpointWriteTime float64   // Time spent writing files to disk during checkpoints, in milliseconds
+	CheckpointSyncTime  float64   // Time spent synchronizing files to disk during checkpoints, in milliseconds
+	BuffersCheckpoint   int64     // Number of buffers written during checkpoints
+	BuffersClean        int64     // Number of buffers written by the background writer
+	MaxwrittenClean     int64     // Number of times the background writer stopped because it had written too many buffers
+	BuffersBackend      int64     // Number of buffers written directly by a backend
+	BuffersBackendFsync int64     // Number of times a backend had to execute its own fsync call
+	BuffersAlloc        int64     // Number of buffers allocated
+	StatsReset          time.Time // Time at which these statistics were last reset
+}
+
+type DiffedPostgresBgwriterStats struct {
+	CheckpointsTimed    int64
+	CheckpointsReq      int64
+	CheckpointWriteTime float64
+	CheckpointSyncTime  float64
+	BuffersCheckpoint   int64
+	BuffersClean        int64
+	MaxwrittenClean     int64
+	BuffersBackend      int64
+	BuffersBackendFsync int64
+	BuffersAlloc        int64
+}
+
+func (curr PostgresBgwriterStats) DiffSince(prev PostgresBgwriterStats) DiffedPostgresBgwriterStats {
+	// The counters start over when the statistics get reset (pg_stat_reset_shared)
+	if !curr.StatsReset.Equal(prev.StatsReset) {
+		prev = PostgresBgwriterStats{}
+	}
+
+	return DiffedPostgresBgwriterStats{
+		CheckpointsTimed:    curr.CheckpointsTimed - prev.CheckpointsTimed,
+		CheckpointsReq:      curr.CheckpointsReq - prev.CheckpointsReq,
+		CheckpointWriteTime: curr.CheckpointWriteTime - prev.CheckpointWriteTime,
+		CheckpointSyncTime:  curr.CheckpointSyncTime - prev.CheckpointSyncTime,
+		BuffersCheckpoint:   curr.BuffersCheckpoint - prev.BuffersCheckpoint,
+		BuffersClean:        curr.BuffersClean - prev.BuffersClean,
+		MaxwrittenClean:     curr.MaxwrittenClean - prev.MaxwrittenClean,
+		BuffersBackend:      curr.BuffersBackend - prev.BuffersBackend,
+		BuffersBackendFsync: curr.BuffersBackendFsync - prev.BuffersBackendFsync,
+		BuffersAlloc:        curr.BuffersAlloc - prev.BuffersAlloc,
+	}
+}
+
+// minRequestedCheckpointsForWarning - Avoids flagging a single manual CHECKPOINT
+// (or one run by pg_basebackup) in an otherwise quiet interval
+const minRequestedCheckpointsForWarning = 2
+
+// RequestedCheckpointsRatio - Share of the checkpoints in the interval that were
+// requested instead of being started by checkpoint_timeout, or null if there were none
+func (s DiffedPostgresBgwriterStats) RequestedCheckpointsRatio() null.Float {
+	total := s.CheckpointsTimed + s.CheckpointsReq
+	if total <= 0 {
+		return null.Float{}
+	}
+	return null.FloatFrom(float64(s.CheckpointsReq) / float64(total))
+}
+
+// AvgCheckpointWriteTime - Average time (in milliseconds) spent writing files
+// to disk for each checkpoint in the interval, or null if there were none
+func (s DiffedPostgresBgwriterStats) AvgCheckpointWriteTime() null.Float {
+	total := s.CheckpointsTimed + s.CheckpointsReq
+	if total <= 0 {
+		return null.Float{}
+	}
+	return null.FloatFrom(s.CheckpointWriteTime / float64(total))
+}
+
+// FrequentRequestedCheckpoints - Whether requested checkpoints outnumbered the
+// scheduled ones in the interval, which usually means max_wal_size is too small
+func (s DiffedPostgresBgwriterStats) FrequentRequestedCheckpoints() bool {
+	return s.CheckpointsReq >= minRequestedCheckpointsForWarning && s.CheckpointsReq > s.CheckpointsTimed
+}
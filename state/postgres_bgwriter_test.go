@@ -0,0 +1,66 @@
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+)
+
+var bgwriterDiffSinceTests = []struct {
+	name     string
+	prev     state.PostgresBgwriterStats
+	curr     state.PostgresBgwriterStats
+	expected state.DiffedPostgresBgwriterStats
+}{
+	{
+		"since previous",
+		state.PostgresBgwriterStats{CheckpointsTimed: 10, CheckpointsReq: 2, CheckpointWriteTime: 1000, BuffersCheckpoint: 500, BuffersAlloc: 100},
+		state.PostgresBgwriterStats{CheckpointsTimed: 12, CheckpointsReq: 5, CheckpointWriteTime: 1500, BuffersCheckpoint: 800, BuffersAlloc: 150},
+		state.DiffedPostgresBgwriterStats{CheckpointsTimed: 2, CheckpointsReq: 3, CheckpointWriteTime: 500, BuffersCheckpoint: 300, BuffersAlloc: 50},
+	},
+	{
+		"after stats reset",
+		state.PostgresBgwriterStats{CheckpointsTimed: 10, CheckpointsReq: 2, BuffersAlloc: 100},
+		state.PostgresBgwriterStats{CheckpointsTimed: 1, CheckpointsReq: 1, BuffersAlloc: 20, StatsReset: time.Unix(1600000000, 0)},
+		state.DiffedPostgresBgwriterStats{CheckpointsTimed: 1, CheckpointsReq: 1, BuffersAlloc: 20},
+	},
+}
+
+func TestBgwriterDiffSince(t *testing.T) {
+	for _, test := range bgwriterDiffSinceTests {
+		actual := test.curr.DiffSince(test.prev)
+		if actual != test.expected {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.expected, actual)
+		}
+	}
+}
+
+var bgwriterCheckpointTests = []struct {
+	name             string
+	diff             state.DiffedPostgresBgwriterStats
+	expectedRatio    null.Float
+	expectedAvgWrite null.Float
+	expectedFrequent bool
+}{
+	{"no checkpoints", state.DiffedPostgresBgwriterStats{}, null.Float{}, null.Float{}, false},
+	{"only timed", state.DiffedPostgresBgwriterStats{CheckpointsTimed: 4, CheckpointWriteTime: 400}, null.FloatFrom(0), null.FloatFrom(100), false},
+	{"single manual checkpoint", state.DiffedPostgresBgwriterStats{CheckpointsReq: 1}, null.FloatFrom(1), null.FloatFrom(0), false},
+	{"requested outnumber timed", state.DiffedPostgresBgwriterStats{CheckpointsTimed: 1, CheckpointsReq: 3, CheckpointWriteTime: 200}, null.FloatFrom(0.75), null.FloatFrom(50), true},
+	{"as many requested as timed", state.DiffedPostgresBgwriterStats{CheckpointsTimed: 2, CheckpointsReq: 2}, null.FloatFrom(0.5), null.FloatFrom(0), false},
+}
+
+func TestBgwriterCheckpoints(t *testing.T) {
+	for _, test := range bgwriterCheckpointTests {
+		if actual := test.diff.RequestedCheckpointsRatio(); actual != test.expectedRatio {
+			t.Errorf("%s: expected requested checkpoints ratio %v, got %v", test.name, test.expectedRatio, actual)
+		}
+		if actual := test.diff.AvgCheckpointWriteTime(); actual != test.expectedAvgWrite {
+			t.Errorf("%s: expected average checkpoint write time %v, got %v", test.name, test.expectedAvgWrite, actual)
+		}
+		if actual := test.diff.FrequentRequestedCheckpoints(); actual != test.expectedFrequent {
+			t.Errorf("%s: expected frequent requested checkpoints %t, got %t", test.name, test.expectedFrequent, actual)
+		}
+	}
+}
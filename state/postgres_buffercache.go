@@ -17,3 +17,23 @@ type PostgresBuffercache struct {
 
 	Entries []PostgresBuffercacheEntry
 }
+
+// PostgresBufferCacheRelation - Number of shared buffers used by a single table or index
+type PostgresBufferCacheRelation struct {
+	RelationOid  Oid
+	Buffers      int64
+	DirtyBuffers int64
+}
+
+// PostgresBufferCacheStats - Summary of the shared buffers in use, collected as part of the full snapshot
+type PostgresBufferCacheStats struct {
+	TotalBuffers int64
+	UsedBuffers  int64
+
+	// Number of used buffers by usage count (index 0 to 5)
+	UsageCountBuffers []int64
+
+	// Tables and indices of the current database with the most buffers
+	DatabaseOid Oid
+	Relations   []PostgresBufferCacheRelation
+}
@@ -0,0 +1,138 @@
+package state
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Number of applications/users to keep in the breakdown of connection usage
+const connectionUsageTopClients = 5
+
+// Number of recent peaks to keep (one per full snapshot)
+const connectionPeakHistorySize = 6
+
+// PostgresConnectionCount - Number of client connections held by one application and user
+type PostgresConnectionCount struct {
+	ApplicationName string
+	RoleName        string
+	Count           int32
+}
+
+// PostgresConnectionUsage - Client connections at one point in time, together
+// with the applications and users holding the most of them
+type PostgresConnectionUsage struct {
+	SampledAt   time.Time
+	Connections int32
+	TopClients  []PostgresConnectionCount // Sorted by count, highest first
+}
+
+// ConnectionPeakSample - Peak client connections during one full snapshot interval
+type ConnectionPeakSample struct {
+	CollectedAt time.Time
+	Connections int32
+}
+
+// ConnectionPeakHistory - Recent peaks, persisted to determine the trend across snapshots (oldest first)
+type ConnectionPeakHistory []ConnectionPeakSample
+
+// PostgresConnectionSaturation - How close the server came to running out of
+// connections since the last full snapshot
+type PostgresConnectionSaturation struct {
+	// Connections available to regular users (max_connections minus superuser_reserved_connections)
+	MaxConnections int32
+
+	Current PostgresConnectionUsage // Sampled with the full snapshot
+	Peak    PostgresConnectionUsage // Highest usage seen since the last full snapshot (including activity snapshots)
+
+	PeakHistory ConnectionPeakHistory // Includes this interval's peak
+
+	// Whether the peak reached connection_saturation_warn_percent of MaxConnections
+	ExceedsWarnPercent bool
+}
+
+// PeakRatio - Share of the available connections used at the peak
+func (s PostgresConnectionSaturation) PeakRatio() float64 {
+	if s.MaxConnections <= 0 {
+		return 0
+	}
+	return float64(s.Peak.Connections) / float64(s.MaxConnections)
+}
+
+// NewConnectionUsage - Summarizes the given per-application/user connection counts
+func NewConnectionUsage(counts []PostgresConnectionCount, sampledAt time.Time) PostgresConnectionUsage {
+	usage := PostgresConnectionUsage{SampledAt: sampledAt}
+	for _, count := range counts {
+		usage.Connections += count.Count
+	}
+
+	sorted := make([]PostgresConnectionCount, len(counts))
+	copy(sorted, counts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Count != sorted[j].Count {
+			return sorted[i].Count > sorted[j].Count
+		}
+		if sorted[i].ApplicationName != sorted[j].ApplicationName {
+			return sorted[i].ApplicationName < sorted[j].ApplicationName
+		}
+		return sorted[i].RoleName < sorted[j].RoleName
+	})
+	if len(sorted) > connectionUsageTopClients {
+		sorted = sorted[:connectionUsageTopClients]
+	}
+	usage.TopClients = sorted
+
+	return usage
+}
+
+// ConnectionUsageFromBackends - Determines connection usage from an activity snapshot
+//
+// Only client backends count towards max_connections, other background processes
+// (e.g. autovacuum workers) are ignored.
+func ConnectionUsageFromBackends(backends []PostgresBackend, sampledAt time.Time) PostgresConnectionUsage {
+	type clientKey struct {
+		applicationName string
+		roleName        string
+	}
+	countByClient := make(map[clientKey]int32)
+	var keys []clientKey
+
+	for _, backend := range backends {
+		if backend.BackendType.Valid && backend.BackendType.String != "client backend" {
+			continue
+		}
+		if !backend.BackendType.Valid && strings.HasPrefix(backend.Query.String, "autovacuum: ") {
+			continue
+		}
+		key := clientKey{backend.ApplicationName.String, backend.RoleName.String}
+		if _, ok := countByClient[key]; !ok {
+			keys = append(keys, key)
+		}
+		countByClient[key]++
+	}
+
+	var counts []PostgresConnectionCount
+	for _, key := range keys {
+		counts = append(counts, PostgresConnectionCount{ApplicationName: key.applicationName, RoleName: key.roleName, Count: countByClient[key]})
+	}
+
+	return NewConnectionUsage(counts, sampledAt)
+}
+
+// HigherConnectionUsage - Returns whichever of the two had more connections (a on ties)
+func HigherConnectionUsage(a PostgresConnectionUsage, b PostgresConnectionUsage) PostgresConnectionUsage {
+	if b.Connections > a.Connections || a.SampledAt.IsZero() {
+		return b
+	}
+	return a
+}
+
+// Add - Appends the given peak, keeping only the most recent ones
+func (h ConnectionPeakHistory) Add(sample ConnectionPeakSample) ConnectionPeakHistory {
+	history := append(ConnectionPeakHistory{}, h...)
+	history = append(history, sample)
+	if len(history) > connectionPeakHistorySize {
+		history = history[len(history)-connectionPeakHistorySize:]
+	}
+	return history
+}
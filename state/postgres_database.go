@@ -17,6 +17,9 @@ type PostgresDatabase struct {
 	// allow pg_clog to be shrunk. It is the minimum of the per-table pg_class.relfrozenxid values.
 	FrozenXID Xid
 
+	// Age of FrozenXID, in transactions
+	FrozenXIDAge int32
+
 	// All multixact IDs before this one have been replaced with a transaction ID in this database.
 	// This is used to track whether the database needs to be vacuumed in order to prevent multixact ID wraparound or to
 	// allow pg_multixact to be shrunk. It is the minimum of the per-table pg_class.relminmxid values.
@@ -0,0 +1,12 @@
+package state
+
+// PostgresDuplicateIndex - An index that is made redundant by another index on the
+// same table, that has the same definition or starts with the same key columns
+type PostgresDuplicateIndex struct {
+	DatabaseOid      Oid
+	RelationOid      Oid
+	IndexOid         Oid   // The redundant index
+	CoveringIndexOid Oid   // The index that can be used instead
+	Exact            bool  // Both indices have the same definition (otherwise the redundant index is a prefix of the covering one)
+	ReclaimableBytes int64 // Size of the redundant index
+}
@@ -0,0 +1,75 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ExplainMisestimateFactor - Ratio between actual and estimated rows (in either direction) at
+// which we consider the planner's row estimate to be off
+const ExplainMisestimateFactor = 10.0
+
+// PostgresExplainSummary - Top-level fields extracted from a FORMAT JSON query plan
+type PostgresExplainSummary struct {
+	NodeType    string  // Type of the top-level plan node, e.g. "Seq Scan"
+	StartupCost float64 // Estimated cost before the first row can be returned
+	TotalCost   float64 // Estimated total cost
+	PlanRows    float64 // Estimated number of rows returned
+
+	// Only set when the plan was produced by EXPLAIN ANALYZE (e.g. auto_explain.log_analyze)
+	HasActualRows bool
+	ActualRows    float64 // Actual number of rows returned (total across all loops)
+	Misestimated  bool    // Whether actual and estimated rows differ by at least ExplainMisestimateFactor
+}
+
+type explainJSONPlan struct {
+	NodeType    string   `json:"Node Type"`
+	StartupCost float64  `json:"Startup Cost"`
+	TotalCost   float64  `json:"Total Cost"`
+	PlanRows    float64  `json:"Plan Rows"`
+	ActualRows  *float64 `json:"Actual Rows"`
+	ActualLoops *float64 `json:"Actual Loops"`
+}
+
+// ParseExplainSummary - Extracts the top-level cost and row estimates from EXPLAIN (FORMAT JSON) output
+func ParseExplainSummary(explainOutput string) (summary PostgresExplainSummary, err error) {
+	var explain []struct {
+		Plan *explainJSONPlan
+	}
+
+	err = json.Unmarshal([]byte(explainOutput), &explain)
+	if err != nil {
+		return
+	}
+	if len(explain) == 0 || explain[0].Plan == nil {
+		err = fmt.Errorf("EXPLAIN output is missing the top-level plan")
+		return
+	}
+
+	plan := explain[0].Plan
+	summary.NodeType = plan.NodeType
+	summary.StartupCost = plan.StartupCost
+	summary.TotalCost = plan.TotalCost
+	summary.PlanRows = plan.PlanRows
+
+	if plan.ActualRows != nil {
+		summary.HasActualRows = true
+		summary.ActualRows = *plan.ActualRows
+		if plan.ActualLoops != nil && *plan.ActualLoops > 1 {
+			summary.ActualRows *= *plan.ActualLoops
+		}
+
+		// Both estimated and actual rows are per-loop values, so compare them directly
+		estimated := plan.PlanRows
+		if estimated < 1 {
+			estimated = 1
+		}
+		actual := *plan.ActualRows
+		if actual < 1 {
+			actual = 1
+		}
+		summary.Misestimated = actual/estimated >= ExplainMisestimateFactor || estimated/actual >= ExplainMisestimateFactor
+	}
+
+	return
+}
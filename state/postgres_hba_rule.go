@@ -0,0 +1,18 @@
+package state
+
+// PostgresHbaRule - An authentication rule from pg_hba.conf, as parsed by
+// Postgres (pg_hba_file_rules, PG10+)
+type PostgresHbaRule struct {
+	LineNumber int32
+	Type       string   // e.g. "local", "host" or "hostssl"
+	Databases  []string // Database names, or keywords like "all" or "replication"
+	UserNames  []string // Role names, or keywords like "all"
+	Address    string   // Host name, IP address, or keyword like "all" (empty for local connections)
+	Netmask    string
+	AuthMethod string   // e.g. "trust", "md5" or "scram-sha-256"
+	Options    []string // Secrets (e.g. ldapbindpasswd) are redacted
+	Error      string   // Set if the line could not be parsed (the rule is not in effect)
+
+	// Why this rule is considered overly permissive (empty if it isn't)
+	PermissiveReasons []string
+}
@@ -0,0 +1,39 @@
+package state
+
+import (
+	"time"
+
+	"github.com/guregu/null"
+	uuid "github.com/satori/go.uuid"
+)
+
+// PostgresLockEventKind - What happened to a backend waiting on a lock
+type PostgresLockEventKind int
+
+const (
+	LockEventWaiting          PostgresLockEventKind = iota // Still waiting after deadlock_timeout (log_lock_waits)
+	LockEventAcquired                                      // Acquired after waiting longer than deadlock_timeout (log_lock_waits)
+	LockEventLockTimeout                                   // Statement canceled due to lock_timeout
+	LockEventStatementTimeout                              // Statement canceled due to statement_timeout
+)
+
+// PostgresLockEvent - Lock contention reported in the Postgres logs, which
+// includes waits that started and ended in between two full snapshots
+type PostgresLockEvent struct {
+	Kind        PostgresLockEventKind
+	OccurredAt  time.Time
+	LogLineUUID uuid.UUID
+
+	Username   string
+	Database   string
+	BackendPid int32
+	Query      string // Statement that waited (or got canceled), if logged
+
+	LockMode    string   // e.g. "ShareLock", not known for timeouts
+	LockType    string   // Matches pg_locks.locktype, not known for timeouts
+	RelationOid null.Int // Only set when the lock refers to a relation
+	DatabaseOid null.Int // Only set when the lock refers to a relation
+
+	WaitedMs     null.Float // How long the lock was waited on when logged (not known for timeouts)
+	BlockingPids []int32    // Processes holding the lock, if logged
+}
@@ -0,0 +1,68 @@
+package state
+
+import (
+	"time"
+
+	"github.com/guregu/null"
+)
+
+// PostgresPublication - A logical replication publication (PG10+), defined in
+// one particular database
+type PostgresPublication struct {
+	DatabaseOid  Oid
+	Name         string
+	AllTables    bool // FOR ALL TABLES
+	Insert       bool // Which operations are published
+	Update       bool
+	Delete       bool
+	RelationOids []Oid // Published tables (for FOR ALL TABLES, all tables in the database)
+}
+
+// PostgresSubscription - A logical replication subscription (PG10+), together
+// with the status of its apply worker
+type PostgresSubscription struct {
+	DatabaseOid  Oid
+	Name         string
+	Enabled      bool
+	WorkerPid    null.Int    // Not set if no apply worker is running
+	ReceivedLsn  null.String // Last WAL location received from the publisher
+	LatestEndLsn null.String // Last WAL location reported back to the publisher
+	LagSecs      null.Float  // Seconds since the last WAL location was reported back
+
+	WorkerDown  bool // Enabled, but no apply worker is running
+	LagExceeded bool // LagSecs exceeds the subscription_lag_warn_secs setting
+}
+
+// PostgresLogicalReplicationSlot - A logical replication slot (PG9.6+), together
+// with how much WAL it retains, and how that developed over recent full snapshots
+type PostgresLogicalReplicationSlot struct {
+	DatabaseOid       Oid
+	SlotName          string
+	Plugin            string
+	Active            bool
+	ActivePid         null.Int
+	RestartLsn        null.String
+	ConfirmedFlushLsn null.String
+	RetainedWalBytes  null.Int // Bytes between restart_lsn and the current WAL insert location
+
+	RetainedWalBytesPerSec null.Float // Growth of RetainedWalBytes over the recent samples (see LogicalSlotWalSample)
+	RetainedWalGrowing     bool       // RetainedWalBytes increased on every recent full snapshot
+
+	InactiveRetainingWal bool // Not active, and RetainedWalGrowing is set (the consumer most likely stalled)
+}
+
+// LogicalSlotWalSample - Retained WAL of a logical replication slot at the time
+// of a full snapshot, persisted to determine the trend across snapshots
+type LogicalSlotWalSample struct {
+	CollectedAt      time.Time
+	RetainedWalBytes int64
+}
+
+// LogicalSlotWalHistory - Recent retained WAL samples, keyed by slot name (oldest first)
+type LogicalSlotWalHistory map[string][]LogicalSlotWalSample
+
+type PostgresLogicalReplication struct {
+	Publications  []PostgresPublication
+	Subscriptions []PostgresSubscription
+	Slots         []PostgresLogicalReplicationSlot
+}
@@ -0,0 +1,16 @@
+package state
+
+import "github.com/guregu/null"
+
+// PostgresMaterializedView - Freshness information for a materialized view
+// (the view itself is part of the regular relations)
+type PostgresMaterializedView struct {
+	DatabaseOid Oid
+	RelationOid Oid
+	IsPopulated bool  // False if created WITH NO DATA (or never refreshed since), querying it fails
+	SizeBytes   int64 // Total on-disk size, including indices and TOAST
+
+	// Time of the last REFRESH MATERIALIZED VIEW, as recorded in the
+	// matview_refresh_tracking_table (not known otherwise)
+	LastRefreshedAt null.Time
+}
@@ -0,0 +1,12 @@
+package state
+
+// PostgresMissingForeignKeyIndex - A foreign key whose referencing columns are not
+// the leading columns of any index, so that updates and deletes on the referenced
+// table have to scan the referencing table to check (or cascade) the constraint
+type PostgresMissingForeignKeyIndex struct {
+	DatabaseOid    Oid
+	RelationOid    Oid      // The referencing table
+	ConstraintName string   // Name of the foreign key constraint
+	ColumnNames    []string // Referencing columns, in the order of the constraint
+	SuggestedIndex string   // CREATE INDEX statement that would support the foreign key
+}
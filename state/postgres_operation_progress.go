@@ -0,0 +1,24 @@
+package state
+
+import "github.com/guregu/null"
+
+// PostgresOperationProgress - A long-running operation other than VACUUM (e.g. CREATE
+// INDEX or ANALYZE) that reports its progress through a pg_stat_progress_* view
+//
+// See https://www.postgresql.org/docs/current/progress-reporting.html
+type PostgresOperationProgress struct {
+	BackendIdentity uint64 // Combination of process start time and PID, used to identify a process over time
+
+	Command      string // e.g. "CREATE INDEX CONCURRENTLY", "CLUSTER", "ANALYZE", "COPY FROM" or "BASE BACKUP"
+	Phase        string // Current processing phase, as reported by the view (empty for COPY)
+	DatabaseName string
+	SchemaName   null.String // Not set for base backups, and relations in other databases
+	RelationName null.String
+	RoleName     string
+	StartedAt    null.Time
+
+	// Units of work done so far, out of the total (e.g. blocks, tuples or bytes,
+	// depending on the command). The total is 0 when not known.
+	WorkDone  int64
+	WorkTotal int64
+}
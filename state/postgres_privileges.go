@@ -0,0 +1,18 @@
+package state
+
+// PostgresPrivileges - What the collector's role is allowed to see, determined
+// when connecting for a full snapshot
+type PostgresPrivileges struct {
+	Superuser      bool // Superuser, or the equivalent on managed services (e.g. rds_superuser)
+	MonitoringRole bool // Member of pg_monitor (PG10+)
+	ReadAllStats   bool // Member of pg_read_all_stats (PG10+, included in pg_monitor)
+
+	// Sections that are missing data because of insufficient privileges, and for
+	// which no helper function (in the pganalyze schema) is installed either
+	PermissionLimitedSections []string
+}
+
+// CanReadAllStats - Whether statistics views show the details of all roles' activity
+func (p PostgresPrivileges) CanReadAllStats() bool {
+	return p.Superuser || p.MonitoringRole || p.ReadAllStats
+}
@@ -24,6 +24,10 @@ type PostgresQuerySample struct {
 	ExplainFormat pganalyze_collector.QuerySample_ExplainFormat
 	ExplainSource pganalyze_collector.QuerySample_ExplainSource
 
+	// Extracted from ExplainOutput, only set for JSON format plans
+	HasExplainSummary bool
+	ExplainSummary    PostgresExplainSummary
+
 	// FUTURE: Could use parameters (and query values) to determine whether
 	// the given value is included in most_common_vals (and which most_common_freqs it has)
 }
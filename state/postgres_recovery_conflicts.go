@@ -0,0 +1,28 @@
+package state
+
+// PostgresRecoveryConflictStats - Queries cancelled on a standby due to conflicts
+// with recovery, for a single database
+//
+// See https://www.postgresql.org/docs/current/monitoring-stats.html#PG-STAT-DATABASE-CONFLICTS-VIEW
+type PostgresRecoveryConflictStats struct {
+	ConflTablespace int64 // Number of queries that have been canceled due to dropped tablespaces
+	ConflLock       int64 // Number of queries that have been canceled due to lock timeouts
+	ConflSnapshot   int64 // Number of queries that have been canceled due to old snapshots
+	ConflBufferpin  int64 // Number of queries that have been canceled due to pinned buffers
+	ConflDeadlock   int64 // Number of queries that have been canceled due to deadlocks
+}
+
+type PostgresRecoveryConflictStatsMap map[Oid]PostgresRecoveryConflictStats
+
+type DiffedPostgresRecoveryConflictStats PostgresRecoveryConflictStats
+type DiffedPostgresRecoveryConflictStatsMap map[Oid]DiffedPostgresRecoveryConflictStats
+
+func (curr PostgresRecoveryConflictStats) DiffSince(prev PostgresRecoveryConflictStats) DiffedPostgresRecoveryConflictStats {
+	return DiffedPostgresRecoveryConflictStats{
+		ConflTablespace: curr.ConflTablespace - prev.ConflTablespace,
+		ConflLock:       curr.ConflLock - prev.ConflLock,
+		ConflSnapshot:   curr.ConflSnapshot - prev.ConflSnapshot,
+		ConflBufferpin:  curr.ConflBufferpin - prev.ConflBufferpin,
+		ConflDeadlock:   curr.ConflDeadlock - prev.ConflDeadlock,
+	}
+}
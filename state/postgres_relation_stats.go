@@ -5,6 +5,10 @@ import "github.com/guregu/null"
 type PostgresRelationStats struct {
 	SizeBytes        int64     // On-disk size including FSM and VM, plus TOAST table if any, excluding indices
 	ToastSizeBytes   int64     // TOAST table and TOAST index size (included in SizeBytes as well)
+	MainSizeBytes    int64     // Size of the main fork, i.e. the table data itself (excluding child partitions)
+	FsmSizeBytes     int64     // Size of the free space map fork
+	VmSizeBytes      int64     // Size of the visibility map fork
+	IndexesSizeBytes int64     // Size of all indexes on this table (excluding the TOAST index)
 	SeqScan          int64     // Number of sequential scans initiated on this table
 	SeqTupRead       int64     // Number of live rows fetched by sequential scans
 	IdxScan          int64     // Number of index scans initiated on this table
@@ -32,6 +36,10 @@ type PostgresRelationStats struct {
 	ToastBlksHit     int64     // Number of buffer hits in this table's TOAST table (if any)
 	TidxBlksRead     int64     // Number of disk blocks read from this table's TOAST table indexes (if any)
 	TidxBlksHit      int64     // Number of buffer hits in this table's TOAST table indexes (if any)
+
+	// Bytes grown (or shrunk) since the previous collection, only set on diffs when
+	// the relation existed in both collections and size growth tracking is enabled
+	SizeBytesGrowth null.Int
 }
 
 type PostgresIndexStats struct {
@@ -41,6 +49,26 @@ type PostgresIndexStats struct {
 	IdxTupFetch int64 // Number of live table rows fetched by simple index scans using this index
 	IdxBlksRead int64 // Number of disk blocks read from this index
 	IdxBlksHit  int64 // Number of buffer hits in this index
+
+	SizeBytesGrowth null.Int // Bytes grown since the previous collection (see PostgresRelationStats)
+}
+
+// HotUpdateRatio - Share of updated rows that were HOT updated (between 0 and 1),
+// low values suggest a too high fillfactor or indexes on frequently updated columns
+func (stats DiffedPostgresRelationStats) HotUpdateRatio() null.Float {
+	if stats.NTupUpd <= 0 {
+		return null.Float{}
+	}
+	return null.FloatFrom(float64(stats.NTupHotUpd) / float64(stats.NTupUpd))
+}
+
+// SeqScanRatio - Share of scans on this table that were sequential scans (between
+// 0 and 1), high values on large tables suggest a missing index
+func (stats DiffedPostgresRelationStats) SeqScanRatio() null.Float {
+	if stats.SeqScan+stats.IdxScan <= 0 {
+		return null.Float{}
+	}
+	return null.FloatFrom(float64(stats.SeqScan) / float64(stats.SeqScan+stats.IdxScan))
 }
 
 type PostgresRelationStatsMap map[Oid]PostgresRelationStats
@@ -55,6 +83,10 @@ func (curr PostgresRelationStats) DiffSince(prev PostgresRelationStats) DiffedPo
 	return DiffedPostgresRelationStats{
 		SizeBytes:        curr.SizeBytes,
 		ToastSizeBytes:   curr.ToastSizeBytes,
+		MainSizeBytes:    curr.MainSizeBytes,
+		FsmSizeBytes:     curr.FsmSizeBytes,
+		VmSizeBytes:      curr.VmSizeBytes,
+		IndexesSizeBytes: curr.IndexesSizeBytes,
 		SeqScan:          curr.SeqScan - prev.SeqScan,
 		SeqTupRead:       curr.SeqTupRead - prev.SeqTupRead,
 		IdxScan:          curr.IdxScan - prev.IdxScan,
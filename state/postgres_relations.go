@@ -22,7 +22,9 @@ type PostgresRelation struct {
 	HasInheritanceChildren bool
 	HasToast               bool
 	FrozenXID              Xid
+	FrozenXIDAge           int32 // Age of FrozenXID in transactions (0 for relations without storage, e.g. views)
 	MinimumMultixactXID    Xid
+	Tuples                 float64 // Estimated number of rows as of the last VACUUM or ANALYZE (pg_class.reltuples), -1 if unknown (14+)
 
 	// True if another process is currently holding an AccessExclusiveLock on this
 	// relation, this also means we don't collect columns/index/constraints data
@@ -47,9 +49,19 @@ type PostgresIndex struct {
 	IsPrimary     bool
 	IsUnique      bool
 	IsValid       bool
+	IsReady       bool // False while the index can't receive inserts yet (early phase of CREATE INDEX CONCURRENTLY)
+	IsBeingBuilt  bool // A CREATE INDEX or REINDEX is currently in progress for this index (only known for Postgres 12+)
 	IndexDef      string
 	ConstraintDef null.String
 	Options       map[string]string
+
+	// Details needed to compare index definitions with each other
+	KeyColumnCount int32       // Number of key columns at the start of Columns, the remaining ones are INCLUDE columns (11+)
+	OpClasses      []Oid       // Operator class of each key column
+	ColumnOptions  []int32     // Per-column flags of each key column (e.g. DESC, NULLS FIRST)
+	Collations     []Oid       // Collation of each key column (0 if not collatable)
+	Expressions    null.String // Expressions of key columns that are not simple column references (these have a 0 in Columns)
+	Predicate      null.String // WHERE clause of a partial index
 }
 
 type PostgresConstraint struct {
@@ -0,0 +1,43 @@
+package state
+
+import "time"
+
+// PostgresSlruStats - Activity of one of the SLRU (simple least-recently-used) caches,
+// e.g. for subtransactions or multixacts (Postgres 13+)
+//
+// See https://www.postgresql.org/docs/13/monitoring-stats.html#MONITORING-PG-STAT-SLRU-VIEW
+type PostgresSlruStats struct {
+	BlksZeroed  int64     // Number of blocks zeroed during initializations
+	BlksHit     int64     // Number of times disk blocks were found already in the SLRU
+	BlksRead    int64     // Number of disk blocks read for this SLRU
+	BlksWritten int64     // Number of disk blocks written for this SLRU
+	Truncates   int64     // Number of truncates for this SLRU
+	StatsReset  time.Time // Time at which these statistics were last reset
+}
+
+// PostgresSlruStatsMap - SLRU cache statistics, keyed by the name of the SLRU
+type PostgresSlruStatsMap map[string]PostgresSlruStats
+
+type DiffedPostgresSlruStats struct {
+	BlksZeroed  int64
+	BlksHit     int64
+	BlksRead    int64
+	BlksWritten int64
+	Truncates   int64
+}
+type DiffedPostgresSlruStatsMap map[string]DiffedPostgresSlruStats
+
+func (curr PostgresSlruStats) DiffSince(prev PostgresSlruStats) DiffedPostgresSlruStats {
+	// The counters start over when the statistics get reset (pg_stat_reset_slru)
+	if !curr.StatsReset.Equal(prev.StatsReset) {
+		prev = PostgresSlruStats{}
+	}
+
+	return DiffedPostgresSlruStats{
+		BlksZeroed:  curr.BlksZeroed - prev.BlksZeroed,
+		BlksHit:     curr.BlksHit - prev.BlksHit,
+		BlksRead:    curr.BlksRead - prev.BlksRead,
+		BlksWritten: curr.BlksWritten - prev.BlksWritten,
+		Truncates:   curr.Truncates - prev.Truncates,
+	}
+}
@@ -13,6 +13,9 @@ type PostgresStatement struct {
 	Unidentified          bool     // True if this represents an unidentified statement without query text
 	InsufficientPrivilege bool     // True if we're missing permissions to see the statement
 	Collector             bool     // True if this statement was produced by the pganalyze collector
+	BelowMinCalls         bool     // True if this combines all statements called less often than query_stats_min_calls
+	OutsideTopStatements  bool     // True if this combines all statements not ranked within query_stats_max_statements
+	QueryTextOmitted      bool     // True if the query text is left out since it's not ranked within query_text_max_statements
 }
 
 // PostgresStatementStats - Statistics from pg_stat_statements extension for a given
@@ -41,6 +44,13 @@ type PostgresStatementStats struct {
 	MaxTime    null.Float // Maximum time spent in the statement, in milliseconds
 	MeanTime   null.Float // Mean time spent in the statement, in milliseconds
 	StddevTime null.Float // Population standard deviation of time spent in the statement, in milliseconds
+
+	// Operating system level statistics from the pg_stat_kcache extension (if installed)
+	HasKcache    bool
+	UserCPUTime  float64 // Total CPU time spent in user mode, in milliseconds
+	SysCPUTime   float64 // Total CPU time spent in kernel mode, in milliseconds
+	FsReadBytes  int64   // Total bytes read from the filesystem layer (i.e. not served from the OS page cache)
+	FsWriteBytes int64   // Total bytes written to the filesystem layer
 }
 
 // PostgresStatementKey - Information that uniquely identifies a query
@@ -81,7 +91,27 @@ func (curr PostgresStatementStats) DiffSince(prev PostgresStatementStats) Diffed
 		TempBlksWritten:   curr.TempBlksWritten - prev.TempBlksWritten,
 		BlkReadTime:       curr.BlkReadTime - prev.BlkReadTime,
 		BlkWriteTime:      curr.BlkWriteTime - prev.BlkWriteTime,
+	}.withKcacheDiff(curr, prev)
+}
+
+// withKcacheDiff - Adds the diffed pg_stat_kcache statistics, unless they are missing
+// from either side, or were reset separately from pg_stat_statements
+func (diff DiffedPostgresStatementStats) withKcacheDiff(curr PostgresStatementStats, prev PostgresStatementStats) DiffedPostgresStatementStats {
+	if !curr.HasKcache || (!prev.HasKcache && prev.Calls != 0) {
+		return diff
 	}
+
+	diff.UserCPUTime = curr.UserCPUTime - prev.UserCPUTime
+	diff.SysCPUTime = curr.SysCPUTime - prev.SysCPUTime
+	diff.FsReadBytes = curr.FsReadBytes - prev.FsReadBytes
+	diff.FsWriteBytes = curr.FsWriteBytes - prev.FsWriteBytes
+	if diff.UserCPUTime < 0 || diff.SysCPUTime < 0 || diff.FsReadBytes < 0 || diff.FsWriteBytes < 0 {
+		diff.UserCPUTime, diff.SysCPUTime, diff.FsReadBytes, diff.FsWriteBytes = 0, 0, 0, 0
+		return diff
+	}
+	diff.HasKcache = true
+
+	return diff
 }
 
 // Add - Adds the statistics of one diffed statement to another, returning the result as a copy
@@ -102,5 +132,10 @@ func (stmt DiffedPostgresStatementStats) Add(other DiffedPostgresStatementStats)
 		TempBlksWritten:   stmt.TempBlksWritten + other.TempBlksWritten,
 		BlkReadTime:       stmt.BlkReadTime + other.BlkReadTime,
 		BlkWriteTime:      stmt.BlkWriteTime + other.BlkWriteTime,
+		HasKcache:         stmt.HasKcache || other.HasKcache,
+		UserCPUTime:       stmt.UserCPUTime + other.UserCPUTime,
+		SysCPUTime:        stmt.SysCPUTime + other.SysCPUTime,
+		FsReadBytes:       stmt.FsReadBytes + other.FsReadBytes,
+		FsWriteBytes:      stmt.FsWriteBytes + other.FsWriteBytes,
 	}
 }
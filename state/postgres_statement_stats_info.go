@@ -0,0 +1,11 @@
+package state
+
+import "time"
+
+// PostgresStatementStatsInfo - Statistics about pg_stat_statements itself (14+)
+//
+// See https://www.postgresql.org/docs/14/pgstatstatements.html#id-1.11.7.39.7
+type PostgresStatementStatsInfo struct {
+	Dealloc    int64     // Number of times entries were evicted because more distinct statements than pg_stat_statements.max were observed
+	StatsReset time.Time // Time at which all statistics in pg_stat_statements were last reset
+}
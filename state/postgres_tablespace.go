@@ -0,0 +1,13 @@
+package state
+
+import "github.com/guregu/null"
+
+// PostgresTablespace - A tablespace, and how much space is used by it
+type PostgresTablespace struct {
+	Oid       Oid
+	Name      string
+	OwnerOid  Oid
+	Config    []string // spcoptions, e.g. "random_page_cost=1.1"
+	Location  string   // Filesystem location, empty for pg_default and pg_global (which live in the data directory)
+	SizeBytes null.Int // Not set if we lack the privileges to determine the size
+}
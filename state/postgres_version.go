@@ -10,6 +10,8 @@ const (
 	PostgresVersion10 = 100000
 	PostgresVersion11 = 110000
 	PostgresVersion12 = 120000
+	PostgresVersion13 = 130000
+	PostgresVersion14 = 140000
 
 	// MinRequiredPostgresVersion - We require PostgreSQL 9.2 or newer, since pg_stat_statements only started being usable then
 	MinRequiredPostgresVersion = PostgresVersion92
@@ -21,6 +23,12 @@ type PostgresVersion struct {
 	Short   string `json:"short"`   // e.g. "9.5.1"
 	Numeric int    `json:"numeric"` // e.g. 90501
 
+	// Compile-time settings (in bytes)
+	BlockSize      int64 `json:"block_size"`       // e.g. 8192
+	WalBlockSize   int64 `json:"wal_block_size"`   // e.g. 8192
+	WalSegmentSize int64 `json:"wal_segment_size"` // e.g. 16777216
+	SegmentSize    int64 `json:"segment_size"`     // e.g. 1073741824
+
 	// For collector use only, to avoid calling functions that don't work
 	IsAwsAurora bool
 	IsCitus     bool
@@ -0,0 +1,40 @@
+package state
+
+// MaxSafeXidAge - Transaction ID age at which Postgres stops accepting commands to
+// prevent wraparound (2^31 minus the 3 million transactions safety margin)
+const MaxSafeXidAge = 2147483648 - 3000000
+
+// PostgresWraparound - How close the databases and their tables are to a forced
+// anti-wraparound VACUUM, and to transaction ID wraparound itself
+type PostgresWraparound struct {
+	AutovacuumFreezeMaxAge int64
+
+	Databases []PostgresDatabaseWraparound
+
+	// Tables closest to a forced anti-wraparound VACUUM (based on their
+	// effective autovacuum_freeze_max_age), across all databases
+	Relations []PostgresRelationWraparound
+}
+
+type PostgresDatabaseWraparound struct {
+	DatabaseOid Oid
+	XidAge      int64
+
+	// Percentage of autovacuum_freeze_max_age, and of the total wraparound budget, used
+	ForcedVacuumPct float64
+	WraparoundPct   float64
+}
+
+type PostgresRelationWraparound struct {
+	DatabaseOid  Oid
+	RelationOid  Oid
+	XidAge       int64
+	FreezeMaxAge int64 // Effective autovacuum_freeze_max_age (considering the table's storage parameters)
+
+	ForcedVacuumPct float64
+	WraparoundPct   float64
+
+	// Set when the table is past its autovacuum_freeze_max_age, i.e. an
+	// anti-wraparound VACUUM should already be running (or is blocked)
+	Flagged bool
+}
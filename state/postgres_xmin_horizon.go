@@ -0,0 +1,16 @@
+package state
+
+import "github.com/guregu/null"
+
+// PostgresXminHorizon - The oldest xmin that currently holds back VACUUM from
+// removing dead rows (across all databases), and what is holding it
+type PostgresXminHorizon struct {
+	Source     string   // "backend", "prepared_xact" or "replication_slot"
+	Identifier string   // PID of the backend, GID of the prepared transaction, or name of the replication slot
+	Xmin       Xid      // The xmin horizon itself
+	XminAge    int64    // Age of the xmin horizon, in transactions
+	AgeSecs    null.Int // Seconds since the transaction started or was prepared (not known for replication slots)
+
+	// Whether the age exceeds the xmin_horizon_warn_age setting
+	ExceedsWarnAge bool
+}
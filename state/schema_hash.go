@@ -0,0 +1,95 @@
+package state
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+)
+
+// SchemaHash - Returns a hash of the table, index and function definitions,
+// used to detect whether the schema changed since the last full snapshot
+//
+// Information that changes without a schema change (e.g. the frozen XID, or the
+// estimated row count) is not included, and neither is the order in which
+// objects were returned by the database.
+func SchemaHash(relations []PostgresRelation, functions []PostgresFunction) string {
+	normalizedRelations := make([]PostgresRelation, len(relations))
+	for idx, relation := range relations {
+		relation.FrozenXID = 0
+		relation.FrozenXIDAge = 0
+		relation.MinimumMultixactXID = 0
+		relation.Tuples = 0
+
+		relation.Columns = append([]PostgresColumn(nil), relation.Columns...)
+		sort.Slice(relation.Columns, func(i, j int) bool {
+			return relation.Columns[i].Position < relation.Columns[j].Position
+		})
+		relation.Indices = append([]PostgresIndex(nil), relation.Indices...)
+		sort.Slice(relation.Indices, func(i, j int) bool {
+			return relation.Indices[i].IndexOid < relation.Indices[j].IndexOid
+		})
+		relation.Constraints = append([]PostgresConstraint(nil), relation.Constraints...)
+		sort.Slice(relation.Constraints, func(i, j int) bool {
+			if relation.Constraints[i].Name != relation.Constraints[j].Name {
+				return relation.Constraints[i].Name < relation.Constraints[j].Name
+			}
+			return relation.Constraints[i].ConstraintDef < relation.Constraints[j].ConstraintDef
+		})
+
+		normalizedRelations[idx] = relation
+	}
+	sort.Slice(normalizedRelations, func(i, j int) bool {
+		if normalizedRelations[i].DatabaseOid != normalizedRelations[j].DatabaseOid {
+			return normalizedRelations[i].DatabaseOid < normalizedRelations[j].DatabaseOid
+		}
+		return normalizedRelations[i].Oid < normalizedRelations[j].Oid
+	})
+
+	normalizedFunctions := append([]PostgresFunction(nil), functions...)
+	sort.Slice(normalizedFunctions, func(i, j int) bool {
+		if normalizedFunctions[i].DatabaseOid != normalizedFunctions[j].DatabaseOid {
+			return normalizedFunctions[i].DatabaseOid < normalizedFunctions[j].DatabaseOid
+		}
+		return normalizedFunctions[i].Oid < normalizedFunctions[j].Oid
+	})
+
+	// Maps (e.g. relation options) are encoded with sorted keys, so this is stable
+	data, err := json.Marshal(struct {
+		Relations []PostgresRelation
+		Functions []PostgresFunction
+	}{normalizedRelations, normalizedFunctions})
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// SchemaUnchanged - Whether the schema can be left out of a full snapshot collected
+// at the given time, because the server already received the same schema recently
+// enough (see skip_unchanged_schema and schema_refresh_interval)
+//
+// This needs the server to explicitly support the "schema_unchanged" section,
+// otherwise it would treat the snapshot as having no tables at all.
+func SchemaUnchanged(conf config.ServerConfig, features GrantFeatures, prevSchemaHash string, schemaHash string, schemaSentAt time.Time, collectedAt time.Time) bool {
+	if !conf.SkipUnchangedSchema || prevSchemaHash == "" || prevSchemaHash != schemaHash {
+		return false
+	}
+
+	refreshInterval := time.Duration(conf.SchemaRefreshInterval) * time.Minute
+	if collectedAt.Sub(schemaSentAt) >= refreshInterval {
+		return false
+	}
+
+	for _, name := range features.SnapshotSections {
+		if name == "schema_unchanged" {
+			return true
+		}
+	}
+	return false
+}
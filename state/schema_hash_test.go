@@ -0,0 +1,133 @@
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+)
+
+func schemaHashTestRelations() []state.PostgresRelation {
+	return []state.PostgresRelation{
+		{
+			Oid:          1,
+			DatabaseOid:  1,
+			SchemaName:   "public",
+			RelationName: "a",
+			Columns: []state.PostgresColumn{
+				{RelationOid: 1, Name: "id", DataType: "integer", Position: 1},
+				{RelationOid: 1, Name: "value", DataType: "text", Position: 2},
+			},
+			Indices: []state.PostgresIndex{{RelationOid: 1, IndexOid: 3}, {RelationOid: 1, IndexOid: 4}},
+		},
+		{
+			Oid:          2,
+			DatabaseOid:  1,
+			SchemaName:   "public",
+			RelationName: "b",
+		},
+	}
+}
+
+var schemaHashTests = []struct {
+	name     string
+	change   func(relations []state.PostgresRelation, functions []state.PostgresFunction) ([]state.PostgresRelation, []state.PostgresFunction)
+	expected bool
+}{
+	{
+		"no change",
+		func(relations []state.PostgresRelation, functions []state.PostgresFunction) ([]state.PostgresRelation, []state.PostgresFunction) {
+			return relations, functions
+		},
+		true,
+	},
+	{
+		"different relation, column and index order",
+		func(relations []state.PostgresRelation, functions []state.PostgresFunction) ([]state.PostgresRelation, []state.PostgresFunction) {
+			relations[0].Columns[0], relations[0].Columns[1] = relations[0].Columns[1], relations[0].Columns[0]
+			relations[0].Indices[0], relations[0].Indices[1] = relations[0].Indices[1], relations[0].Indices[0]
+			return []state.PostgresRelation{relations[1], relations[0]}, functions
+		},
+		true,
+	},
+	{
+		"frozen XID and row estimate",
+		func(relations []state.PostgresRelation, functions []state.PostgresFunction) ([]state.PostgresRelation, []state.PostgresFunction) {
+			relations[0].FrozenXID = 1234
+			relations[0].Tuples = 5678
+			return relations, functions
+		},
+		true,
+	},
+	{
+		"column type",
+		func(relations []state.PostgresRelation, functions []state.PostgresFunction) ([]state.PostgresRelation, []state.PostgresFunction) {
+			relations[0].Columns[1].DataType = "varchar"
+			return relations, functions
+		},
+		false,
+	},
+	{
+		"new index",
+		func(relations []state.PostgresRelation, functions []state.PostgresFunction) ([]state.PostgresRelation, []state.PostgresFunction) {
+			relations[1].Indices = []state.PostgresIndex{{RelationOid: 2, IndexOid: 5}}
+			return relations, functions
+		},
+		false,
+	},
+	{
+		"new function",
+		func(relations []state.PostgresRelation, functions []state.PostgresFunction) ([]state.PostgresRelation, []state.PostgresFunction) {
+			return relations, append(functions, state.PostgresFunction{Oid: 6, DatabaseOid: 1, SchemaName: "public", FunctionName: "f"})
+		},
+		false,
+	},
+}
+
+func TestSchemaHash(t *testing.T) {
+	expected := state.SchemaHash(schemaHashTestRelations(), nil)
+	if expected == "" {
+		t.Fatalf("Expected schema hash to be set")
+	}
+
+	for _, test := range schemaHashTests {
+		relations, functions := test.change(schemaHashTestRelations(), nil)
+		actual := state.SchemaHash(relations, functions)
+		if (actual == expected) != test.expected {
+			t.Errorf("%s: expected hash to be unchanged: %t, got %s (was %s)", test.name, test.expected, actual, expected)
+		}
+	}
+}
+
+var schemaUnchangedTests = []struct {
+	name           string
+	skipUnchanged  bool
+	sections       []string
+	prevSchemaHash string
+	schemaHash     string
+	sentAgo        time.Duration
+	expected       bool
+}{
+	{"unchanged", true, []string{"schema_unchanged"}, "abc", "abc", time.Hour, true},
+	{"setting disabled", false, []string{"schema_unchanged"}, "abc", "abc", time.Hour, false},
+	{"schema changed", true, []string{"schema_unchanged"}, "abc", "def", time.Hour, false},
+	{"first run", true, []string{"schema_unchanged"}, "", "abc", time.Hour, false},
+	{"refresh interval passed", true, []string{"schema_unchanged"}, "abc", "abc", 24 * time.Hour, false},
+	{"sections not advertised", true, nil, "abc", "abc", time.Hour, false},
+	{"section not supported", true, []string{"hba_rules"}, "abc", "abc", time.Hour, false},
+}
+
+func TestSchemaUnchanged(t *testing.T) {
+	collectedAt := time.Now()
+	conf := config.ServerConfig{SchemaRefreshInterval: 12 * 60}
+
+	for _, test := range schemaUnchangedTests {
+		conf.SkipUnchangedSchema = test.skipUnchanged
+		features := state.GrantFeatures{SnapshotSections: test.sections}
+		actual := state.SchemaUnchanged(conf, features, test.prevSchemaHash, test.schemaHash, collectedAt.Add(-test.sentAgo), collectedAt)
+		if actual != test.expected {
+			t.Errorf("%s: expected %t, got %t", test.name, test.expected, actual)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	raven "github.com/getsentry/raven-go"
+	"github.com/guregu/null"
 	"github.com/pganalyze/collector/config"
 )
 
@@ -14,14 +15,48 @@ type PersistedState struct {
 
 	ActivitySnapshotAt time.Time
 
+	// Whether the server was a standby at collection time - this is checked on
+	// every run, since a failover may have changed the server we're connected to
+	InRecovery bool
+
 	StatementStats PostgresStatementStatsMap
 	RelationStats  PostgresRelationStatsMap
 	IndexStats     PostgresIndexStatsMap
 	FunctionStats  PostgresFunctionStatsMap
 
+	// Only collected on standbys (i.e. when InRecovery is set)
+	RecoveryConflicts PostgresRecoveryConflictStatsMap
+
+	// Only collected on Postgres 13+
+	SlruStats PostgresSlruStatsMap
+
+	// Retained WAL of logical replication slots over recent snapshots
+	LogicalSlotWalHistory LogicalSlotWalHistory
+
+	// Highest client connection usage seen by activity snapshots since the last
+	// full snapshot, and the peaks of recent full snapshot intervals
+	ConnectionPeak        PostgresConnectionUsage
+	ConnectionPeakHistory ConnectionPeakHistory
+
+	// Nil if collecting background writer statistics failed
+	BgwriterStats *PostgresBgwriterStats
+
 	Relations []PostgresRelation
 	Functions []PostgresFunction
 
+	// Hash of Relations and Functions (see SchemaHash), and when we last sent the
+	// full schema - used to leave out the schema when it didn't change
+	SchemaHash   string
+	SchemaSentAt time.Time
+
+	// When we last sent the full schema to each additional API destination (by API
+	// base URL), since these can miss snapshots independently of the primary one
+	DestinationSchemaSentAt map[string]time.Time
+
+	// Incremented every run, and reset when a forced full snapshot gets sent (see
+	// force_full_snapshot_every)
+	ForceFullSnapshotCounter int
+
 	System         SystemState
 	CollectorStats CollectorStats
 
@@ -33,8 +68,20 @@ type PersistedState struct {
 	// Keep track of when we last collected statement stats, to calculate time distance
 	LastStatementStatsAt time.Time
 
+	// Position up to which statement stats were read, for sources that keep
+	// statistics in time buckets instead of ever-increasing counters (pg_stat_monitor)
+	StatementSourceCursor time.Time
+
 	// All statement stats that have not been identified (will be cleared by the next full snapshot)
 	UnidentifiedStatementStats HistoricStatementStatsMap
+
+	// When queries were last explained (for explain_sample_interval), carried over
+	// to each new state since log processing updates it concurrently
+	ExplainSampling *ExplainSampling
+
+	// Eviction counter of pg_stat_statements (14+), to determine how many entries
+	// got evicted since the last full snapshot
+	StatementStatsInfo PostgresStatementStatsInfo
 }
 
 // TransientState - State thats only used within a collector run (and not needed for diffs)
@@ -42,23 +89,84 @@ type TransientState struct {
 	// Databases we connected to and fetched local catalog data (e.g. schema)
 	DatabaseOidsWithLocalCatalog []Oid
 
-	Roles     []PostgresRole
-	Databases []PostgresDatabase
+	Roles       []PostgresRole
+	Databases   []PostgresDatabase
+	Tablespaces []PostgresTablespace
+
+	// Size of each database in bytes, only collected when db_max_size_mb is set
+	// (and db_name includes "*")
+	DatabaseSizes map[Oid]int64
+
+	HasPrivileges bool
+	Privileges    PostgresPrivileges
+
+	// Name of the statement source (e.g. pg_stat_statements) that Statements
+	// and StatementTexts were collected from
+	QuerySource string
+
+	// Set when the schema is unchanged since the last full snapshot, and should
+	// be left out of this one (see skip_unchanged_schema)
+	SchemaUnchanged bool
+
+	// Set when this full snapshot was sent without any incremental optimizations,
+	// so the server can use it as a new baseline (see force_full_snapshot_every)
+	ForcedFullSnapshot bool
 
 	Statements             PostgresStatementMap
 	StatementTexts         PostgresStatementTextMap
 	HistoricStatementStats HistoricStatementStatsMap
 
+	HasStatementStatsInfo bool
+	StatementStatsInfo    PostgresStatementStatsInfo
+	StatementDeallocDiff  null.Int // Entries evicted since the last full snapshot (not set for the first one)
+
 	// This is a new zero value that was recorded after a pg_stat_statements_reset(),
 	// in order to enable the next snapshot to be able to diff against something
 	ResetStatementStats PostgresStatementStatsMap
 
+	// Collected together with the schema information of each database
+	MaterializedViews []PostgresMaterializedView
+
+	// Derived from the collected index definitions
+	DuplicateIndices []PostgresDuplicateIndex
+
+	MissingForeignKeyIndices []PostgresMissingForeignKeyIndex
+
+	// Publications are collected for each database, subscriptions once per server
+	LogicalReplication PostgresLogicalReplication
+
+	// Only collected when pg_hba_file_rules is readable (PG10+, superuser by default)
+	HbaRules []PostgresHbaRule
+
+	// Labels returned by the labels_query - for the whole snapshot, and for tables
+	// in the database we're connected to (identified by RelationLabelsDatabaseOid)
+	DynamicLabels             map[string]string
+	RelationLabels            map[Oid]map[string]string
+	RelationLabelsDatabaseOid Oid
+
 	Replication   PostgresReplication
 	Settings      []PostgresSetting
 	BackendCounts []PostgresBackendCount
 
+	HasBufferCacheStats bool
+	BufferCacheStats    PostgresBufferCacheStats
+
+	HasXminHorizon bool
+	XminHorizon    PostgresXminHorizon
+
+	HasConnectionSaturation bool
+	ConnectionSaturation    PostgresConnectionSaturation
+
+	HasWraparound bool
+	Wraparound    PostgresWraparound
+
+	HasAutovacuumForecast bool
+	AutovacuumForecast    PostgresAutovacuumForecast
+
 	Version PostgresVersion
 
+	CollectionStatus CollectionSectionStatusMap
+
 	SentryClient *raven.Client
 }
 
@@ -69,6 +177,12 @@ type DiffState struct {
 	IndexStats     DiffedPostgresIndexStatsMap
 	FunctionStats  DiffedPostgresFunctionStatsMap
 
+	RecoveryConflicts DiffedPostgresRecoveryConflictStatsMap
+	SlruStats         DiffedPostgresSlruStatsMap
+
+	// Nil unless the statistics were collected both now and on the previous run
+	BgwriterStats *DiffedPostgresBgwriterStats
+
 	SystemCPUStats     DiffedSystemCPUStatsMap
 	SystemNetworkStats DiffedNetworkStatsMap
 	SystemDiskStats    DiffedDiskStatsMap
@@ -108,6 +222,31 @@ type CollectionOpts struct {
 	DebugLogs           bool
 	DiscoverLogLocation bool
 
+	// Path to a snapshot file (or directory of files) to re-submit with its original collected_at
+	BackfillSnapshotPath string
+
+	// Directory to save every collected full snapshot to (as JSON), and a path to
+	// such files to submit them as-is - these are intended for testing/debugging
+	RecordSnapshotsDir string
+	ReplaySnapshotPath string
+
+	// Single server specified on the command line (instead of the config file),
+	// collected from once before exiting
+	CommandLineServer *config.ServerConfig
+
+	// Overrides the full snapshot schedule with a fixed interval (for testing/debugging only)
+	CollectInterval time.Duration
+
+	// Config sections to collect from (all if empty), and to leave out (--only/--exclude)
+	OnlySections    []string
+	ExcludeSections []string
+
+	// Recent activity snapshots kept in memory, shared across config reloads
+	ActivityHistory *ActivityHistory
+
+	// Last submitted full snapshots, only retained when the control server is enabled
+	LastSnapshots *LastSnapshots
+
 	StateFilename    string
 	WriteStateUpdate bool
 	ForceEmptyGrant  bool
@@ -129,6 +268,11 @@ type GrantFeatures struct {
 	StatementResetFrequency     int   `json:"statement_reset_frequency"`
 	StatementTimeoutMs          int32 `json:"statement_timeout_ms"`            // Statement timeout for all SQL statements sent to the database (defaults to 30s)
 	StatementTimeoutMsQueryText int32 `json:"statement_timeout_ms_query_text"` // Statement timeout for pg_stat_statements query text requests (defaults to 120s)
+
+	// Optional full snapshot sections the server processes - sections not listed
+	// here are omitted, unless the server doesn't advertise this (nil), in which
+	// case everything is sent
+	SnapshotSections []string `json:"snapshot_sections"`
 }
 
 type Grant struct {
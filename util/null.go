@@ -1,6 +1,11 @@
 package util
 
-import "time"
+import (
+	"math"
+	"time"
+
+	"github.com/guregu/null"
+)
 
 func StringPtrToString(ptr *string) string {
 	if ptr == nil {
@@ -43,3 +48,20 @@ func IntPtrToString(ptr *int64) int64 {
 	}
 	return *ptr
 }
+
+// FiniteFloatOrZero - Returns 0 for NaN and +/-Infinity, which Postgres can
+// return for float columns (e.g. after a statistics reset)
+func FiniteFloatOrZero(f float64) float64 {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0
+	}
+	return f
+}
+
+// FiniteNullFloat - Returns an invalid (NULL) value for NaN and +/-Infinity
+func FiniteNullFloat(f null.Float) null.Float {
+	if f.Valid && (math.IsNaN(f.Float64) || math.IsInf(f.Float64, 0)) {
+		return null.Float{}
+	}
+	return f
+}
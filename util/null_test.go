@@ -0,0 +1,51 @@
+package util_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/util"
+)
+
+var finiteFloatOrZeroTests = []struct {
+	input    float64
+	expected float64
+}{
+	{0, 0},
+	{1.5, 1.5},
+	{-42, -42},
+	{math.NaN(), 0},
+	{math.Inf(1), 0},
+	{math.Inf(-1), 0},
+}
+
+func TestFiniteFloatOrZero(t *testing.T) {
+	for _, test := range finiteFloatOrZeroTests {
+		actual := util.FiniteFloatOrZero(test.input)
+		if actual != test.expected {
+			t.Errorf("\nInput: %v\n\tExpected: %v\n\tActual: %v\n", test.input, test.expected, actual)
+		}
+	}
+}
+
+var finiteNullFloatTests = []struct {
+	input    null.Float
+	expected null.Float
+}{
+	{null.Float{}, null.Float{}},
+	{null.FloatFrom(0), null.FloatFrom(0)},
+	{null.FloatFrom(12.25), null.FloatFrom(12.25)},
+	{null.FloatFrom(math.NaN()), null.Float{}},
+	{null.FloatFrom(math.Inf(1)), null.Float{}},
+	{null.FloatFrom(math.Inf(-1)), null.Float{}},
+}
+
+func TestFiniteNullFloat(t *testing.T) {
+	for _, test := range finiteNullFloatTests {
+		actual := util.FiniteNullFloat(test.input)
+		if actual.Valid != test.expected.Valid || (actual.Valid && actual.Float64 != test.expected.Float64) {
+			t.Errorf("\nInput: %v\n\tExpected: %v\n\tActual: %v\n", test.input, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package util
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/keybase/go-ps"
+)
+
+// WritePidfile - Writes the current process ID to the given file, through a temporary
+// file that gets renamed, so readers never see a partially written pidfile
+//
+// Unless skipRunningCheck is set, this refuses to overwrite a pidfile that points
+// to another running collector process.
+func WritePidfile(filename string, skipRunningCheck bool) error {
+	if !skipRunningCheck {
+		pid, err := readPidfile(filename)
+		if err == nil && pid != os.Getpid() && collectorProcessRunning(pid) {
+			return fmt.Errorf("pidfile %s points to a running collector process (PID %d)", filename, pid)
+		}
+	}
+
+	tmpFile, err := ioutil.TempFile(filepath.Dir(filename), "."+filepath.Base(filename)+".")
+	if err != nil {
+		return err
+	}
+	tmpFilename := tmpFile.Name()
+
+	_, err = tmpFile.WriteString(strconv.Itoa(os.Getpid()))
+	if err == nil {
+		err = tmpFile.Chmod(0644)
+	}
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpFilename, filename)
+	}
+	if err != nil {
+		os.Remove(tmpFilename)
+		return err
+	}
+
+	return nil
+}
+
+// RemovePidfile - Removes the given pidfile, unless it was overwritten by another process
+func RemovePidfile(filename string) error {
+	pid, err := readPidfile(filename)
+	if err != nil {
+		return err
+	}
+	if pid != os.Getpid() {
+		return nil
+	}
+	return os.Remove(filename)
+}
+
+func readPidfile(filename string) (int, error) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(content)))
+}
+
+// collectorProcessRunning - Whether the given PID belongs to a running process with
+// the same executable name as ours (to not be fooled by a recycled PID)
+func collectorProcessRunning(pid int) bool {
+	process, err := ps.FindProcess(pid)
+	if err != nil || process == nil {
+		return false
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return true
+	}
+	return process.Executable() == filepath.Base(executable)
+}
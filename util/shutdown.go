@@ -0,0 +1,65 @@
+package util
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultShutdownGracePeriod - How long in-flight API requests may continue after
+// the collector was asked to exit, unless configured otherwise
+const DefaultShutdownGracePeriod = 10 * time.Second
+
+var shutdown = struct {
+	sync.Mutex
+	ctx         context.Context
+	cancel      context.CancelFunc
+	gracePeriod time.Duration
+	started     bool
+}{gracePeriod: DefaultShutdownGracePeriod}
+
+func init() {
+	shutdown.ctx, shutdown.cancel = context.WithCancel(context.Background())
+}
+
+// SetShutdownGracePeriod - Sets how long in-flight API requests may continue
+// after BeginShutdown is called (0 cancels them right away, negative values use
+// the default)
+func SetShutdownGracePeriod(gracePeriod time.Duration) {
+	shutdown.Lock()
+	defer shutdown.Unlock()
+
+	if gracePeriod < 0 {
+		gracePeriod = DefaultShutdownGracePeriod
+	}
+	shutdown.gracePeriod = gracePeriod
+}
+
+// ShutdownContext - Context for API requests (e.g. snapshot submissions), which
+// gets cancelled once the shutdown grace period has passed
+func ShutdownContext() context.Context {
+	return shutdown.ctx
+}
+
+// BeginShutdown - Starts the shutdown grace period, after which all in-flight
+// (and new) API requests get cancelled, so the process can exit even if the
+// API doesn't respond
+func BeginShutdown(logger *Logger) {
+	shutdown.Lock()
+	defer shutdown.Unlock()
+
+	if shutdown.started {
+		return
+	}
+	shutdown.started = true
+
+	gracePeriod := shutdown.gracePeriod
+	time.AfterFunc(gracePeriod, func() {
+		select {
+		case <-shutdown.ctx.Done():
+		default:
+			logger.PrintVerbose("Shutdown grace period of %s expired, cancelling in-flight requests", gracePeriod)
+			shutdown.cancel()
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package util_test
+
+import (
+	"io/ioutil"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/util"
+)
+
+// Note: Shutting down can only happen once per process, so this is the only test
+// that may call BeginShutdown
+func TestShutdownWithoutGracePeriod(t *testing.T) {
+	util.SetShutdownGracePeriod(0)
+
+	select {
+	case <-util.ShutdownContext().Done():
+		t.Fatalf("Expected requests not to be cancelled before shutting down")
+	default:
+	}
+
+	util.BeginShutdown(&util.Logger{Destination: log.New(ioutil.Discard, "", 0)})
+
+	select {
+	case <-util.ShutdownContext().Done():
+	case <-time.After(time.Second):
+		t.Errorf("Expected requests to be cancelled right away when the grace period is 0")
+	}
+}
@@ -2,3 +2,8 @@ package util
 
 const CollectorVersion = "0.27.0"
 const CollectorNameAndVersion = "pganalyze-collector " + CollectorVersion
+
+// SnapshotSchemaVersion - Version of the full snapshot format as a whole. Optional
+// sections are negotiated separately through the snapshot sections advertised by
+// the server, so adding one doesn't require increasing this
+const SnapshotSchemaVersion = 1